@@ -28,6 +28,15 @@ type retryClient struct {
 
 func (c *retryClient) doWithCustomMarshaller(timeout time.Duration,
 	req *http.Request, data []byte, decode func([]byte) error, success func(code int) bool,
+) (err error) {
+	return c.doWithResponseDecoder(timeout, req, data,
+		func(_ http.Header, body []byte) error { return decode(body) }, success)
+}
+
+// doWithResponseDecoder is doWithCustomMarshaller, but the decoder also has
+// access to the response headers
+func (c *retryClient) doWithResponseDecoder(timeout time.Duration,
+	req *http.Request, data []byte, decode func(http.Header, []byte) error, success func(code int) bool,
 ) (err error) {
 	ctx, cancel := context.WithTimeout(req.Context(), timeout)
 	defer cancel()
@@ -50,7 +59,7 @@ func (c *retryClient) doWithCustomMarshaller(timeout time.Duration,
 			return shouldRetry(code), fmt.Errorf("status code: %v, error: %s", code, respBody)
 		}
 
-		if err := decode(respBody); err != nil {
+		if err := decode(res.Header, respBody); err != nil {
 			return false, fmt.Errorf("unmarshal response: %w", err)
 		}
 
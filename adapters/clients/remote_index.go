@@ -27,11 +27,14 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type RemoteIndex struct {
@@ -309,7 +312,11 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 
 func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 	shardName string, ids []strfmt.UUID,
-) ([]*storobj.Object, error) {
+) (_ []*storobj.Object, err error) {
+	ctx, span := tracing.Start(ctx, "remote.MultiGetObjects", attribute.String("host", hostName),
+		attribute.String("index", indexName), attribute.String("shard", shardName))
+	defer func() { tracing.End(span, err) }()
+
 	idsBytes, err := json.Marshal(ids)
 	if err != nil {
 		return nil, errors.Wrap(err, "marshal selectProps props")
@@ -367,6 +374,7 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 
 func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string,
 	vector []float32,
+	multiVector [][]float32,
 	targetVector string,
 	limit int,
 	filters *filters.LocalFilter,
@@ -375,10 +383,14 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 	cursor *filters.Cursor,
 	groupBy *searchparams.GroupBy,
 	additional additional.Properties,
-) ([]*storobj.Object, []float32, error) {
+) (_ []*storobj.Object, _ []float32, err error) {
+	ctx, span := tracing.Start(ctx, "remote.SearchShard", attribute.String("host", host),
+		attribute.String("index", index), attribute.String("shard", shard))
+	defer func() { tracing.End(span, err) }()
+
 	// new request
 	body, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+		Marshal(vector, multiVector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
 	}
@@ -392,21 +404,38 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 		return nil, nil, fmt.Errorf("create http request: %w", err)
 	}
 	clusterapi.IndicesPayloads.SearchParams.SetContentTypeHeaderReq(req)
+	prof := profile.FromContext(ctx)
+	if prof != nil {
+		req.Header.Set(profile.Header, "true")
+	}
 
 	// send request
 	resp := &searchShardResp{}
-	err = c.doWithCustomMarshaller(c.timeoutUnit*20, req, body, resp.decode, successCode)
-	return resp.Objects, resp.Distributions, err
+	err = c.doWithResponseDecoder(c.timeoutUnit*20, req, body, resp.decode, successCode)
+	if err != nil {
+		return nil, nil, err
+	}
+	prof.AddShards(resp.Profile)
+	return resp.Objects, resp.Distributions, nil
 }
 
 type searchShardResp struct {
 	Objects       []*storobj.Object
 	Distributions []float32
+	Profile       []*profile.Shard
 }
 
-func (r *searchShardResp) decode(data []byte) (err error) {
+func (r *searchShardResp) decode(header http.Header, data []byte) (err error) {
 	r.Objects, r.Distributions, err = clusterapi.IndicesPayloads.SearchResults.Unmarshal(data)
-	return
+	if err != nil {
+		return err
+	}
+	if p := header.Get(profile.Header); p != "" {
+		if err := json.Unmarshal([]byte(p), &r.Profile); err != nil {
+			return fmt.Errorf("unmarshal profile: %w", err)
+		}
+	}
+	return nil
 }
 
 type aggregateResp struct {
@@ -420,7 +449,11 @@ func (r *aggregateResp) decode(data []byte) (err error) {
 
 func (c *RemoteIndex) Aggregate(ctx context.Context, hostName, index,
 	shard string, params aggregation.Params,
-) (*aggregation.Result, error) {
+) (_ *aggregation.Result, err error) {
+	ctx, span := tracing.Start(ctx, "remote.Aggregate", attribute.String("host", hostName),
+		attribute.String("index", index), attribute.String("shard", shard))
+	defer func() { tracing.End(span, err) }()
+
 	// create new request
 	body, err := clusterapi.IndicesPayloads.AggregationParams.Marshal(params)
 	if err != nil {
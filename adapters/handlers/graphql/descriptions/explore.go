@@ -23,6 +23,15 @@ const (
 	Certainty            = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
 	Distance             = "The required degree of similarity between an object's characteristics and the provided filter values"
 	Vector               = "Target vector to be used in kNN search"
+	MultiVector          = "Set of query vectors to be scored against multi-vector embeddings using late interaction (MaxSim)"
+	SparseVector         = "Sparse query vector, scored against the sparse vectors of objects by their dot product"
+	SparseIndices        = "Dimensions of the non-zero weights of the sparse vector"
+	SparseValues         = "Non-negative weights of the sparse vector, in the order of the indices"
+	Recommend            = "Search for objects similar to the positive and dissimilar to the negative examples, the examples are never part of the results"
+	RecommendPositive    = "IDs of objects to find similar objects to"
+	RecommendNegative    = "IDs of objects to find dissimilar objects to"
+	RecommendVectors     = "Vectors used as examples in addition to the example objects"
+	RecommendStrategy    = "How the examples are combined, either \"average\" (default) to search near the average of the examples or \"best_score\" to rank objects by their distance to the closest example"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
@@ -66,6 +66,13 @@ const (
 	WhereValueRangeGeoCoordinatesLongitude = "The longitude (in decimal format) of the geoCoordinates to search around."
 	WhereValueRangeDistance                = "The distance from the point specified via geoCoordinates."
 	WhereValueRangeDistanceMax             = "The maximum distance from the point specified geoCoordinates."
+	WhereValueGeoPolygon                   = "Specify the outline of a polygon as a list of geo-coordinates (latitude and longitude as decimals). The search will return any result which is located inside the polygon."
+	WhereValueGeoPolygonPoints             = "The geoCoordinates of the outline of the polygon. The polygon is closed implicitly."
+	WhereValueGeoBoundingBox               = "Specify the top left and bottom right corners of a bounding box as geo-coordinates (latitude and longitude as decimals). The search will return any result which is located inside the box."
+	WhereValueGeoBoundingBoxTopLeft        = "The geoCoordinates of the top left (north west) corner of the bounding box."
+	WhereValueGeoBoundingBoxBottomRight    = "The geoCoordinates of the bottom right (south east) corner of the bounding box."
+	GeoCoordinatesLatitude                 = "The latitude (in decimal format) of the geoCoordinates."
+	GeoCoordinatesLongitude                = "The longitude (in decimal format) of the geoCoordinates."
 	WhereValueText                         = "Specify a Text value that the target property will be compared to"
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
 )
@@ -144,6 +151,10 @@ const (
 const (
 	SortPath  = "Specify the path from the Objects fields to the property name (e.g. ['Get', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	SortOrder = "Specify the sort order, either ascending (asc) which is default or descending (desc)"
+
+	SortGeoDistanceFrom          = "Sort a geoCoordinates property by its distance from these geo-coordinates instead of by its coordinates"
+	SortGeoDistanceFromLatitude  = "The latitude (in decimal format) of the point to calculate the distance from."
+	SortGeoDistanceFromLongitude = "The longitude (in decimal format) of the point to calculate the distance from."
 )
 
 const (
@@ -26,20 +26,22 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type: graphql.NewEnum(graphql.EnumConfig{
 				Name: fmt.Sprintf("%sWhereOperatorEnum", path),
 				Values: graphql.EnumValueConfigMap{
-					"And":              &graphql.EnumValueConfig{},
-					"Like":             &graphql.EnumValueConfig{},
-					"Or":               &graphql.EnumValueConfig{},
-					"Equal":            &graphql.EnumValueConfig{},
-					"Not":              &graphql.EnumValueConfig{},
-					"NotEqual":         &graphql.EnumValueConfig{},
-					"GreaterThan":      &graphql.EnumValueConfig{},
-					"GreaterThanEqual": &graphql.EnumValueConfig{},
-					"LessThan":         &graphql.EnumValueConfig{},
-					"LessThanEqual":    &graphql.EnumValueConfig{},
-					"WithinGeoRange":   &graphql.EnumValueConfig{},
-					"IsNull":           &graphql.EnumValueConfig{},
-					"ContainsAny":      &graphql.EnumValueConfig{},
-					"ContainsAll":      &graphql.EnumValueConfig{},
+					"And":                  &graphql.EnumValueConfig{},
+					"Like":                 &graphql.EnumValueConfig{},
+					"Or":                   &graphql.EnumValueConfig{},
+					"Equal":                &graphql.EnumValueConfig{},
+					"Not":                  &graphql.EnumValueConfig{},
+					"NotEqual":             &graphql.EnumValueConfig{},
+					"GreaterThan":          &graphql.EnumValueConfig{},
+					"GreaterThanEqual":     &graphql.EnumValueConfig{},
+					"LessThan":             &graphql.EnumValueConfig{},
+					"LessThanEqual":        &graphql.EnumValueConfig{},
+					"WithinGeoRange":       &graphql.EnumValueConfig{},
+					"WithinGeoPolygon":     &graphql.EnumValueConfig{},
+					"WithinGeoBoundingBox": &graphql.EnumValueConfig{},
+					"IsNull":               &graphql.EnumValueConfig{},
+					"ContainsAny":          &graphql.EnumValueConfig{},
+					"ContainsAll":          &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
@@ -77,6 +79,14 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 			Type:        newGeoRangeInputObject(path),
 			Description: descriptions.WhereValueRange,
 		},
+		"valueGeoPolygon": &graphql.InputObjectFieldConfig{
+			Type:        newGeoPolygonInputObject(path),
+			Description: descriptions.WhereValueGeoPolygon,
+		},
+		"valueGeoBoundingBox": &graphql.InputObjectFieldConfig{
+			Type:        newGeoBoundingBoxInputObject(path),
+			Description: descriptions.WhereValueGeoBoundingBox,
+		},
 	}
 
 	// Recurse into the same time.
@@ -139,3 +149,48 @@ func newGeoRangeDistanceInputObject(path string) *graphql.InputObject {
 		},
 	})
 }
+
+func newGeoPolygonInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoPolygonInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"points": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(
+					newGeoCoordinatesInputObject(path, "GeoPolygonPoints")))),
+				Description: descriptions.WhereValueGeoPolygonPoints,
+			},
+		},
+	})
+}
+
+func newGeoBoundingBoxInputObject(path string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhereGeoBoundingBoxInpObj", path),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"topLeft": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(newGeoCoordinatesInputObject(path, "GeoBoundingBoxTopLeft")),
+				Description: descriptions.WhereValueGeoBoundingBoxTopLeft,
+			},
+			"bottomRight": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(newGeoCoordinatesInputObject(path, "GeoBoundingBoxBottomRight")),
+				Description: descriptions.WhereValueGeoBoundingBoxBottomRight,
+			},
+		},
+	})
+}
+
+func newGeoCoordinatesInputObject(path, prefix string) *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sWhere%sGeoCoordinatesInpObj", path, prefix),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"latitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.GeoCoordinatesLatitude,
+			},
+			"longitude": &graphql.InputObjectFieldConfig{
+				Type:        graphql.NewNonNull(graphql.Float),
+				Description: descriptions.GeoCoordinatesLongitude,
+			},
+		},
+	})
+}
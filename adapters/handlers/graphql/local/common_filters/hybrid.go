@@ -142,6 +142,14 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if sparseVector, ok := source["sparseVector"].(map[string]interface{}); ok {
+		arguments, err := ExtractNearSparseVector(sparseVector)
+		if err != nil {
+			return nil, fmt.Errorf("sparseVector: %w", err)
+		}
+		args.SparseVectorParams = &arguments
+	}
+
 	args.Type = "hybrid"
 	return &args, nil
 }
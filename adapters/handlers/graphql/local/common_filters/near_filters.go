@@ -35,7 +35,11 @@ func NearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 	return graphql.InputObjectConfigFieldMap{
 		"vector": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Vector,
-			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+			Type:        graphql.NewList(graphql.Float),
+		},
+		"multiVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MultiVector,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
 		},
 		"certainty": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Certainty,
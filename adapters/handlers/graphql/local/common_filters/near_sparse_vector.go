@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func NearSparseVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"indices": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseIndices,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Int)),
+		},
+		"values": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseValues,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: "Target vector",
+			Type:        graphql.String,
+		},
+	}
+}
+
+// ExtractNearSparseVector arguments, such as "indices", "values" and "targetVector"
+func ExtractNearSparseVector(source map[string]interface{}) (searchparams.NearSparseVector, error) {
+	var args searchparams.NearSparseVector
+
+	indices, _ := source["indices"].([]interface{})
+	values, _ := source["values"].([]interface{})
+	if len(indices) != len(values) {
+		return searchparams.NearSparseVector{},
+			fmt.Errorf("sparse vector has %d indices but %d values", len(indices), len(values))
+	}
+
+	args.Indices = make([]uint32, len(indices))
+	for i, value := range indices {
+		index := value.(int)
+		if index < 0 || int64(index) > math.MaxUint32 {
+			return searchparams.NearSparseVector{},
+				fmt.Errorf("sparse vector index %d is out of range", index)
+		}
+		args.Indices[i] = uint32(index)
+	}
+
+	args.Values = make([]float32, len(values))
+	for i, value := range values {
+		args.Values[i] = float32(value.(float64))
+	}
+
+	if targetVector, ok := source["targetVector"].(string); ok {
+		args.TargetVector = targetVector
+	}
+
+	return args, nil
+}
@@ -17,15 +17,37 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
-// ExtractNearVector arguments, such as "vector" and "distance"
+// ExtractNearVector arguments, such as "vector", "multiVector" and "distance"
 func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector, error) {
 	var args searchparams.NearVector
 
-	// vector is a required argument, so we don't need to check for its existing
-	vector := source["vector"].([]interface{})
-	args.Vector = make([]float32, len(vector))
-	for i, value := range vector {
-		args.Vector[i] = float32(value.(float64))
+	vector, vectorOK := source["vector"].([]interface{})
+	multiVector, multiVectorOK := source["multiVector"].([]interface{})
+	if vectorOK && multiVectorOK {
+		return searchparams.NearVector{},
+			fmt.Errorf("cannot provide vector and multiVector")
+	}
+	if !vectorOK && !multiVectorOK {
+		return searchparams.NearVector{},
+			fmt.Errorf("either vector or multiVector is required")
+	}
+
+	if vectorOK {
+		args.Vector = make([]float32, len(vector))
+		for i, value := range vector {
+			args.Vector[i] = float32(value.(float64))
+		}
+	}
+
+	if multiVectorOK {
+		args.MultiVector = make([][]float32, len(multiVector))
+		for i, v := range multiVector {
+			values, _ := v.([]interface{})
+			args.MultiVector[i] = make([]float32, len(values))
+			for j, value := range values {
+				args.MultiVector[i][j] = float32(value.(float64))
+			}
+		}
 	}
 
 	certainty, certaintyOK := source["certainty"]
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func RecommendArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sRecommendInpObj", prefix),
+				Fields:      recommendFields(),
+				Description: descriptions.Recommend,
+			},
+		),
+	}
+}
+
+func recommendFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"positive": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendPositive,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"negative": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendNegative,
+			Type:        graphql.NewList(graphql.String),
+		},
+		"positiveVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendVectors,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+		},
+		"negativeVectors": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendVectors,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+		},
+		"strategy": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RecommendStrategy,
+			Type:        graphql.String,
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Distance,
+			Type:        graphql.Float,
+		},
+		"targetVectors": &graphql.InputObjectFieldConfig{
+			Description: "Target vectors",
+			Type:        graphql.NewList(graphql.String),
+		},
+	}
+}
+
+// ExtractRecommend arguments, such as "positive", "negative" and "strategy"
+func ExtractRecommend(source map[string]interface{}) (searchparams.Recommend, error) {
+	var args searchparams.Recommend
+
+	args.Positive = extractStrings(source["positive"])
+	args.Negative = extractStrings(source["negative"])
+	args.PositiveVectors = extractVectors(source["positiveVectors"])
+	args.NegativeVectors = extractVectors(source["negativeVectors"])
+
+	if strategy, ok := source["strategy"].(string); ok {
+		args.Strategy = strategy
+	}
+
+	certainty, certaintyOK := source["certainty"]
+	if certaintyOK {
+		args.Certainty = certainty.(float64)
+	}
+
+	distance, distanceOK := source["distance"]
+	if distanceOK {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
+	if certaintyOK && distanceOK {
+		return searchparams.Recommend{},
+			fmt.Errorf("cannot provide distance and certainty")
+	}
+
+	args.TargetVectors = extractStrings(source["targetVectors"])
+	return args, nil
+}
+
+func extractStrings(source interface{}) []string {
+	values, ok := source.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([]string, len(values))
+	for i, value := range values {
+		out[i] = value.(string)
+	}
+	return out
+}
+
+func extractVectors(source interface{}) [][]float32 {
+	vectors, ok := source.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	out := make([][]float32, len(vectors))
+	for i, v := range vectors {
+		values, _ := v.([]interface{})
+		out[i] = make([]float32, len(values))
+		for j, value := range values {
+			out[i][j] = float32(value.(float64))
+		}
+	}
+	return out
+}
@@ -228,6 +228,7 @@ func buildGetClassField(classObject *graphql.Object,
 			"sort":       sortArgument(class.Class),
 			"nearVector": nearVectorArgument(class.Class),
 			"nearObject": nearObjectArgument(class.Class),
+			"recommend":  recommendArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
@@ -236,6 +237,7 @@ func buildGetClassField(classObject *graphql.Object,
 	}
 
 	field.Args["bm25"] = bm25Argument(class.Class)
+	field.Args["nearSparseVector"] = nearSparseVectorArgument(class.Class)
 	field.Args["hybrid"] = hybridArgument(classObject, class, modulesProvider, fusionEnum)
 
 	if modulesProvider != nil {
@@ -387,6 +389,15 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		nearObjectParams = &p
 	}
 
+	var recommendParams *searchparams.Recommend
+	if recommend, ok := p.Args["recommend"]; ok {
+		p, err := common_filters.ExtractRecommend(recommend.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract recommend params: %s", err)
+		}
+		recommendParams = &p
+	}
+
 	var moduleParams map[string]interface{}
 	if r.modulesProvider != nil {
 		extractedParams := r.modulesProvider.ExtractSearchParams(p.Args, className)
@@ -405,6 +416,24 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		keywordRankingParams = &p
 	}
 
+	// extracts nearSparseVector, which is ranked like a keyword search
+	if nearSparseVector, ok := p.Args["nearSparseVector"]; ok {
+		if keywordRankingParams != nil {
+			return nil, fmt.Errorf("cannot provide bm25 and nearSparseVector")
+		}
+		if len(sort) > 0 {
+			return nil, fmt.Errorf("nearSparseVector search is not compatible with sort")
+		}
+		p, err := common_filters.ExtractNearSparseVector(nearSparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract nearSparseVector params: %w", err)
+		}
+		keywordRankingParams = &searchparams.KeywordRanking{
+			Type:         searchparams.KeywordRankingTypeSparse,
+			SparseVector: &p,
+		}
+	}
+
 	// Extract hybrid search params from the processed query
 	// Everything hybrid can go in another namespace AFTER modulesprovider is
 	// refactored
@@ -449,6 +478,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		Sort:                  sort,
 		NearVector:            nearVectorParams,
 		NearObject:            nearObjectParams,
+		Recommend:             recommendParams,
 		Group:                 group,
 		ModuleParams:          moduleParams,
 		AdditionalProperties:  addlProps,
@@ -566,7 +596,7 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "profile" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -680,6 +710,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "profile" {
+							additionalProps.Profile = true
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
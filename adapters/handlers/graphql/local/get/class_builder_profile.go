@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/profile"
+)
+
+func (b *classBuilder) additionalProfileField(class *models.Class) *graphql.Field {
+	filter := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalProfileShardFilter", class.Class),
+		Fields: graphql.Fields{
+			"tookMs":        &graphql.Field{Type: graphql.Float, Resolve: resolveProfileFilter(func(f *profile.Filter) interface{} { return ms(f.Took) })},
+			"allowListSize": &graphql.Field{Type: graphql.Int, Resolve: resolveProfileFilter(func(f *profile.Filter) interface{} { return f.AllowListSize })},
+		},
+	})
+	vector := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalProfileShardVector", class.Class),
+		Fields: graphql.Fields{
+			"tookMs":  &graphql.Field{Type: graphql.Float, Resolve: resolveProfileVector(func(v *profile.Vector) interface{} { return ms(v.Took) })},
+			"index":   &graphql.Field{Type: graphql.String, Resolve: resolveProfileVector(func(v *profile.Vector) interface{} { return v.Index })},
+			"flat":    &graphql.Field{Type: graphql.Boolean, Resolve: resolveProfileVector(func(v *profile.Vector) interface{} { return v.Flat })},
+			"ef":      &graphql.Field{Type: graphql.Int, Resolve: resolveProfileVector(func(v *profile.Vector) interface{} { return v.Ef })},
+			"visited": &graphql.Field{Type: graphql.Int, Resolve: resolveProfileVector(func(v *profile.Vector) interface{} { return v.Visited })},
+		},
+	})
+	bm25 := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalProfileShardBM25", class.Class),
+		Fields: graphql.Fields{
+			"tookMs":          &graphql.Field{Type: graphql.Float, Resolve: resolveProfileBM25(func(b *profile.BM25) interface{} { return ms(b.Took) })},
+			"terms":           &graphql.Field{Type: graphql.Int, Resolve: resolveProfileBM25(func(b *profile.BM25) interface{} { return b.Terms })},
+			"postingsScanned": &graphql.Field{Type: graphql.Int, Resolve: resolveProfileBM25(func(b *profile.BM25) interface{} { return b.PostingsScanned })},
+		},
+	})
+	shard := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalProfileShard", class.Class),
+		Fields: graphql.Fields{
+			"class":   &graphql.Field{Type: graphql.String, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Class })},
+			"shard":   &graphql.Field{Type: graphql.String, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Shard })},
+			"node":    &graphql.Field{Type: graphql.String, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Node })},
+			"search":  &graphql.Field{Type: graphql.String, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Search })},
+			"tookMs":  &graphql.Field{Type: graphql.Float, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return ms(s.Took) })},
+			"results": &graphql.Field{Type: graphql.Int, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Results })},
+			"sortMs":  &graphql.Field{Type: graphql.Float, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return ms(s.Sort) })},
+			"filter":  &graphql.Field{Type: filter, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Filter })},
+			"vector":  &graphql.Field{Type: vector, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.Vector })},
+			"bm25":    &graphql.Field{Type: bm25, Resolve: resolveProfileShard(func(s *profile.Shard) interface{} { return s.BM25 })},
+		},
+	})
+	phase := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%sAdditionalProfilePhase", class.Class),
+		Fields: graphql.Fields{
+			"name":   &graphql.Field{Type: graphql.String},
+			"tookMs": &graphql.Field{Type: graphql.Float},
+		},
+	})
+
+	return &graphql.Field{
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalProfile", class.Class),
+			Fields: graphql.Fields{
+				"shards": &graphql.Field{
+					Type: graphql.NewList(shard),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						prof, ok := p.Source.(*profile.Profile)
+						if !ok {
+							return nil, nil
+						}
+						return prof.Shards, nil
+					},
+				},
+				"phases": &graphql.Field{
+					Type: graphql.NewList(phase),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						prof, ok := p.Source.(*profile.Profile)
+						if !ok {
+							return nil, nil
+						}
+						return profilePhases(prof), nil
+					},
+				},
+			},
+		}),
+	}
+}
+
+// profilePhases returns the phases of the profile ordered by name, so that
+// the output is stable
+func profilePhases(prof *profile.Profile) []map[string]interface{} {
+	names := make([]string, 0, len(prof.Phases))
+	for name := range prof.Phases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	phases := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		phases[i] = map[string]interface{}{
+			"name":   name,
+			"tookMs": ms(prof.Phases[name]),
+		}
+	}
+	return phases
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func resolveProfileShard(fn func(*profile.Shard) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		s, ok := p.Source.(*profile.Shard)
+		if !ok {
+			return nil, nil
+		}
+		return fn(s), nil
+	}
+}
+
+func resolveProfileFilter(fn func(*profile.Filter) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		f, ok := p.Source.(*profile.Filter)
+		if !ok {
+			return nil, nil
+		}
+		return fn(f), nil
+	}
+}
+
+func resolveProfileVector(fn func(*profile.Vector) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		v, ok := p.Source.(*profile.Vector)
+		if !ok {
+			return nil, nil
+		}
+		return fn(v), nil
+	}
+}
+
+func resolveProfileBM25(fn func(*profile.BM25) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		b, ok := p.Source.(*profile.BM25)
+		if !ok {
+			return nil, nil
+		}
+		return fn(b), nil
+	}
+}
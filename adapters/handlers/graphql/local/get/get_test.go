@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	helper "github.com/weaviate/weaviate/test/helper"
@@ -477,6 +478,58 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional profile",
+			query: "{ Get { SomeAction { _additional { profile { shards { shard search tookMs results vector { index ef visited } } phases { name tookMs } } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Profile: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"profile": &profile.Profile{
+							Shards: []*profile.Shard{{
+								Shard:   "abc",
+								Search:  profile.SearchVector,
+								Took:    1500 * time.Microsecond,
+								Results: 10,
+								Vector:  &profile.Vector{Index: "hnsw", Ef: 64, Visited: 120},
+							}},
+							Phases: map[string]time.Duration{
+								profile.PhaseSort:       time.Millisecond,
+								profile.PhaseReferences: 2 * time.Millisecond,
+							},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"profile": map[string]interface{}{
+						"shards": []interface{}{
+							map[string]interface{}{
+								"shard":   "abc",
+								"search":  "vector",
+								"tookMs":  1.5,
+								"results": 10,
+								"vector": map[string]interface{}{
+									"index":   "hnsw",
+									"ef":      64,
+									"visited": 120,
+								},
+							},
+						},
+						"phases": []interface{}{
+							map[string]interface{}{"name": "referenceResolution", "tookMs": 2.0},
+							map[string]interface{}{"name": "sort", "tookMs": 1.0},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional classification",
 			query: "{ Get { SomeAction { _additional { classification { id completed classifiedFields scope basedOn }  } } } }",
@@ -1243,6 +1296,34 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with multi vector", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								multiVector: [[0.123, 0.984], [0.5, 0.25]]
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				MultiVector: [][]float32{{0.123, 0.984}, {0.5, 0.25}},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with vector and multi vector", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								multiVector: [[0.123, 0.984]]
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+
 	t.Run("for things with optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -1898,6 +1979,114 @@ func TestHybridWithSort(t *testing.T) {
 	resolver.AssertFailToResolve(t, query, "hybrid search is not compatible with sort")
 }
 
+func TestNearSparseVector(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolverWithNoModules()
+
+	t.Run("with indices and values", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearSparseVector: {
+								indices: [3, 17]
+								values: [0.5, 1.25]
+								targetVector: "splade"
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type: searchparams.KeywordRankingTypeSparse,
+				SparseVector: &searchparams.NearSparseVector{
+					Indices:      []uint32{3, 17},
+					Values:       []float32{0.5, 1.25},
+					TargetVector: "splade",
+				},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with mismatching indices and values", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearSparseVector: {
+								indices: [3, 17]
+								values: [0.5]
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query,
+			"failed to extract nearSparseVector params: sparse vector has 2 indices but 1 values")
+	})
+
+	t.Run("with negative index", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearSparseVector: {
+								indices: [-1]
+								values: [0.5]
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query,
+			"failed to extract nearSparseVector params: sparse vector index -1 is out of range")
+	})
+
+	t.Run("together with bm25", func(t *testing.T) {
+		query := `{ Get { SomeThing(bm25: {query: "apple"}, nearSparseVector: {
+								indices: [3]
+								values: [0.5]
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query, "cannot provide bm25 and nearSparseVector")
+	})
+}
+
+func TestRecommend(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolverWithNoModules()
+
+	t.Run("with examples and strategy", func(t *testing.T) {
+		query := `{ Get { SomeThing(recommend: {
+								positive: ["e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"]
+								negative: ["5cd2e20c-4d7e-3f2c-89d1-9a5a6e59a0f4"]
+								positiveVectors: [[0.5, 1.5]]
+								strategy: "best_score"
+								distance: 0.3
+								targetVectors: ["title"]
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Recommend: &searchparams.Recommend{
+				Positive:        []string{"e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"},
+				Negative:        []string{"5cd2e20c-4d7e-3f2c-89d1-9a5a6e59a0f4"},
+				PositiveVectors: [][]float32{{0.5, 1.5}},
+				Strategy:        searchparams.RecommendStrategyBestScore,
+				Distance:        0.3,
+				WithDistance:    true,
+				TargetVectors:   []string{"title"},
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with distance and certainty", func(t *testing.T) {
+		query := `{ Get { SomeThing(recommend: {
+								positive: ["e5dc4a4c-ef0f-3aed-89a3-a73435c6bbcf"]
+								distance: 0.3
+								certainty: 0.7
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query,
+			"failed to extract recommend params: cannot provide distance and certainty")
+	})
+}
+
 func TestNearObjectNoModules(t *testing.T) {
 	t.Parallel()
 
@@ -2280,6 +2469,29 @@ func TestSort(t *testing.T) {
 
 				tt.resolver.AssertResolve(t, query)
 			})
+
+			t.Run("sort by geo distance", func(t *testing.T) {
+				query := `{ Get { SomeAction(sort:[{
+										path: ["location"] order: asc
+										geoDistanceFrom: {latitude: 52.5, longitude: 13.4}
+									}]) { intField } } }`
+
+				lat, lon := float32(52.5), float32(13.4)
+				expectedParams := dto.GetParams{
+					ClassName:  "SomeAction",
+					Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+					Sort: []filters.Sort{{
+						Path:            []string{"location"},
+						Order:           "asc",
+						GeoDistanceFrom: &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+					}},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
 		})
 	}
 }
@@ -71,6 +71,16 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"sparseVector": &graphql.InputObjectFieldConfig{
+			Description: "Sparse vector used instead of the keyword search",
+			Type: graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name:        fmt.Sprintf("%sHybridSparseVectorInpObj", prefixName),
+					Fields:      common_filters.NearSparseVectorFields(prefixName),
+					Description: descriptions.SparseVector,
+				},
+			),
+		},
 
 		"searches": &graphql.InputObjectFieldConfig{
 			Description: "Subsearch list",
@@ -49,5 +49,21 @@ func sortFields(prefix string) graphql.InputObjectConfigFieldMap {
 				},
 			}),
 		},
+		"geoDistanceFrom": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SortGeoDistanceFrom,
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sSortGeoDistanceFromInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"latitude": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewNonNull(graphql.Float),
+						Description: descriptions.SortGeoDistanceFromLatitude,
+					},
+					"longitude": &graphql.InputObjectFieldConfig{
+						Type:        graphql.NewNonNull(graphql.Float),
+						Description: descriptions.SortGeoDistanceFromLongitude,
+					},
+				},
+			}),
+		},
 	}
 }
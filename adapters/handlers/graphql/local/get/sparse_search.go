@@ -15,6 +15,8 @@ import (
 	"fmt"
 
 	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 )
 
 func bm25Argument(className string) *graphql.ArgumentConfig {
@@ -41,3 +43,16 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func nearSparseVectorArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:        fmt.Sprintf("%sNearSparseVectorInpObj", prefix),
+				Fields:      common_filters.NearSparseVectorFields(prefix),
+				Description: descriptions.SparseVector,
+			},
+		),
+	}
+}
@@ -24,6 +24,9 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
 type Traverser interface {
@@ -67,8 +70,12 @@ func Build(schema *schema.Schema, traverser Traverser,
 }
 
 // Resolve at query time
-func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+func (g *graphQL) Resolve(ctx context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
+	ctx, span := tracing.Start(ctx, "graphql.Resolve",
+		attribute.String("graphql.operation.name", operationName))
+	defer span.End()
+
+	result := graphql.Do(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
 			"Resolver": g.traverser,
@@ -77,8 +84,13 @@ func (g *graphQL) Resolve(context context.Context, query string, operationName s
 		RequestString:  query,
 		OperationName:  operationName,
 		VariableValues: variables,
-		Context:        context,
+		Context:        ctx,
 	})
+	for _, err := range result.Errors {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Message)
+	}
+	return result
 }
 
 func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
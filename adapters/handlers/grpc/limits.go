@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// limitsStatus converts errors of rate limits and quotas to the status
+// ResourceExhausted. Rate limited requests carry the time until they would be
+// allowed as RetryInfo.
+func limitsStatus(err error) error {
+	var rateLimited ratelimiter.ErrRateLimited
+	if errors.As(err, &rateLimited) {
+		st := status.New(codes.ResourceExhausted, err.Error())
+		if detailed, derr := st.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(rateLimited.RetryAfter),
+		}); derr == nil {
+			st = detailed
+		}
+		return st.Err()
+	}
+	if errors.As(err, &objects.ErrQuotaExceeded{}) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+func limitsUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, limitsStatus(err)
+	}
+	return resp, nil
+}
+
+func limitsStreamInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := handler(srv, ss); err != nil {
+		return limitsStatus(err)
+	}
+	return nil
+}
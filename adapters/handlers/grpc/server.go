@@ -19,6 +19,7 @@ import (
 	pbv0 "github.com/weaviate/weaviate/grpc/generated/protocol/v0"
 	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip" // Install the gzip compressor
@@ -34,6 +35,8 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 	o := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(maxMsgSize),
 		grpc.MaxSendMsgSize(maxMsgSize),
+		grpc.ChainUnaryInterceptor(limitsUnaryInterceptor),
+		grpc.ChainStreamInterceptor(limitsStreamInterceptor),
 	}
 
 	// Add TLS creds for the GRPC connection, if defined.
@@ -47,6 +50,11 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		o = append(o, grpc.Creds(c))
 	}
 
+	if state.ServerConfig.Config.Tracing.Enabled {
+		// starts a span per call, continuing the trace of the client
+		o = append(o, grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	}
+
 	s := grpc.NewServer(o...)
 	weaviateV0 := v0.NewService()
 	weaviateV1 := v1.NewService(
@@ -57,7 +65,9 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
 		state.BatchManager,
+		state.ObjectsManager,
 		&state.ServerConfig.Config,
+		state.AuditLog,
 		state.Logger,
 	)
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
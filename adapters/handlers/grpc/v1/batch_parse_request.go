@@ -89,20 +89,79 @@ func batchFromProto(req *pb.BatchObjectsRequest, getClass func(string) *models.C
 			}
 		}
 
+		multiVectors, err := extractMultiVectors(obj.MultiVectors)
+		if err != nil {
+			objectErrors[i] = err
+			continue
+		}
+
+		sparseVectors, err := extractSparseVectors(obj.SparseVectors)
+		if err != nil {
+			objectErrors[i] = err
+			continue
+		}
+
 		objOriginalIndex[insertCounter] = i
 		objs = append(objs, &models.Object{
-			Class:      obj.Collection,
-			Tenant:     obj.Tenant,
-			Vector:     vector,
-			Properties: props,
-			ID:         strfmt.UUID(obj.Uuid),
-			Vectors:    vectors,
+			Class:         obj.Collection,
+			Tenant:        obj.Tenant,
+			Vector:        vector,
+			Properties:    props,
+			ID:            strfmt.UUID(obj.Uuid),
+			Vectors:       vectors,
+			MultiVectors:  multiVectors,
+			SparseVectors: sparseVectors,
 		})
 		insertCounter += 1
 	}
 	return objs[:insertCounter], objOriginalIndex, objectErrors
 }
 
+// extractMultiVectors groups the given vectors by name, entries of the same
+// name are placed at their index and must form a contiguous sequence
+func extractMultiVectors(in []*pb.Vectors) (models.MultiVectors, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	byName := make(map[string][]*pb.Vectors)
+	for _, vec := range in {
+		byName[vec.Name] = append(byName[vec.Name], vec)
+	}
+
+	out := make(models.MultiVectors, len(byName))
+	for name, vecs := range byName {
+		multiVector := make([]models.Vector, len(vecs))
+		for _, vec := range vecs {
+			if vec.Index >= uint64(len(vecs)) || multiVector[vec.Index] != nil {
+				return nil, fmt.Errorf("multi vector %q: invalid or duplicate index %d", name, vec.Index)
+			}
+			multiVector[vec.Index] = byteops.Float32FromByteVector(vec.VectorBytes)
+		}
+		out[name] = multiVector
+	}
+	return out, nil
+}
+
+func extractSparseVectors(in []*pb.SparseVector) (models.SparseVectors, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	out := make(models.SparseVectors, len(in))
+	for _, vec := range in {
+		if _, ok := out[vec.Name]; ok {
+			return nil, fmt.Errorf("duplicate sparse vector %q", vec.Name)
+		}
+		if len(vec.Indices) != len(vec.Values) {
+			return nil, fmt.Errorf("sparse vector %q: got %d indices but %d values",
+				vec.Name, len(vec.Indices), len(vec.Values))
+		}
+		out[vec.Name] = models.SparseVector{Indices: vec.Indices, Values: vec.Values}
+	}
+	return out, nil
+}
+
 func extractSingleRefTarget(class *models.Class, properties []*pb.BatchObject_SingleTargetRefProps, props map[string]interface{}) error {
 	for _, refSingle := range properties {
 		propName := refSingle.GetPropName()
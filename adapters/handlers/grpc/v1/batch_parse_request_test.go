@@ -137,6 +137,48 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "multi vectors",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, MultiVectors: []*pb.Vectors{
+				{Name: "custom", Index: 1, VectorBytes: byteVector([]float32{0.4, 0.5})},
+				{Name: "custom", Index: 0, VectorBytes: byteVector([]float32{0.1, 0.2})},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				MultiVectors: models.MultiVectors{
+					"custom": []models.Vector{{0.1, 0.2}, {0.4, 0.5}},
+				},
+			}},
+		},
+		{
+			name: "multi vectors with duplicate index",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, MultiVectors: []*pb.Vectors{
+				{Name: "custom", Index: 0, VectorBytes: byteVector([]float32{0.4, 0.5})},
+				{Name: "custom", Index: 0, VectorBytes: byteVector([]float32{0.1, 0.2})},
+			}}},
+			out:      []*models.Object{},
+			outError: []int{0},
+		},
+		{
+			name: "sparse vectors",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, SparseVectors: []*pb.SparseVector{
+				{Name: "custom", Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				SparseVectors: models.SparseVectors{
+					"custom": {Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}},
+				},
+			}},
+		},
+		{
+			name: "sparse vectors with mismatching values",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, SparseVectors: []*pb.SparseVector{
+				{Name: "custom", Indices: []uint32{3, 17}, Values: []float32{0.5}},
+			}}},
+			out:      []*models.Object{},
+			outError: []int{0},
+		},
 		{
 			name: "only mult ref",
 			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Properties: &pb.BatchObject_Properties{
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/objectfile"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) BulkLoad(ctx context.Context, req *pb.BulkLoadRequest) (*pb.BulkLoadReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	format, err := bulkLoadFormat(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// clients may only load files from the configured import directory
+	path, err := objectfile.ResolvePath(s.config.BulkLoad.ImportPath, req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r, err := objectfile.Open(path, format)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer r.Close()
+
+	count, err := s.batchManager.BulkLoad(ctx, principal, req.Collection, r)
+	s.auditLog.Log(audit.NewEvent(audit.SourceGRPC, principal, audit.OpBulkLoad).
+		WithClass(req.Collection, "").WithCount(int64(count)).WithError(err))
+	if err != nil {
+		return nil, fmt.Errorf("bulk load: %w", err)
+	}
+
+	return &pb.BulkLoadReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Objects: int64(count),
+	}, nil
+}
+
+func bulkLoadFormat(req *pb.BulkLoadRequest) (objectfile.Format, error) {
+	if req.Format != nil {
+		return objectfile.ParseFormat(*req.Format)
+	}
+	return objectfile.FormatFromPath(req.Path)
+}
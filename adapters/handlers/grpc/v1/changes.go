@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func (s *Service) StreamChanges(req *pb.ChangeStreamRequest, stream pb.Weaviate_StreamChangesServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	params := objects.ChangeStreamParams{
+		Class:         req.Collection,
+		Tenant:        req.GetTenant(),
+		Offsets:       req.Offsets,
+		IncludeObject: req.IncludeObject,
+	}
+
+	err = s.objectsManager.StreamChanges(ctx, principal, params, func(shard string, ev changelog.Event) error {
+		reply, err := changeEventToProto(shard, ev, req.IncludeObject)
+		if err != nil {
+			return fmt.Errorf("change event %d of shard %q: %w", ev.Offset, shard, err)
+		}
+		return stream.Send(reply)
+	})
+
+	switch {
+	case errors.Is(err, changelog.ErrOffsetOutOfRange):
+		// clients need to be able to tell that they have to re-sync
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, changelog.ErrClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return err
+	}
+}
+
+func changeEventToProto(shard string, ev changelog.Event, includeObject bool) (*pb.ChangeEvent, error) {
+	reply := &pb.ChangeEvent{
+		Shard:           shard,
+		Offset:          ev.Offset,
+		Uuid:            ev.ID.String(),
+		Collection:      ev.Class,
+		Tenant:          ev.Tenant,
+		TimestampUnixMs: ev.Timestamp.UnixMilli(),
+	}
+
+	switch ev.Operation {
+	case changelog.OperationPut:
+		reply.Operation = pb.ChangeEvent_OPERATION_PUT
+	case changelog.OperationMerge:
+		reply.Operation = pb.ChangeEvent_OPERATION_MERGE
+	case changelog.OperationDelete:
+		reply.Operation = pb.ChangeEvent_OPERATION_DELETE
+	default:
+		return nil, fmt.Errorf("unknown operation %q", ev.Operation)
+	}
+
+	if includeObject && ev.Object != nil {
+		obj, err := changeEventObjectToProto(ev.Object)
+		if err != nil {
+			return nil, err
+		}
+		reply.Object = obj
+	}
+
+	return reply, nil
+}
+
+func changeEventObjectToProto(obj *storobj.Object) (*pb.ChangeEventObject, error) {
+	out := &pb.ChangeEventObject{
+		CreationTimeUnix:   obj.CreationTimeUnix(),
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix(),
+	}
+
+	if props := obj.Properties(); props != nil {
+		// properties are stored with Go types (e.g. *models.GeoCoordinates)
+		// that structpb can not handle, their JSON representation can
+		asJSON, err := json.Marshal(props)
+		if err != nil {
+			return nil, fmt.Errorf("marshal properties: %w", err)
+		}
+		var asMap map[string]interface{}
+		if err := json.Unmarshal(asJSON, &asMap); err != nil {
+			return nil, fmt.Errorf("unmarshal properties: %w", err)
+		}
+		out.Properties, err = structpb.NewStruct(asMap)
+		if err != nil {
+			return nil, fmt.Errorf("properties: %w", err)
+		}
+	}
+
+	if len(obj.Vector) > 0 {
+		out.VectorBytes = byteops.Float32ToByteVector(obj.Vector)
+	}
+
+	names := make([]string, 0, len(obj.Vectors))
+	for name := range obj.Vectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out.Vectors = append(out.Vectors, &pb.Vectors{
+			Name:        name,
+			VectorBytes: byteops.Float32ToByteVector(obj.Vectors[name]),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestChangeEventToProto(t *testing.T) {
+	id := strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294")
+	ts := time.UnixMilli(1700000000000)
+	obj := storobj.FromObject(&models.Object{
+		Class:              "Article",
+		ID:                 id,
+		CreationTimeUnix:   1,
+		LastUpdateTimeUnix: 2,
+		Properties: map[string]interface{}{
+			"title":    "hello",
+			"location": &models.GeoCoordinates{Latitude: ptFloat32(1.5), Longitude: ptFloat32(2.5)},
+		},
+	}, []float32{1, 2, 3}, nil)
+
+	tests := []struct {
+		name          string
+		event         changelog.Event
+		includeObject bool
+		expected      *pb.ChangeEvent
+	}{
+		{
+			name: "put without object",
+			event: changelog.Event{
+				Offset: 7, Operation: changelog.OperationPut, ID: id,
+				Class: "Article", Timestamp: ts, Object: obj,
+			},
+			expected: &pb.ChangeEvent{
+				Shard: "shard1", Offset: 7, Operation: pb.ChangeEvent_OPERATION_PUT,
+				Uuid: id.String(), Collection: "Article", TimestampUnixMs: ts.UnixMilli(),
+			},
+		},
+		{
+			name: "delete of tenant",
+			event: changelog.Event{
+				Offset: 8, Operation: changelog.OperationDelete, ID: id,
+				Class: "Article", Tenant: "tenant1", Timestamp: ts,
+			},
+			includeObject: true,
+			expected: &pb.ChangeEvent{
+				Shard: "shard1", Offset: 8, Operation: pb.ChangeEvent_OPERATION_DELETE,
+				Uuid: id.String(), Collection: "Article", Tenant: "tenant1", TimestampUnixMs: ts.UnixMilli(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := changeEventToProto("shard1", tt.event, tt.includeObject)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+
+	t.Run("merge with object", func(t *testing.T) {
+		out, err := changeEventToProto("shard1", changelog.Event{
+			Offset: 9, Operation: changelog.OperationMerge, ID: id,
+			Class: "Article", Timestamp: ts, Object: obj,
+		}, true)
+		require.Nil(t, err)
+
+		assert.Equal(t, pb.ChangeEvent_OPERATION_MERGE, out.Operation)
+		require.NotNil(t, out.Object)
+		assert.Equal(t, int64(1), out.Object.CreationTimeUnix)
+		assert.Equal(t, int64(2), out.Object.LastUpdateTimeUnix)
+		assert.Equal(t, []float32{1, 2, 3}, byteops.Float32FromByteVector(out.Object.VectorBytes))
+
+		props := out.Object.Properties.AsMap()
+		assert.Equal(t, "hello", props["title"])
+		assert.Equal(t, map[string]interface{}{"latitude": 1.5, "longitude": 2.5}, props["location"])
+	})
+}
+
+func ptFloat32(in float32) *float32 {
+	return &in
+}
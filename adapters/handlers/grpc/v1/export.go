@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/audit"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) Export(req *pb.ExportRequest, stream pb.Weaviate_ExportServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	format, err := objectfile.ParseFormat(req.Format)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params := objects.ExportParams{
+		Class:     req.Collection,
+		Tenant:    req.GetTenant(),
+		After:     req.After,
+		BatchSize: int(req.BatchSize),
+	}
+	if req.Filters != nil {
+		getClass := s.schemaManager.ReadOnlyClass
+		clause, err := extractFilters(req.Filters, getClass, req.Collection)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter := &filters.LocalFilter{Root: &clause}
+		if err := filters.ValidateFilters(getClass, filter); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		params.Filters = filter
+	}
+
+	return s.objectsManager.ExportObjects(ctx, principal, params, func(objs []*models.Object, cursor string) error {
+		data, err := encodeObjects(format, objs)
+		if err != nil {
+			return fmt.Errorf("encode objects up to %s: %w", cursor, err)
+		}
+		return stream.Send(&pb.ExportReply{Data: data, Objects: int64(len(objs)), Cursor: cursor})
+	})
+}
+
+func (s *Service) Import(stream pb.Weaviate_ImportServer) (err error) {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	var (
+		params   objects.ImportParams
+		format   objectfile.Format
+		received bool
+		imported int64
+	)
+	// a single event covers all requests of the stream
+	defer func() {
+		if received {
+			s.auditLog.Log(audit.NewEvent(audit.SourceGRPC, principal, audit.OpImport).
+				WithClass(params.Class, params.Tenant).WithCount(imported).WithError(err))
+		}
+	}()
+	for seq := uint64(0); ; seq++ {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if seq == 0 {
+			received = true
+			if format, err = objectfile.ParseFormat(req.Format); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			params = objects.ImportParams{
+				Class:  req.Collection,
+				Tenant: req.GetTenant(),
+				Repl:   extractReplicationProperties(req.ConsistencyLevel),
+			}
+		}
+
+		r, err := objectfile.NewReader(bytes.NewReader(req.Data), int64(len(req.Data)), format)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "import request %d: %v", seq, err)
+		}
+		res, err := s.batchManager.ImportObjects(ctx, principal, params, r)
+		imported += res.Succeeded()
+		if err != nil {
+			return fmt.Errorf("import request %d: %w", seq, err)
+		}
+
+		reply := &pb.ImportReply{Sequence: seq, Objects: int64(len(res))}
+		for _, obj := range res {
+			if obj.Err != nil {
+				reply.Failed++
+				reply.Errors = append(reply.Errors, &pb.ImportError{
+					Index: int32(obj.OriginalIndex),
+					Error: obj.Err.Error(),
+				})
+			}
+		}
+		if err := stream.Send(reply); err != nil {
+			return err
+		}
+	}
+}
+
+func encodeObjects(format objectfile.Format, objs []*models.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := objectfile.NewWriter(&buf, format)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if err := w.Write(obj); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+)
+
+func TestEncodeObjects(t *testing.T) {
+	objs := []*models.Object{
+		{
+			Class:      "Article",
+			ID:         "8c29da7a-600a-43dc-85fb-83ab2b08c294",
+			Properties: map[string]interface{}{"title": "hello"},
+			Vector:     []float32{1, 2, 3},
+		},
+		{
+			Class:   "Article",
+			ID:      "9c29da7a-600a-43dc-85fb-83ab2b08c294",
+			Tenant:  "tenant1",
+			Vectors: models.Vectors{"title": []float32{4, 5}},
+		},
+	}
+
+	for _, format := range []objectfile.Format{objectfile.FormatJSONL, objectfile.FormatParquet} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := encodeObjects(format, objs)
+			require.Nil(t, err)
+
+			r, err := objectfile.NewReader(bytes.NewReader(data), int64(len(data)), format)
+			require.Nil(t, err)
+			for _, expected := range objs {
+				obj, err := r.Read()
+				require.Nil(t, err)
+				assert.Equal(t, expected.ID, obj.ID)
+				assert.Equal(t, expected.Tenant, obj.Tenant)
+				assert.Equal(t, expected.Vector, obj.Vector)
+				assert.Equal(t, expected.Vectors, obj.Vectors)
+			}
+			_, err = r.Read()
+			assert.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
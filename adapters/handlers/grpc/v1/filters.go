@@ -70,6 +70,10 @@ func extractFilters(filterIn *pb.Filters, getClass func(string) *models.Class, c
 			returnFilter.Operator = filters.ContainsAny
 		case pb.Filters_OPERATOR_CONTAINS_ALL:
 			returnFilter.Operator = filters.ContainsAll
+		case pb.Filters_OPERATOR_WITHIN_GEO_POLYGON:
+			returnFilter.Operator = filters.OperatorWithinGeoPolygon
+		case pb.Filters_OPERATOR_WITHIN_GEO_BOUNDING_BOX:
+			returnFilter.Operator = filters.OperatorWithinGeoBoundingBox
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
 		}
@@ -133,6 +137,22 @@ func extractFilters(filterIn *pb.Filters, getClass func(string) *models.Class, c
 				},
 				Distance: valueFilter.Distance,
 			}
+		case *pb.Filters_ValueGeoPolygon:
+			points := filterIn.GetValueGeoPolygon().Points
+			polygon := filters.GeoPolygon{Points: make([]*models.GeoCoordinates, len(points))}
+			for i, point := range points {
+				polygon.Points[i] = geoCoordinatesFromProto(point)
+			}
+			val = polygon
+		case *pb.Filters_ValueGeoBoundingBox:
+			valueFilter := filterIn.GetValueGeoBoundingBox()
+			if valueFilter.TopLeft == nil || valueFilter.BottomRight == nil {
+				return filters.Clause{}, fmt.Errorf("geo bounding box needs top left and bottom right to be set")
+			}
+			val = filters.GeoBoundingBox{
+				TopLeft:     geoCoordinatesFromProto(valueFilter.TopLeft),
+				BottomRight: geoCoordinatesFromProto(valueFilter.BottomRight),
+			}
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown value type %v", filterIn.TestValue)
 		}
@@ -298,3 +318,13 @@ func extractPathNew(getClass func(string) *models.Class, className string, targe
 		return nil, "", fmt.Errorf("unknown target type %v", target)
 	}
 }
+
+func geoCoordinatesFromProto(in *pb.GeoCoordinate) *models.GeoCoordinates {
+	if in == nil {
+		return nil
+	}
+	return &models.GeoCoordinates{
+		Latitude:  &in.Latitude,
+		Longitude: &in.Longitude,
+	}
+}
@@ -80,6 +80,20 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
 	}
 
+	if nsv := req.NearSparseVector; nsv != nil {
+		if req.Bm25Search != nil {
+			return out, fmt.Errorf("cannot provide bm25 and near_sparse_vector")
+		}
+		sparseVector, err := extractNearSparseVector(nsv)
+		if err != nil {
+			return out, fmt.Errorf("near_sparse_vector: %w", err)
+		}
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         searchparams.KeywordRankingTypeSparse,
+			SparseVector: sparseVector,
+		}
+	}
+
 	if nv := req.NearVector; nv != nil {
 		var vector []float32
 		// bytes vector has precedent for being more efficient
@@ -93,6 +107,16 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 			TargetVectors: nv.TargetVectors,
 		}
 
+		if len(nv.MultiVectorBytes) > 0 {
+			if len(vector) > 0 {
+				return out, fmt.Errorf("near_vector: cannot provide vector and multi vector")
+			}
+			out.NearVector.MultiVector = make([][]float32, len(nv.MultiVectorBytes))
+			for i, vectorBytes := range nv.MultiVectorBytes {
+				out.NearVector.MultiVector[i] = byteops.Float32FromByteVector(vectorBytes)
+			}
+		}
+
 		// The following business logic should not sit in the API. However, it is
 		// also part of the GraphQL API, so we need to duplicate it in order to get
 		// the same behavior
@@ -133,6 +157,13 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 		}
 	}
 
+	if rec := req.Recommend; rec != nil {
+		out.Recommend, err = extractRecommend(rec)
+		if err != nil {
+			return out, fmt.Errorf("recommend: %w", err)
+		}
+	}
+
 	if ni := req.NearImage; ni != nil {
 		nearImageOut, err := parseNearImage(ni)
 		if err != nil {
@@ -257,6 +288,13 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 			}
 		}
 
+		if hs.SparseVector != nil {
+			out.HybridSearch.SparseVectorParams, err = extractNearSparseVector(hs.SparseVector)
+			if err != nil {
+				return dto.GetParams{}, fmt.Errorf("hybrid sparse_vector: %w", err)
+			}
+		}
+
 		if nearTxt != nil {
 			out.HybridSearch.NearTextParams = &searchparams.NearTextParams{Values: nearTxt.Values, Limit: nearTxt.Limit, MoveAwayFrom: searchparams.ExploreMove{Force: nearTxt.MoveAwayFrom.Force, Values: nearTxt.MoveAwayFrom.Values}, MoveTo: searchparams.ExploreMove{Force: nearTxt.MoveTo.Force, Values: nearTxt.MoveTo.Values}}
 		}
@@ -305,7 +343,7 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 	}
 
 	if len(req.SortBy) > 0 {
-		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
+		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.NearSparseVector != nil || req.Recommend != nil || req.Generative != nil {
 			return dto.GetParams{}, errors.New("sorting cannot be combined with search")
 		}
 		out.Sort = extractSorting(req.SortBy)
@@ -324,6 +362,66 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 	return out, nil
 }
 
+func extractNearSparseVector(in *pb.NearSparseVector) (*searchparams.NearSparseVector, error) {
+	if len(in.Indices) != len(in.Values) {
+		return nil, fmt.Errorf("got %d indices but %d values", len(in.Indices), len(in.Values))
+	}
+	return &searchparams.NearSparseVector{
+		Indices:      in.Indices,
+		Values:       in.Values,
+		TargetVector: in.TargetVector,
+	}, nil
+}
+
+func extractRecommend(in *pb.Recommend) (*searchparams.Recommend, error) {
+	out := &searchparams.Recommend{
+		Positive:        in.Positive,
+		Negative:        in.Negative,
+		PositiveVectors: vectorsFromBytes(in.PositiveVectors),
+		NegativeVectors: vectorsFromBytes(in.NegativeVectors),
+		TargetVectors:   in.TargetVectors,
+	}
+
+	switch in.Strategy {
+	case pb.Recommend_STRATEGY_UNSPECIFIED, pb.Recommend_STRATEGY_AVERAGE:
+		out.Strategy = searchparams.RecommendStrategyAverage
+	case pb.Recommend_STRATEGY_BEST_SCORE:
+		out.Strategy = searchparams.RecommendStrategyBestScore
+	default:
+		return nil, fmt.Errorf("unknown strategy %v", in.Strategy)
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if in.Distance != nil && in.Certainty != nil {
+		return nil, fmt.Errorf("cannot provide distance and certainty")
+	}
+
+	if in.Certainty != nil {
+		out.Certainty = *in.Certainty
+	}
+
+	if in.Distance != nil {
+		out.Distance = *in.Distance
+		out.WithDistance = true
+	}
+
+	return out, nil
+}
+
+func vectorsFromBytes(in [][]byte) [][]float32 {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([][]float32, len(in))
+	for i, vectorBytes := range in {
+		out[i] = byteops.Float32FromByteVector(vectorBytes)
+	}
+	return out
+}
+
 func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams) (*searchparams.GroupBy, error) {
 	if len(groupIn.Path) != 1 {
 		return nil, fmt.Errorf("groupby path can only have one entry, received %v", groupIn.Path)
@@ -370,6 +468,9 @@ func extractTargetVectors(req *pb.SearchRequest, class *models.Class) (*[]string
 	if no := req.NearObject; no != nil {
 		targetVectors = &no.TargetVectors
 	}
+	if rec := req.Recommend; rec != nil {
+		targetVectors = &rec.TargetVectors
+	}
 	if nt := req.NearText; nt != nil {
 		targetVectors = &nt.TargetVectors
 	}
@@ -400,6 +501,9 @@ func extractSorting(sortIn []*pb.SortBy) []filters.Sort {
 			order = "desc"
 		}
 		sortOut[i] = filters.Sort{Order: order, Path: sortIn[i].Path}
+		if sortIn[i].GeoDistanceFrom != nil {
+			sortOut[i].GeoDistanceFrom = geoCoordinatesFromProto(sortIn[i].GeoDistanceFrom)
+		}
 	}
 	return sortOut
 }
@@ -721,6 +825,7 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		ExplainScore:       prop.ExplainScore,
 		IsConsistent:       prop.IsConsistent,
 		Vectors:            prop.Vectors,
+		Profile:            prop.QueryProfile,
 	}
 
 	// return all named vectors if vector is true
@@ -356,6 +356,128 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near vector with multi vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					MultiVectorBytes: [][]byte{byteVector([]float32{1, 2}), byteVector([]float32{3, 4})},
+					TargetVectors:    []string{"custom"},
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            multiVecClass,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true},
+				NearVector: &searchparams.NearVector{
+					MultiVector:   [][]float32{{1, 2}, {3, 4}},
+					TargetVectors: []string{"custom"},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near vector with vector and multi vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					Vector:           []float32{1, 2, 3},
+					MultiVectorBytes: [][]byte{byteVector([]float32{1, 2})},
+					TargetVectors:    []string{"custom"},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "near sparse vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearSparseVector: &pb.NearSparseVector{
+					Indices:      []uint32{3, 17},
+					Values:       []float32{0.5, 1.25},
+					TargetVector: "custom",
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            multiVecClass,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true},
+				KeywordRanking: &searchparams.KeywordRanking{
+					Type: searchparams.KeywordRankingTypeSparse,
+					SparseVector: &searchparams.NearSparseVector{
+						Indices:      []uint32{3, 17},
+						Values:       []float32{0.5, 1.25},
+						TargetVector: "custom",
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near sparse vector with mismatching values",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearSparseVector: &pb.NearSparseVector{
+					Indices: []uint32{3, 17},
+					Values:  []float32{0.5},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "recommend",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				Recommend: &pb.Recommend{
+					Positive:        []string{UUID3},
+					Negative:        []string{UUID4},
+					PositiveVectors: [][]byte{byteVector([]float32{1, 2})},
+					Strategy:        pb.Recommend_STRATEGY_BEST_SCORE,
+					Distance:        &one,
+					TargetVectors:   []string{"custom"},
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            multiVecClass,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true},
+				Recommend: &searchparams.Recommend{
+					Positive:        []string{UUID3},
+					Negative:        []string{UUID4},
+					PositiveVectors: [][]float32{{1, 2}},
+					Strategy:        searchparams.RecommendStrategyBestScore,
+					Distance:        1,
+					WithDistance:    true,
+					TargetVectors:   []string{"custom"},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "recommend with distance and certainty",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				Recommend: &pb.Recommend{
+					Positive:      []string{UUID3},
+					Distance:      &one,
+					Certainty:     &one,
+					TargetVectors: []string{"custom"},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Vectors throws error if no target vectors are given",
 			req: &pb.SearchRequest{
@@ -28,6 +28,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/search"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -63,6 +64,55 @@ func searchResultsToProto(res []interface{}, start time.Time, searchParams dto.G
 	return out, nil
 }
 
+func queryProfileToProto(prof *profile.Profile) *pb.QueryProfile {
+	ms := func(d time.Duration) float64 {
+		return float64(d) / float64(time.Millisecond)
+	}
+
+	out := &pb.QueryProfile{
+		Shards:   make([]*pb.QueryProfile_Shard, len(prof.Shards)),
+		PhasesMs: make(map[string]float64, len(prof.Phases)),
+	}
+	for i, s := range prof.Shards {
+		shard := &pb.QueryProfile_Shard{
+			Class:   s.Class,
+			Shard:   s.Shard,
+			Node:    s.Node,
+			Search:  s.Search,
+			TookMs:  ms(s.Took),
+			Results: int64(s.Results),
+			SortMs:  ms(s.Sort),
+		}
+		if s.Filter != nil {
+			shard.Filter = &pb.QueryProfile_Filter{
+				TookMs:        ms(s.Filter.Took),
+				AllowListSize: int64(s.Filter.AllowListSize),
+			}
+		}
+		if s.Vector != nil {
+			shard.Vector = &pb.QueryProfile_Vector{
+				TookMs:  ms(s.Vector.Took),
+				Index:   s.Vector.Index,
+				Flat:    s.Vector.Flat,
+				Ef:      int64(s.Vector.Ef),
+				Visited: int64(s.Vector.Visited),
+			}
+		}
+		if s.BM25 != nil {
+			shard.Bm25 = &pb.QueryProfile_BM25{
+				TookMs:          ms(s.BM25.Took),
+				Terms:           int64(s.BM25.Terms),
+				PostingsScanned: int64(s.BM25.PostingsScanned),
+			}
+		}
+		out.Shards[i] = shard
+	}
+	for phase, took := range prof.Phases {
+		out.PhasesMs[phase] = ms(took)
+	}
+	return out
+}
+
 func extractObjectsToResults(res []interface{}, searchParams dto.GetParams, getClass func(string) *models.Class, fromGroup, usesPropertiesMessage bool) ([]*pb.SearchResult, string, error) {
 	results := make([]*pb.SearchResult, len(res))
 	generativeGroupResultsReturn := ""
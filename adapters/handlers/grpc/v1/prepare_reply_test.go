@@ -26,11 +26,13 @@ import (
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
@@ -1570,3 +1572,30 @@ func TestGRPCReply(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryProfileToProto(t *testing.T) {
+	prof := profile.New()
+	prof.AddShards([]*profile.Shard{{
+		Class:   "Test",
+		Shard:   "abc",
+		Node:    "node1",
+		Search:  profile.SearchBM25,
+		Took:    2 * time.Millisecond,
+		Results: 3,
+		Filter:  &profile.Filter{Took: time.Millisecond, AllowListSize: 7},
+		BM25:    &profile.BM25{Took: time.Millisecond, Terms: 2, PostingsScanned: 40},
+	}})
+	prof.ObservePhase(profile.PhaseSort, 500*time.Microsecond)
+
+	out := queryProfileToProto(prof)
+	require.Len(t, out.Shards, 1)
+	shard := out.Shards[0]
+	assert.Equal(t, "abc", shard.Shard)
+	assert.Equal(t, "bm25", shard.Search)
+	assert.Equal(t, 2.0, shard.TookMs)
+	assert.Equal(t, int64(3), shard.Results)
+	assert.Equal(t, int64(7), shard.Filter.AllowListSize)
+	assert.Equal(t, int64(40), shard.Bm25.PostingsScanned)
+	assert.Nil(t, shard.Vector)
+	assert.Equal(t, map[string]float64{"sort": 0.5}, out.PhasesMs)
+}
@@ -25,8 +25,10 @@ import (
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
@@ -39,13 +41,16 @@ type Service struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	objectsManager       *objects.Manager
 	config               *config.Config
+	auditLog             *audit.Logger
 	logger               logrus.FieldLogger
 }
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, logger logrus.FieldLogger,
+	batchManager *objects.BatchManager, objectsManager *objects.Manager,
+	config *config.Config, auditLog *audit.Logger, logger logrus.FieldLogger,
 ) *Service {
 	return &Service{
 		traverser:            traverser,
@@ -53,7 +58,9 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		objectsManager:       objectsManager,
 		config:               config,
+		auditLog:             auditLog,
 		logger:               logger,
 	}
 }
@@ -77,6 +84,10 @@ func (s *Service) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (
 	}
 
 	response, err := s.batchManager.DeleteObjectsFromGRPC(ctx, principal, params, replicationProperties, tenant)
+	if !params.DryRun {
+		s.auditLog.Log(audit.NewEvent(audit.SourceGRPC, principal, audit.OpBatchDelete).
+			WithClass(req.Collection, tenant).WithCount(response.Objects.Succeeded()).WithError(err))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("batch delete: %w", err)
 	}
@@ -102,6 +113,8 @@ func (s *Service) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 
 	all := "ALL"
 	response, err := s.batchManager.AddObjects(ctx, principal, objs, []*string{&all}, replicationProperties)
+	s.auditLog.Log(audit.NewEvent(audit.SourceGRPC, principal, audit.OpBatchObjects).
+		WithCount(response.Succeeded()).WithError(err))
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +145,8 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		return nil, fmt.Errorf("extract auth: %w", err)
 	}
 
+	ctx, slowQuery := s.traverser.SlowQueryLog().Start(ctx, "grpc")
+
 	type reply struct {
 		Result *pb.SearchReply
 		Error  error
@@ -163,6 +178,10 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 			}
 		}
 
+		if searchParams.AdditionalProperties.Profile && profile.FromContext(ctx) == nil {
+			ctx = profile.NewContext(ctx, profile.New())
+		}
+
 		res, err := s.traverser.GetClass(ctx, principal, searchParams)
 		if err != nil {
 			c <- reply{
@@ -172,6 +191,10 @@ func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		}
 
 		proto, err := searchResultsToProto(res, before, searchParams, s.schemaManager.ReadOnlyClass, req.Uses_123Api)
+		if proto != nil && searchParams.AdditionalProperties.Profile {
+			proto.QueryProfile = queryProfileToProto(profile.FromContext(ctx))
+		}
+		slowQuery.End(searchParams, err)
 		c <- reply{
 			Result: proto,
 			Error:  err,
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/profile"
 	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -105,7 +106,7 @@ type shards interface {
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
 		id []strfmt.UUID) ([]*storobj.Object, error)
 	Search(ctx context.Context, indexName, shardName string,
-		vector []float32, targetVector string, distance float32, limit int,
+		vector []float32, multiVector [][]float32, targetVector string, distance float32, limit int,
 		filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 		sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
@@ -613,7 +614,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
+		vector, multiVector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -621,8 +622,17 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
+		ctx := r.Context()
+		var prof *profile.Profile
+		if r.Header.Get(profile.Header) != "" {
+			// the coordinating node profiles the query, the shard profiles
+			// are sent back in the response header
+			prof = profile.New()
+			ctx = profile.NewContext(ctx, prof)
+		}
+
+		results, dists, err := i.shards.Search(ctx, index, shard,
+			vector, multiVector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -634,6 +644,15 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
+		if prof != nil {
+			profBytes, err := json.Marshal(prof.Shards)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set(profile.Header, string(profBytes))
+		}
+
 		IndicesPayloads.SearchResults.SetContentTypeHeader(w)
 		w.Write(resBytes)
 	})
@@ -17,6 +17,7 @@ import (
 	"net/http"
 
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 func Serve(appState *state.State) {
@@ -51,7 +52,31 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/status", backups.Status())
 
 	mux.Handle("/", index())
-	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+
+	var handler http.Handler = mux
+	if appState.ServerConfig.Config.Tracing.Enabled {
+		// continues the trace of the coordinating node
+		handler = otelhttp.NewHandler(mux, "cluster", otelhttp.WithSpanNameFormatter(SpanName))
+	}
+
+	addr := fmt.Sprintf(":%d", port)
+	if appState.ClusterTLS == nil {
+		http.ListenAndServe(addr, handler)
+		return
+	}
+	server := &http.Server{
+		Addr:      addr,
+		Handler:   handler,
+		TLSConfig: appState.ClusterTLS.ServerConfig(),
+	}
+	// the certificates are provided by the TLS config
+	server.ListenAndServeTLS("", "")
+}
+
+// SpanName names the spans of intra-cluster requests on both the sending and
+// the receiving node
+func SpanName(_ string, r *http.Request) string {
+	return "cluster " + r.Method + " " + r.URL.Path
 }
 
 func index() http.Handler {
@@ -13,6 +13,7 @@ package rest
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -48,6 +49,7 @@ import (
 	rCluster "github.com/weaviate/weaviate/cluster"
 	rStore "github.com/weaviate/weaviate/cluster/store"
 	vectorIndex "github.com/weaviate/weaviate/entities/vectorindex"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -87,6 +89,7 @@ import (
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
 	modvoyageai "github.com/weaviate/weaviate/modules/text2vec-voyageai"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
@@ -96,17 +99,28 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/telemetry"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
 
+// clusterTLSReloadInterval is how often the certificates of the cluster
+// communication are checked for changes
+const clusterTLSReloadInterval = time.Minute
+
+// limitsReloadInterval is how often the file configuring rate limits and
+// quotas is checked for changes
+const limitsReloadInterval = 10 * time.Second
+
 func makeConfigureServer(appState *state.State) func(*http.Server, string, string) {
 	return func(s *http.Server, scheme, addr string) {
 		// Add properties to the config
@@ -165,7 +179,29 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("invalid config")
 	}
 
-	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig)
+	if tlsConfig := appState.ServerConfig.Config.Cluster.TLS; tlsConfig.Enabled {
+		clusterTLS, err := cluster.NewTLS(tlsConfig)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not load cluster tls certificates")
+		}
+		appState.ClusterTLS = clusterTLS
+		enterrors.GoWrapper(func() {
+			clusterTLS.Watch(context.Background(), clusterTLSReloadInterval, appState.Logger)
+		}, appState.Logger)
+	}
+	if auditConfig := appState.ServerConfig.Config.AuditLog; auditConfig.Enabled {
+		auditLog, err := newAuditLog(auditConfig, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not open audit log")
+		}
+		appState.AuditLog = auditLog
+	}
+	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig,
+		appState.ClusterTLS, appState.ServerConfig.Config.Tracing.Enabled)
 	appState.MemWatch = memwatch.NewMonitor(memwatch.LiveHeapReader, debug.SetMemoryLimit, 0.97)
 
 	var vectorRepo vectorRepo
@@ -177,27 +213,42 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Metrics = promMetrics
 	}
 
+	queryCache, err := querycache.New(appState.ServerConfig.Config.QueryCache, appState.Metrics)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid query cache")
+	}
+	appState.Modules.SetQueryCache(queryCache)
+
 	// TODO: configure http transport for efficient intra-cluster comm
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
 	repo, err := db.New(appState.Logger, db.Config{
-		ServerVersion:             config.ServerVersion,
-		GitHash:                   config.GitHash,
-		MemtablesFlushDirtyAfter:  appState.ServerConfig.Config.Persistence.MemtablesFlushDirtyAfter,
-		MemtablesInitialSizeMB:    10,
-		MemtablesMaxSizeMB:        appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
-		MemtablesMinActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
-		MemtablesMaxActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
-		RootPath:                  appState.ServerConfig.Config.Persistence.DataPath,
-		QueryLimit:                appState.ServerConfig.Config.QueryDefaults.Limit,
-		QueryMaximumResults:       appState.ServerConfig.Config.QueryMaximumResults,
-		QueryNestedRefLimit:       appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
-		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
-		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
-		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
-		AvoidMMap:                 appState.ServerConfig.Config.AvoidMmap,
-		DisableLazyLoadShards:     appState.ServerConfig.Config.DisableLazyLoadShards,
+		ServerVersion:                  config.ServerVersion,
+		GitHash:                        config.GitHash,
+		MemtablesFlushDirtyAfter:       appState.ServerConfig.Config.Persistence.MemtablesFlushDirtyAfter,
+		MemtablesInitialSizeMB:         10,
+		MemtablesMaxSizeMB:             appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds:      appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
+		MemtablesMaxActiveSeconds:      appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
+		RootPath:                       appState.ServerConfig.Config.Persistence.DataPath,
+		QueryLimit:                     appState.ServerConfig.Config.QueryDefaults.Limit,
+		QueryMaximumResults:            appState.ServerConfig.Config.QueryMaximumResults,
+		QueryNestedRefLimit:            appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
+		MaxImportGoroutinesFactor:      appState.ServerConfig.Config.MaxImportGoroutinesFactor,
+		TrackVectorDimensions:          appState.ServerConfig.Config.TrackVectorDimensions,
+		ResourceUsage:                  appState.ServerConfig.Config.ResourceUsage,
+		AvoidMMap:                      appState.ServerConfig.Config.AvoidMmap,
+		LSMCompactionStrategy:          appState.ServerConfig.Config.Persistence.LSMCompactionStrategy,
+		LSMCompactionMaxBytesPerSecond: appState.ServerConfig.Config.Persistence.LSMCompactionMaxBytesPerSecond,
+		LSMSegmentCompression:          appState.ServerConfig.Config.Persistence.LSMSegmentCompression,
+		HNSWDisableSnapshots:           appState.ServerConfig.Config.Persistence.HNSWDisableSnapshots,
+		HNSWSnapshotMinDeltaCommitlogs: appState.ServerConfig.Config.Persistence.HNSWSnapshotMinDeltaCommitlogs,
+		DisableLazyLoadShards:          appState.ServerConfig.Config.DisableLazyLoadShards,
+		ChangeLog:                      appState.ServerConfig.Config.ChangeLog,
+		QueryCache:                     queryCache,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -288,6 +339,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		LoadLegacySchema:   schemaRepo.LoadLegacySchema,
 		SaveLegacySchema:   schemaRepo.SaveLegacySchema,
 	}
+	if appState.ClusterTLS != nil {
+		rConfig.ServerTLS = appState.ClusterTLS.ServerConfig()
+		rConfig.ClientTLS = appState.ClusterTLS.ClientConfig
+	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
 		if strings.Contains(name, rConfig.NodeID) {
 			rConfig.Voter = true
@@ -321,8 +376,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.RemoteNodeIncoming = sharding.NewRemoteNodeIncoming(repo)
 	appState.RemoteReplicaIncoming = replica.NewRemoteReplicaIncoming(repo)
 
+	backupKey, err := backup.LoadEncryptionKey(appState.ServerConfig.Config.Backup)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("invalid backup encryption key")
+	}
 	backupManager := backup.NewHandler(appState.Logger, appState.Authorizer,
-		schemaManager, repo, appState.Modules)
+		schemaManager, repo, appState.Modules).WithEncryption(backupKey)
 	appState.BackupManager = backupManager
 
 	enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
@@ -344,14 +405,33 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	time.Sleep(2 * time.Second)
 	// TODO-RAFT END
 
+	if limits := appState.ServerConfig.Config.Limits; limits.ConfigPath != "" {
+		loader := &limitsLoader{
+			path:   limits.ConfigPath,
+			rates:  ratelimiter.NewPrincipals(ratelimiter.Limits{}),
+			quotas: objects.NewQuotas(nil, repo, limits.QuotaUsageRefreshInterval),
+		}
+		if _, err := loader.load(); err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not load rate limits and quotas")
+		}
+		appState.RateLimits, appState.Quotas = loader.rates, loader.quotas
+		enterrors.GoWrapper(func() {
+			loader.watch(context.Background(), limitsReloadInterval, appState.Logger)
+		}, appState.Logger)
+	}
+
 	batchManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.Metrics)
+	batchManager.SetLimits(appState.RateLimits, appState.Quotas)
 	appState.BatchManager = batchManager
 	objectsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	objectsTraverser.SetRateLimits(appState.RateLimits)
 	appState.Traverser = objectsTraverser
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState.Logger, appState, objectsTraverser)
@@ -464,14 +544,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Authorizer,
 		appState.Logger, appState.Modules)
 
-	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
+	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.AuditLog, appState.Logger)
 	objectsManager := objects.NewManager(appState.Locks,
 		appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+	objectsManager.SetLimits(appState.RateLimits, appState.Quotas)
+	appState.ObjectsManager = objectsManager
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
-		appState.Modules, appState.Metrics)
-	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
+		appState.Modules, appState.Metrics, appState.AuditLog)
+	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.AuditLog, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
 		appState.Metrics, appState.Logger)
 	setupMiscHandlers(api, appState.ServerConfig, appState.SchemaManager, appState.Modules,
@@ -484,8 +566,23 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		membership{appState.Cluster, appState.CloudService},
 		appState.SchemaManager,
 		appState.Logger)
-	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
-	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
+	backupSchedules := backup.NewScheduleManager(appState.Authorizer, backupScheduler,
+		appState.CloudService, appState.Logger)
+	backupSchedulesCtx, backupSchedulesCancel := context.WithCancel(context.Background())
+	enterrors.GoWrapper(func() {
+		backupSchedules.Run(backupSchedulesCtx, appState.ServerConfig.Config.Backup.ScheduleInterval)
+	}, appState.Logger)
+	setupBackupHandlers(api, backupScheduler, backupSchedules, appState.Metrics, appState.AuditLog,
+		appState.Logger)
+	setupNodesHandlers(api, appState.SchemaManager, appState.DB, backupSchedules, appState)
+
+	shutdownTracing, err := tracing.Init(context.Background(), appState.ServerConfig.Config.Tracing,
+		appState.Cluster.LocalName())
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not set up tracing")
+	}
 
 	grpcServer := createGrpcServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
@@ -518,6 +615,9 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// stop reindexing on server shutdown
 		appState.ReindexCtxCancel()
 
+		// stop running backup schedules
+		backupSchedulesCancel()
+
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
@@ -527,6 +627,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		if err := appState.CloudService.Close(ctx); err != nil {
 			panic(err)
 		}
+
+		if err := appState.AuditLog.Close(); err != nil {
+			appState.Logger.WithField("action", "stop_audit_log").
+				Errorf("failed to close audit log: %s", err.Error())
+		}
+
+		if err := shutdownTracing(ctx); err != nil {
+			appState.Logger.WithField("action", "stop_tracing").
+				Errorf("failed to flush traces: %s", err.Error())
+		}
 	}
 
 	startGrpcServer(grpcServer, appState)
@@ -1029,7 +1139,35 @@ func (c clientWithAuth) RoundTrip(r *http.Request) (*http.Response, error) {
 	return c.r.RoundTrip(r)
 }
 
-func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
+// clientWithTLS sends requests to other nodes over https. The clients of the
+// cluster API build http URLs, TLS is a property of the connection.
+type clientWithTLS struct {
+	r http.RoundTripper
+}
+
+func (c clientWithTLS) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Scheme == "http" {
+		r = r.Clone(r.Context())
+		r.URL.Scheme = "https"
+	}
+	return c.r.RoundTrip(r)
+}
+
+// newAuditLog creates the audit log writing to stdout or to a rotating file
+func newAuditLog(cfg config.AuditLog, logger logrus.FieldLogger) (*audit.Logger, error) {
+	if cfg.Output == config.DefaultAuditLogOutput {
+		return audit.New(os.Stdout, logger), nil
+	}
+	f, err := audit.OpenRotatingFile(cfg.Output, int64(cfg.MaxSizeMB)<<20, cfg.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return audit.New(f, logger), nil
+}
+
+func reasonableHttpClient(authConfig cluster.AuthConfig, clusterTLS *cluster.TLS,
+	tracingEnabled bool,
+) *http.Client {
 	t := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
@@ -1042,10 +1180,34 @@ func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
+	var r http.RoundTripper = t
+	if clusterTLS != nil {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 120 * time.Second,
+		}
+		// a fresh config for every connection picks up reloaded certificates
+		t.DialTLSContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			cfg := clusterTLS.ClientConfig()
+			if cfg.ServerName == "" {
+				host, _, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, err
+				}
+				cfg.ServerName = host
+			}
+			return (&tls.Dialer{NetDialer: dialer, Config: cfg}).DialContext(ctx, network, addr)
+		}
+		r = clientWithTLS{r: r}
+	}
 	if authConfig.BasicAuth.Enabled() {
-		return &http.Client{Transport: clientWithAuth{r: t, basicAuth: authConfig.BasicAuth}}
+		r = clientWithAuth{r: r, basicAuth: authConfig.BasicAuth}
+	}
+	if tracingEnabled {
+		// propagates the trace context to the remote node
+		r = otelhttp.NewTransport(r, otelhttp.WithSpanNameFormatter(clusterapi.SpanName))
 	}
-	return &http.Client{Transport: t}
+	return &http.Client{Transport: r}
 }
 
 func setupGoProfiling(config config.Config, logger logrus.FieldLogger) {
@@ -147,6 +147,147 @@ func init() {
         }
       }
     },
+    "/backup-schedules": {
+      "get": {
+        "description": "Returns all backup schedules of the cluster including the status of their last run",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Backup schedules successfully returned",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackupSchedule"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backup-schedules/{id}": {
+      "delete": {
+        "description": "Deletes a backup schedule. Backups which were already created by the schedule are kept.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of a backup schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Backup schedule successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "put": {
+        "description": "Creates a backup schedule or replaces an existing one. The leader of the cluster starts the backups of the schedule and prunes them according to its retention policy.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of a backup schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully stored",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/backups/{backend}": {
       "post": {
         "description": "Starts a process of creating a backup for a set of classes",
@@ -3079,6 +3220,17 @@ func init() {
           "minimum": 2,
           "x-nullable": false
         },
+        "Codec": {
+          "description": "compression algorithm of the backup chunks",
+          "type": "string",
+          "default": "gzip",
+          "enum": [
+            "gzip",
+            "zstd",
+            "none"
+          ],
+          "x-nullable": false
+        },
         "CompressionLevel": {
           "description": "compression level used by compression algorithm",
           "type": "string",
@@ -3117,6 +3269,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "incrementalBaseBackupId": {
+          "description": "The ID of a completed backup on the same backend to use as the base of an incremental backup. Segment files that did not change since the base backup are referenced instead of uploaded again.",
+          "type": "string"
         }
       }
     },
@@ -3196,6 +3352,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
+        "classMapping": {
+          "description": "Restores classes under new names. Maps the name of a class in the backup to the name it is restored as.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process",
           "type": "object",
@@ -3215,12 +3378,26 @@ func init() {
             "type": "string"
           }
         },
+        "mergeTenants": {
+          "description": "Restores the tenants of multi-tenant classes into existing classes with a compatible schema instead of failing because the classes exist. The restored tenants must not exist yet.",
+          "type": "boolean"
+        },
         "node_mapping": {
           "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
           "type": "object",
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "tenants": {
+          "description": "Restores only the given tenants of multi-tenant classes. Maps the name of a class in the backup to the tenants to restore.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
         }
       }
     },
@@ -3297,6 +3474,115 @@ func init() {
         }
       }
     },
+    "BackupRetention": {
+      "description": "Which backups created by a backup schedule to keep. Backups which are not kept by any of the rules are deleted from the backend. If no rule is set, all backups are kept.",
+      "type": "object",
+      "properties": {
+        "keepDailyDays": {
+          "description": "Number of days for which the most recent successful backup of each day (UTC) is kept",
+          "type": "integer",
+          "minimum": 0
+        },
+        "keepLast": {
+          "description": "Number of the most recent successful backups to keep",
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A schedule which periodically creates a backup of a set of classes and prunes old backups according to its retention policy",
+      "type": "object",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom configuration for the scheduled backups",
+          "type": "object",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "cron": {
+          "description": "Five field cron expression (minute, hour, day of month, month, day of week) evaluated in UTC. The macros @hourly, @daily, @weekly, @monthly and @every <duration> are supported as well.",
+          "type": "string",
+          "example": "0 3 * * *"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the scheduled backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule. Backups created by the schedule use it as prefix of their ID. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the scheduled backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "incremental": {
+          "description": "Base every backup of the schedule on the previous successful one, so that only segment files which changed since are uploaded. Backups still referenced by a retained backup are not pruned.",
+          "type": "boolean"
+        },
+        "retention": {
+          "description": "Which of the backups created by the schedule to keep",
+          "type": "object",
+          "$ref": "#/definitions/BackupRetention"
+        },
+        "status": {
+          "description": "Status of the schedule. It is set by the server and ignored in requests.",
+          "type": "object",
+          "$ref": "#/definitions/BackupScheduleStatus"
+        }
+      }
+    },
+    "BackupScheduleStatus": {
+      "description": "The status of a backup schedule",
+      "type": "object",
+      "properties": {
+        "backups": {
+          "description": "IDs of the backups created by the schedule which are kept by its retention policy",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule",
+          "type": "string"
+        },
+        "lastBackupId": {
+          "description": "The ID of the backup started by the last run",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "error message if the last run failed",
+          "type": "string"
+        },
+        "lastRunAt": {
+          "description": "time when the schedule last started a backup",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "lastStatus": {
+          "description": "phase of the backup started by the last run",
+          "type": "string"
+        },
+        "nextRunAt": {
+          "description": "time when the schedule will start the next backup",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
     "BatchDelete": {
       "type": "object",
       "properties": {
@@ -4053,6 +4339,16 @@ func init() {
         }
       }
     },
+    "MultiVectors": {
+      "description": "A map of named multi-vectors, each holding a variable number of vectors",
+      "type": "object",
+      "additionalProperties": {
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/Vector"
+        }
+      }
+    },
     "MultipleRef": {
       "description": "Multiple instances of references to other objects.",
       "type": "array",
@@ -4130,6 +4426,12 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "objectStorageBytes": {
+          "description": "The size of the objects stored in the shard in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -4209,6 +4511,13 @@ func init() {
       "description": "The status of all of the Weaviate nodes",
       "type": "object",
       "properties": {
+        "backupSchedules": {
+          "description": "Status of the backup schedules of the cluster",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupScheduleStatus"
+          }
+        },
         "nodes": {
           "type": "array",
           "items": {
@@ -4242,9 +4551,17 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "multiVectors": {
+          "description": "This field returns multi-vectors associated with the Object.",
+          "$ref": "#/definitions/MultiVectors"
+        },
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "sparseVectors": {
+          "description": "This field returns sparse vectors associated with the Object.",
+          "$ref": "#/definitions/SparseVectors"
+        },
         "tenant": {
           "description": "Name of the Objects tenant.",
           "type": "string"
@@ -4559,6 +4876,11 @@ func init() {
           "type": "number",
           "format": "float32"
         },
+        "confidence": {
+          "description": "Share of the (optionally distance-weighted) votes of the winning group, a number between 0 and 1",
+          "type": "number",
+          "format": "float32"
+        },
         "losingCount": {
           "description": "size of the losing group, can be 0 if the winning group size equals k",
           "type": "number",
@@ -4740,6 +5062,35 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "A sparse vector, given as the indices of its non-zero dimensions and their weights",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The indices of the non-zero dimensions.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the dimensions, in the same order as the indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "SparseVectors": {
+      "description": "A map of named sparse vectors",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/SparseVector"
+      }
+    },
     "StopwordConfig": {
       "description": "fine-grained control over stopword list usage",
       "type": "object",
@@ -4845,6 +5196,8 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
             "IsNull",
             "ContainsAny",
             "ContainsAll"
@@ -4898,6 +5251,18 @@ func init() {
           "x-omitempty": true,
           "example": "TODO"
         },
+        "valueGeoBoundingBox": {
+          "description": "value as geo bounding box",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as geo polygon",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -4980,6 +5345,33 @@ func init() {
         }
       }
     },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a bounding box given by its top left and bottom right corners",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "description": "bottom right (south east) corner",
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "description": "top left (north west) corner",
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within a polygon given by its outline",
+      "type": "object",
+      "properties": {
+        "points": {
+          "description": "points of the outline, the polygon is closed implicitly",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
+        }
+      }
+    },
     "WhereFilterGeoRange": {
       "description": "filter within a distance of a georange",
       "type": "object",
@@ -5170,45 +5562,143 @@ func init() {
           "200": {
             "description": "The application is able to respond to HTTP requests"
           }
-        }
-      }
-    },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
-        "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/backup-schedules": {
+      "get": {
+        "description": "Returns all backup schedules of the cluster including the status of their last run",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Backup schedules successfully returned",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackupSchedule"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backup-schedules/{id}": {
+      "delete": {
+        "description": "Deletes a backup schedule. Backups which were already created by the schedule are kept.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of a backup schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
+          "204": {
+            "description": "Backup schedule successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
-            "description": "Not found, no oidc provider present"
+            "description": "Not Found - Backup schedule does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -5217,22 +5707,65 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "operationId": "weaviate.wellknown.readiness",
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "put": {
+        "description": "Creates a backup schedule or replaces an existing one. The leader of the cluster starts the backups of the schedule and prunes them according to its retention policy.",
+        "tags": [
+          "backups"
+        ],
+        "operationId": "backups.schedules.put",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ID of a backup schedule. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
+            "description": "Backup schedule successfully stored",
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
           },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
       }
     },
     "/backups/{backend}": {
@@ -8289,6 +8822,17 @@ func init() {
           "minimum": 2,
           "x-nullable": false
         },
+        "Codec": {
+          "description": "compression algorithm of the backup chunks",
+          "type": "string",
+          "default": "gzip",
+          "enum": [
+            "gzip",
+            "zstd",
+            "none"
+          ],
+          "x-nullable": false
+        },
         "CompressionLevel": {
           "description": "compression level used by compression algorithm",
           "type": "string",
@@ -8327,6 +8871,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "incrementalBaseBackupId": {
+          "description": "The ID of a completed backup on the same backend to use as the base of an incremental backup. Segment files that did not change since the base backup are referenced instead of uploaded again.",
+          "type": "string"
         }
       }
     },
@@ -8406,6 +8954,13 @@ func init() {
     "BackupRestoreRequest": {
       "description": "Request body for restoring a backup for a set of classes",
       "properties": {
+        "classMapping": {
+          "description": "Restores classes under new names. Maps the name of a class in the backup to the name it is restored as.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
         "config": {
           "description": "Custom configuration for the backup restoration process",
           "type": "object",
@@ -8425,12 +8980,26 @@ func init() {
             "type": "string"
           }
         },
+        "mergeTenants": {
+          "description": "Restores the tenants of multi-tenant classes into existing classes with a compatible schema instead of failing because the classes exist. The restored tenants must not exist yet.",
+          "type": "boolean"
+        },
         "node_mapping": {
           "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
           "type": "object",
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "tenants": {
+          "description": "Restores only the given tenants of multi-tenant classes. Maps the name of a class in the backup to the tenants to restore.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
         }
       }
     },
@@ -8507,6 +9076,115 @@ func init() {
         }
       }
     },
+    "BackupRetention": {
+      "description": "Which backups created by a backup schedule to keep. Backups which are not kept by any of the rules are deleted from the backend. If no rule is set, all backups are kept.",
+      "type": "object",
+      "properties": {
+        "keepDailyDays": {
+          "description": "Number of days for which the most recent successful backup of each day (UTC) is kept",
+          "type": "integer",
+          "minimum": 0
+        },
+        "keepLast": {
+          "description": "Number of the most recent successful backups to keep",
+          "type": "integer",
+          "minimum": 0
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A schedule which periodically creates a backup of a set of classes and prunes old backups according to its retention policy",
+      "type": "object",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "config": {
+          "description": "Custom configuration for the scheduled backups",
+          "type": "object",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "cron": {
+          "description": "Five field cron expression (minute, hour, day of month, month, day of week) evaluated in UTC. The macros @hourly, @daily, @weekly, @monthly and @every <duration> are supported as well.",
+          "type": "string",
+          "example": "0 3 * * *"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the scheduled backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule. Backups created by the schedule use it as prefix of their ID. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of classes to include in the scheduled backups",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "incremental": {
+          "description": "Base every backup of the schedule on the previous successful one, so that only segment files which changed since are uploaded. Backups still referenced by a retained backup are not pruned.",
+          "type": "boolean"
+        },
+        "retention": {
+          "description": "Which of the backups created by the schedule to keep",
+          "type": "object",
+          "$ref": "#/definitions/BackupRetention"
+        },
+        "status": {
+          "description": "Status of the schedule. It is set by the server and ignored in requests.",
+          "type": "object",
+          "$ref": "#/definitions/BackupScheduleStatus"
+        }
+      }
+    },
+    "BackupScheduleStatus": {
+      "description": "The status of a backup schedule",
+      "type": "object",
+      "properties": {
+        "backups": {
+          "description": "IDs of the backups created by the schedule which are kept by its retention policy",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the schedule",
+          "type": "string"
+        },
+        "lastBackupId": {
+          "description": "The ID of the backup started by the last run",
+          "type": "string"
+        },
+        "lastError": {
+          "description": "error message if the last run failed",
+          "type": "string"
+        },
+        "lastRunAt": {
+          "description": "time when the schedule last started a backup",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        },
+        "lastStatus": {
+          "description": "phase of the backup started by the last run",
+          "type": "string"
+        },
+        "nextRunAt": {
+          "description": "time when the schedule will start the next backup",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
+        }
+      }
+    },
     "BatchDelete": {
       "type": "object",
       "properties": {
@@ -9422,6 +10100,16 @@ func init() {
         }
       }
     },
+    "MultiVectors": {
+      "description": "A map of named multi-vectors, each holding a variable number of vectors",
+      "type": "object",
+      "additionalProperties": {
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/Vector"
+        }
+      }
+    },
     "MultipleRef": {
       "description": "Multiple instances of references to other objects.",
       "type": "array",
@@ -9499,6 +10187,12 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "objectStorageBytes": {
+          "description": "The size of the objects stored in the shard in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -9578,6 +10272,13 @@ func init() {
       "description": "The status of all of the Weaviate nodes",
       "type": "object",
       "properties": {
+        "backupSchedules": {
+          "description": "Status of the backup schedules of the cluster",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BackupScheduleStatus"
+          }
+        },
         "nodes": {
           "type": "array",
           "items": {
@@ -9611,9 +10312,17 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "multiVectors": {
+          "description": "This field returns multi-vectors associated with the Object.",
+          "$ref": "#/definitions/MultiVectors"
+        },
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "sparseVectors": {
+          "description": "This field returns sparse vectors associated with the Object.",
+          "$ref": "#/definitions/SparseVectors"
+        },
         "tenant": {
           "description": "Name of the Objects tenant.",
           "type": "string"
@@ -9946,6 +10655,11 @@ func init() {
           "type": "number",
           "format": "float32"
         },
+        "confidence": {
+          "description": "Share of the (optionally distance-weighted) votes of the winning group, a number between 0 and 1",
+          "type": "number",
+          "format": "float32"
+        },
         "losingCount": {
           "description": "size of the losing group, can be 0 if the winning group size equals k",
           "type": "number",
@@ -10127,6 +10841,35 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "A sparse vector, given as the indices of its non-zero dimensions and their weights",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The indices of the non-zero dimensions.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the dimensions, in the same order as the indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "SparseVectors": {
+      "description": "A map of named sparse vectors",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/SparseVector"
+      }
+    },
     "StopwordConfig": {
       "description": "fine-grained control over stopword list usage",
       "type": "object",
@@ -10232,6 +10975,8 @@ func init() {
             "LessThan",
             "LessThanEqual",
             "WithinGeoRange",
+            "WithinGeoPolygon",
+            "WithinGeoBoundingBox",
             "IsNull",
             "ContainsAny",
             "ContainsAll"
@@ -10285,6 +11030,18 @@ func init() {
           "x-omitempty": true,
           "example": "TODO"
         },
+        "valueGeoBoundingBox": {
+          "description": "value as geo bounding box",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoBoundingBox"
+        },
+        "valueGeoPolygon": {
+          "description": "value as geo polygon",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoPolygon"
+        },
         "valueGeoRange": {
           "description": "value as geo coordinates and distance",
           "type": "object",
@@ -10367,6 +11124,33 @@ func init() {
         }
       }
     },
+    "WhereFilterGeoBoundingBox": {
+      "description": "filter within a bounding box given by its top left and bottom right corners",
+      "type": "object",
+      "properties": {
+        "bottomRight": {
+          "description": "bottom right (south east) corner",
+          "$ref": "#/definitions/GeoCoordinates"
+        },
+        "topLeft": {
+          "description": "top left (north west) corner",
+          "$ref": "#/definitions/GeoCoordinates"
+        }
+      }
+    },
+    "WhereFilterGeoPolygon": {
+      "description": "filter within a polygon given by its outline",
+      "type": "object",
+      "properties": {
+        "points": {
+          "description": "points of the outline, the polygon is closed implicitly",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GeoCoordinates"
+          }
+        }
+      }
+    },
     "WhereFilterGeoRange": {
       "description": "filter within a distance of a georange",
       "type": "object",
@@ -135,6 +135,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNotEqual, nil
 	case models.WhereFilterOperatorWithinGeoRange:
 		return filters.OperatorWithinGeoRange, nil
+	case models.WhereFilterOperatorWithinGeoPolygon:
+		return filters.OperatorWithinGeoPolygon, nil
+	case models.WhereFilterOperatorWithinGeoBoundingBox:
+		return filters.OperatorWithinGeoBoundingBox, nil
 	case models.WhereFilterOperatorAnd:
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
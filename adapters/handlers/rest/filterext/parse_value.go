@@ -162,6 +162,55 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// geo polygon
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoPolygon == nil {
+			return nil, nil
+		}
+
+		if len(in.ValueGeoPolygon.Points) < 3 {
+			return nil, fmt.Errorf("valueGeoPolygon: field 'points' must have at least 3 points")
+		}
+
+		points := make([]*models.GeoCoordinates, len(in.ValueGeoPolygon.Points))
+		for i, point := range in.ValueGeoPolygon.Points {
+			if point == nil {
+				return nil, fmt.Errorf("valueGeoPolygon: point at position %d must be set", i)
+			}
+			points[i] = &models.GeoCoordinates{
+				Latitude:  point.Latitude,
+				Longitude: point.Longitude,
+			}
+		}
+
+		return valueFilter(filters.GeoPolygon{Points: points},
+			schema.DataTypeGeoCoordinates), nil
+	},
+	// geo bounding box
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueGeoBoundingBox == nil {
+			return nil, nil
+		}
+
+		if in.ValueGeoBoundingBox.TopLeft == nil {
+			return nil, fmt.Errorf("valueGeoBoundingBox: field 'topLeft' must be set")
+		}
+
+		if in.ValueGeoBoundingBox.BottomRight == nil {
+			return nil, fmt.Errorf("valueGeoBoundingBox: field 'bottomRight' must be set")
+		}
+
+		return valueFilter(filters.GeoBoundingBox{
+			TopLeft: &models.GeoCoordinates{
+				Latitude:  in.ValueGeoBoundingBox.TopLeft.Latitude,
+				Longitude: in.ValueGeoBoundingBox.TopLeft.Longitude,
+			},
+			BottomRight: &models.GeoCoordinates{
+				Latitude:  in.ValueGeoBoundingBox.BottomRight.Latitude,
+				Longitude: in.ValueGeoBoundingBox.BottomRight.Longitude,
+			},
+		}, schema.DataTypeGeoCoordinates), nil
+	},
 	// deprecated string
 	func(in *models.WhereFilter) (*filters.Value, error) {
 		if in.ValueString == nil {
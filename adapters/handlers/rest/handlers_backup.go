@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	ubak "github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -25,36 +26,14 @@ import (
 
 type backupHandlers struct {
 	manager             *ubak.Scheduler
+	schedules           *ubak.ScheduleManager
 	metricRequestsTotal restApiRequestsTotal
+	auditLog            *audit.Logger
 }
 
 // compressionFromCfg transforms model backup config to a backup compression config
 func compressionFromBCfg(cfg *models.BackupConfig) ubak.Compression {
-	if cfg != nil {
-		if cfg.CPUPercentage == 0 {
-			cfg.CPUPercentage = ubak.DefaultCPUPercentage
-		}
-
-		if cfg.ChunkSize == 0 {
-			cfg.ChunkSize = ubak.DefaultChunkSize
-		}
-
-		if cfg.CompressionLevel == "" {
-			cfg.CompressionLevel = models.BackupConfigCompressionLevelDefaultCompression
-		}
-
-		return ubak.Compression{
-			CPUPercentage: int(cfg.CPUPercentage),
-			ChunkSize:     int(cfg.ChunkSize),
-			Level:         parseCompressionLevel(cfg.CompressionLevel),
-		}
-	}
-
-	return ubak.Compression{
-		Level:         ubak.DefaultCompression,
-		CPUPercentage: ubak.DefaultCPUPercentage,
-		ChunkSize:     ubak.DefaultChunkSize,
-	}
+	return ubak.CompressionFromConfig(cfg)
 }
 
 func compressionFromRCfg(cfg *models.RestoreConfig) ubak.Compression {
@@ -77,17 +56,6 @@ func compressionFromRCfg(cfg *models.RestoreConfig) ubak.Compression {
 	}
 }
 
-func parseCompressionLevel(l string) ubak.CompressionLevel {
-	switch {
-	case l == models.BackupConfigCompressionLevelBestSpeed:
-		return ubak.BestSpeed
-	case l == models.BackupConfigCompressionLevelBestCompression:
-		return ubak.BestCompression
-	default:
-		return ubak.DefaultCompression
-	}
-}
-
 func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -97,7 +65,11 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 		Include:     params.Body.Include,
 		Exclude:     params.Body.Exclude,
 		Compression: compressionFromBCfg(params.Body.Config),
+
+		IncrementalBaseBackupID: params.Body.IncrementalBaseBackupID,
 	})
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBackupCreate).
+		WithBackup(params.Body.ID).WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch err.(type) {
@@ -161,7 +133,13 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		Exclude:     params.Body.Exclude,
 		NodeMapping: params.Body.NodeMapping,
 		Compression: compressionFromRCfg(params.Body.Config),
+
+		ClassMapping: params.Body.ClassMapping,
+		Tenants:      params.Body.Tenants,
+		MergeTenants: params.Body.MergeTenants,
 	})
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBackupRestore).
+		WithBackup(params.ID).WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch err.(type) {
@@ -218,10 +196,86 @@ func (s *backupHandlers) restoreBackupStatus(params backups.BackupsRestoreStatus
 	return backups.NewBackupsRestoreStatusOK().WithPayload(&payload)
 }
 
+func (s *backupHandlers) listSchedules(params backups.BackupsSchedulesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedules, err := s.schedules.Schedules(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesListOK().WithPayload(schedules)
+}
+
+func (s *backupHandlers) putSchedule(params backups.BackupsSchedulesPutParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedule := params.Body
+	if schedule == nil {
+		schedule = &models.BackupSchedule{}
+	}
+	schedule.ID = params.ID
+	err := s.schedules.PutSchedule(params.HTTPRequest.Context(), principal, schedule)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBackupSchedulePut).
+		WithBackup(params.ID).WithError(err))
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesPutForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsSchedulesPutUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesPutInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesPutOK().WithPayload(schedule)
+}
+
+func (s *backupHandlers) deleteSchedule(params backups.BackupsSchedulesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.schedules.DeleteSchedule(params.HTTPRequest.Context(), principal, params.ID)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBackupScheduleDelete).
+		WithBackup(params.ID).WithError(err))
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesDeleteNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesDeleteNoContent()
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
-	scheduler *ubak.Scheduler, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+	scheduler *ubak.Scheduler, schedules *ubak.ScheduleManager,
+	metrics *monitoring.PrometheusMetrics, auditLog *audit.Logger, logger logrus.FieldLogger,
 ) {
-	h := &backupHandlers{scheduler, newBackupRequestsTotal(metrics, logger)}
+	h := &backupHandlers{scheduler, schedules, newBackupRequestsTotal(metrics, logger), auditLog}
 	api.BackupsBackupsCreateHandler = backups.
 		BackupsCreateHandlerFunc(h.createBackup)
 	api.BackupsBackupsCreateStatusHandler = backups.
@@ -230,6 +284,12 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsRestoreHandlerFunc(h.restoreBackup)
 	api.BackupsBackupsRestoreStatusHandler = backups.
 		BackupsRestoreStatusHandlerFunc(h.restoreBackupStatus)
+	api.BackupsBackupsSchedulesListHandler = backups.
+		BackupsSchedulesListHandlerFunc(h.listSchedules)
+	api.BackupsBackupsSchedulesPutHandler = backups.
+		BackupsSchedulesPutHandlerFunc(h.putSchedule)
+	api.BackupsBackupsSchedulesDeleteHandler = backups.
+		BackupsSchedulesDeleteHandlerFunc(h.deleteSchedule)
 }
 
 type backupRequestsTotal struct {
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/audit"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -29,6 +30,7 @@ import (
 type batchObjectHandlers struct {
 	manager             *objects.BatchManager
 	metricRequestsTotal restApiRequestsTotal
+	auditLog            *audit.Logger
 }
 
 func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
@@ -43,8 +45,13 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 
 	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
 		params.Body.Objects, params.Body.Fields, repl)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBatchObjects).
+		WithCount(objs.Succeeded()).WithError(err))
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		switch err.(type) {
 		case autherrs.Forbidden:
 			return batch.NewBatchObjectsCreateForbidden().
@@ -100,8 +107,13 @@ func (h *batchObjectHandlers) addReferences(params batch.BatchReferencesCreatePa
 	}
 
 	references, err := h.manager.AddReferences(params.HTTPRequest.Context(), principal, params.Body, repl)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBatchReferences).
+		WithCount(references.Succeeded()).WithError(err))
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		switch err.(type) {
 		case autherrs.Forbidden:
 			return batch.NewBatchReferencesCreateForbidden().
@@ -164,8 +176,16 @@ func (h *batchObjectHandlers) deleteObjects(params batch.BatchObjectsDeleteParam
 
 	res, err := h.manager.DeleteObjects(params.HTTPRequest.Context(), principal,
 		params.Body.Match, params.Body.DryRun, params.Body.Output, repl, tenant)
+	if params.Body.DryRun == nil || !*params.Body.DryRun {
+		h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpBatchDelete).
+			WithClass(batchDeleteClass(params.Body.Match), tenant).
+			WithCount(batchObjectsDeleted(res)).WithError(err))
+	}
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		if errors.As(err, &objects.ErrInvalidUserInput{}) {
 			return batch.NewBatchObjectsDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -236,8 +256,10 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
-func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
-	h := &batchObjectHandlers{manager, newBatchRequestsTotal(metrics, logger)}
+func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager, metrics *monitoring.PrometheusMetrics,
+	auditLog *audit.Logger, logger logrus.FieldLogger,
+) {
+	h := &batchObjectHandlers{manager, newBatchRequestsTotal(metrics, logger), auditLog}
 
 	api.BatchBatchObjectsCreateHandler = batch.
 		BatchObjectsCreateHandlerFunc(h.addObjects)
@@ -247,6 +269,20 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
 }
 
+func batchObjectsDeleted(res *objects.BatchDeleteResponse) int64 {
+	if res == nil {
+		return 0
+	}
+	return res.Result.Objects.Succeeded()
+}
+
+func batchDeleteClass(match *models.BatchDeleteMatch) string {
+	if match != nil {
+		return match.Class
+	}
+	return ""
+}
+
 type batchRequestsTotal struct {
 	*restApiRequestsTotalImpl
 }
@@ -268,7 +304,8 @@ func (e *batchRequestsTotal) logError(className string, err error) {
 	default:
 		if errors.As(err, &objects.ErrMultiTenancy{}) ||
 			errors.As(err, &objects.ErrInvalidUserInput{}) ||
-			errors.As(err, &autherrs.Forbidden{}) {
+			errors.As(err, &autherrs.Forbidden{}) ||
+			isTooManyRequests(err) {
 			e.logUserError(className)
 		} else {
 			e.logServerError(className, err)
@@ -116,6 +116,10 @@ func setupGraphQLHandlers(
 
 		result := graphQL.Resolve(ctx, query,
 			operationName, variables)
+		if err := graphQLRateLimited(result); err != nil {
+			metricRequestsTotal.logUserError()
+			return tooManyRequests(err)
+		}
 
 		// Marshal the JSON
 		resultJSON, jsonErr := json.Marshal(result)
@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/verbosity"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	backupUC "github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	nodesUC "github.com/weaviate/weaviate/usecases/nodes"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
@@ -31,6 +32,7 @@ import (
 
 type nodesHandlers struct {
 	manager             *nodesUC.Manager
+	backupSchedules     *backupUC.ScheduleManager
 	metricRequestsTotal restApiRequestsTotal
 }
 
@@ -48,6 +50,9 @@ func (n *nodesHandlers) getNodesStatus(params nodes.NodesGetParams, principal *m
 	status := &models.NodesStatusResponse{
 		Nodes: nodeStatuses,
 	}
+	if n.backupSchedules != nil {
+		status.BackupSchedules = n.backupSchedules.Statuses()
+	}
 
 	n.metricRequestsTotal.logOk("")
 	return nodes.NewNodesGetOK().WithPayload(status)
@@ -91,12 +96,13 @@ func (n *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 }
 
 func setupNodesHandlers(api *operations.WeaviateAPI,
-	schemaManger *schemaUC.Manager, repo *db.DB, appState *state.State,
+	schemaManger *schemaUC.Manager, repo *db.DB, backupSchedules *backupUC.ScheduleManager,
+	appState *state.State,
 ) {
 	nodesManager := nodesUC.NewManager(appState.Logger, appState.Authorizer,
 		repo, schemaManger)
 
-	h := &nodesHandlers{nodesManager, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
+	h := &nodesHandlers{nodesManager, backupSchedules, newNodesRequestsTotal(appState.Metrics, appState.Logger)}
 	api.NodesNodesGetHandler = nodes.
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/audit"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -38,6 +39,7 @@ type objectHandlers struct {
 	config              config.Config
 	modulesProvider     ModulesProvider
 	metricRequestsTotal restApiRequestsTotal
+	auditLog            *audit.Logger
 }
 
 type ModulesProvider interface {
@@ -88,8 +90,13 @@ func (h *objectHandlers) addObject(params objects.ObjectsCreateParams,
 
 	object, err := h.manager.AddObject(params.HTTPRequest.Context(),
 		principal, params.Body, repl)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpObjectCreate).
+		WithClass(className, getClassTenant(params.Body)).WithObjects(createdID(params.Body, object)).WithError(err))
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		if errors.As(err, &uco.ErrInvalidUserInput{}) {
 			return objects.NewObjectsCreateUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -188,6 +195,9 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 		params.ClassName, params.ID, additional, replProps, tenant)
 	if err != nil {
 		h.metricRequestsTotal.logError(getClassName(object), err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		switch err.(type) {
 		case autherrs.Forbidden:
 			return objects.NewObjectsClassGetForbidden().
@@ -232,6 +242,9 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 		getTenant(params.Tenant))
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		switch err.(type) {
 		case autherrs.Forbidden:
 			return objects.NewObjectsListForbidden().
@@ -284,6 +297,8 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 	if rerr != nil {
 		h.metricRequestsTotal.logError(req.Class, rerr)
 		switch rerr.Code {
+		case uco.StatusTooManyRequests:
+			return tooManyRequests(rerr)
 		case uco.StatusForbidden:
 			return objects.NewObjectsListForbidden().
 				WithPayload(errPayloadFromSingleErr(rerr))
@@ -332,8 +347,13 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 
 	err = h.manager.DeleteObject(params.HTTPRequest.Context(),
 		principal, params.ClassName, params.ID, repl, tenant)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpObjectDelete).
+		WithClass(params.ClassName, tenant).WithObjects(params.ID).WithError(err))
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		switch err.(type) {
 		case autherrs.Forbidden:
 			return objects.NewObjectsClassDeleteForbidden().
@@ -366,8 +386,13 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 
 	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(),
 		principal, params.ClassName, params.ID, params.Body, repl)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpObjectUpdate).
+		WithClass(params.ClassName, getClassTenant(params.Body)).WithObjects(params.ID).WithError(err))
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
+		if isTooManyRequests(err) {
+			return tooManyRequests(err)
+		}
 		if errors.As(err, &uco.ErrInvalidUserInput{}) {
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -409,6 +434,8 @@ func (h *objectHandlers) headObject(params objects.ObjectsClassHeadParams,
 	if objErr != nil {
 		h.metricRequestsTotal.logError(params.ClassName, objErr)
 		switch {
+		case objErr.TooManyRequests():
+			return tooManyRequests(objErr)
 		case objErr.Forbidden():
 			return objects.NewObjectsClassHeadForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -441,9 +468,13 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 	}
 
 	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpObjectPatch).
+		WithClass(params.ClassName, updates.Tenant).WithObjects(params.ID).WithError(objectsErr(objErr)))
 	if objErr != nil {
 		h.metricRequestsTotal.logError(getClassName(updates), objErr)
 		switch {
+		case objErr.TooManyRequests():
+			return tooManyRequests(objErr)
 		case objErr.NotFound():
 			return objects.NewObjectsClassPatchNotFound()
 		case objErr.Forbidden():
@@ -485,9 +516,13 @@ func (h *objectHandlers) addObjectReference(
 	tenant := getTenant(params.Tenant)
 
 	objErr := h.manager.AddObjectReference(params.HTTPRequest.Context(), principal, &input, repl, tenant)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpReferenceAdd).
+		WithClass(params.ClassName, tenant).WithObjects(params.ID).WithError(objectsErr(objErr)))
 	if objErr != nil {
 		h.metricRequestsTotal.logError(params.ClassName, objErr)
 		switch {
+		case objErr.TooManyRequests():
+			return tooManyRequests(objErr)
 		case objErr.Forbidden():
 			return objects.NewObjectsClassReferencesCreateForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -529,9 +564,13 @@ func (h *objectHandlers) putObjectReferences(params objects.ObjectsClassReferenc
 	tenant := getTenant(params.Tenant)
 
 	objErr := h.manager.UpdateObjectReferences(params.HTTPRequest.Context(), principal, &input, repl, tenant)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpReferenceUpdate).
+		WithClass(params.ClassName, tenant).WithObjects(params.ID).WithError(objectsErr(objErr)))
 	if objErr != nil {
 		h.metricRequestsTotal.logError(params.ClassName, objErr)
 		switch {
+		case objErr.TooManyRequests():
+			return tooManyRequests(objErr)
 		case objErr.Forbidden():
 			return objects.NewObjectsClassReferencesPutForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -572,9 +611,13 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 	tenant := getTenant(params.Tenant)
 
 	objErr := h.manager.DeleteObjectReference(params.HTTPRequest.Context(), principal, &input, repl, tenant)
+	h.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpReferenceDelete).
+		WithClass(params.ClassName, tenant).WithObjects(params.ID).WithError(objectsErr(objErr)))
 	if objErr != nil {
 		h.metricRequestsTotal.logError(params.ClassName, objErr)
 		switch objErr.Code {
+		case uco.StatusTooManyRequests:
+			return tooManyRequests(objErr)
 		case uco.StatusForbidden:
 			return objects.NewObjectsClassReferencesDeleteForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -598,9 +641,9 @@ func (h *objectHandlers) deleteObjectReference(params objects.ObjectsClassRefere
 
 func setupObjectHandlers(api *operations.WeaviateAPI,
 	manager *uco.Manager, config config.Config, logger logrus.FieldLogger,
-	modulesProvider ModulesProvider, metrics *monitoring.PrometheusMetrics,
+	modulesProvider ModulesProvider, metrics *monitoring.PrometheusMetrics, auditLog *audit.Logger,
 ) {
-	h := &objectHandlers{manager, logger, config, modulesProvider, newObjectsRequestsTotal(metrics, logger)}
+	h := &objectHandlers{manager, logger, config, modulesProvider, newObjectsRequestsTotal(metrics, logger), auditLog}
 	api.ObjectsObjectsCreateHandler = objects.
 		ObjectsCreateHandlerFunc(h.addObject)
 	api.ObjectsObjectsValidateHandler = objects.
@@ -816,7 +859,8 @@ func (e *objectsRequestsTotal) logError(className string, err error) {
 	default:
 		if errors.As(err, &uco.ErrInvalidUserInput{}) ||
 			errors.As(err, &uco.ErrMultiTenancy{}) ||
-			errors.As(err, &autherrs.Forbidden{}) {
+			errors.As(err, &autherrs.Forbidden{}) ||
+			isTooManyRequests(err) {
 			e.logUserError(className)
 		} else {
 			e.logServerError(className, err)
@@ -918,6 +962,33 @@ func getClassName(obj *models.Object) string {
 	return ""
 }
 
+func getClassTenant(obj *models.Object) string {
+	if obj != nil {
+		return obj.Tenant
+	}
+	return ""
+}
+
+// createdID returns the ID of the created object, which is generated if the
+// request did not specify one
+func createdID(requested, created *models.Object) strfmt.UUID {
+	if created != nil {
+		return created.ID
+	}
+	if requested != nil {
+		return requested.ID
+	}
+	return ""
+}
+
+// objectsErr converts err to an error interface which is nil if err is nil
+func objectsErr(err *uco.Error) error {
+	if err == nil {
+		return nil
+	}
+	return err
+}
+
 type errReplication struct {
 	err error
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
@@ -26,12 +27,15 @@ import (
 type schemaHandlers struct {
 	manager             *schemaUC.Manager
 	metricRequestsTotal restApiRequestsTotal
+	auditLog            *audit.Logger
 }
 
 func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	_, err := s.manager.AddClass(params.HTTPRequest.Context(), principal, params.ObjectClass)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpClassCreate).
+		WithClass(params.ObjectClass.Class, "").WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ObjectClass.Class, err)
 		switch err.(type) {
@@ -53,6 +57,8 @@ func (s *schemaHandlers) updateClass(params schema.SchemaObjectsUpdateParams,
 ) middleware.Responder {
 	err := s.manager.UpdateClass(params.HTTPRequest.Context(), principal, params.ClassName,
 		params.ObjectClass)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpClassUpdate).
+		WithClass(params.ClassName, "").WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		if err == schemaUC.ErrNotFound {
@@ -100,6 +106,8 @@ func (s *schemaHandlers) getClass(params schema.SchemaObjectsGetParams,
 
 func (s *schemaHandlers) deleteClass(params schema.SchemaObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 	err := s.manager.DeleteClass(params.HTTPRequest.Context(), principal, params.ClassName)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpClassDelete).
+		WithClass(params.ClassName, "").WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -119,6 +127,8 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	principal *models.Principal,
 ) middleware.Responder {
 	_, err := s.manager.AddClassProperty(params.HTTPRequest.Context(), principal, s.manager.ReadOnlyClass(params.ClassName), false, params.Body)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpPropertyAdd).
+		WithClass(params.ClassName, "").WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -204,6 +214,8 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 ) middleware.Responder {
 	_, err := s.manager.UpdateShardStatus(
 		params.HTTPRequest.Context(), principal, params.ClassName, params.ShardName, params.Body.Status)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpShardUpdate).
+		WithClass(params.ClassName, "").WithShard(params.ShardName, params.Body.Status).WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
 		switch err.(type) {
@@ -227,6 +239,8 @@ func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 ) middleware.Responder {
 	_, err := s.manager.AddTenants(
 		params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpTenantsCreate).
+		WithClass(params.ClassName, "").WithTenantStatus(params.Body).WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -248,6 +262,8 @@ func (s *schemaHandlers) updateTenants(params schema.TenantsUpdateParams,
 ) middleware.Responder {
 	err := s.manager.UpdateTenants(
 		params.HTTPRequest.Context(), principal, params.ClassName, params.Body)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpTenantsUpdate).
+		WithClass(params.ClassName, "").WithTenantStatus(params.Body).WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -271,6 +287,8 @@ func (s *schemaHandlers) deleteTenants(params schema.TenantsDeleteParams,
 ) middleware.Responder {
 	err := s.manager.DeleteTenants(
 		params.HTTPRequest.Context(), principal, params.ClassName, params.Tenants)
+	s.auditLog.Log(audit.NewEvent(audit.SourceREST, principal, audit.OpTenantsDelete).
+		WithClass(params.ClassName, "").WithTenants(params.Tenants...).WithError(err))
 	if err != nil {
 		s.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -326,8 +344,10 @@ func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principa
 	return schema.NewTenantExistsOK()
 }
 
-func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
-	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
+func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics,
+	auditLog *audit.Logger, logger logrus.FieldLogger,
+) {
+	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger), auditLog}
 
 	api.SchemaSchemaObjectsCreateHandler = schema.
 		SchemaObjectsCreateHandlerFunc(h.addClass)
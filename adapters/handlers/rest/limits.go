@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	tailorincgraphql "github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/gqlerrors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"gopkg.in/yaml.v2"
+)
+
+// isTooManyRequests returns whether err is caused by a rate limit or a quota
+func isTooManyRequests(err error) bool {
+	return errors.As(err, &ratelimiter.ErrRateLimited{}) ||
+		errors.As(err, &uco.ErrQuotaExceeded{})
+}
+
+// tooManyRequests responds with status 429 to requests rejected by rate
+// limits or quotas. The Retry-After header of rate limited requests holds
+// the seconds until the request would be allowed.
+func tooManyRequests(err error) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		var rateLimited ratelimiter.ErrRateLimited
+		if errors.As(err, &rateLimited) {
+			seconds := int(math.Ceil(rateLimited.RetryAfter.Seconds()))
+			rw.Header().Set("Retry-After", strconv.Itoa(max(1, seconds)))
+		}
+		rw.WriteHeader(http.StatusTooManyRequests)
+		if err := producer.Produce(rw, errPayloadFromSingleErr(err)); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	})
+}
+
+// limitsFile is the YAML file configuring rate limits and quotas at runtime
+// graphQLRateLimited returns the rate limit error of a GraphQL result, if a
+// query was rejected because of one. GraphQL errors carry no status code,
+// the whole request is responded to with status 429 instead.
+func graphQLRateLimited(result *tailorincgraphql.Result) error {
+	for _, gqlErr := range result.Errors {
+		err := gqlErr.OriginalError()
+		for err != nil {
+			var rateLimited ratelimiter.ErrRateLimited
+			if errors.As(err, &rateLimited) {
+				return rateLimited
+			}
+			switch e := err.(type) {
+			case *gqlerrors.Error:
+				err = e.OriginalError
+			case gqlerrors.FormattedError:
+				err = e.OriginalError()
+			case enterrors.ErrGraphQLUser:
+				err = e.OriginalError()
+			default:
+				err = nil
+			}
+		}
+	}
+	return nil
+}
+
+type limitsFile struct {
+	Rates  ratelimiter.Limits `yaml:"rates"`
+	Quotas []uco.Quota        `yaml:"quotas"`
+}
+
+func (f *limitsFile) validate() error {
+	rates := []ratelimiter.Rates{f.Rates.Default}
+	for _, r := range f.Rates.Principals {
+		rates = append(rates, r)
+	}
+	for _, r := range rates {
+		for _, rate := range []ratelimiter.Rate{r.Queries, r.Writes} {
+			if rate.PerSecond < 0 || rate.Burst < 0 {
+				return fmt.Errorf("rates must not be negative")
+			}
+		}
+	}
+	for _, q := range f.Quotas {
+		if q.Class == "" {
+			return fmt.Errorf("quota without class")
+		}
+		if q.MaxObjects < 0 || q.MaxBytes < 0 {
+			return fmt.Errorf("quota of class %q must not be negative", q.Class)
+		}
+	}
+	return nil
+}
+
+// limitsLoader applies the limits file to the rate limiter and quotas
+type limitsLoader struct {
+	path    string
+	rates   *ratelimiter.Principals
+	quotas  *uco.Quotas
+	version string
+}
+
+// load applies the limits file if it changed since it was last loaded
+func (l *limitsLoader) load() (bool, error) {
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return false, fmt.Errorf("stat limits file: %w", err)
+	}
+	version := fmt.Sprintf("%d-%d", info.Size(), info.ModTime().UnixNano())
+	if version == l.version {
+		return false, nil
+	}
+
+	content, err := os.ReadFile(l.path)
+	if err != nil {
+		return false, fmt.Errorf("read limits file: %w", err)
+	}
+	var f limitsFile
+	if err := yaml.UnmarshalStrict(content, &f); err != nil {
+		return false, fmt.Errorf("parse limits file: %w", err)
+	}
+	if err := f.validate(); err != nil {
+		return false, fmt.Errorf("invalid limits file: %w", err)
+	}
+
+	l.rates.SetLimits(f.Rates)
+	l.quotas.SetQuotas(f.Quotas)
+	l.version = version
+	return true, nil
+}
+
+// watch reloads the limits file every interval until ctx is done. Invalid
+// files are logged and the previous limits stay in place.
+func (l *limitsLoader) watch(ctx context.Context, interval time.Duration, logger logrus.FieldLogger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := l.load()
+			if err != nil {
+				logger.WithField("action", "reload_limits").WithError(err).
+					Error("could not reload rate limits and quotas")
+			} else if changed {
+				logger.WithField("action", "reload_limits").
+					Info("reloaded rate limits and quotas")
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tailorincgraphql "github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/gqlerrors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+func TestLimitsLoader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "limits.yaml")
+	write := func(content string, mtime time.Time) {
+		require.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+		require.Nil(t, os.Chtimes(path, mtime, mtime))
+	}
+	mtime := time.Now().Add(-time.Hour)
+	write(`
+rates:
+  default:
+    queries:
+      perSecond: 1
+  principals:
+    batch-job:
+      writes:
+        perSecond: 100
+        burst: 1000
+quotas:
+  - class: Article
+    tenant: "*"
+    maxObjects: 1000
+`, mtime)
+
+	l := &limitsLoader{
+		path:   path,
+		rates:  ratelimiter.NewPrincipals(ratelimiter.Limits{}),
+		quotas: uco.NewQuotas(nil, nil, time.Minute),
+	}
+	changed, err := l.load()
+	require.Nil(t, err)
+	assert.True(t, changed)
+
+	jane := &models.Principal{Username: "jane"}
+	require.Nil(t, l.rates.Allow(jane, ratelimiter.Queries))
+	assert.NotNil(t, l.rates.Allow(jane, ratelimiter.Queries))
+
+	changed, err = l.load()
+	require.Nil(t, err)
+	assert.False(t, changed)
+
+	// invalid files keep the previous limits
+	write("rates:\n  default:\n    queries:\n      perSecond: -1\n", mtime.Add(time.Minute))
+	_, err = l.load()
+	assert.ErrorContains(t, err, "rates must not be negative")
+	write("quotas:\n  - maxObjects: 10\n", mtime.Add(2*time.Minute))
+	_, err = l.load()
+	assert.ErrorContains(t, err, "quota without class")
+	write("ratez: {}\n", mtime.Add(3*time.Minute))
+	_, err = l.load()
+	assert.ErrorContains(t, err, "parse limits file")
+	assert.NotNil(t, l.rates.Allow(jane, ratelimiter.Queries))
+
+	write("rates: {}\n", mtime.Add(4*time.Minute))
+	changed, err = l.load()
+	require.Nil(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, l.rates.Allow(jane, ratelimiter.Queries))
+}
+
+func TestTooManyRequests(t *testing.T) {
+	t.Run("rate limited", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		err := ratelimiter.ErrRateLimited{Principal: "jane", Kind: ratelimiter.Writes, RetryAfter: 1500 * time.Millisecond}
+		require.True(t, isTooManyRequests(err))
+		tooManyRequests(err).WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, 429, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+		assert.Contains(t, rec.Body.String(), `"too many writes by \"jane\", retry after 1.5s"`)
+	})
+
+	t.Run("rate limited graphql query", func(t *testing.T) {
+		err := ratelimiter.ErrRateLimited{Principal: "jane", Kind: ratelimiter.Queries, RetryAfter: time.Second}
+		userErr := enterrors.NewErrGraphQLUser(err, "Get", "Article")
+		result := &tailorincgraphql.Result{Errors: []gqlerrors.FormattedError{
+			gqlerrors.FormatError(gqlerrors.NewLocatedError(userErr, nil)),
+		}}
+		assert.Equal(t, err, graphQLRateLimited(result))
+
+		result = &tailorincgraphql.Result{Errors: []gqlerrors.FormattedError{
+			gqlerrors.FormatError(gqlerrors.NewLocatedError(errors.New("other"), nil)),
+		}}
+		assert.Nil(t, graphQLRateLimited(result))
+	})
+
+	t.Run("quota exceeded", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		err := &uco.Error{Msg: "objects/Article", Code: uco.StatusTooManyRequests, Err: uco.ErrQuotaExceeded{
+			Quota: uco.Quota{Class: "Article", MaxObjects: 10}, Objects: 11,
+		}}
+		require.True(t, isTooManyRequests(err))
+		tooManyRequests(err).WriteResponse(rec, runtime.JSONProducer())
+
+		assert.Equal(t, 429, rec.Code)
+		assert.Empty(t, rec.Header().Get("Retry-After"))
+		assert.Contains(t, rec.Body.String(), `quota exceeded: class \"Article\" would store 11 objects, maximum is 10`)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
@@ -119,6 +120,9 @@ func makeSetupGlobalMiddleware(appState *state.State) func(http.Handler) http.Ha
 		if appState.ServerConfig.Config.Monitoring.Enabled {
 			handler = makeAddMonitoring(appState.Metrics)(handler)
 		}
+		if appState.ServerConfig.Config.Tracing.Enabled {
+			handler = addTracing(handler)
+		}
 		handler = addPreflight(handler, appState.ServerConfig.Config.CORS)
 		handler = addLiveAndReadyness(appState, handler)
 		handler = addHandleRoot(handler)
@@ -165,6 +169,15 @@ func makeAddMonitoring(metrics *monitoring.PrometheusMetrics) func(http.Handler)
 	}
 }
 
+// addTracing starts a span for every request, continuing the trace of the
+// client if it sent a trace context
+func addTracing(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "rest", otelhttp.WithSpanNameFormatter(
+		func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}))
+}
+
 func addPreflight(next http.Handler, cfg config.CORS) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", cfg.AllowOrigin)
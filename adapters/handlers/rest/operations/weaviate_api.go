@@ -81,6 +81,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BackupsBackupsSchedulesDeleteHandler: backups.BackupsSchedulesDeleteHandlerFunc(func(params backups.BackupsSchedulesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesDelete has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesListHandler: backups.BackupsSchedulesListHandlerFunc(func(params backups.BackupsSchedulesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesList has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesPutHandler: backups.BackupsSchedulesPutHandlerFunc(func(params backups.BackupsSchedulesPutParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesPut has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
@@ -281,6 +290,12 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BackupsBackupsSchedulesDeleteHandler sets the operation handler for the backups schedules delete operation
+	BackupsBackupsSchedulesDeleteHandler backups.BackupsSchedulesDeleteHandler
+	// BackupsBackupsSchedulesListHandler sets the operation handler for the backups schedules list operation
+	BackupsBackupsSchedulesListHandler backups.BackupsSchedulesListHandler
+	// BackupsBackupsSchedulesPutHandler sets the operation handler for the backups schedules put operation
+	BackupsBackupsSchedulesPutHandler backups.BackupsSchedulesPutHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
@@ -472,6 +487,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BackupsBackupsSchedulesDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesDeleteHandler")
+	}
+	if o.BackupsBackupsSchedulesListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesListHandler")
+	}
+	if o.BackupsBackupsSchedulesPutHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesPutHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
@@ -714,6 +738,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/.well-known/openid-configuration"] = well_known.NewGetWellKnownOpenidConfiguration(o.context, o.WellKnownGetWellKnownOpenidConfigurationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backup-schedules/{id}"] = backups.NewBackupsSchedulesDelete(o.context, o.BackupsBackupsSchedulesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backup-schedules"] = backups.NewBackupsSchedulesList(o.context, o.BackupsBackupsSchedulesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/backup-schedules/{id}"] = backups.NewBackupsSchedulesPut(o.context, o.BackupsBackupsSchedulesPutHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	rCluster "github.com/weaviate/weaviate/cluster"
+	"github.com/weaviate/weaviate/usecases/audit"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/anonymous"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/oidc"
@@ -33,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
@@ -67,7 +69,12 @@ type State struct {
 	BackupManager      *backup.Handler
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
+	ObjectsManager     *objects.Manager
 	ClusterHttpClient  *http.Client
+	ClusterTLS         *cluster.TLS            // nil if intra-cluster TLS is disabled
+	AuditLog           *audit.Logger           // nil if audit logging is disabled
+	RateLimits         *ratelimiter.Principals // nil if rate limits are disabled
+	Quotas             *objects.Quotas         // nil if quotas are disabled
 	ReindexCtxCancel   context.CancelFunc
 	MemWatch           *memwatch.Monitor
 	/// TODO-RAFT START
@@ -0,0 +1,280 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// A bulk load imports objects into empty shards without going through the
+// regular write path. For every affected shard the objects are written into
+// a staging shard next to it by a shardBulkWriter. It buffers the entries of
+// each LSM bucket (objects, inverted indexes, property lengths, dimensions)
+// in memory, sorts them by key and writes them as segments directly, without
+// memtables or a write-ahead log. The vectors are added to each vector index
+// in a single pass once all objects were written, an HNSW index builds its
+// graph in bulk and writes it to its commit log only once. Afterwards the
+// staging shard is shut down, marked complete and moved into the place of
+// the target shard.
+//
+// A crash before the staging shard was marked complete loses the load. The
+// staging shard is discarded by recoverBulkLoad when the target shard is
+// loaded the next time, which stays empty, and the bulk load has to be run
+// again. A crash after the staging shard was marked complete loses nothing,
+// recoverBulkLoad completes the move.
+
+const (
+	bulkLoadStagingSuffix  = ".bulkload"
+	bulkLoadReplacedSuffix = ".bulkload-replaced"
+	bulkLoadCompleteFile   = "bulkload.complete"
+)
+
+// BulkLoadObjects imports all objects returned by r into the local, empty
+// shards of the given class. Each shard is replaced atomically once all of
+// its objects were written. Objects are expected to be validated already.
+func (db *DB) BulkLoadObjects(ctx context.Context, className string,
+	r objectfile.Reader,
+) (int, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return 0, fmt.Errorf("bulk load into non-existing index for %s", className)
+	}
+
+	idx.dropIndex.RLock()
+	defer idx.dropIndex.RUnlock()
+
+	count, err := idx.bulkLoad(ctx, r)
+	if err != nil {
+		return count, fmt.Errorf("bulk load into index %s: %w", idx.ID(), err)
+	}
+	return count, nil
+}
+
+type shardBulkLoad struct {
+	name    string
+	target  ShardLike
+	staging *Shard
+	writer  *shardBulkWriter
+	// set once the staging shard was shut down
+	closed bool
+}
+
+func (i *Index) bulkLoad(ctx context.Context, r objectfile.Reader) (int, error) {
+	if i.replicationEnabled() {
+		return 0, fmt.Errorf("bulk load is not supported for replicated classes")
+	}
+
+	loads := map[string]*shardBulkLoad{}
+	defer func() {
+		for _, load := range loads {
+			i.abortShardBulkLoad(load)
+		}
+	}()
+
+	count := 0
+	for {
+		obj, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("read object %d: %w", count, err)
+		}
+
+		object := storobj.FromObject(obj, obj.Vector, obj.Vectors)
+		if err := i.validateMultiTenancy(obj.Tenant); err != nil {
+			return 0, fmt.Errorf("object %s: %w", obj.ID, err)
+		}
+		shardName, err := i.determineObjectShard(obj.ID, obj.Tenant)
+		if err != nil {
+			return 0, fmt.Errorf("object %s: %w", obj.ID, err)
+		}
+
+		load, ok := loads[shardName]
+		if !ok {
+			if load, err = i.beginShardBulkLoad(ctx, shardName); err != nil {
+				return 0, err
+			}
+			loads[shardName] = load
+		}
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if err := load.writer.put(ctx, object); err != nil {
+			return 0, fmt.Errorf("shard %q: object %s: %w", shardName, obj.ID, err)
+		}
+		count++
+	}
+
+	for name, load := range loads {
+		if err := load.writer.flush(ctx); err != nil {
+			return 0, fmt.Errorf("shard %q: %w", name, err)
+		}
+	}
+
+	attached := 0
+	for name, load := range loads {
+		if err := i.attachShardBulkLoad(ctx, load); err != nil {
+			return 0, fmt.Errorf("attach shard %q after %d of %d shards: %w",
+				name, attached, len(loads), err)
+		}
+		delete(loads, name)
+		attached++
+	}
+
+	return count, nil
+}
+
+// beginShardBulkLoad creates the staging shard for the given target shard.
+// The target shard must be local and empty. It is made read-only for the
+// duration of the load, as it is replaced afterwards.
+func (i *Index) beginShardBulkLoad(ctx context.Context, name string) (*shardBulkLoad, error) {
+	target := i.localShard(name)
+	if target == nil {
+		return nil, fmt.Errorf("shard %q is not local to this node", name)
+	}
+	if err := target.UpdateStatus(storagestate.StatusReadOnly.String()); err != nil {
+		return nil, fmt.Errorf("shard %q: %w", name, err)
+	}
+	if count := target.ObjectCount(); count > 0 {
+		target.UpdateStatus(storagestate.StatusReady.String())
+		return nil, fmt.Errorf("shard %q is not empty, it contains %d objects", name, count)
+	}
+
+	stagingName := name + bulkLoadStagingSuffix
+	if err := os.RemoveAll(shardPath(i.path(), stagingName)); err != nil {
+		target.UpdateStatus(storagestate.StatusReady.String())
+		return nil, fmt.Errorf("remove previous staging shard of %q: %w", name, err)
+	}
+
+	class := i.getSchema.ReadOnlyClass(i.Config.ClassName.String())
+	staging, err := newShard(ctx, nil, stagingName, i, class, i.centralJobQueue,
+		i.indexCheckpoints, true)
+	if err != nil {
+		target.UpdateStatus(storagestate.StatusReady.String())
+		return nil, fmt.Errorf("create staging shard of %q: %w", name, err)
+	}
+
+	return &shardBulkLoad{
+		name:    name,
+		target:  target,
+		staging: staging,
+		writer:  newShardBulkWriter(staging),
+	}, nil
+}
+
+// attachShardBulkLoad replaces the target shard with the completed staging
+// shard.
+func (i *Index) attachShardBulkLoad(ctx context.Context, load *shardBulkLoad) error {
+	load.closed = true
+	if err := load.staging.Shutdown(ctx); err != nil {
+		return fmt.Errorf("shut down staging shard: %w", err)
+	}
+	// from here on a crash completes the load when the shard is loaded again
+	marker, err := os.Create(filepath.Join(load.staging.path(), bulkLoadCompleteFile))
+	if err != nil {
+		return err
+	}
+	if err := marker.Sync(); err != nil {
+		marker.Close()
+		return err
+	}
+	if err := marker.Close(); err != nil {
+		return err
+	}
+
+	i.backupMutex.RLock()
+	defer i.backupMutex.RUnlock()
+
+	if err := load.target.Shutdown(ctx); err != nil {
+		return fmt.Errorf("shut down shard: %w", err)
+	}
+	if err := recoverBulkLoad(shardPath(i.path(), load.name), i.logger); err != nil {
+		return err
+	}
+
+	class := i.getSchema.ReadOnlyClass(i.Config.ClassName.String())
+	shard, err := i.initShard(ctx, load.name, class, i.metrics.baseMetrics)
+	if err != nil {
+		return err
+	}
+	i.shards.Store(load.name, shard)
+	return nil
+}
+
+func (i *Index) abortShardBulkLoad(load *shardBulkLoad) {
+	logger := i.logger.WithField("action", "bulk_load").WithField("shard", load.name)
+	if !load.closed {
+		if err := load.staging.Shutdown(context.Background()); err != nil {
+			logger.WithError(err).Error("shut down staging shard")
+		}
+	}
+	if err := os.RemoveAll(load.staging.path()); err != nil {
+		logger.WithError(err).Error("remove staging shard")
+	}
+	if err := load.target.UpdateStatus(storagestate.StatusReady.String()); err != nil {
+		logger.WithError(err).Error("reset shard status")
+	}
+}
+
+// recoverBulkLoad moves a complete staging shard into the place of the shard
+// at shardPath, or removes an incomplete one. It is idempotent, so that it
+// can resume after a crash at any point.
+func recoverBulkLoad(shardPath string, logger logrus.FieldLogger) error {
+	staging := shardPath + bulkLoadStagingSuffix
+	replaced := shardPath + bulkLoadReplacedSuffix
+
+	if _, err := os.Stat(staging); err == nil {
+		_, err := os.Stat(filepath.Join(staging, bulkLoadCompleteFile))
+		switch {
+		case err == nil:
+			if _, err := os.Stat(shardPath); err == nil {
+				if err := os.RemoveAll(replaced); err != nil {
+					return err
+				}
+				if err := os.Rename(shardPath, replaced); err != nil {
+					return fmt.Errorf("move aside replaced shard: %w", err)
+				}
+			}
+			if err := os.Rename(staging, shardPath); err != nil {
+				return fmt.Errorf("move staging shard into place: %w", err)
+			}
+			logger.WithField("action", "bulk_load").WithField("path", shardPath).
+				Info("attached bulk loaded shard")
+		case os.IsNotExist(err):
+			logger.WithField("action", "bulk_load").WithField("path", shardPath).
+				Warn("removing incomplete bulk load")
+			if err := os.RemoveAll(staging); err != nil {
+				return fmt.Errorf("remove incomplete staging shard: %w", err)
+			}
+		default:
+			return err
+		}
+	}
+
+	if err := os.Remove(filepath.Join(shardPath, bulkLoadCompleteFile)); err != nil &&
+		!os.IsNotExist(err) {
+		return err
+	}
+	return os.RemoveAll(replaced)
+}
@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+type sliceObjectReader struct {
+	objects []*models.Object
+}
+
+func (r *sliceObjectReader) Read() (*models.Object, error) {
+	if len(r.objects) == 0 {
+		return nil, io.EOF
+	}
+	obj := r.objects[0]
+	r.objects = r.objects[1:]
+	return obj, nil
+}
+
+func TestBulkLoadObjects(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	className := "BulkLoadClass"
+	count := 2500
+
+	vectorIndexConfig := enthnsw.NewDefaultUserConfig()
+	vectorIndexConfig.Distance = "l2-squared"
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   vectorIndexConfig,
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "name",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWhitespace,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: singleShardState(),
+	}
+	newRepo := func(t *testing.T) *DB {
+		repo, err := New(logger, Config{
+			RootPath:                  dirName,
+			QueryMaximumResults:       10000,
+			MaxImportGoroutinesFactor: 1,
+			MemtablesFlushDirtyAfter:  60,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	ids := make([]strfmt.UUID, count)
+	objects := func() *sliceObjectReader {
+		objs := make([]*models.Object, count)
+		for i := range objs {
+			objs[i] = &models.Object{
+				ID:         ids[i],
+				Class:      className,
+				Properties: map[string]interface{}{"name": fmt.Sprintf("object%d", i)},
+				Vector:     []float32{float32(i), 1, float32(i % 7)},
+			}
+		}
+		return &sliceObjectReader{objects: objs}
+	}
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.NewString())
+	}
+
+	repo := newRepo(t)
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+
+	onlyShard := func(t *testing.T, repo *DB) ShardLike {
+		var shard ShardLike
+		repo.GetIndex(schema.ClassName(className)).ForEachShard(func(_ string, s ShardLike) error {
+			shard = s
+			return nil
+		})
+		require.NotNil(t, shard)
+		return shard
+	}
+
+	verify := func(t *testing.T, repo *DB) {
+		shard := onlyShard(t, repo)
+		assert.Equal(t, count, shard.ObjectCount())
+
+		obj, err := shard.ObjectByID(ctx, ids[42], nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, "object42", obj.Properties().(map[string]interface{})["name"])
+
+		res, _, err := shard.ObjectVectorSearch(ctx, []float32{1234, 1, 2}, nil, "", 0, 1,
+			nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[1234], res[0].ID())
+
+		res, _, err = shard.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"name"}, Query: "object777",
+		}, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[777], res[0].ID())
+
+		res, _, err = shard.ObjectSearch(ctx, 10, &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: "name"},
+			Value:    &filters.Value{Value: "object1999", Type: schema.DataTypeText},
+		}}, nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[1999], res[0].ID())
+	}
+
+	t.Run("bulk load with duplicate ids fails", func(t *testing.T) {
+		r := objects()
+		r.objects = append(r.objects, r.objects[0])
+		_, err := repo.BulkLoadObjects(ctx, className, r)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "duplicate object id")
+		assert.Equal(t, 0, onlyShard(t, repo).ObjectCount())
+	})
+
+	t.Run("bulk load into empty shard", func(t *testing.T) {
+		n, err := repo.BulkLoadObjects(ctx, className, objects())
+		require.Nil(t, err)
+		assert.Equal(t, count, n)
+		verify(t, repo)
+	})
+
+	t.Run("no staging files are left behind", func(t *testing.T) {
+		err := filepath.Walk(dirName, func(path string, info os.FileInfo, err error) error {
+			require.Nil(t, err)
+			assert.NotContains(t, path, bulkLoadStagingSuffix)
+			assert.NotContains(t, path, bulkLoadReplacedSuffix)
+			assert.NotEqual(t, bulkLoadCompleteFile, info.Name())
+			return nil
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("bulk load into non-empty shard fails", func(t *testing.T) {
+		_, err := repo.BulkLoadObjects(ctx, className, objects())
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is not empty")
+
+		// the shard stays writable
+		obj := &models.Object{ID: strfmt.UUID(uuid.NewString()), Class: className}
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 2, 3}, nil, nil))
+		require.Nil(t, repo.DeleteObject(ctx, className, obj.ID, nil, ""))
+	})
+
+	t.Run("bulk loaded objects survive a restart", func(t *testing.T) {
+		require.Nil(t, repo.Shutdown(ctx))
+		repo = newRepo(t)
+		verify(t, repo)
+		require.Nil(t, repo.Shutdown(ctx))
+	})
+}
+
+func TestRecoverBulkLoad(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	files := func(t *testing.T, dir string) []string {
+		var out []string
+		filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			require.Nil(t, err)
+			if !info.IsDir() {
+				rel, _ := filepath.Rel(dir, path)
+				out = append(out, rel)
+			}
+			return nil
+		})
+		return out
+	}
+	write := func(t *testing.T, path string) {
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.Nil(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	t.Run("complete staging shard replaces the shard", func(t *testing.T) {
+		dir := t.TempDir()
+		shard := filepath.Join(dir, "shard")
+		write(t, filepath.Join(shard, "old"))
+		write(t, filepath.Join(shard+bulkLoadStagingSuffix, "new"))
+		write(t, filepath.Join(shard+bulkLoadStagingSuffix, bulkLoadCompleteFile))
+
+		require.Nil(t, recoverBulkLoad(shard, logger))
+		assert.Equal(t, []string{filepath.Join("shard", "new")}, files(t, dir))
+	})
+
+	t.Run("crash after the shard was moved aside", func(t *testing.T) {
+		dir := t.TempDir()
+		shard := filepath.Join(dir, "shard")
+		write(t, filepath.Join(shard+bulkLoadReplacedSuffix, "old"))
+		write(t, filepath.Join(shard+bulkLoadStagingSuffix, "new"))
+		write(t, filepath.Join(shard+bulkLoadStagingSuffix, bulkLoadCompleteFile))
+
+		require.Nil(t, recoverBulkLoad(shard, logger))
+		assert.Equal(t, []string{filepath.Join("shard", "new")}, files(t, dir))
+	})
+
+	t.Run("crash after the staging shard was moved", func(t *testing.T) {
+		dir := t.TempDir()
+		shard := filepath.Join(dir, "shard")
+		write(t, filepath.Join(shard+bulkLoadReplacedSuffix, "old"))
+		write(t, filepath.Join(shard, "new"))
+		write(t, filepath.Join(shard, bulkLoadCompleteFile))
+
+		require.Nil(t, recoverBulkLoad(shard, logger))
+		assert.Equal(t, []string{filepath.Join("shard", "new")}, files(t, dir))
+	})
+
+	t.Run("incomplete staging shard is removed", func(t *testing.T) {
+		dir := t.TempDir()
+		shard := filepath.Join(dir, "shard")
+		write(t, filepath.Join(shard, "old"))
+		write(t, filepath.Join(shard+bulkLoadStagingSuffix, "new"))
+
+		require.Nil(t, recoverBulkLoad(shard, logger))
+		assert.Equal(t, []string{filepath.Join("shard", "old")}, files(t, dir))
+	})
+
+	t.Run("nothing to recover", func(t *testing.T) {
+		dir := t.TempDir()
+		shard := filepath.Join(dir, "shard")
+		write(t, filepath.Join(shard, "old"))
+
+		require.Nil(t, recoverBulkLoad(shard, logger))
+		assert.Equal(t, []string{filepath.Join("shard", "old")}, files(t, dir))
+	})
+}
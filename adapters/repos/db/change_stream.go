@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+var errChangeLogDisabled = errors.New("change log is disabled, set CHANGE_LOG_ENABLED to enable it")
+
+// StreamChanges passes the change log events of all local shards of the class
+// (or of the tenant's shard for multi-tenant classes) to fn. Events are
+// ordered per shard, but interleaved across shards. Only shards stored on
+// this node are considered, consumers interested in the whole class need to
+// stream from every node.
+//
+// The change log only contains the ids of the mutated objects. If
+// includeObject is set, the current state of the object is loaded from the
+// shard for every put and merge event.
+func (db *DB) StreamChanges(ctx context.Context, class, tenant string,
+	offsets map[string]uint64, includeObject bool, fn func(shard string, ev changelog.Event) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return objects.NewErrNotFound("class %q not found", class)
+	}
+
+	return idx.streamChanges(ctx, tenant, offsets, includeObject, fn)
+}
+
+func (i *Index) streamChanges(ctx context.Context, tenant string,
+	offsets map[string]uint64, includeObject bool, fn func(shard string, ev changelog.Event) error,
+) error {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return err
+	}
+
+	shardNames, err := i.targetShardNames(tenant)
+	if err != nil {
+		return err
+	}
+
+	logs := make(map[string]*changelog.Log, len(shardNames))
+	shards := make(map[string]ShardLike, len(shardNames))
+	for _, name := range shardNames {
+		shard := i.localShard(name)
+		if shard == nil {
+			// shard is not stored on this node
+			continue
+		}
+		log := shard.ChangeLog()
+		if log == nil {
+			return errChangeLogDisabled
+		}
+		logs[name] = log
+		shards[name] = shard
+	}
+	if len(logs) == 0 {
+		return objects.NewErrNotFound("no shard of class %q found on this node", i.Config.ClassName)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type shardEvent struct {
+		shard string
+		event changelog.Event
+	}
+	events := make(chan shardEvent)
+	// buffered, so that readers never block on reporting why they stopped
+	readErrs := make(chan error, len(logs))
+
+	for name, log := range logs {
+		name, log, shard := name, log, shards[name]
+		from, ok := offsets[name]
+		if !ok {
+			from = log.Oldest()
+		}
+
+		f := func() {
+			err := log.Read(ctx, from, func(ev changelog.Event) error {
+				if includeObject && ev.Operation != changelog.OperationDelete {
+					obj, err := shard.ObjectByID(ctx, ev.ID, nil, additional.Properties{})
+					if err != nil {
+						return fmt.Errorf("load object %s: %w", ev.ID, err)
+					}
+					// nil if the object has been deleted in the meantime
+					ev.Object = obj
+				}
+
+				select {
+				case events <- shardEvent{shard: name, event: ev}:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+			readErrs <- fmt.Errorf("shard %q: %w", name, err)
+		}
+		enterrors.GoWrapper(f, i.logger)
+	}
+
+	for {
+		select {
+		case ev := <-events:
+			if err := fn(ev.shard, ev.event); err != nil {
+				return err
+			}
+		case err := <-readErrs:
+			// a reader only stops on errors, such as a cancelled context, an
+			// offset that is no longer retained or a shard shutdown
+			return err
+		}
+	}
+}
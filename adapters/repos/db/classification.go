@@ -78,7 +78,7 @@ func (db *DB) ZeroShotSearch(ctx context.Context, vector []float32,
 // TODO: why is this logic in the persistence package? This is business-logic,
 // move out of here!
 func (db *DB) AggregateNeighbors(ctx context.Context, vector []float32,
-	class string, properties []string, k int,
+	class string, properties []string, k int, weighted bool,
 	filter *libfilters.LocalFilter,
 ) ([]classification.NeighborRef, error) {
 	mergedFilter := mergeUserFilterWithRefCountFilter(filter, class, properties,
@@ -98,7 +98,7 @@ func (db *DB) AggregateNeighbors(ctx context.Context, vector []float32,
 		return nil, errors.Wrap(err, "aggregate neighbors: search neighbors")
 	}
 
-	return NewKnnAggregator(res, vector).Aggregate(k, properties)
+	return NewKnnAggregator(res, vector).Aggregate(k, properties, weighted)
 }
 
 // TODO: this is business logic, move out of here
@@ -111,13 +111,16 @@ func NewKnnAggregator(input search.Results, sourceVector []float32) *KnnAggregat
 	return &KnnAggregator{input: input, sourceVector: sourceVector}
 }
 
-func (a *KnnAggregator) Aggregate(k int, properties []string) ([]classification.NeighborRef, error) {
+// Aggregate determines the winning beacon of each property. If weighted is
+// set, every neighbor votes with the inverse of its distance rather than with
+// an equal vote, so that few close neighbors can outvote many distant ones.
+func (a *KnnAggregator) Aggregate(k int, properties []string, weighted bool) ([]classification.NeighborRef, error) {
 	neighbors, err := a.extractBeacons(properties)
 	if err != nil {
 		return nil, errors.Wrap(err, "aggregate: extract beacons from neighbors")
 	}
 
-	return a.aggregateBeacons(neighbors)
+	return a.aggregateBeacons(neighbors, weighted)
 }
 
 func (a *KnnAggregator) extractBeacons(properties []string) (neighborProps, error) {
@@ -163,27 +166,38 @@ func (a *KnnAggregator) extractBeacons(properties []string) (neighborProps, erro
 	return neighbors, nil
 }
 
-func (a *KnnAggregator) aggregateBeacons(props neighborProps) ([]classification.NeighborRef, error) {
+func (a *KnnAggregator) aggregateBeacons(props neighborProps, weighted bool) ([]classification.NeighborRef, error) {
 	var out []classification.NeighborRef
 	for propName, prop := range props {
 		var winningBeacon string
 		var winningCount int
 		var totalCount int
+		var winningVotes float32
+		var totalVotes float32
 
 		for beacon, distances := range prop.beacons {
+			votes := a.votes(distances, weighted)
 			totalCount += len(distances)
-			if len(distances) > winningCount {
+			totalVotes += votes
+			if votes > winningVotes {
 				winningBeacon = beacon
 				winningCount = len(distances)
+				winningVotes = votes
 			}
 		}
 
+		var confidence float32
+		if totalVotes > 0 {
+			confidence = winningVotes / totalVotes
+		}
+
 		distances := a.distances(prop.beacons, winningBeacon)
 		out = append(out, classification.NeighborRef{
 			Beacon:       strfmt.URI(winningBeacon),
 			WinningCount: winningCount,
 			OverallCount: totalCount,
 			LosingCount:  totalCount - winningCount,
+			Confidence:   confidence,
 			Property:     propName,
 			Distances:    distances,
 		})
@@ -192,6 +206,18 @@ func (a *KnnAggregator) aggregateBeacons(props neighborProps) ([]classification.
 	return out, nil
 }
 
+func (a *KnnAggregator) votes(distances []float32, weighted bool) float32 {
+	if !weighted {
+		return float32(len(distances))
+	}
+
+	var votes float32
+	for _, distance := range distances {
+		votes += classification.DistanceWeight(distance)
+	}
+	return votes
+}
+
 func (a *KnnAggregator) distances(beacons neighborBeacons,
 	winner string,
 ) classification.NeighborRefDistances {
@@ -109,7 +109,7 @@ func TestClassifications(t *testing.T) {
 		t.Run("close to politics (no filters)", func(t *testing.T) {
 			res, err := repo.AggregateNeighbors(context.Background(),
 				[]float32{0.7, 0.01, 0.01}, "Article",
-				[]string{"exactCategory", "mainCategory"}, 1, nil)
+				[]string{"exactCategory", "mainCategory"}, 1, false, nil)
 
 			expectedRes := []classification.NeighborRef{
 				{
@@ -118,6 +118,7 @@ func TestClassifications(t *testing.T) {
 					OverallCount: 1,
 					WinningCount: 1,
 					LosingCount:  0,
+					Confidence:   1,
 					Distances: classification.NeighborRefDistances{
 						MeanWinningDistance:    0.00010201335,
 						ClosestWinningDistance: 0.00010201335,
@@ -130,6 +131,7 @@ func TestClassifications(t *testing.T) {
 					OverallCount: 1,
 					WinningCount: 1,
 					LosingCount:  0,
+					Confidence:   1,
 					Distances: classification.NeighborRefDistances{
 						MeanWinningDistance:    0.00010201335,
 						ClosestWinningDistance: 0.00010201335,
@@ -145,7 +147,7 @@ func TestClassifications(t *testing.T) {
 		t.Run("close to food and drink (no filters)", func(t *testing.T) {
 			res, err := repo.AggregateNeighbors(context.Background(),
 				[]float32{0.01, 0.01, 0.66}, "Article",
-				[]string{"exactCategory", "mainCategory"}, 1, nil)
+				[]string{"exactCategory", "mainCategory"}, 1, false, nil)
 
 			expectedRes := []classification.NeighborRef{
 				{
@@ -154,6 +156,7 @@ func TestClassifications(t *testing.T) {
 					OverallCount: 1,
 					WinningCount: 1,
 					LosingCount:  0,
+					Confidence:   1,
 					Distances: classification.NeighborRefDistances{
 						MeanWinningDistance:    0.00011473894,
 						ClosestWinningDistance: 0.00011473894,
@@ -166,6 +169,7 @@ func TestClassifications(t *testing.T) {
 					OverallCount: 1,
 					WinningCount: 1,
 					LosingCount:  0,
+					Confidence:   1,
 					Distances: classification.NeighborRefDistances{
 						MeanWinningDistance:    0.00011473894,
 						ClosestWinningDistance: 0.00011473894,
@@ -193,7 +197,7 @@ func TestClassifications(t *testing.T) {
 			}
 			res, err := repo.AggregateNeighbors(context.Background(),
 				[]float32{0.01, 0.01, 0.66}, "Article",
-				[]string{"exactCategory", "mainCategory"}, 1, filter)
+				[]string{"exactCategory", "mainCategory"}, 1, false, filter)
 
 			expectedRes := []classification.NeighborRef{
 				{
@@ -202,6 +206,7 @@ func TestClassifications(t *testing.T) {
 					OverallCount: 1,
 					WinningCount: 1,
 					LosingCount:  0,
+					Confidence:   1,
 					Distances: classification.NeighborRefDistances{
 						MeanWinningDistance:    0.49242598,
 						ClosestWinningDistance: 0.49242598,
@@ -214,6 +219,7 @@ func TestClassifications(t *testing.T) {
 					OverallCount: 1,
 					WinningCount: 1,
 					LosingCount:  0,
+					Confidence:   1,
 					Distances: classification.NeighborRefDistances{
 						MeanWinningDistance:    0.49242598,
 						ClosestWinningDistance: 0.49242598,
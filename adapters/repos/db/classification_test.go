@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestKnnAggregator(t *testing.T) {
+	labelled := func(vector []float32, beacon string) search.Result {
+		return search.Result{
+			Vector: vector,
+			Schema: map[string]interface{}{
+				"label": models.MultipleRef{&models.SingleRef{Beacon: strfmt.URI(beacon)}},
+			},
+		}
+	}
+
+	// two distant neighbors share a label, a single close neighbor has another
+	input := search.Results{
+		labelled([]float32{0, 1}, "weaviate://localhost/far"),
+		labelled([]float32{0.1, 1}, "weaviate://localhost/far"),
+		labelled([]float32{1, 0.01}, "weaviate://localhost/close"),
+	}
+	source := []float32{1, 0}
+
+	t.Run("majority vote", func(t *testing.T) {
+		res, err := NewKnnAggregator(input, source).Aggregate(3, []string{"label"}, false)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, strfmt.URI("weaviate://localhost/far"), res[0].Beacon)
+		assert.Equal(t, 2, res[0].WinningCount)
+		assert.Equal(t, 1, res[0].LosingCount)
+		assert.InDelta(t, 2.0/3.0, res[0].Confidence, 0.001)
+	})
+
+	t.Run("distance-weighted vote", func(t *testing.T) {
+		res, err := NewKnnAggregator(input, source).Aggregate(3, []string{"label"}, true)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, strfmt.URI("weaviate://localhost/close"), res[0].Beacon)
+		assert.Equal(t, 1, res[0].WinningCount)
+		assert.Equal(t, 2, res[0].LosingCount)
+		assert.Greater(t, res[0].Confidence, float32(0.9))
+	})
+}
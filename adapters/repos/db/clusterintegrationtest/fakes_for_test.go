@@ -181,6 +181,10 @@ func (f *fakeSchemaManager) RestoreClass(ctx context.Context, d *backup.ClassDes
 	return nil
 }
 
+func (f *fakeSchemaManager) RestoreTenants(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error {
+	return nil
+}
+
 func (f *fakeSchemaManager) Nodes() []string {
 	return []string{"NOT SET"}
 }
@@ -312,6 +316,12 @@ func (f *fakeBackupBackend) Read(ctx context.Context, backupID, key string, w io
 	return 0, nil
 }
 
+func (f *fakeBackupBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) SourceDataPath() string {
 	f.Lock()
 	defer f.Unlock()
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ExportObjects returns up to limit objects of a class (or a single tenant)
+// whose UUIDs follow after, in the order of their UUIDs. Objects can be
+// narrowed down with a filter. Paging through a class this way visits every
+// object exactly once, unless it is created or deleted in the meantime.
+// Unlike Query, shards on other nodes are included and the objects contain
+// all their vectors.
+func (db *DB) ExportObjects(ctx context.Context, class, tenant string,
+	filter *filters.LocalFilter, after string, limit int,
+) ([]*models.Object, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, objects.NewErrNotFound("class %q not found", class)
+	}
+	if limit <= 0 {
+		return nil, objects.NewErrInvalidUserInput("limit must be greater than 0")
+	}
+	if after != "" {
+		if _, err := uuid.Parse(after); err != nil {
+			return nil, objects.NewErrInvalidUserInput("invalid cursor %q: %v", after, err)
+		}
+	}
+
+	cursor := &filters.Cursor{After: after, Limit: limit}
+	addl := additional.Properties{Vector: true}
+	res, _, err := idx.objectSearch(ctx, limit, filter, nil, nil, cursor, addl, nil, tenant, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*models.Object, len(res))
+	for i, obj := range res {
+		out[i] = obj.SearchResult(additional.Properties{}, tenant).ObjectWithVector(true)
+	}
+	return out, nil
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestExportObjects(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	className := "ExportClass"
+	count := 300
+
+	class := &models.Class{
+		Class:               className,
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{
+			{Name: "number", DataType: schema.DataTypeInt.PropString()},
+			{Name: "previous", DataType: []string{className}},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+		shardState: multiShardState(),
+	}
+	repo, err := New(logger, Config{
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+		MemtablesFlushDirtyAfter:  60,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, schemaGetter.shardState))
+
+	ids := make([]strfmt.UUID, count)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.NewString())
+		props := map[string]interface{}{"number": int64(i)}
+		if i > 0 {
+			props["previous"] = models.MultipleRef{
+				crossref.NewLocalhost(className, ids[i-1]).SingleRef(),
+			}
+		}
+		obj := &models.Object{ID: ids[i], Class: className, Properties: props, CreationTimeUnix: 1000}
+		require.Nil(t, repo.PutObject(ctx, obj, []float32{float32(i), 1, 2}, nil, nil))
+	}
+	sorted := make([]string, count)
+	for i, id := range ids {
+		sorted[i] = id.String()
+	}
+	sort.Strings(sorted)
+
+	export := func(t *testing.T, filter *filters.LocalFilter, after string, limit int) []*models.Object {
+		var out []*models.Object
+		for {
+			page, err := repo.ExportObjects(ctx, className, "", filter, after, limit)
+			require.Nil(t, err)
+			out = append(out, page...)
+			if len(page) < limit {
+				return out
+			}
+			after = page[len(page)-1].ID.String()
+		}
+	}
+	idsOf := func(objs []*models.Object) []string {
+		out := make([]string, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID.String()
+		}
+		return out
+	}
+
+	t.Run("all objects of all shards in uuid order", func(t *testing.T) {
+		objs := export(t, nil, "", 70)
+		assert.Equal(t, sorted, idsOf(objs))
+
+		for _, obj := range objs {
+			number := obj.Properties.(map[string]interface{})["number"].(float64)
+			assert.Equal(t, []float32{float32(number), 1, 2}, []float32(obj.Vector))
+			assert.Equal(t, className, obj.Class)
+			assert.NotZero(t, obj.CreationTimeUnix)
+			if number > 0 {
+				refs := obj.Properties.(map[string]interface{})["previous"].(models.MultipleRef)
+				require.Len(t, refs, 1)
+				assert.Equal(t, fmt.Sprintf("weaviate://localhost/%s/%s", className, ids[int(number)-1]),
+					refs[0].Beacon.String())
+			}
+		}
+	})
+
+	t.Run("resume after cursor", func(t *testing.T) {
+		objs := export(t, nil, sorted[149], 70)
+		assert.Equal(t, sorted[150:], idsOf(objs))
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: "number"},
+			Value:    &filters.Value{Value: 100, Type: schema.DataTypeInt},
+		}}
+		objs := export(t, filter, "", 30)
+
+		expected := make([]string, 100)
+		for i := range expected {
+			expected[i] = ids[i].String()
+		}
+		sort.Strings(expected)
+		assert.Equal(t, expected, idsOf(objs))
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := repo.ExportObjects(ctx, className, "", nil, "not-a-uuid", 10)
+		assert.NotNil(t, err)
+	})
+}
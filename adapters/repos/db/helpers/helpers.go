@@ -65,3 +65,9 @@ func TempBucketFromBucketName(bucketName string) string {
 func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
+
+// BucketSparseVectorFromTargetVectorLSM creates the name of the inverted
+// index holding the sparse vectors of a target vector
+func BucketSparseVectorFromTargetVectorLSM(targetVector string) string {
+	return fmt.Sprintf("sparse_vector_%s", targetVector)
+}
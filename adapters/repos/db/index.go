@@ -35,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/cluster/utils"
@@ -45,6 +46,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/search"
@@ -54,6 +56,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/querycache"
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -503,6 +506,18 @@ func (i *Index) updateVectorIndexConfigs(ctx context.Context,
 	return nil
 }
 
+// getVectorIndexConfig returns the user config of the vector index of the
+// target vector, or of the legacy vector index if targetVector is empty
+func (i *Index) getVectorIndexConfig(targetVector string) schemaConfig.VectorIndexConfig {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	if targetVector == "" {
+		return i.vectorIndexUserConfig
+	}
+	return i.vectorIndexUserConfigs[targetVector]
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -535,6 +550,19 @@ type IndexConfig struct {
 	ReplicationFactor         int64
 	AvoidMMap                 bool
 	DisableLazyLoadShards     bool
+	ChangeLog                 config.ChangeLog
+
+	// shared by all indexes of a node, nil means default strategy and
+	// no limit respectively
+	LSMCompactionStrategy  lsmkv.CompactionStrategy
+	LSMCompactionIOLimiter *lsmkv.IOLimiter
+	LSMSegmentCompression  lsmkv.Compression
+
+	// shared by all indexes of a node, nil if query caching is disabled
+	QueryCache *querycache.Cache
+
+	HNSWDisableSnapshots           bool
+	HNSWSnapshotMinDeltaCommitlogs int
 
 	TrackVectorDimensions bool
 }
@@ -1185,7 +1213,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	if len(sort) > 0 {
 		if len(shardNames) > 1 {
 			var err error
-			outObjects, outScores, err = i.sort(outObjects, outScores, sort, limit)
+			outObjects, outScores, err = i.sort(ctx, outObjects, outScores, sort, limit)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "sort")
 			}
@@ -1264,7 +1292,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				}
 			} else {
 				objs, scores, nodeName, err = i.remote.SearchShard(
-					ctx, shardName, nil, "", limit, filters, keywordRanking,
+					ctx, shardName, nil, nil, "", limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled())
 				if err != nil {
 					return fmt.Errorf(
@@ -1339,9 +1367,12 @@ func (i *Index) sortKeywordRanking(objects []*storobj.Object,
 	return newScoresSorter().sort(objects, scores)
 }
 
-func (i *Index) sort(objects []*storobj.Object, scores []float32,
+func (i *Index) sort(ctx context.Context, objects []*storobj.Object, scores []float32,
 	sort []filters.Sort, limit int,
 ) ([]*storobj.Object, []float32, error) {
+	before := time.Now()
+	defer func() { profile.FromContext(ctx).ObservePhase(profile.PhaseSort, time.Since(before)) }()
+
 	return sorter.NewObjectsSorter(i.getSchema.ReadOnlyClass).
 		Sort(objects, scores, limit, sort)
 }
@@ -1353,13 +1384,13 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 }
 
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVector []float32,
-	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
+	searchMultiVector [][]float32, targetVector string, dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
 	shardName string,
 ) ([]*storobj.Object, []float32, error) {
 	shard := i.localShard(shardName)
 	res, resDists, err := shard.ObjectVectorSearch(
-		ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, searchMultiVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -1388,7 +1419,7 @@ func (i *Index) targetShardNames(tenant string) ([]string, error) {
 }
 
 func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	targetVector string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
+	searchMultiVector [][]float32, targetVector string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string,
 ) ([]*storobj.Object, []float32, error) {
@@ -1402,7 +1433,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 	if len(shardNames) == 1 {
 		if i.localShard(shardNames[0]) != nil {
-			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, targetVector, dist, limit, filters,
+			return i.singleLocalShardObjectVectorSearch(ctx, searchVector, searchMultiVector, targetVector, dist, limit, filters,
 				sort, groupBy, additional, shardNames[0])
 		}
 	}
@@ -1435,14 +1466,14 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 			if shard := i.localShard(shardName); shard != nil {
 				nodeName = i.getSchema.NodeName()
 				res, resDists, err = shard.ObjectVectorSearch(
-					ctx, searchVector, targetVector, dist, limit, filters, sort, groupBy, additional)
+					ctx, searchVector, searchMultiVector, targetVector, dist, limit, filters, sort, groupBy, additional)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
 
 			} else {
 				res, resDists, nodeName, err = i.remote.SearchShard(ctx,
-					shardName, searchVector, targetVector, limit, filters,
+					shardName, searchVector, searchMultiVector, targetVector, limit, filters,
 					nil, sort, nil, groupBy, additional, i.replicationEnabled())
 				if err != nil {
 					return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1474,7 +1505,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 	}
 
 	if len(shardNames) > 1 && len(sort) > 0 {
-		return i.sort(out, dists, sort, limit)
+		return i.sort(ctx, out, dists, sort, limit)
 	}
 
 	out, dists = newDistancesSorter().sort(out, dists)
@@ -1499,7 +1530,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
-	searchVector []float32, targetVector string, distance float32, limit int,
+	searchVector []float32, searchMultiVector [][]float32, targetVector string, distance float32, limit int,
 	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties,
@@ -1509,7 +1540,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 		return nil, nil, ErrShardNotFound
 	}
 
-	if searchVector == nil {
+	if searchVector == nil && searchMultiVector == nil {
 		res, scores, err := shard.ObjectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional)
 		if err != nil {
 			return nil, nil, err
@@ -1519,7 +1550,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.ObjectVectorSearch(
-		ctx, searchVector, targetVector, distance, limit, filters, sort, groupBy, additional)
+		ctx, searchVector, searchMultiVector, targetVector, distance, limit, filters, sort, groupBy, additional)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
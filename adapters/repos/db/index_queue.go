@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -460,6 +461,23 @@ func (q *IndexQueue) SearchByVectorDistance(vector []float32, dist float32, maxL
 	return q.search(vector, dist, int(maxLimit), allowList)
 }
 
+// SearchByVectorWithProfile is SearchByVector, but lets the index describe
+// the search in vp, if it supports it
+func (q *IndexQueue) SearchByVectorWithProfile(vector []float32, k int, allowList helpers.AllowList,
+	vp *profile.Vector,
+) ([]uint64, []float32, error) {
+	pi, ok := q.Index.(profiledVectorIndex)
+	if !ok {
+		return q.SearchByVector(vector, k, allowList)
+	}
+
+	indexedResults, distances, err := pi.SearchByVectorWithProfile(vector, k, allowList, vp)
+	if err != nil {
+		return nil, nil, err
+	}
+	return q.searchUnindexed(vector, -1, k, allowList, indexedResults, distances)
+}
+
 func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	var indexedResults []uint64
 	var distances []float32
@@ -473,6 +491,14 @@ func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowL
 		return nil, nil, err
 	}
 
+	return q.searchUnindexed(vector, dist, maxLimit, allowList, indexedResults, distances)
+}
+
+// searchUnindexed uses brute force to add the vectors which are still queued
+// to the results of the index
+func (q *IndexQueue) searchUnindexed(vector []float32, dist float32, maxLimit int,
+	allowList helpers.AllowList, indexedResults []uint64, distances []float32,
+) ([]uint64, []float32, error) {
 	if !asyncEnabled() {
 		return indexedResults, distances, nil
 	}
@@ -486,7 +512,7 @@ func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowL
 	var results *priorityqueue.Queue[any]
 	var seen map[uint64]struct{}
 
-	err = q.queue.Iterate(allowList, func(objects []vectorDescriptor) error {
+	err := q.queue.Iterate(allowList, func(objects []vectorDescriptor) error {
 		if results == nil {
 			results = q.pqMaxPool.GetMax(maxLimit)
 			seen = make(map[uint64]struct{}, len(indexedResults))
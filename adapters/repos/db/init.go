@@ -78,20 +78,27 @@ func (db *DB) init(ctx context.Context) error {
 			}
 
 			idx, err := NewIndex(ctx, IndexConfig{
-				ClassName:                 schema.ClassName(class.Class),
-				RootPath:                  db.config.RootPath,
-				ResourceUsage:             db.config.ResourceUsage,
-				QueryMaximumResults:       db.config.QueryMaximumResults,
-				QueryNestedRefLimit:       db.config.QueryNestedRefLimit,
-				MemtablesFlushDirtyAfter:  db.config.MemtablesFlushDirtyAfter,
-				MemtablesInitialSizeMB:    db.config.MemtablesInitialSizeMB,
-				MemtablesMaxSizeMB:        db.config.MemtablesMaxSizeMB,
-				MemtablesMinActiveSeconds: db.config.MemtablesMinActiveSeconds,
-				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
-				TrackVectorDimensions:     db.config.TrackVectorDimensions,
-				AvoidMMap:                 db.config.AvoidMMap,
-				DisableLazyLoadShards:     db.config.DisableLazyLoadShards,
-				ReplicationFactor:         class.ReplicationConfig.Factor,
+				ClassName:                      schema.ClassName(class.Class),
+				RootPath:                       db.config.RootPath,
+				ResourceUsage:                  db.config.ResourceUsage,
+				QueryMaximumResults:            db.config.QueryMaximumResults,
+				QueryNestedRefLimit:            db.config.QueryNestedRefLimit,
+				MemtablesFlushDirtyAfter:       db.config.MemtablesFlushDirtyAfter,
+				MemtablesInitialSizeMB:         db.config.MemtablesInitialSizeMB,
+				MemtablesMaxSizeMB:             db.config.MemtablesMaxSizeMB,
+				MemtablesMinActiveSeconds:      db.config.MemtablesMinActiveSeconds,
+				MemtablesMaxActiveSeconds:      db.config.MemtablesMaxActiveSeconds,
+				TrackVectorDimensions:          db.config.TrackVectorDimensions,
+				AvoidMMap:                      db.config.AvoidMMap,
+				DisableLazyLoadShards:          db.config.DisableLazyLoadShards,
+				ChangeLog:                      db.config.ChangeLog,
+				QueryCache:                     db.config.QueryCache,
+				LSMCompactionStrategy:          db.lsmCompactionStrategy,
+				LSMCompactionIOLimiter:         db.lsmCompactionIOLimiter,
+				LSMSegmentCompression:          db.lsmSegmentCompression,
+				HNSWDisableSnapshots:           db.config.HNSWDisableSnapshots,
+				HNSWSnapshotMinDeltaCommitlogs: db.config.HNSWSnapshotMinDeltaCommitlogs,
+				ReplicationFactor:              class.ReplicationConfig.Factor,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				convertToVectorIndexConfig(class.VectorIndexConfig),
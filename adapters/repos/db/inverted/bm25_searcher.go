@@ -20,6 +20,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/entities/inverted"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -99,6 +102,7 @@ func (b *BM25Searcher) GetPropertyLengthTracker() *JsonPropertyLengthTracker {
 func (b *BM25Searcher) wand(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int,
 ) ([]*storobj.Object, []float32, error) {
+	before := time.Now()
 	N := float64(b.store.Bucket(helpers.ObjectsBucketLSM).Count())
 
 	var stopWordDetector *stopwords.Detector
@@ -180,6 +184,7 @@ func (b *BM25Searcher) wand(
 	eg.SetLimit(_NUMCPU)
 
 	var resultsLock sync.Mutex
+	var postingsScanned atomic.Int64
 
 	for _, tokenization := range helpers.Tokenizations {
 		propNames := propNamesByTokenization[tokenization]
@@ -197,7 +202,7 @@ func (b *BM25Searcher) wand(
 
 				eg.Go(func() (err error) {
 					termResult, docIndices, termErr := b.createTerm(N, filterDocIds, queryTerms[j], propNames,
-						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
+						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations, &postingsScanned)
 					if termErr != nil {
 						err = termErr
 						return
@@ -227,7 +232,10 @@ func (b *BM25Searcher) wand(
 	copy(resultsOriginalOrder, results)
 
 	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
-	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	objs, scores, err := b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
+	profile.ShardFromContext(ctx).ObserveBM25(time.Since(before), len(resultsOriginalOrder),
+		int(postingsScanned.Load()))
+	return objs, scores, err
 }
 
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string,
@@ -319,6 +327,13 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue[any],
 }
 
 func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength float64,
+) *priorityqueue.Queue[any] {
+	return wandTopKHeap(limit, results, averagePropLength, b.config)
+}
+
+// wandTopKHeap scores the terms using WAND and returns the top k results
+func wandTopKHeap(limit int, results terms, averagePropLength float64,
+	config schema.BM25Config,
 ) *priorityqueue.Queue[any] {
 	topKHeap := priorityqueue.NewMin[any](limit)
 	worstDist := float64(-10000) // tf score can be negative
@@ -328,7 +343,7 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 			return topKHeap
 		}
 
-		id, score := results.scoreNext(averagePropLength, b.config)
+		id, score := results.scoreNext(averagePropLength, config)
 
 		if topKHeap.Len() < limit || topKHeap.Top().Dist < float32(score) {
 			topKHeap.Insert(id, float32(score))
@@ -346,7 +361,7 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 
 func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string,
 	propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int,
-	additionalExplanations bool,
+	additionalExplanations bool, postingsScanned *atomic.Int64,
 ) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
@@ -362,6 +377,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 		if err != nil {
 			return termResult, nil, err
 		}
+		postingsScanned.Add(int64(len(preM)))
 
 		var m []lsmkv.MapPair
 		if filterDocIds != nil {
@@ -506,13 +522,20 @@ type term struct {
 	data       []docPointerWithScore
 	exhausted  bool
 	queryTerm  string
+
+	// weighted terms hold precomputed weights in (0, 1] instead of term
+	// frequencies, which are scaled by idf without any BM25 normalization
+	weighted bool
 }
 
 func (t *term) scoreAndAdvance(averagePropLength float64, config schema.BM25Config) (uint64, float64) {
 	id := t.idPointer
 	pair := t.data[t.posPointer]
 	freq := float64(pair.frequency)
-	tf := freq / (freq + config.K1*(1-config.B+config.B*float64(pair.propLength)/averagePropLength))
+	tf := freq
+	if !t.weighted {
+		tf = freq / (freq + config.K1*(1-config.B+config.B*float64(pair.propLength)/averagePropLength))
+	}
 
 	// advance
 	t.posPointer++
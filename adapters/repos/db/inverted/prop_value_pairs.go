@@ -34,15 +34,18 @@ type propValuePair struct {
 	// that's not a geoRange
 	value []byte
 
-	// only set if operator=OperatorWithinGeoRange, as that cannot be served by a
-	// byte value from an inverted index
-	valueGeoRange      *filters.GeoRange
-	docIDs             docBitmap
-	children           []*propValuePair
-	hasFilterableIndex bool
-	hasSearchableIndex bool
-	Class              *models.Class // The schema
-	logger             logrus.FieldLogger
+	// only set if operator=OperatorWithinGeoRange, OperatorWithinGeoPolygon or
+	// OperatorWithinGeoBoundingBox respectively, as those cannot be served by
+	// a byte value from an inverted index
+	valueGeoRange       *filters.GeoRange
+	valueGeoPolygon     *filters.GeoPolygon
+	valueGeoBoundingBox *filters.GeoBoundingBox
+	docIDs              docBitmap
+	children            []*propValuePair
+	hasFilterableIndex  bool
+	hasSearchableIndex  bool
+	Class               *models.Class // The schema
+	logger              logrus.FieldLogger
 }
 
 func newPropValuePair(class *models.Class, logger logrus.FieldLogger) (*propValuePair, error) {
@@ -83,8 +86,8 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 		b := s.store.Bucket(bucketName)
 
 		// TODO:  I think we can delete this check entirely.  The bucket will never be nill, and routines should now check if their particular feature is active in the schema.  However, not all those routines have checks yet.
-		if b == nil && pv.operator != filters.OperatorWithinGeoRange {
-			// a nil bucket is ok for a WithinGeoRange filter, as this query is not
+		if b == nil && !isGeoOperator(pv.operator) {
+			// a nil bucket is ok for a geo filter, as this query is not
 			// served by the inverted index, but propagated to a secondary index in
 			// .docPointers()
 			return errors.Errorf("bucket for prop %s not found - is it indexed?", pv.prop)
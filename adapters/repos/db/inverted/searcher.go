@@ -19,6 +19,7 @@ import (
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -33,9 +34,11 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/inverted"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 type Searcher struct {
@@ -79,17 +82,22 @@ func (s *Searcher) Objects(ctx context.Context, limit int,
 	filter *filters.LocalFilter, sort []filters.Sort, additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
+	sp := profile.ShardFromContext(ctx)
+	beforeFilter := time.Now()
 	allowList, err := s.docIDs(ctx, filter, additional, className, limit)
 	if err != nil {
 		return nil, err
 	}
+	sp.ObserveFilter(time.Since(beforeFilter), allowList.Len())
 
 	var it docIDsIterator
 	if len(sort) > 0 {
+		beforeSort := time.Now()
 		docIDs, err := s.sort(ctx, limit, sort, allowList, className)
 		if err != nil {
 			return nil, fmt.Errorf("sort doc ids: %w", err)
 		}
+		sp.ObserveSort(time.Since(beforeSort))
 		it = newSliceDocIDsIterator(docIDs)
 	} else {
 		it = allowList.Iterator()
@@ -170,6 +178,7 @@ func (s *Searcher) objectsByDocID(it docIDsIterator,
 func (s *Searcher) DocIDs(ctx context.Context, filter *filters.LocalFilter,
 	additional additional.Properties, className schema.ClassName,
 ) (helpers.AllowList, error) {
+	before := time.Now()
 	allow, err := s.docIDs(ctx, filter, additional, className, 0)
 	if err != nil {
 		return nil, err
@@ -179,13 +188,19 @@ func (s *Searcher) DocIDs(ctx context.Context, filter *filters.LocalFilter,
 	// overhead of repopulating the base bitmap. Here we can truncate that
 	// buffer to ensure that the caller is receiving only the possible range
 	// of docIDs
-	return allow.Truncate(s.bitmapFactory.ActualMaxVal()), nil
+	allow = allow.Truncate(s.bitmapFactory.ActualMaxVal())
+	profile.ShardFromContext(ctx).ObserveFilter(time.Since(before), allow.Len())
+	return allow, nil
 }
 
 func (s *Searcher) docIDs(ctx context.Context, filter *filters.LocalFilter,
 	additional additional.Properties, className schema.ClassName,
 	limit int,
-) (helpers.AllowList, error) {
+) (_ helpers.AllowList, err error) {
+	_, span := tracing.Start(ctx, "inverted.EvaluateFilter",
+		attribute.String("class", className.String()))
+	defer func() { tracing.End(span, err) }()
+
 	pv, err := s.extractPropValuePair(filter.Root, className)
 	if err != nil {
 		return nil, err
@@ -390,21 +405,44 @@ func (s *Searcher) extractGeoFilter(prop *models.Property, value interface{},
 	valueType schema.DataType, operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
 	if valueType != schema.DataTypeGeoCoordinates {
-		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only"+
-			"be used with geoRange filters", prop.Name)
+		return nil, fmt.Errorf("prop %q is of type geoCoordinates, it can only "+
+			"be used with geoRange, geoPolygon or geoBoundingBox filters", prop.Name)
 	}
 
-	parsed := value.(filters.GeoRange)
-
-	return &propValuePair{
+	pv := &propValuePair{
 		value:              nil, // not going to be served by an inverted index
-		valueGeoRange:      &parsed,
 		prop:               prop.Name,
 		operator:           operator,
 		hasFilterableIndex: HasFilterableIndex(prop),
 		hasSearchableIndex: HasSearchableIndex(prop),
 		Class:              class,
-	}, nil
+	}
+
+	switch parsed := value.(type) {
+	case filters.GeoRange:
+		if operator != filters.OperatorWithinGeoRange {
+			return nil, fmt.Errorf("geoRange value on prop %q requires operator "+
+				"WithinGeoRange, got %s", prop.Name, operator.Name())
+		}
+		pv.valueGeoRange = &parsed
+	case filters.GeoPolygon:
+		if operator != filters.OperatorWithinGeoPolygon {
+			return nil, fmt.Errorf("geoPolygon value on prop %q requires operator "+
+				"WithinGeoPolygon, got %s", prop.Name, operator.Name())
+		}
+		pv.valueGeoPolygon = &parsed
+	case filters.GeoBoundingBox:
+		if operator != filters.OperatorWithinGeoBoundingBox {
+			return nil, fmt.Errorf("geoBoundingBox value on prop %q requires operator "+
+				"WithinGeoBoundingBox, got %s", prop.Name, operator.Name())
+		}
+		pv.valueGeoBoundingBox = &parsed
+	default:
+		return nil, fmt.Errorf("unsupported geo value of type %T on prop %q",
+			value, prop.Name)
+	}
+
+	return pv, nil
 }
 
 func (s *Searcher) extractUUIDFilter(prop *models.Property, value interface{},
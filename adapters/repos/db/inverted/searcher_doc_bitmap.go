@@ -26,7 +26,7 @@ func (s *Searcher) docBitmap(ctx context.Context, b *lsmkv.Bucket, limit int,
 	// geo props cannot be served by the inverted index and they require an
 	// external index. So, instead of trying to serve this chunk of the filter
 	// request internally, we can pass it to an external geo index
-	if pv.operator == filters.OperatorWithinGeoRange {
+	if isGeoOperator(pv.operator) {
 		return s.docBitmapGeo(ctx, pv)
 	}
 	// all other operators perform operations on the inverted index which we
@@ -164,11 +164,36 @@ func (s *Searcher) docBitmapGeo(ctx context.Context, pv *propValuePair) (docBitm
 		return out, nil
 	}
 
-	res, err := propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
-	if err != nil {
-		return out, fmt.Errorf("geo index range search on prop %q: %w", pv.prop, err)
+	var res []uint64
+	var err error
+	switch pv.operator {
+	case filters.OperatorWithinGeoPolygon:
+		res, err = propIndex.GeoIndex.WithinPolygon(ctx, *pv.valueGeoPolygon)
+		if err != nil {
+			return out, fmt.Errorf("geo index polygon search on prop %q: %w", pv.prop, err)
+		}
+	case filters.OperatorWithinGeoBoundingBox:
+		res, err = propIndex.GeoIndex.WithinBoundingBox(ctx, *pv.valueGeoBoundingBox)
+		if err != nil {
+			return out, fmt.Errorf("geo index bounding box search on prop %q: %w", pv.prop, err)
+		}
+	default:
+		res, err = propIndex.GeoIndex.WithinRange(ctx, *pv.valueGeoRange)
+		if err != nil {
+			return out, fmt.Errorf("geo index range search on prop %q: %w", pv.prop, err)
+		}
 	}
 
 	out.docIDs.SetMany(res)
 	return out, nil
 }
+
+func isGeoOperator(operator filters.Operator) bool {
+	switch operator {
+	case filters.OperatorWithinGeoRange, filters.OperatorWithinGeoPolygon,
+		filters.OperatorWithinGeoBoundingBox:
+		return true
+	default:
+		return false
+	}
+}
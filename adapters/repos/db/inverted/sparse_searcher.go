@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// Sparse vectors are stored in a mapcollection bucket per target vector. Every
+// non-zero dimension is a row, holding the weights of all objects with that
+// dimension keyed by their docID. This is the same layout as the searchable
+// index of text properties, so the WAND implementation of the BM25 searcher is
+// reused, with term frequencies replaced by the (normalized) weights.
+
+// SparseVectorKey returns the row key of the given dimension
+func SparseVectorKey(index uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, index)
+	return key
+}
+
+// SparseVectorPair returns the entry of an object in the row of a dimension
+func SparseVectorPair(docID uint64, weight float32) lsmkv.MapPair {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	value := make([]byte, 4)
+	binary.LittleEndian.PutUint32(value, math.Float32bits(weight))
+	return lsmkv.MapPair{Key: key, Value: value}
+}
+
+// ValidateSparseVector makes sure the vector can be scored with WAND, which
+// requires non-negative weights
+func ValidateSparseVector(indices []uint32, values []float32) error {
+	if len(indices) != len(values) {
+		return fmt.Errorf("sparse vector has %d indices but %d values", len(indices), len(values))
+	}
+	seen := make(map[uint32]struct{}, len(indices))
+	for i, index := range indices {
+		if _, ok := seen[index]; ok {
+			return fmt.Errorf("sparse vector has duplicate index %d", index)
+		}
+		seen[index] = struct{}{}
+		if v := values[i]; v < 0 || math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return fmt.Errorf("sparse vector weight of index %d must be a non-negative number, got %v", index, v)
+		}
+	}
+	return nil
+}
+
+type SparseSearcher struct {
+	store  *lsmkv.Store
+	logger logrus.FieldLogger
+}
+
+func NewSparseSearcher(store *lsmkv.Store, logger logrus.FieldLogger) *SparseSearcher {
+	return &SparseSearcher{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Search returns the objects with the highest dot product between their
+// sparse vector and the query, scores are the dot products
+func (s *SparseSearcher) Search(ctx context.Context, filterDocIds helpers.AllowList,
+	query searchparams.NearSparseVector, limit int,
+) ([]*storobj.Object, []float32, error) {
+	if err := ValidateSparseVector(query.Indices, query.Values); err != nil {
+		return nil, nil, err
+	}
+
+	bucket := s.store.Bucket(helpers.BucketSparseVectorFromTargetVectorLSM(query.TargetVector))
+	if bucket == nil {
+		return nil, nil, fmt.Errorf("could not find sparse vector index for target vector %q",
+			query.TargetVector)
+	}
+
+	results := make(terms, 0, len(query.Indices))
+	for i, index := range query.Indices {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if query.Values[i] == 0 {
+			continue
+		}
+
+		t, err := s.createTerm(bucket, filterDocIds, index, query.Values[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if !t.exhausted {
+			results = append(results, t)
+		}
+	}
+
+	// without a limit, all matches are returned
+	if limit <= 0 {
+		for _, t := range results {
+			limit += len(t.data)
+		}
+	}
+	if limit == 0 || len(results) == 0 {
+		return nil, nil, nil
+	}
+
+	topKHeap := wandTopKHeap(limit, results, 0, schema.BM25Config{})
+	return s.topKObjects(topKHeap)
+}
+
+func (s *SparseSearcher) createTerm(bucket *lsmkv.Bucket, filterDocIds helpers.AllowList,
+	index uint32, queryWeight float32,
+) (term, error) {
+	t := term{queryTerm: fmt.Sprint(index), weighted: true}
+
+	pairs, err := bucket.MapList(SparseVectorKey(index))
+	if err != nil {
+		return t, err
+	}
+
+	maxWeight := float32(0)
+	data := make([]docPointerWithScore, 0, len(pairs))
+	for _, pair := range pairs {
+		if len(pair.Value) < 4 {
+			s.logger.Warnf("Skipping pair in sparse search: MapPair.Value should be 4 bytes long, but is %d.",
+				len(pair.Value))
+			continue
+		}
+		docID := binary.BigEndian.Uint64(pair.Key)
+		if filterDocIds != nil && !filterDocIds.Contains(docID) {
+			continue
+		}
+		weight := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value))
+		if weight <= 0 {
+			continue
+		}
+		if weight > maxWeight {
+			maxWeight = weight
+		}
+		data = append(data, docPointerWithScore{id: docID, frequency: weight})
+	}
+
+	if len(data) == 0 {
+		t.exhausted = true
+		return t, nil
+	}
+
+	// normalize the weights, so idf doubles as the max impact of the term
+	for i := range data {
+		data[i].frequency /= maxWeight
+	}
+	t.data = data
+	t.idf = float64(queryWeight) * float64(maxWeight)
+	t.idPointer = data[0].id
+	return t, nil
+}
+
+func (s *SparseSearcher) topKObjects(topKHeap *priorityqueue.Queue[any],
+) ([]*storobj.Object, []float32, error) {
+	objectsBucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if objectsBucket == nil {
+		return nil, nil, errors.Errorf("objects bucket not found")
+	}
+
+	// the heap pops the lowest scores first
+	objects := make([]*storobj.Object, topKHeap.Len())
+	scores := make([]float32, topKHeap.Len())
+	pos := topKHeap.Len()
+
+	buf := make([]byte, 8)
+	for topKHeap.Len() > 0 {
+		res := topKHeap.Pop()
+		binary.LittleEndian.PutUint64(buf, res.ID)
+		objectByte, err := objectsBucket.GetBySecondary(0, buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		// deleted objects whose entries were not cleaned up yet
+		if len(objectByte) == 0 {
+			continue
+		}
+
+		obj, err := storobj.FromBinary(objectByte)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pos--
+		objects[pos] = obj
+		scores[pos] = res.Dist
+	}
+
+	return objects[pos:], scores[pos:], nil
+}
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidateSparseVector(t *testing.T) {
+	tests := []struct {
+		name    string
+		indices []uint32
+		values  []float32
+		err     string
+	}{
+		{
+			name:    "valid",
+			indices: []uint32{1, 7, 3},
+			values:  []float32{0.5, 0, 2},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:    "mismatching lengths",
+			indices: []uint32{1, 7},
+			values:  []float32{0.5},
+			err:     "sparse vector has 2 indices but 1 values",
+		},
+		{
+			name:    "duplicate index",
+			indices: []uint32{1, 1},
+			values:  []float32{0.5, 0.5},
+			err:     "sparse vector has duplicate index 1",
+		},
+		{
+			name:    "negative weight",
+			indices: []uint32{1},
+			values:  []float32{-0.5},
+			err:     "sparse vector weight of index 1 must be a non-negative number, got -0.5",
+		},
+		{
+			name:    "NaN weight",
+			indices: []uint32{1},
+			values:  []float32{float32(math.NaN())},
+			err:     "sparse vector weight of index 1 must be a non-negative number, got NaN",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateSparseVector(test.indices, test.values)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestSparseVectorWandScores(t *testing.T) {
+	// query {1: 2, 2: 1}, documents:
+	// 10: {1: 1, 2: 4} => 6
+	// 11: {1: 3}       => 6
+	// 12: {2: 0.5}     => 0.5
+	// 13: {1: 2, 2: 3} => 7
+	newTerm := func(queryWeight float32, docIDs []uint64, weights []float32) term {
+		maxWeight := float32(0)
+		for _, w := range weights {
+			maxWeight = float32(math.Max(float64(maxWeight), float64(w)))
+		}
+		data := make([]docPointerWithScore, len(docIDs))
+		for i := range docIDs {
+			data[i] = docPointerWithScore{id: docIDs[i], frequency: weights[i] / maxWeight}
+		}
+		return term{
+			idf:       float64(queryWeight) * float64(maxWeight),
+			data:      data,
+			idPointer: docIDs[0],
+			weighted:  true,
+		}
+	}
+
+	results := terms{
+		newTerm(2, []uint64{10, 11, 13}, []float32{1, 3, 2}),
+		newTerm(1, []uint64{10, 12, 13}, []float32{4, 0.5, 3}),
+	}
+
+	heap := wandTopKHeap(2, results, 0, schema.BM25Config{})
+	assert.Equal(t, 2, heap.Len())
+
+	first := heap.Pop()
+	second := heap.Pop()
+	assert.InDelta(t, 6, first.Dist, 1e-5)
+	assert.Contains(t, []uint64{10, 11}, first.ID)
+	assert.Equal(t, uint64(13), second.ID)
+	assert.InDelta(t, 7, second.Dist, 1e-5)
+}
@@ -54,6 +54,7 @@ type Bucket struct {
 	haltedFlushTimer *interval.BackoffTimer
 
 	walThreshold      uint64
+	disableWAL        bool
 	flushDirtyAfter   time.Duration
 	memtableThreshold uint64
 	memtableResizer   *memtableSizeAdvisor
@@ -113,6 +114,18 @@ type Bucket struct {
 
 	forceCompaction bool
 
+	// Codec used for the value blocks of newly written segments. Roaring set
+	// segments are never compressed.
+	// OFF by default
+	compression Compression
+
+	// Decides which segments are merged next, defaults to the level strategy
+	compactionStrategy CompactionStrategy
+	// Compaction writes are throttled by both the bucket's own and the
+	// (typically node-wide) shared limiter. Either may be nil.
+	compactionIOLimiter       *IOLimiter
+	sharedCompactionIOLimiter *IOLimiter
+
 	// optionally supplied to prevent starting memory-intensive
 	// processes when memory pressure is high
 	allocChecker memwatch.AllocChecker
@@ -176,6 +189,11 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			forceCompaction:       b.forceCompaction,
 			useBloomFilter:        b.useBloomFilter,
 			calcCountNetAdditions: b.calcCountNetAdditions,
+			compression:           b.compression,
+			compactionStrategy:    b.compactionStrategy,
+			compactionIOLimiters: []*IOLimiter{
+				b.sharedCompactionIOLimiter, b.compactionIOLimiter,
+			},
 		}, b.allocChecker)
 	if err != nil {
 		return nil, fmt.Errorf("init disk segments: %w", err)
@@ -241,6 +259,10 @@ func (b *Bucket) GetDesiredStrategy() string {
 	return b.desiredStrategy
 }
 
+func (b *Bucket) GetCompression() Compression {
+	return b.compression
+}
+
 func (b *Bucket) GetSecondaryIndices() uint16 {
 	return b.secondaryIndices
 }
@@ -783,11 +805,15 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return errors.Wrap(err, "init commit logger")
 	}
+	if b.disableWAL {
+		cl.pause()
+	}
 
 	mt, err := newMemtable(path, b.strategy, b.secondaryIndices, cl, b.metrics)
 	if err != nil {
 		return err
 	}
+	mt.compression = b.compression
 
 	b.active = mt
 	return nil
@@ -830,6 +856,13 @@ func (b *Bucket) CountAsync() int {
 	return b.disk.count()
 }
 
+// DiskSize returns the size of the bucket's segments on disk in bytes. Like
+// CountAsync it does not consider the memtables and is therefore eventually
+// consistent.
+func (b *Bucket) DiskSize() int64 {
+	return b.disk.size()
+}
+
 func (b *Bucket) memtableNetCount(stats *countStats, previousMemtable *countStats) int {
 	netCount := 0
 
@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+)
+
+// BulkWriter writes entries into a bucket without going through the memtable
+// and the WAL. Entries are buffered in a run, which is sorted by key and
+// written as a new segment once it reaches the memtable threshold of the
+// bucket, or when Flush is called. Entries only become visible to readers of
+// the bucket once their run was flushed.
+//
+// A BulkWriter is meant to fill a bucket which is not written to otherwise,
+// e.g. while bulk loading a new shard. Segments of a BulkWriter take
+// precedence over all segments which existed before, but not over the active
+// memtable. Each key should be written only once, as a later Put of the same
+// key within a run replaces the previous one. A BulkWriter is not safe for
+// concurrent use.
+type BulkWriter struct {
+	bucket    *Bucket
+	threshold uint64
+	size      uint64
+
+	replace    map[string]*binarySearchNode
+	collection map[string][]value
+	maps       map[string][]MapPair
+	roaringSet map[string]*sroar.Bitmap
+}
+
+// NewBulkWriter creates a BulkWriter for the bucket. Runs are flushed once
+// their size exceeds the memtable threshold of the bucket.
+func (b *Bucket) NewBulkWriter() *BulkWriter {
+	w := &BulkWriter{
+		bucket:    b,
+		threshold: b.GetMemtableThreshold(),
+	}
+	w.reset()
+	return w
+}
+
+func (w *BulkWriter) reset() {
+	w.size = 0
+	w.replace = map[string]*binarySearchNode{}
+	w.collection = map[string][]value{}
+	w.maps = map[string][]MapPair{}
+	w.roaringSet = map[string]*sroar.Bitmap{}
+}
+
+// Strategy returns the strategy of the bucket written to
+func (w *BulkWriter) Strategy() string {
+	return w.bucket.strategy
+}
+
+// Put adds a key to a bucket of the replace strategy, see [Bucket.Put]
+func (w *BulkWriter) Put(key, value []byte, opts ...SecondaryKeyOption) error {
+	if w.bucket.strategy != StrategyReplace {
+		return fmt.Errorf("put only possible with strategy 'replace'")
+	}
+
+	var secondaryKeys [][]byte
+	if w.bucket.secondaryIndices > 0 {
+		secondaryKeys = make([][]byte, w.bucket.secondaryIndices)
+		for _, opt := range opts {
+			if err := opt(secondaryKeys); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.replace[string(key)] = &binarySearchNode{
+		key:           key,
+		value:         value,
+		secondaryKeys: secondaryKeys,
+	}
+	size := len(key) + len(value)
+	for _, sec := range secondaryKeys {
+		size += len(sec)
+	}
+	return w.grow(size)
+}
+
+// Has indicates whether key was written to a bucket of the replace strategy,
+// either in the current run or in any segment of the bucket
+func (w *BulkWriter) Has(key []byte) (bool, error) {
+	if w.bucket.strategy != StrategyReplace {
+		return false, fmt.Errorf("has only possible with strategy 'replace'")
+	}
+
+	if _, ok := w.replace[string(key)]; ok {
+		return true, nil
+	}
+	v, err := w.bucket.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return v != nil, nil
+}
+
+// SetAdd adds values to a bucket of the set strategy, see [Bucket.SetAdd]
+func (w *BulkWriter) SetAdd(key []byte, values [][]byte) error {
+	if w.bucket.strategy != StrategySetCollection {
+		return fmt.Errorf("set add only possible with strategy 'setcollection'")
+	}
+
+	w.collection[string(key)] = append(w.collection[string(key)],
+		newSetEncoder().Do(values)...)
+	size := len(key)
+	for _, v := range values {
+		size += len(v)
+	}
+	return w.grow(size)
+}
+
+// MapSet adds a pair to a bucket of the map strategy, see [Bucket.MapSet]
+func (w *BulkWriter) MapSet(rowKey []byte, kv MapPair) error {
+	if w.bucket.strategy != StrategyMapCollection {
+		return fmt.Errorf("map set only possible with strategy 'mapcollection'")
+	}
+
+	w.maps[string(rowKey)] = append(w.maps[string(rowKey)], kv)
+	return w.grow(len(rowKey) + len(kv.Key) + len(kv.Value))
+}
+
+// RoaringSetAddOne adds a value to a bucket of the roaring set strategy, see
+// [Bucket.RoaringSetAddOne]
+func (w *BulkWriter) RoaringSetAddOne(key []byte, value uint64) error {
+	return w.RoaringSetAddList(key, []uint64{value})
+}
+
+// RoaringSetAddList adds values to a bucket of the roaring set strategy, see
+// [Bucket.RoaringSetAddList]
+func (w *BulkWriter) RoaringSetAddList(key []byte, values []uint64) error {
+	if err := checkStrategyRoaringSet(w.bucket.strategy); err != nil {
+		return err
+	}
+
+	bm, ok := w.roaringSet[string(key)]
+	if !ok {
+		bm = roaringset.NewBitmap()
+		w.roaringSet[string(key)] = bm
+	}
+	bm.SetMany(values)
+	return w.grow(len(key) + 8*len(values))
+}
+
+func (w *BulkWriter) grow(size int) error {
+	w.size += uint64(size)
+	if w.size < w.threshold {
+		return nil
+	}
+	return w.Flush()
+}
+
+// Flush sorts the current run by key, writes it as a new segment and adds the
+// segment to the bucket. The segment is fsynced before it is added.
+func (w *BulkWriter) Flush() error {
+	var write func(f io.Writer) ([]segmentindex.Key, error)
+	var count int
+
+	b := w.bucket
+	switch b.strategy {
+	case StrategyReplace:
+		flat := make([]*binarySearchNode, 0, len(w.replace))
+		for _, node := range w.replace {
+			flat = append(flat, node)
+		}
+		sort.Slice(flat, func(i, j int) bool {
+			return string(flat[i].key) < string(flat[j].key)
+		})
+		count = len(flat)
+		write = func(f io.Writer) ([]segmentindex.Key, error) {
+			return writeReplaceNodes(f, flat, b.secondaryIndices, b.strategy, b.compression)
+		}
+
+	case StrategySetCollection:
+		flat := make([]*binarySearchNodeMulti, 0, len(w.collection))
+		for key, values := range w.collection {
+			flat = append(flat, &binarySearchNodeMulti{key: []byte(key), values: values})
+		}
+		sortMultiNodes(flat)
+		count = len(flat)
+		write = func(f io.Writer) ([]segmentindex.Key, error) {
+			return writeCollectionNodes(f, flat, b.secondaryIndices, b.strategy, b.compression)
+		}
+
+	case StrategyMapCollection:
+		mapNodes := make([]*binarySearchNodeMap, 0, len(w.maps))
+		for key, pairs := range w.maps {
+			mapNodes = append(mapNodes, &binarySearchNodeMap{
+				key:    []byte(key),
+				values: sortAndDedupValues(pairs),
+			})
+		}
+		flat, err := mapNodesAsCollection(mapNodes)
+		if err != nil {
+			return err
+		}
+		sortMultiNodes(flat)
+		count = len(flat)
+		write = func(f io.Writer) ([]segmentindex.Key, error) {
+			return writeCollectionNodes(f, flat, b.secondaryIndices, b.strategy, b.compression)
+		}
+
+	case StrategyRoaringSet:
+		flat := make([]*roaringset.BinarySearchNode, 0, len(w.roaringSet))
+		for key, bm := range w.roaringSet {
+			flat = append(flat, &roaringset.BinarySearchNode{
+				Key: []byte(key),
+				Value: roaringset.BitmapLayer{
+					Additions: bm,
+					Deletions: roaringset.NewBitmap(),
+				},
+			})
+		}
+		sort.Slice(flat, func(i, j int) bool {
+			return string(flat[i].Key) < string(flat[j].Key)
+		})
+		count = len(flat)
+		write = func(f io.Writer) ([]segmentindex.Key, error) {
+			return writeRoaringSetNodes(f, flat)
+		}
+
+	default:
+		return fmt.Errorf("cannot bulk write strategy %s", b.strategy)
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	path := filepath.Join(b.dir, fmt.Sprintf("segment-%d", time.Now().UnixNano()))
+	if err := writeSegmentFile(path, b.secondaryIndices, write); err != nil {
+		return fmt.Errorf("write segment: %w", err)
+	}
+	if err := b.addBulkSegment(path + ".db"); err != nil {
+		return err
+	}
+
+	w.reset()
+	return nil
+}
+
+func (b *Bucket) addBulkSegment(path string) error {
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+
+	if err := b.disk.add(path); err != nil {
+		return err
+	}
+
+	if b.strategy == StrategyReplace && b.monitorCount {
+		b.metrics.ObjectCount(b.disk.count())
+	}
+
+	return nil
+}
+
+func sortMultiNodes(flat []*binarySearchNodeMulti) {
+	sort.Slice(flat, func(i, j int) bool {
+		return string(flat[i].key) < string(flat[j].key)
+	})
+}
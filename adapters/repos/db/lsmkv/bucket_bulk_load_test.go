@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketBulkWriter(t *testing.T) {
+	ctx := context.Background()
+	tests := bucketTests{
+		{
+			name: "bulkWriterReplace",
+			f:    bulkWriterReplace,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+			},
+		},
+		{
+			name: "bulkWriterReplaceCompressed",
+			f:    bulkWriterReplace,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+				WithCompression(CompressionSnappy),
+			},
+		},
+		{
+			name: "bulkWriterSet",
+			f:    bulkWriterSet,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+			},
+		},
+		{
+			name: "bulkWriterMap",
+			f:    bulkWriterMap,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+			},
+		},
+		{
+			name: "bulkWriterRoaringSet",
+			f:    bulkWriterRoaringSet,
+			opts: []BucketOption{
+				WithStrategy(StrategyRoaringSet),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+// the threshold is small enough for every bulk write to span several segments
+const bulkWriterTestThreshold = 256
+
+func newBulkWriterTestBucket(ctx context.Context, t *testing.T, dir string,
+	opts []BucketOption,
+) *Bucket {
+	logger, _ := test.NewNullLogger()
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		append([]BucketOption{WithMemtableThreshold(bulkWriterTestThreshold)}, opts...)...)
+	require.NoError(t, err)
+	return b
+}
+
+func countSegmentFiles(t *testing.T, dir string) int {
+	files, err := filepath.Glob(filepath.Join(dir, "segment-*.db"))
+	require.NoError(t, err)
+	return len(files)
+}
+
+func bulkWriterReplace(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	b := newBulkWriterTestBucket(ctx, t, dir, opts)
+
+	// keys are written in reverse order, they are sorted by the writer
+	w := b.NewBulkWriter()
+	for i := 99; i >= 0; i-- {
+		secondary := make([]byte, 8)
+		binary.LittleEndian.PutUint64(secondary, uint64(i))
+		require.NoError(t, w.Put([]byte(fmt.Sprintf("key-%03d", i)),
+			[]byte(fmt.Sprintf("value-%03d", i)), WithSecondaryKey(0, secondary)))
+	}
+
+	has, err := w.Has([]byte("key-000"))
+	require.NoError(t, err)
+	assert.True(t, has, "key of the current run")
+	has, err = w.Has([]byte("key-099"))
+	require.NoError(t, err)
+	assert.True(t, has, "key of a flushed run")
+	has, err = w.Has([]byte("key-100"))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	require.NoError(t, w.Flush())
+	assert.Greater(t, countSegmentFiles(t, dir), 1)
+
+	assertValues := func(t *testing.T, b *Bucket) {
+		for i := 0; i < 100; i++ {
+			v, err := b.Get([]byte(fmt.Sprintf("key-%03d", i)))
+			require.NoError(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("value-%03d", i)), v)
+
+			secondary := make([]byte, 8)
+			binary.LittleEndian.PutUint64(secondary, uint64(i))
+			v, err = b.GetBySecondary(0, secondary)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(fmt.Sprintf("value-%03d", i)), v)
+		}
+		assert.Equal(t, 100, b.Count())
+	}
+
+	t.Run("segments are readable", func(t *testing.T) {
+		assertValues(t, b)
+	})
+
+	t.Run("segments are loaded after restart", func(t *testing.T) {
+		require.NoError(t, b.Shutdown(ctx))
+		b = newBulkWriterTestBucket(ctx, t, dir, opts)
+		defer b.Shutdown(ctx)
+
+		assertValues(t, b)
+	})
+}
+
+func bulkWriterSet(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	b := newBulkWriterTestBucket(ctx, t, dir, opts)
+	defer b.Shutdown(ctx)
+
+	w := b.NewBulkWriter()
+	for i := 49; i >= 0; i-- {
+		require.NoError(t, w.SetAdd([]byte(fmt.Sprintf("key-%02d", i%5)),
+			[][]byte{[]byte(fmt.Sprintf("value-%02d", i))}))
+	}
+	require.NoError(t, w.Flush())
+	assert.Greater(t, countSegmentFiles(t, dir), 1)
+
+	for i := 0; i < 5; i++ {
+		values, err := b.SetList([]byte(fmt.Sprintf("key-%02d", i)))
+		require.NoError(t, err)
+		assert.Len(t, values, 10)
+	}
+}
+
+func bulkWriterMap(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	b := newBulkWriterTestBucket(ctx, t, dir, opts)
+	defer b.Shutdown(ctx)
+
+	w := b.NewBulkWriter()
+	for i := 49; i >= 0; i-- {
+		mapKey := make([]byte, 8)
+		binary.BigEndian.PutUint64(mapKey, uint64(i))
+		require.NoError(t, w.MapSet([]byte(fmt.Sprintf("key-%02d", i%5)), MapPair{
+			Key:   mapKey,
+			Value: []byte(fmt.Sprintf("value-%02d", i)),
+		}))
+	}
+	require.NoError(t, w.Flush())
+	assert.Greater(t, countSegmentFiles(t, dir), 1)
+
+	for i := 0; i < 5; i++ {
+		pairs, err := b.MapList([]byte(fmt.Sprintf("key-%02d", i)))
+		require.NoError(t, err)
+		require.Len(t, pairs, 10)
+		for j, pair := range pairs {
+			docID := uint64(i + 5*j)
+			assert.Equal(t, docID, binary.BigEndian.Uint64(pair.Key))
+			assert.Equal(t, []byte(fmt.Sprintf("value-%02d", docID)), pair.Value)
+		}
+	}
+}
+
+func bulkWriterRoaringSet(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	b := newBulkWriterTestBucket(ctx, t, dir, opts)
+	defer b.Shutdown(ctx)
+
+	w := b.NewBulkWriter()
+	for i := 99; i >= 0; i-- {
+		require.NoError(t, w.RoaringSetAddOne([]byte(fmt.Sprintf("key-%02d", i%5)), uint64(i)))
+	}
+	require.NoError(t, w.RoaringSetAddList([]byte("key-05"), []uint64{1000, 1001}))
+	require.NoError(t, w.Flush())
+	assert.Greater(t, countSegmentFiles(t, dir), 1)
+
+	for i := 0; i < 5; i++ {
+		bm, err := b.RoaringSetGet([]byte(fmt.Sprintf("key-%02d", i)))
+		require.NoError(t, err)
+		assert.Equal(t, 20, bm.GetCardinality())
+		assert.True(t, bm.Contains(uint64(i)))
+	}
+	bm, err := b.RoaringSetGet([]byte("key-05"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{1000, 1001}, bm.ToArray())
+}
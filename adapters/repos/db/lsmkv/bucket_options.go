@@ -141,6 +141,68 @@ func WithUseBloomFilter(useBloomFilter bool) BucketOption {
 	}
 }
 
+// WithCompression compresses the value blocks of newly written replace and
+// collection segments. Existing segments are not rewritten, but will be
+// compressed once they are compacted. Segments of any compression can be read
+// regardless of this setting.
+func WithCompression(compression Compression) BucketOption {
+	return func(b *Bucket) error {
+		switch compression {
+		case CompressionNone, CompressionSnappy, CompressionZstd:
+		default:
+			return errors.Errorf("unrecognized compression %s", compression)
+		}
+
+		b.compression = compression
+		return nil
+	}
+}
+
+// WithCompactionStrategy selects how segments are picked for compaction. If
+// not set, the [LevelCompactionStrategy] is used.
+func WithCompactionStrategy(strategy CompactionStrategy) BucketOption {
+	return func(b *Bucket) error {
+		if strategy == nil {
+			return errors.Errorf("compaction strategy must not be nil")
+		}
+
+		b.compactionStrategy = strategy
+		return nil
+	}
+}
+
+// WithCompactionIOLimit limits the rate at which compactions of this bucket
+// write to disk. A non-positive value disables the limit. It applies in
+// addition to a shared limit set through [WithSharedCompactionIOLimiter].
+func WithCompactionIOLimit(bytesPerSecond int) BucketOption {
+	return func(b *Bucket) error {
+		b.compactionIOLimiter = NewIOLimiter(bytesPerSecond)
+		return nil
+	}
+}
+
+// WithSharedCompactionIOLimiter makes compactions of this bucket compete for
+// the write budget of the given limiter. Passing the same limiter to all
+// buckets of a node enforces a node-wide limit. A nil limiter is allowed and
+// never blocks.
+func WithSharedCompactionIOLimiter(limiter *IOLimiter) BucketOption {
+	return func(b *Bucket) error {
+		b.sharedCompactionIOLimiter = limiter
+		return nil
+	}
+}
+
+// WithWriteAheadLog controls whether writes to the bucket are recorded in a
+// write-ahead log. Without it, anything that was not flushed to a segment yet
+// is lost on a crash. This is only meant for buckets whose contents can be
+// rebuilt from scratch, such as the staging buckets of a bulk load.
+func WithWriteAheadLog(enabled bool) BucketOption {
+	return func(b *Bucket) error {
+		b.disableWAL = !enabled
+		return nil
+	}
+}
+
 func WithCalcCountNetAdditions(calcCountNetAdditions bool) BucketOption {
 	return func(b *Bucket) error {
 		b.calcCountNetAdditions = calcCountNetAdditions
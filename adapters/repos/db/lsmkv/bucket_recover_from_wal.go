@@ -69,6 +69,7 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		mt.compression = b.compression
 
 		b.logger.WithField("action", "lsm_recover_from_active_wal").
 			WithField("path", path).
@@ -14,6 +14,7 @@ package lsmkv
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -259,3 +260,37 @@ func TestBucket_MemtableCountWithFlushing(t *testing.T) {
 		})
 	}
 }
+
+func TestBucket_WithoutWriteAheadLog(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithWriteAheadLog(false))
+	require.Nil(t, err)
+
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	require.Nil(t, b.active.commitlog.flushBuffers())
+
+	files, err := os.ReadDir(dirName)
+	require.Nil(t, err)
+	wal, ok := findFileWithExt(files, ".wal")
+	require.True(t, ok)
+	info, err := os.Stat(filepath.Join(dirName, wal))
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), info.Size())
+
+	// the memtable is flushed to a segment on shutdown
+	require.Nil(t, b.Shutdown(ctx))
+
+	b, err = NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	value, err := b.Get([]byte("hello"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("world"), value)
+}
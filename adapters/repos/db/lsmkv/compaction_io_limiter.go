@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+// IOLimiter limits the rate at which compactions write to disk, so that
+// large compactions do not starve queries of IO. A single limiter can be
+// shared across buckets (see [WithSharedCompactionIOLimiter]) to enforce a
+// node-wide budget.
+type IOLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewIOLimiter returns a limiter for the given amount of bytes per second.
+// A non-positive limit disables throttling, in which case nil is returned.
+// A nil *IOLimiter is valid and never blocks.
+func NewIOLimiter(bytesPerSecond int) *IOLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+
+	return &IOLimiter{
+		// allow bursts of up to a second worth of writes, so that a single
+		// buffered write never exceeds the burst
+		limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond),
+	}
+}
+
+// BytesPerSecond returns the configured limit, 0 means unlimited
+func (l *IOLimiter) BytesPerSecond() int {
+	if l == nil {
+		return 0
+	}
+	return int(l.limiter.Limit())
+}
+
+func (l *IOLimiter) waitN(ctx context.Context, n int) error {
+	if l == nil {
+		return nil
+	}
+
+	burst := l.limiter.Burst()
+	for n > 0 {
+		chunk := n
+		if chunk > burst {
+			chunk = burst
+		}
+		if err := l.limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+
+	return nil
+}
+
+// throttledWriteSeeker delays writes until all limiters allow them. Seeks
+// are not throttled.
+type throttledWriteSeeker struct {
+	ctx      context.Context
+	w        io.WriteSeeker
+	limiters []*IOLimiter
+}
+
+func newThrottledWriteSeeker(ctx context.Context, w io.WriteSeeker,
+	limiters ...*IOLimiter,
+) io.WriteSeeker {
+	active := make([]*IOLimiter, 0, len(limiters))
+	for _, l := range limiters {
+		if l != nil {
+			active = append(active, l)
+		}
+	}
+
+	if len(active) == 0 {
+		return w
+	}
+
+	return &throttledWriteSeeker{ctx: ctx, w: w, limiters: active}
+}
+
+func (t *throttledWriteSeeker) Write(p []byte) (int, error) {
+	for _, l := range t.limiters {
+		if err := l.waitN(t.ctx, len(p)); err != nil {
+			return 0, err
+		}
+	}
+
+	return t.w.Write(p)
+}
+
+func (t *throttledWriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	return t.w.Seek(offset, whence)
+}
@@ -0,0 +1,357 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func multiCompactionStrategy() CompactionStrategy {
+	s := NewSizeTieredCompactionStrategy()
+	s.MinThreshold = 3
+	s.MaxThreshold = 5
+	return s
+}
+
+func TestMultiSegmentCompaction(t *testing.T) {
+	ctx := context.Background()
+	tests := bucketTests{
+		{
+			name: "multiCompactionReplace",
+			f:    multiCompactionReplace,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+				WithCompactionStrategy(multiCompactionStrategy()),
+			},
+		},
+		{
+			name: "multiCompactionSet",
+			f:    multiCompactionSet,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+				WithCompactionStrategy(multiCompactionStrategy()),
+			},
+		},
+		{
+			name: "multiCompactionMap",
+			f:    multiCompactionMap,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+				WithCompactionStrategy(multiCompactionStrategy()),
+			},
+		},
+		{
+			name: "multiCompactionRecovery",
+			f:    multiCompactionRecovery,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithCompactionStrategy(multiCompactionStrategy()),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+func multiCompactionReplace(ctx context.Context, t *testing.T, opts []BucketOption) {
+	b := newCompressionTestBucket(ctx, t, t.TempDir(), opts)
+	defer b.Shutdown(ctx)
+
+	expected := map[string][]byte{}
+	for s := 0; s < 5; s++ {
+		for i := 0; i < 20; i++ {
+			// overlapping keys, newer segments win
+			id := s*10 + i
+			key := fmt.Sprintf("key-%03d", id)
+			val := []byte(fmt.Sprintf("value-%03d-%d", id, s))
+			require.Nil(t, b.Put([]byte(key), val,
+				WithSecondaryKey(0, []byte("secondary-"+key))))
+			expected[key] = val
+		}
+		// delete a key that exists in an older segment
+		if s > 0 {
+			key := fmt.Sprintf("key-%03d", s*10-5)
+			require.Nil(t, b.Delete([]byte(key)))
+			delete(expected, key)
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+	require.Equal(t, 5, b.disk.Len())
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	assert.Equal(t, 1, b.disk.Len(), "all segments are merged in a single compaction")
+
+	for key, val := range expected {
+		res, err := b.Get([]byte(key))
+		require.Nil(t, err)
+		assert.Equal(t, val, res, key)
+
+		res, err = b.GetBySecondary(0, []byte("secondary-"+key))
+		require.Nil(t, err)
+		assert.Equal(t, val, res, key)
+	}
+
+	count := 0
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		assert.Equal(t, expected[string(k)], v)
+		count++
+	}
+	c.Close()
+	assert.Equal(t, len(expected), count)
+	assert.Equal(t, len(expected), b.Count())
+}
+
+func multiCompactionSet(ctx context.Context, t *testing.T, opts []BucketOption) {
+	b := newCompressionTestBucket(ctx, t, t.TempDir(), opts)
+	defer b.Shutdown(ctx)
+
+	expected := map[string][][]byte{}
+	for s := 0; s < 4; s++ {
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key-%03d", s*5+i)
+			val := []byte(fmt.Sprintf("value-%d", s))
+			require.Nil(t, b.SetAdd([]byte(key), [][]byte{val}))
+			expected[key] = append(expected[key], val)
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	// remove a value that was added in the first segment
+	require.Nil(t, b.SetDeleteSingle([]byte("key-007"), []byte("value-0")))
+	expected["key-007"] = expected["key-007"][1:]
+	require.Nil(t, b.FlushMemtable())
+	require.Equal(t, 5, b.disk.Len())
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	assert.Equal(t, 1, b.disk.Len())
+
+	for key, values := range expected {
+		res, err := b.SetList([]byte(key))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, values, res, key)
+	}
+}
+
+func multiCompactionMap(ctx context.Context, t *testing.T, opts []BucketOption) {
+	b := newCompressionTestBucket(ctx, t, t.TempDir(), opts)
+	defer b.Shutdown(ctx)
+
+	expected := map[string]map[string][]byte{}
+	for s := 0; s < 4; s++ {
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			// every segment adds a new map key and overwrites a shared one
+			for _, mapKey := range []string{fmt.Sprintf("own-%d", s), "shared"} {
+				val := []byte(fmt.Sprintf("value-%d-%d", i, s))
+				require.Nil(t, b.MapSet([]byte(key), MapPair{Key: []byte(mapKey), Value: val}))
+				if expected[key] == nil {
+					expected[key] = map[string][]byte{}
+				}
+				expected[key][mapKey] = val
+			}
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+	require.Equal(t, 4, b.disk.Len())
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	assert.Equal(t, 1, b.disk.Len())
+
+	for key, pairs := range expected {
+		res, err := b.MapList([]byte(key))
+		require.Nil(t, err)
+		require.Len(t, res, len(pairs), key)
+		for _, pair := range res {
+			assert.Equal(t, pairs[string(pair.Key)], pair.Value)
+		}
+	}
+}
+
+// multiCompactionRecovery simulates a crash after the oldest segment of a
+// multi-segment compaction was already dropped, but the others weren't.
+func multiCompactionRecovery(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	backup := t.TempDir()
+
+	b := newCompressionTestBucket(ctx, t, dir, opts)
+	expected := map[string][]byte{}
+	for s := 0; s < 3; s++ {
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key-%03d", s*5+i)
+			val := []byte(fmt.Sprintf("value-%d", s))
+			require.Nil(t, b.Put([]byte(key), val))
+			expected[key] = val
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	var ids []string
+	for _, seg := range b.disk.segments {
+		ids = append(ids, segmentID(seg.path))
+	}
+	require.Len(t, ids, 3)
+	copyDirFiles(t, dir, backup)
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	compactedPath := b.disk.segments[0].path
+	require.Nil(t, b.Shutdown(ctx))
+
+	// restore the state in which the compacted segment was written, but only
+	// the oldest segment was dropped
+	compactedSegment, err := os.ReadFile(compactedPath)
+	require.Nil(t, err)
+	require.Nil(t, os.RemoveAll(dir))
+	require.Nil(t, os.MkdirAll(dir, 0o700))
+	copyDirFiles(t, backup, dir)
+	require.Nil(t, os.Remove(filepath.Join(dir, "segment-"+ids[0]+".db")))
+	tmpName := "segment-" + strings.Join(ids, "_") + ".db.tmp"
+	require.Nil(t, os.WriteFile(filepath.Join(dir, tmpName), compactedSegment, 0o600))
+
+	b = newCompressionTestBucket(ctx, t, dir, opts)
+	defer b.Shutdown(ctx)
+
+	assert.Equal(t, 1, b.disk.Len())
+	for key, val := range expected {
+		res, err := b.Get([]byte(key))
+		require.Nil(t, err)
+		assert.Equal(t, val, res, key)
+	}
+	assert.Equal(t, len(expected), b.Count())
+
+	files, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	for _, f := range files {
+		assert.NotContains(t, f.Name(), ids[1], "middle segment was removed")
+		assert.NotEqual(t, ".tmp", filepath.Ext(f.Name()))
+	}
+}
+
+func copyDirFiles(t *testing.T, from, to string) {
+	files, err := os.ReadDir(from)
+	require.Nil(t, err)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		contents, err := os.ReadFile(filepath.Join(from, f.Name()))
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(filepath.Join(to, f.Name()), contents, 0o600))
+	}
+}
+
+type seekableBuffer struct {
+	bytes.Buffer
+}
+
+func (s *seekableBuffer) Seek(offset int64, whence int) (int64, error) {
+	return 0, nil
+}
+
+func TestThrottledWriteSeeker(t *testing.T) {
+	t.Run("without limiters the writer is returned as is", func(t *testing.T) {
+		buf := &seekableBuffer{}
+		w := newThrottledWriteSeeker(context.Background(), buf, nil, NewIOLimiter(0))
+		assert.Equal(t, io.WriteSeeker(buf), w)
+	})
+
+	t.Run("writes are throttled", func(t *testing.T) {
+		buf := &seekableBuffer{}
+		limiter := NewIOLimiter(64 * 1024)
+		assert.Equal(t, 64*1024, limiter.BytesPerSecond())
+		w := newThrottledWriteSeeker(context.Background(), buf, limiter)
+
+		// the first second worth of writes is covered by the burst, the rest
+		// needs to wait
+		before := time.Now()
+		for i := 0; i < 10; i++ {
+			_, err := w.Write(make([]byte, 16*1024))
+			require.Nil(t, err)
+		}
+		took := time.Since(before)
+
+		assert.Equal(t, 160*1024, buf.Len())
+		assert.GreaterOrEqual(t, took, 1400*time.Millisecond)
+	})
+
+	t.Run("writes larger than the burst are split", func(t *testing.T) {
+		buf := &seekableBuffer{}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		w := newThrottledWriteSeeker(ctx, buf, NewIOLimiter(1024))
+		_, err := w.Write(make([]byte, 4*1024))
+		assert.NotNil(t, err, "would need to wait beyond the deadline")
+	})
+}
+
+func TestCompactionCancelledOnShutdown(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	b := newCompressionTestBucket(ctx, t, dir, []BucketOption{
+		WithStrategy(StrategyReplace),
+		WithCompactionIOLimit(1024),
+	})
+	defer b.Shutdown(ctx)
+
+	for s := 0; s < 2; s++ {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%03d-%d", i, s)
+			require.Nil(t, b.Put([]byte(key), bytes.Repeat([]byte("v"), 200)))
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := b.disk.compactOnce()
+		done <- err
+	}()
+
+	// the compaction needs several seconds worth of IO budget
+	time.Sleep(50 * time.Millisecond)
+	b.disk.cancelCompaction()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("compaction was not cancelled")
+	}
+
+	// the partially written segment is removed
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	for _, e := range entries {
+		assert.False(t, strings.HasSuffix(e.Name(), ".tmp"), e.Name())
+	}
+	assert.Equal(t, 2, b.disk.Len())
+}
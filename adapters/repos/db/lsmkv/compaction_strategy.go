@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	CompactionStrategyLevel      = "level"
+	CompactionStrategySizeTiered = "sizetiered"
+)
+
+// maxCompactionFanIn is the most segments a single compaction merges. It is
+// limited by the name of the temporary compaction file, which contains the
+// ids of all segments involved and must fit within the usual 255 byte limit
+// for file names.
+const maxCompactionFanIn = 10
+
+// CompactionSegment is the view a [CompactionStrategy] has on a single disk
+// segment.
+type CompactionSegment struct {
+	Level uint16
+	// Size is the size of the segment on disk in bytes
+	Size int64
+}
+
+// CompactionStrategy decides which disk segments of a bucket are merged
+// next. Segments are passed from oldest to newest.
+type CompactionStrategy interface {
+	Name() string
+
+	// Candidates returns the positions of the segments that should be merged
+	// into a single one, in ascending order, or nil if there is nothing to
+	// compact. If compactLeftOver is set (see [WithForceCompation]), the
+	// strategy should keep merging until only a single segment is left.
+	//
+	// Strategies can return more than two candidates to merge several segments
+	// in one go. Roaring set buckets, however, only support merging pairs, so
+	// only the first two candidates are used for those. At most
+	// maxCompactionFanIn candidates are used.
+	Candidates(segments []CompactionSegment, compactLeftOver bool) []int
+}
+
+// ParseCompactionStrategy returns the strategy with default settings for the
+// given name. An empty name selects the default level strategy.
+func ParseCompactionStrategy(name string) (CompactionStrategy, error) {
+	switch name {
+	case "", CompactionStrategyLevel:
+		return LevelCompactionStrategy{}, nil
+	case CompactionStrategySizeTiered:
+		return NewSizeTieredCompactionStrategy(), nil
+	default:
+		return nil, fmt.Errorf("unrecognized compaction strategy %q", name)
+	}
+}
+
+// LevelCompactionStrategy is the default strategy. It always merges a pair
+// of segments of the lowest level that has at least two segments. The merged
+// segment is promoted to the next level.
+type LevelCompactionStrategy struct{}
+
+func (LevelCompactionStrategy) Name() string {
+	return CompactionStrategyLevel
+}
+
+func (LevelCompactionStrategy) Candidates(segments []CompactionSegment,
+	compactLeftOver bool,
+) []int {
+	// Nothing to compact
+	if len(segments) < 2 {
+		return nil
+	}
+
+	// first determine the lowest level with candidates
+	levels := map[uint16]int{}
+	lowestPairLevel := uint16(math.MaxUint16)
+	lowestLevel := uint16(math.MaxUint16)
+	lowestIndex := -1
+	secondLowestIndex := -1
+	pairExists := false
+
+	for ind, seg := range segments {
+		levels[seg.Level]++
+		val := levels[seg.Level]
+		if val > 1 {
+			if seg.Level < lowestPairLevel {
+				lowestPairLevel = seg.Level
+				pairExists = true
+			}
+		}
+
+		if seg.Level < lowestLevel {
+			secondLowestIndex = lowestIndex
+			lowestLevel = seg.Level
+			lowestIndex = ind
+		}
+	}
+
+	if pairExists {
+		// now pick any two segments which match the level
+		var res []int
+
+		for i, segment := range segments {
+			if len(res) >= 2 {
+				break
+			}
+
+			if segment.Level == lowestPairLevel {
+				res = append(res, i)
+			}
+		}
+
+		return res
+	} else {
+		if compactLeftOver {
+			// Some segments exist, but none are of the same level
+			// Merge the two lowest segments
+
+			return []int{secondLowestIndex, lowestIndex}
+		} else {
+			// No segments of the same level exist, and we are not allowed to merge the lowest segments
+			// This means we cannot compact.  Set COMPACT_LEFTOVER_SEGMENTS to true to compact the remaining segments
+			return nil
+		}
+	}
+}
+
+// SizeTieredCompactionStrategy merges runs of adjacent segments of similar
+// size. Compared to the level strategy each byte is rewritten far less
+// often, as several segments are merged at once and a segment is only
+// rewritten once enough segments of its size have accumulated.
+type SizeTieredCompactionStrategy struct {
+	// MinThreshold is the minimum number of similarly sized segments before
+	// they are merged.
+	MinThreshold int
+	// MaxThreshold is the maximum number of segments merged at once.
+	MaxThreshold int
+	// Segments are considered similar if their size lies within
+	// [BucketLow, BucketHigh] times the average size of the run.
+	BucketLow  float64
+	BucketHigh float64
+	// Segments smaller than MinSegmentSize are all considered similar,
+	// regardless of their actual size.
+	MinSegmentSize int64
+}
+
+func NewSizeTieredCompactionStrategy() SizeTieredCompactionStrategy {
+	return SizeTieredCompactionStrategy{
+		MinThreshold:   4,
+		MaxThreshold:   8,
+		BucketLow:      0.5,
+		BucketHigh:     1.5,
+		MinSegmentSize: 32 * 1024 * 1024,
+	}
+}
+
+func (SizeTieredCompactionStrategy) Name() string {
+	return CompactionStrategySizeTiered
+}
+
+func (s SizeTieredCompactionStrategy) Candidates(segments []CompactionSegment,
+	compactLeftOver bool,
+) []int {
+	if len(segments) < 2 {
+		return nil
+	}
+
+	minThreshold := s.MinThreshold
+	if minThreshold < 2 {
+		minThreshold = 2
+	}
+	maxThreshold := s.MaxThreshold
+	if maxThreshold < minThreshold {
+		maxThreshold = minThreshold
+	}
+
+	bestStart, bestEnd := -1, -1
+	bestAvg := math.MaxFloat64
+
+	// Only adjacent segments can be merged, as the order of segments
+	// determines which value wins on conflicting writes. Segments are grouped
+	// into maximal runs of similar size, of which the run with the smallest
+	// average size is compacted first, as it is the cheapest to merge.
+	for start := 0; start < len(segments); {
+		end := start + 1
+		sum := float64(segments[start].Size)
+		for end < len(segments) && end-start < maxThreshold {
+			avg := sum / float64(end-start)
+			if !s.similar(segments[end].Size, avg) {
+				break
+			}
+			sum += float64(segments[end].Size)
+			end++
+		}
+
+		avg := sum / float64(end-start)
+		if end-start >= minThreshold && avg < bestAvg {
+			bestStart, bestEnd, bestAvg = start, end, avg
+		}
+
+		start = end
+	}
+
+	if bestStart == -1 {
+		if !compactLeftOver {
+			return nil
+		}
+		// merge the oldest segments, so that eventually only a single one is
+		// left
+		bestStart, bestEnd = 0, len(segments)
+		if bestEnd > maxThreshold {
+			bestEnd = maxThreshold
+		}
+	}
+
+	out := make([]int, 0, bestEnd-bestStart)
+	for i := bestStart; i < bestEnd; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+func (s SizeTieredCompactionStrategy) similar(size int64, avg float64) bool {
+	if size < s.MinSegmentSize && avg < float64(s.MinSegmentSize) {
+		return true
+	}
+
+	return float64(size) >= avg*s.BucketLow && float64(size) <= avg*s.BucketHigh
+}
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompactionStrategy(t *testing.T) {
+	s, err := ParseCompactionStrategy("")
+	require.Nil(t, err)
+	assert.Equal(t, CompactionStrategyLevel, s.Name())
+
+	s, err = ParseCompactionStrategy("level")
+	require.Nil(t, err)
+	assert.Equal(t, CompactionStrategyLevel, s.Name())
+
+	s, err = ParseCompactionStrategy("sizetiered")
+	require.Nil(t, err)
+	assert.Equal(t, CompactionStrategySizeTiered, s.Name())
+
+	_, err = ParseCompactionStrategy("leveled")
+	assert.NotNil(t, err)
+}
+
+func levels(in ...uint16) []CompactionSegment {
+	out := make([]CompactionSegment, len(in))
+	for i, level := range in {
+		out[i] = CompactionSegment{Level: level}
+	}
+	return out
+}
+
+func sizes(in ...int64) []CompactionSegment {
+	out := make([]CompactionSegment, len(in))
+	for i, size := range in {
+		out[i] = CompactionSegment{Size: size}
+	}
+	return out
+}
+
+func TestLevelCompactionStrategy(t *testing.T) {
+	tests := []struct {
+		name            string
+		segments        []CompactionSegment
+		compactLeftOver bool
+		expected        []int
+	}{
+		{
+			name:     "single segment",
+			segments: levels(0),
+		},
+		{
+			name:     "pair on lowest level",
+			segments: levels(3, 2, 2, 1, 1),
+			expected: []int{3, 4},
+		},
+		{
+			name:     "no pair",
+			segments: levels(3, 2, 1),
+		},
+		{
+			name:            "no pair, but compacting leftovers",
+			segments:        levels(3, 2, 1),
+			compactLeftOver: true,
+			expected:        []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := LevelCompactionStrategy{}.Candidates(test.segments, test.compactLeftOver)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
+
+func TestSizeTieredCompactionStrategy(t *testing.T) {
+	const mb = 1024 * 1024
+	s := SizeTieredCompactionStrategy{
+		MinThreshold:   3,
+		MaxThreshold:   4,
+		BucketLow:      0.5,
+		BucketHigh:     1.5,
+		MinSegmentSize: 1 * mb,
+	}
+
+	tests := []struct {
+		name            string
+		segments        []CompactionSegment
+		compactLeftOver bool
+		expected        []int
+	}{
+		{
+			name:     "single segment",
+			segments: sizes(100 * mb),
+		},
+		{
+			name:     "too few similar segments",
+			segments: sizes(1000*mb, 100*mb, 90*mb),
+		},
+		{
+			name:     "run of similar segments",
+			segments: sizes(1000*mb, 100*mb, 90*mb, 110*mb),
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "runs are limited to the max threshold",
+			segments: sizes(100*mb, 90*mb, 110*mb, 100*mb, 95*mb, 105*mb),
+			expected: []int{0, 1, 2, 3},
+		},
+		{
+			name:     "the run with the smallest segments wins",
+			segments: sizes(1000*mb, 900*mb, 1100*mb, 100*mb, 90*mb, 110*mb),
+			expected: []int{3, 4, 5},
+		},
+		{
+			name:     "similar segments must be adjacent",
+			segments: sizes(100*mb, 1000*mb, 100*mb, 1000*mb, 100*mb),
+		},
+		{
+			name:     "tiny segments are always similar",
+			segments: sizes(10*mb, 1024, 100*1024, 300*1024, 2*1024),
+			expected: []int{1, 2, 3, 4},
+		},
+		{
+			name:            "compacting leftovers merges the oldest segments",
+			segments:        sizes(1000*mb, 100*mb, 10*mb, 1*mb, 1*mb, 10*mb),
+			compactLeftOver: true,
+			expected:        []int{0, 1, 2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := s.Candidates(test.segments, test.compactLeftOver)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
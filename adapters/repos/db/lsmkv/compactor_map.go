@@ -22,10 +22,9 @@ import (
 )
 
 type compactorMap struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorCollectionReusable
-	c2 *segmentCursorCollectionReusable
+	// cursors are ordered from oldest to newest segment, so when there is a
+	// conflict the later cursor wins
+	cursors []*segmentCursorCollectionReusable
 
 	// the level matching those of the cursors
 	currentLevel        uint16
@@ -39,6 +38,7 @@ type compactorMap struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+	compression      Compression
 
 	// for backward-compatibility with states where the disk state for maps was
 	// not guaranteed to be sorted yet
@@ -46,18 +46,19 @@ type compactorMap struct {
 }
 
 func newCompactorMapCollection(w io.WriteSeeker,
-	c1, c2 *segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
+	cursors []*segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
 	scratchSpacePath string, requiresSorting bool, cleanupTombstones bool,
+	compression Compression,
 ) *compactorMap {
 	return &compactorMap{
-		c1:                  c1,
-		c2:                  c2,
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
 		cleanupTombstones:   cleanupTombstones,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 		requiresSorting:     requiresSorting,
 	}
 }
@@ -86,7 +87,7 @@ func (c *compactorMap) do() error {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, c.secondaryIndexCount,
+	if err := c.writeHeader(c.currentLevel, c.compression.segmentVersion(), c.secondaryIndexCount,
 		dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
@@ -107,99 +108,76 @@ func (c *compactorMap) init() error {
 }
 
 func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
-	key1, value1, _ := c.c1.first()
-	key2, value2, _ := c.c2.first()
+	keys := make([][]byte, len(c.cursors))
+	values := make([][]value, len(c.cursors))
+	for i, cursor := range c.cursors {
+		keys[i], values[i], _ = cursor.first()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := segmentindex.HeaderSize
 
 	var kis []segmentindex.Key
-	pairs := newReusableMapPairs()
+	pairs := newReusableMapPairs(len(c.cursors))
+	lists := make([][]MapPair, 0, len(c.cursors))
+	matches := make([]int, 0, len(c.cursors))
 	me := newMapEncoder()
 	ssm := newSortedMapMerger()
 
 	for {
-		if key1 == nil && key2 == nil {
+		key, matching := smallestKey(keys, matches)
+		if key == nil {
 			break
 		}
-		if bytes.Equal(key1, key2) {
-			pairs.ResizeLeft(len(value1))
-			pairs.ResizeRight(len(value2))
 
-			for i, v := range value1 {
-				if err := pairs.left[i].FromBytes(v.value, false); err != nil {
-					return nil, err
+		merged := values[matching[0]]
+		if len(matching) > 1 {
+			lists = lists[:0]
+			for _, pos := range matching {
+				pairs.Resize(pos, len(values[pos]))
+				list := pairs.lists[pos]
+				for i, v := range values[pos] {
+					if err := list[i].FromBytes(v.value, false); err != nil {
+						return nil, err
+					}
+					list[i].Tombstone = v.tombstone
 				}
-				pairs.left[i].Tombstone = v.tombstone
-			}
 
-			for i, v := range value2 {
-				if err := pairs.right[i].FromBytes(v.value, false); err != nil {
-					return nil, err
+				if c.requiresSorting {
+					sort.Slice(list, func(a, b int) bool {
+						return bytes.Compare(list[a].Key, list[b].Key) < 0
+					})
 				}
-				pairs.right[i].Tombstone = v.tombstone
-			}
-
-			if c.requiresSorting {
-				sort.Slice(pairs.left, func(a, b int) bool {
-					return bytes.Compare(pairs.left[a].Key, pairs.left[b].Key) < 0
-				})
-				sort.Slice(pairs.right, func(a, b int) bool {
-					return bytes.Compare(pairs.right[a].Key, pairs.right[b].Key) < 0
-				})
+				lists = append(lists, list)
 			}
 
-			ssm.reset([][]MapPair{pairs.left, pairs.right})
+			ssm.reset(lists)
 			mergedPairs, err := ssm.
 				doKeepTombstonesReusable()
 			if err != nil {
 				return nil, err
 			}
 
-			mergedEncoded, err := me.DoMultiReusable(mergedPairs)
+			merged, err = me.DoMultiReusable(mergedPairs)
 			if err != nil {
 				return nil, err
 			}
-
-			if values, skip := c.cleanupValues(mergedEncoded); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (equal keys)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			// advance both!
-			key1, value1, _ = c.c1.next()
-			key2, value2, _ = c.c2.next()
-			continue
 		}
 
-		if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
-			// key 1 is smaller
-			if values, skip := c.cleanupValues(value1); !skip {
-				ki, err := c.writeIndividualNode(offset, key1, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key1 smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+		if vals, skip := c.cleanupValues(merged); !skip {
+			ki, err := c.writeIndividualNode(offset, key, vals)
+			if err != nil {
+				return nil, errors.Wrap(err, "write individual node")
 			}
-			key1, value1, _ = c.c1.next()
-		} else {
-			// key 2 is smaller
-			if values, skip := c.cleanupValues(value2); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key2 smaller)")
-				}
 
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			key2, value2, _ = c.c2.next()
+			offset = ki.ValueEnd
+			kis = append(kis, ki)
+		}
+
+		// the key may point into the buffer of one of the cursors, so they can
+		// only be advanced once it is no longer needed
+		for _, pos := range matching {
+			keys[pos], values[pos], _ = c.cursors[pos].next()
 		}
 	}
 
@@ -222,11 +200,11 @@ func (c *compactorMap) writeIndividualNode(offset int, key []byte,
 	keyCopy := make([]byte, len(key))
 	copy(keyCopy, key)
 
-	return segmentCollectionNode{
+	return c.compression.writeNode(c.bufw, segmentCollectionNode{
 		values:     values,
 		primaryKey: keyCopy,
 		offset:     offset,
-	}.KeyIndexAndWriteTo(c.bufw)
+	}, offset)
 }
 
 func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
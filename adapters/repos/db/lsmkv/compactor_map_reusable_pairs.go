@@ -12,21 +12,21 @@
 package lsmkv
 
 // reusableMapPairs is not thread-safe and intended for usage from a single
-// thread. The caller is resoponsible for initializing each element themselves,
-// the Resize functions will only set the size. If the size is reduced, this
-// will only truncate elements, but will not reset values.
+// thread. It holds one list of pairs per input segment. The caller is
+// resoponsible for initializing each element themselves, the Resize function
+// will only set the size. If the size is reduced, this will only truncate
+// elements, but will not reset values.
 type reusableMapPairs struct {
-	left  []MapPair
-	right []MapPair
+	lists [][]MapPair
 }
 
-func newReusableMapPairs() *reusableMapPairs {
-	return &reusableMapPairs{}
+func newReusableMapPairs(inputs int) *reusableMapPairs {
+	return &reusableMapPairs{lists: make([][]MapPair, inputs)}
 }
 
-func (rmp *reusableMapPairs) ResizeLeft(size int) {
-	if cap(rmp.left) >= size {
-		rmp.left = rmp.left[:size]
+func (rmp *reusableMapPairs) Resize(pos, size int) {
+	if cap(rmp.lists[pos]) >= size {
+		rmp.lists[pos] = rmp.lists[pos][:size]
 	} else {
 		// The 25% overhead for the capacity was chosen because we saw a lot
 		// re-allocations during testing with just a few elements more than before.
@@ -34,20 +34,6 @@ func (rmp *reusableMapPairs) ResizeLeft(size int) {
 		// in the test scenarios based on the
 		// weaviate-chaos-engineering/apps/importer-no-vector-index test script a
 		// simple 25% overhead reduced the resizing needs to almost zero.
-		rmp.left = make([]MapPair, size, int(float64(size)*1.25))
-	}
-}
-
-func (rmp *reusableMapPairs) ResizeRight(size int) {
-	if cap(rmp.right) >= size {
-		rmp.right = rmp.right[:size]
-	} else {
-		// The 25% overhead for the capacity was chosen because we saw a lot
-		// re-allocations during testing with just a few elements more than before.
-		// This is something that really depends on the user's usage pattern, but
-		// in the test scenarios based on the
-		// weaviate-chaos-engineering/apps/importer-no-vector-index test script a
-		// simple 25% overhead reduced the resizing needs to almost zero.
-		rmp.right = make([]MapPair, size, int(float64(size)*1.25))
+		rmp.lists[pos] = make([]MapPair, size, int(float64(size)*1.25))
 	}
 }
@@ -23,10 +23,9 @@ import (
 )
 
 type compactorReplace struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorReplace
-	c2 *segmentCursorReplace
+	// cursors are ordered from oldest to newest segment, so when there is a
+	// conflict the later cursor wins (because of the replace strategy)
+	cursors []*segmentCursorReplace
 
 	// the level matching those of the cursors
 	currentLevel uint16
@@ -39,21 +38,23 @@ type compactorReplace struct {
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
 	scratchSpacePath string
+	compression      Compression
 }
 
 func newCompactorReplace(w io.WriteSeeker,
-	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
+	cursors []*segmentCursorReplace, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
+	compression Compression,
 ) *compactorReplace {
 	return &compactorReplace{
-		c1:                  c1,
-		c2:                  c2,
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
 		cleanupTombstones:   cleanupTombstones,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 	}
 }
 
@@ -81,7 +82,7 @@ func (c *compactorReplace) do() error {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, c.secondaryIndexCount, dataEnd); err != nil {
+	if err := c.writeHeader(c.currentLevel, c.compression.segmentVersion(), c.secondaryIndexCount, dataEnd); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -101,8 +102,11 @@ func (c *compactorReplace) init() error {
 }
 
 func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
-	res1, err1 := c.c1.firstWithAllKeys()
-	res2, err2 := c.c2.firstWithAllKeys()
+	nodes := make([]segmentReplaceNode, len(c.cursors))
+	errs := make([]error, len(c.cursors))
+	for i, cursor := range c.cursors {
+		nodes[i], errs[i] = cursor.firstWithAllKeys()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := segmentindex.HeaderSize
@@ -110,53 +114,41 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 	var kis []segmentindex.Key
 
 	for {
-		if res1.primaryKey == nil && res2.primaryKey == nil {
+		// find the smallest key, on equal keys the newest segment wins
+		winner := -1
+		for i := range nodes {
+			if nodes[i].primaryKey == nil {
+				continue
+			}
+			if winner == -1 || bytes.Compare(nodes[i].primaryKey, nodes[winner].primaryKey) <= 0 {
+				winner = i
+			}
+		}
+
+		if winner == -1 {
 			break
 		}
-		if bytes.Equal(res1.primaryKey, res2.primaryKey) {
-			if !(c.cleanupTombstones && errors.Is(err2, lsmkv.Deleted)) {
-				ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
-					res2.secondaryKeys, errors.Is(err2, lsmkv.Deleted))
-				if err != nil {
-					return nil, fmt.Errorf("write individual node (equal keys): %w", err)
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+
+		deleted := errors.Is(errs[winner], lsmkv.Deleted)
+		if !(c.cleanupTombstones && deleted) {
+			ki, err := c.writeIndividualNode(offset, nodes[winner].primaryKey,
+				nodes[winner].value, nodes[winner].secondaryKeys, deleted)
+			if err != nil {
+				return nil, fmt.Errorf("write individual node: %w", err)
 			}
-			// advance both!
-			res1, err1 = c.c1.nextWithAllKeys()
-			res2, err2 = c.c2.nextWithAllKeys()
-			continue
+
+			offset = ki.ValueEnd
+			kis = append(kis, ki)
 		}
 
-		if (res1.primaryKey != nil && bytes.Compare(res1.primaryKey, res2.primaryKey) == -1) || res2.primaryKey == nil {
-			// key 1 is smaller
-			if !(c.cleanupTombstones && errors.Is(err1, lsmkv.Deleted)) {
-				ki, err := c.writeIndividualNode(offset, res1.primaryKey, res1.value,
-					res1.secondaryKeys, errors.Is(err1, lsmkv.Deleted))
-				if err != nil {
-					return nil, fmt.Errorf("write individual node (res1.primaryKey smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			res1, err1 = c.c1.nextWithAllKeys()
-		} else {
-			// key 2 is smaller
-			if !(c.cleanupTombstones && errors.Is(err2, lsmkv.Deleted)) {
-				ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
-					res2.secondaryKeys, errors.Is(err2, lsmkv.Deleted))
-				if err != nil {
-					return nil, fmt.Errorf("write individual node (res2.primaryKey smaller): %w", err)
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+		// advance all cursors that are positioned on the written key
+		key := nodes[winner].primaryKey
+		for i := range nodes {
+			if i != winner && bytes.Equal(nodes[i].primaryKey, key) {
+				nodes[i], errs[i] = c.cursors[i].nextWithAllKeys()
 			}
-			res2, err2 = c.c2.nextWithAllKeys()
 		}
+		nodes[winner], errs[winner] = c.cursors[winner].nextWithAllKeys()
 	}
 
 	return kis, nil
@@ -174,7 +166,7 @@ func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 		secondaryKeys:       secondaryKeys,
 	}
 
-	return segNode.KeyIndexAndWriteTo(c.bufw)
+	return c.compression.writeNode(c.bufw, &segNode, offset)
 }
 
 func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
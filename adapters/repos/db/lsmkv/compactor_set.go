@@ -21,10 +21,9 @@ import (
 )
 
 type compactorSet struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorCollection
-	c2 *segmentCursorCollection
+	// cursors are ordered from oldest to newest segment, values of the same
+	// key are merged in that order
+	cursors []*segmentCursorCollection
 
 	// the level matching those of the cursors
 	currentLevel        uint16
@@ -38,21 +37,23 @@ type compactorSet struct {
 	bufw *bufio.Writer
 
 	scratchSpacePath string
+	compression      Compression
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
-	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
+	cursors []*segmentCursorCollection, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
+	compression Compression,
 ) *compactorSet {
 	return &compactorSet{
-		c1:                  c1,
-		c2:                  c2,
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
 		cleanupTombstones:   cleanupTombstones,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
+		compression:         compression,
 	}
 }
 
@@ -80,7 +81,7 @@ func (c *compactorSet) do() error {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, c.secondaryIndexCount,
+	if err := c.writeHeader(c.currentLevel, c.compression.segmentVersion(), c.secondaryIndexCount,
 		dataEnd); err != nil {
 		return errors.Wrap(err, "write header")
 	}
@@ -101,60 +102,47 @@ func (c *compactorSet) init() error {
 }
 
 func (c *compactorSet) writeKeys() ([]segmentindex.Key, error) {
-	key1, value1, _ := c.c1.first()
-	key2, value2, _ := c.c2.first()
+	keys := make([][]byte, len(c.cursors))
+	values := make([][]value, len(c.cursors))
+	for i, cursor := range c.cursors {
+		keys[i], values[i], _ = cursor.first()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := segmentindex.HeaderSize
 
 	var kis []segmentindex.Key
+	matches := make([]int, 0, len(c.cursors))
 
 	for {
-		if key1 == nil && key2 == nil {
+		key, matching := smallestKey(keys, matches)
+		if key == nil {
 			break
 		}
-		if bytes.Equal(key1, key2) {
-			values := append(value1, value2...)
-			valuesMerged := newSetDecoder().DoPartial(values)
-			if values, skip := c.cleanupValues(valuesMerged); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (equal keys)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+
+		var merged []value
+		if len(matching) == 1 {
+			merged = values[matching[0]]
+		} else {
+			var all []value
+			for _, pos := range matching {
+				all = append(all, values[pos]...)
 			}
-			// advance both!
-			key1, value1, _ = c.c1.next()
-			key2, value2, _ = c.c2.next()
-			continue
+			merged = newSetDecoder().DoPartial(all)
 		}
 
-		if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
-			// key 1 is smaller
-			if values, skip := c.cleanupValues(value1); !skip {
-				ki, err := c.writeIndividualNode(offset, key1, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key1 smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+		if vals, skip := c.cleanupValues(merged); !skip {
+			ki, err := c.writeIndividualNode(offset, key, vals)
+			if err != nil {
+				return nil, errors.Wrap(err, "write individual node")
 			}
-			key1, value1, _ = c.c1.next()
-		} else {
-			// key 2 is smaller
-			if values, skip := c.cleanupValues(value2); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key2 smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			key2, value2, _ = c.c2.next()
+
+			offset = ki.ValueEnd
+			kis = append(kis, ki)
+		}
+
+		for _, pos := range matching {
+			keys[pos], values[pos], _ = c.cursors[pos].next()
 		}
 	}
 
@@ -164,11 +152,11 @@ func (c *compactorSet) writeKeys() ([]segmentindex.Key, error) {
 func (c *compactorSet) writeIndividualNode(offset int, key []byte,
 	values []value,
 ) (segmentindex.Key, error) {
-	return (&segmentCollectionNode{
+	return c.compression.writeNode(c.bufw, &segmentCollectionNode{
 		values:     values,
 		primaryKey: key,
 		offset:     offset,
-	}).KeyIndexAndWriteTo(c.bufw)
+	}, offset)
 }
 
 func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
@@ -238,3 +226,32 @@ func (c *compactorSet) cleanupValues(values []value) (vals []value, skip bool) {
 	}
 	return values[:last], false
 }
+
+// smallestKey returns the smallest of the given keys along with the
+// positions of all keys equal to it, in ascending order. nil keys indicate an
+// exhausted cursor. The returned slice reuses the memory of buf.
+func smallestKey(keys [][]byte, buf []int) ([]byte, []int) {
+	var smallest []byte
+	matching := buf[:0]
+	for i, key := range keys {
+		if key == nil {
+			continue
+		}
+
+		if smallest == nil {
+			smallest = key
+			matching = append(matching, i)
+			continue
+		}
+
+		switch cmp := bytes.Compare(key, smallest); {
+		case cmp < 0:
+			smallest = key
+			matching = append(matching[:0], i)
+		case cmp == 0:
+			matching = append(matching, i)
+		}
+	}
+
+	return smallest, matching
+}
@@ -88,6 +88,10 @@ func (s *segmentCursorCollection) first() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollection) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
+	if s.segment.compressed() {
+		return s.segment.parseCompressedCollectionNode(offset)
+	}
+
 	r, err := s.segment.newNodeReader(offset)
 	if err != nil {
 		return segmentCollectionNode{}, err
@@ -12,6 +12,8 @@
 package lsmkv
 
 import (
+	"bytes"
+
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -74,6 +76,19 @@ func (s *segmentCursorCollectionReusable) first() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollectionReusable) parseCollectionNodeInto(offset nodeOffset) error {
+	if s.segment.compressed() {
+		raw, blockLen, err := s.segment.readCompressedNode(offset)
+		if err != nil {
+			return err
+		}
+
+		err = ParseCollectionNodeInto(bytes.NewReader(raw), &s.nodeBuf)
+		// the cursor needs to advance by the size of the block on disk, not by
+		// the size of the decompressed node
+		s.nodeBuf.offset = blockLen
+		return err
+	}
+
 	r, err := s.segment.newNodeReader(offset)
 	if err != nil {
 		return err
@@ -110,6 +110,10 @@ func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
 }
 
 func (s *segmentCursorMap) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
+	if s.segment.compressed() {
+		return s.segment.parseCompressedCollectionNode(offset)
+	}
+
 	r, err := s.segment.newNodeReader(offset)
 	if err != nil {
 		return segmentCollectionNode{}, err
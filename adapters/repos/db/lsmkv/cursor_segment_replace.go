@@ -12,6 +12,8 @@
 package lsmkv
 
 import (
+	"bytes"
+
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/usecases/byteops"
 )
@@ -128,6 +130,10 @@ func (s *segmentCursorReplace) firstWithAllKeys() (segmentReplaceNode, error) {
 }
 
 func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentReplaceNode, error) {
+	if s.segment.compressed() {
+		return s.parseCompressedReplaceNode(offset)
+	}
+
 	r, err := s.segment.newNodeReader(offset)
 	if err != nil {
 		return segmentReplaceNode{}, err
@@ -139,7 +145,36 @@ func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentRepla
 	return out, err
 }
 
+func (s *segmentCursorReplace) parseCompressedReplaceNode(offset nodeOffset) (segmentReplaceNode, error) {
+	raw, blockLen, err := s.segment.readCompressedNode(offset)
+	if err != nil {
+		return segmentReplaceNode{}, err
+	}
+
+	out, err := ParseReplaceNode(bytes.NewReader(raw), s.segment.secondaryIndexCount)
+	// the cursor needs to advance by the size of the block on disk, not by the
+	// size of the decompressed node
+	out.offset = blockLen
+	if out.tombstone {
+		return out, lsmkv.Deleted
+	}
+	return out, err
+}
+
 func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset, buf []byte) error {
+	if s.segment.compressed() {
+		raw, blockLen, err := s.segment.readCompressedNode(offset)
+		if err != nil {
+			return err
+		}
+
+		err = s.parse(raw)
+		// the cursor needs to advance by the size of the block on disk, not by
+		// the size of the decompressed node
+		s.reusableNode.offset = blockLen
+		return err
+	}
+
 	if s.segment.mmapContents {
 		return s.parse(buf)
 	}
@@ -38,6 +38,8 @@ type Memtable struct {
 	dirtyAt   time.Time
 	createdAt time.Time
 	metrics   *memtableMetrics
+	// codec for the value blocks of the segment this memtable is flushed to
+	compression Compression
 }
 
 func newMemtable(path string, strategy string,
@@ -42,43 +42,56 @@ func (m *Memtable) flush() error {
 		return nil
 	}
 
-	f, err := os.OpenFile(m.path+".db", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
-	if err != nil {
-		return err
-	}
-
-	w := bufio.NewWriter(f)
-
-	var keys []segmentindex.Key
+	var write func(w io.Writer) ([]segmentindex.Key, error)
 	switch m.strategy {
 	case StrategyReplace:
-		if keys, err = m.flushDataReplace(w); err != nil {
-			return err
-		}
+		write = m.flushDataReplace
 
 	case StrategySetCollection:
-		if keys, err = m.flushDataSet(w); err != nil {
-			return err
-		}
+		write = m.flushDataSet
 
 	case StrategyRoaringSet:
-		if keys, err = m.flushDataRoaringSet(w); err != nil {
-			return err
-		}
+		write = m.flushDataRoaringSet
 
 	case StrategyMapCollection:
-		if keys, err = m.flushDataMap(w); err != nil {
-			return err
-		}
+		write = m.flushDataMap
 
 	default:
 		return fmt.Errorf("cannot flush strategy %s", m.strategy)
 	}
 
+	if err := writeSegmentFile(m.path, m.secondaryIndices, write); err != nil {
+		return err
+	}
+
+	// only now that the file has been flushed is it safe to delete the commit log
+	// TODO: there might be an interest in keeping the commit logs around for
+	// longer as they might come in handy for replication
+	return m.commitlog.delete()
+}
+
+// writeSegmentFile writes a new segment to path+".db". The nodes are written
+// by write, which has to write the segment header followed by all nodes in
+// sorted order. The file is fsynced before returning.
+func writeSegmentFile(path string, secondaryIndices uint16,
+	write func(w io.Writer) ([]segmentindex.Key, error),
+) error {
+	f, err := os.OpenFile(path+".db", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+
+	keys, err := write(w)
+	if err != nil {
+		return err
+	}
+
 	indices := &segmentindex.Indexes{
 		Keys:                keys,
-		SecondaryIndexCount: m.secondaryIndices,
-		ScratchSpacePath:    m.path + ".scratch.d",
+		SecondaryIndexCount: secondaryIndices,
+		ScratchSpacePath:    path + ".scratch.d",
 	}
 
 	if _, err := indices.WriteTo(w); err != nil {
@@ -93,28 +106,42 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
-	if err := f.Close(); err != nil {
-		return err
-	}
-
-	// only now that the file has been flushed is it safe to delete the commit log
-	// TODO: there might be an interest in keeping the commit logs around for
-	// longer as they might come in handy for replication
-	return m.commitlog.delete()
+	return f.Close()
 }
 
 func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
-	flat := m.key.flattenInOrder()
+	return writeReplaceNodes(f, m.key.flattenInOrder(), m.secondaryIndices,
+		m.strategy, m.compression)
+}
 
+// writeReplaceNodes writes a segment of the replace strategy, flat has to be
+// sorted by key
+func writeReplaceNodes(f io.Writer, flat []*binarySearchNode,
+	secondaryIndices uint16, strategy string, compression Compression,
+) ([]segmentindex.Key, error) {
 	totalDataLength := totalKeyAndValueSize(flat)
-	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(m.secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
+	perObjectAdditions := len(flat) * (1 + 8 + 4 + int(secondaryIndices)*4) // 1 byte for the tombstone, 8 bytes value length encoding, 4 bytes key length encoding, + 4 bytes key encoding for every secondary index
 	headerSize := segmentindex.HeaderSize
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + perObjectAdditions + headerSize),
 		Level:            0, // always level zero on a new one
 		Version:          0, // always version 0 for now
-		SecondaryIndices: m.secondaryIndices,
-		Strategy:         SegmentStrategyFromString(m.strategy),
+		SecondaryIndices: secondaryIndices,
+		Strategy:         SegmentStrategyFromString(strategy),
+	}
+
+	if compression != CompressionNone {
+		nodes := make([]segmentNodeWriter, len(flat))
+		for i, node := range flat {
+			nodes[i] = &segmentReplaceNode{
+				tombstone:           node.tombstone,
+				value:               node.value,
+				primaryKey:          node.key,
+				secondaryKeys:       node.secondaryKeys,
+				secondaryIndexCount: secondaryIndices,
+			}
+		}
+		return compression.writeCompressedSegment(f, header, nodes)
 	}
 
 	n, err := header.WriteTo(f)
@@ -132,7 +159,7 @@ func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 			value:               node.value,
 			primaryKey:          node.key,
 			secondaryKeys:       node.secondaryKeys,
-			secondaryIndexCount: m.secondaryIndices,
+			secondaryIndexCount: secondaryIndices,
 		}
 
 		ki, err := segNode.KeyIndexAndWriteTo(f)
@@ -157,8 +184,17 @@ func (m *Memtable) flushDataMap(f io.Writer) ([]segmentindex.Key, error) {
 	flat := m.keyMap.flattenInOrder()
 	m.RUnlock()
 
-	// by encoding each map pair we can force the same structure as for a
-	// collection, which means we can reuse the same flushing logic
+	asMulti, err := mapNodesAsCollection(flat)
+	if err != nil {
+		return nil, err
+	}
+	return m.flushDataCollection(f, asMulti)
+}
+
+// mapNodesAsCollection encodes each map pair, by doing so we can force the
+// same structure as for a collection, which means we can reuse the same
+// flushing logic
+func mapNodesAsCollection(flat []*binarySearchNodeMap) ([]*binarySearchNodeMulti, error) {
 	asMulti := make([]*binarySearchNodeMulti, len(flat))
 	for i, mapNode := range flat {
 		asMulti[i] = &binarySearchNodeMulti{
@@ -179,19 +215,39 @@ func (m *Memtable) flushDataMap(f io.Writer) ([]segmentindex.Key, error) {
 		}
 
 	}
-	return m.flushDataCollection(f, asMulti)
+	return asMulti, nil
 }
 
 func (m *Memtable) flushDataCollection(f io.Writer,
 	flat []*binarySearchNodeMulti,
+) ([]segmentindex.Key, error) {
+	return writeCollectionNodes(f, flat, m.secondaryIndices, m.strategy,
+		m.compression)
+}
+
+// writeCollectionNodes writes a segment of a collection strategy, flat has to
+// be sorted by key
+func writeCollectionNodes(f io.Writer, flat []*binarySearchNodeMulti,
+	secondaryIndices uint16, strategy string, compression Compression,
 ) ([]segmentindex.Key, error) {
 	totalDataLength := totalValueSizeCollection(flat)
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + segmentindex.HeaderSize),
 		Level:            0, // always level zero on a new one
 		Version:          0, // always version 0 for now
-		SecondaryIndices: m.secondaryIndices,
-		Strategy:         SegmentStrategyFromString(m.strategy),
+		SecondaryIndices: secondaryIndices,
+		Strategy:         SegmentStrategyFromString(strategy),
+	}
+
+	if compression != CompressionNone {
+		nodes := make([]segmentNodeWriter, len(flat))
+		for i, node := range flat {
+			nodes[i] = &segmentCollectionNode{
+				values:     node.values,
+				primaryKey: node.key,
+			}
+		}
+		return compression.writeCompressedSegment(f, header, nodes)
 	}
 
 	n, err := header.WriteTo(f)
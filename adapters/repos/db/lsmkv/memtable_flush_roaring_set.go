@@ -20,8 +20,13 @@ import (
 )
 
 func (m *Memtable) flushDataRoaringSet(f io.Writer) ([]segmentindex.Key, error) {
-	flat := m.roaringSet.FlattenInOrder()
+	return writeRoaringSetNodes(f, m.roaringSet.FlattenInOrder())
+}
 
+// writeRoaringSetNodes writes a segment of the roaring set strategy, flat has
+// to be sorted by key
+func writeRoaringSetNodes(f io.Writer, flat []*roaringset.BinarySearchNode,
+) ([]segmentindex.Key, error) {
 	totalDataLength := totalPayloadSizeRoaringSet(flat)
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + segmentindex.HeaderSize),
@@ -53,6 +53,12 @@ func (s *segment) getCollection(key []byte) ([]value, error) {
 		return nil, err
 	}
 
+	if s.compressed() {
+		if contentsCopy, _, err = decompressBlock(contentsCopy); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.collectionStratParseData(contentsCopy)
 }
 
@@ -0,0 +1,320 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// Compression describes the codec used to compress the value blocks of
+// replace and collection segments. Each node is stored as its own block so
+// that point reads only ever need to decompress a single node.
+type Compression uint8
+
+const (
+	CompressionNone Compression = iota
+	CompressionSnappy
+	CompressionZstd
+)
+
+// compressedBlockHeaderSize is composed of 1 byte for the codec and 4 bytes
+// for the length of the (compressed) payload that follows
+const compressedBlockHeaderSize = 5
+
+func ParseCompression(in string) (Compression, error) {
+	switch in {
+	case "", "none":
+		return CompressionNone, nil
+	case "snappy":
+		return CompressionSnappy, nil
+	case "zstd":
+		return CompressionZstd, nil
+	default:
+		return CompressionNone, fmt.Errorf("unrecognized compression %q", in)
+	}
+}
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// segmentVersion returns the segment header version that segments written
+// with this compression need to carry
+func (c Compression) segmentVersion() uint16 {
+	if c == CompressionNone {
+		return segmentindex.SegmentVersionUncompressed
+	}
+	return segmentindex.SegmentVersionCompressedBlocks
+}
+
+var (
+	zstdEncoderOnce sync.Once
+	zstdEncoder     *zstd.Encoder
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+)
+
+// EncodeAll and DecodeAll are safe for concurrent use, so a single
+// encoder/decoder can be shared across all buckets
+func getZstdEncoder() *zstd.Encoder {
+	zstdEncoderOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedDefault),
+			zstd.WithEncoderConcurrency(1))
+	})
+	return zstdEncoder
+}
+
+func getZstdDecoder() *zstd.Decoder {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdDecoder
+}
+
+// compressBlock frames raw as a compressed block. If the codec does not
+// manage to shrink the payload, the block is stored uncompressed instead, so
+// that small or incompressible nodes never grow by more than the block
+// header.
+func (c Compression) compressBlock(raw []byte) ([]byte, error) {
+	var payload []byte
+	switch c {
+	case CompressionSnappy:
+		payload = snappy.Encode(nil, raw)
+	case CompressionZstd:
+		payload = getZstdEncoder().EncodeAll(raw, nil)
+	case CompressionNone:
+		payload = raw
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", c)
+	}
+
+	codec := c
+	if len(payload) >= len(raw) {
+		codec = CompressionNone
+		payload = raw
+	}
+
+	out := make([]byte, compressedBlockHeaderSize+len(payload))
+	out[0] = byte(codec)
+	binary.LittleEndian.PutUint32(out[1:5], uint32(len(payload)))
+	copy(out[compressedBlockHeaderSize:], payload)
+	return out, nil
+}
+
+// decompressBlock parses the block at the beginning of in and returns the
+// decompressed node as well as the length of the entire block on disk. in
+// may extend beyond the end of the block.
+func decompressBlock(in []byte) ([]byte, int, error) {
+	if len(in) < compressedBlockHeaderSize {
+		return nil, 0, fmt.Errorf("compressed block: need at least %d bytes, got %d",
+			compressedBlockHeaderSize, len(in))
+	}
+
+	payloadLen := int(binary.LittleEndian.Uint32(in[1:5]))
+	blockLen := compressedBlockHeaderSize + payloadLen
+	if len(in) < blockLen {
+		return nil, 0, fmt.Errorf("compressed block: need %d bytes, got %d",
+			blockLen, len(in))
+	}
+
+	raw, err := decodePayload(Compression(in[0]), in[compressedBlockHeaderSize:blockLen])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return raw, blockLen, nil
+}
+
+// readCompressedBlock is the io.Reader equivalent of decompressBlock
+func readCompressedBlock(r io.Reader) ([]byte, int, error) {
+	header := make([]byte, compressedBlockHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, 0, fmt.Errorf("read compressed block header: %w", err)
+	}
+
+	payload := make([]byte, binary.LittleEndian.Uint32(header[1:5]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, fmt.Errorf("read compressed block payload: %w", err)
+	}
+
+	raw, err := decodePayload(Compression(header[0]), payload)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return raw, compressedBlockHeaderSize + len(payload), nil
+}
+
+func decodePayload(codec Compression, payload []byte) ([]byte, error) {
+	switch codec {
+	case CompressionNone:
+		// payload may point into shared (e.g. mmapped) memory, so it needs to
+		// be copied just like a decompressed block would be
+		out := make([]byte, len(payload))
+		copy(out, payload)
+		return out, nil
+	case CompressionSnappy:
+		out, err := snappy.Decode(nil, payload)
+		if err != nil {
+			return nil, fmt.Errorf("snappy decode block: %w", err)
+		}
+		return out, nil
+	case CompressionZstd:
+		out, err := getZstdDecoder().DecodeAll(payload, nil)
+		if err != nil {
+			return nil, fmt.Errorf("zstd decode block: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported block codec %d", codec)
+	}
+}
+
+// segmentNodeWriter is implemented by both the replace and the collection
+// segment nodes
+type segmentNodeWriter interface {
+	KeyIndexAndWriteTo(w io.Writer) (segmentindex.Key, error)
+}
+
+// writeNode writes node to w at the given offset. Without compression this
+// is identical to calling node.KeyIndexAndWriteTo directly, otherwise the
+// node is encoded, compressed and written as a single block. The returned
+// key points at the block boundaries.
+func (c Compression) writeNode(w io.Writer, node segmentNodeWriter,
+	offset int,
+) (segmentindex.Key, error) {
+	if c == CompressionNone {
+		return node.KeyIndexAndWriteTo(w)
+	}
+
+	block, ki, err := c.encodeNode(node, offset)
+	if err != nil {
+		return ki, err
+	}
+
+	if _, err := w.Write(block); err != nil {
+		return ki, err
+	}
+
+	return ki, nil
+}
+
+func (c Compression) encodeNode(node segmentNodeWriter, offset int,
+) ([]byte, segmentindex.Key, error) {
+	var buf bytes.Buffer
+	ki, err := node.KeyIndexAndWriteTo(&buf)
+	if err != nil {
+		return nil, ki, err
+	}
+
+	block, err := c.compressBlock(buf.Bytes())
+	if err != nil {
+		return nil, ki, err
+	}
+
+	ki.ValueStart = offset
+	ki.ValueEnd = offset + len(block)
+	return block, ki, nil
+}
+
+// writeCompressedSegment writes the header and all nodes as compressed
+// blocks. As the size of the data section is unknown before compressing, all
+// blocks are compressed in memory first, so that the header can be written
+// upfront to a non-seekable writer.
+func (c Compression) writeCompressedSegment(w io.Writer,
+	header segmentindex.Header, nodes []segmentNodeWriter,
+) ([]segmentindex.Key, error) {
+	keys := make([]segmentindex.Key, len(nodes))
+	blocks := make([][]byte, len(nodes))
+
+	offset := segmentindex.HeaderSize
+	for i, node := range nodes {
+		block, ki, err := c.encodeNode(node, offset)
+		if err != nil {
+			return nil, fmt.Errorf("write node %d: %w", i, err)
+		}
+
+		keys[i] = ki
+		blocks[i] = block
+		offset = ki.ValueEnd
+	}
+
+	header.Version = c.segmentVersion()
+	header.IndexStart = uint64(offset)
+	if _, err := header.WriteTo(w); err != nil {
+		return nil, err
+	}
+
+	for i, block := range blocks {
+		if _, err := w.Write(block); err != nil {
+			return nil, fmt.Errorf("write node %d: %w", i, err)
+		}
+	}
+
+	return keys, nil
+}
+
+// compressed indicates whether the nodes of this segment are stored as
+// compressed blocks
+func (s *segment) compressed() bool {
+	return s.version == segmentindex.SegmentVersionCompressedBlocks
+}
+
+// readCompressedNode returns the decompressed node stored in the block
+// starting at offset.start, as well as the length of the block on disk. The
+// returned memory is always a copy and safe to hold on to.
+func (s *segment) readCompressedNode(offset nodeOffset) ([]byte, int, error) {
+	if s.mmapContents {
+		if offset.start >= uint64(len(s.contents)) {
+			return nil, 0, fmt.Errorf("compressed block offset %d out of range", offset.start)
+		}
+		return decompressBlock(s.contents[offset.start:])
+	}
+
+	r, err := s.newNodeReader(nodeOffset{start: offset.start})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return readCompressedBlock(r)
+}
+
+func (s *segment) parseCompressedCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
+	raw, blockLen, err := s.readCompressedNode(offset)
+	if err != nil {
+		return segmentCollectionNode{}, err
+	}
+
+	out, err := ParseCollectionNode(bytes.NewReader(raw))
+	// cursors need to advance by the size of the block on disk, not by the
+	// size of the decompressed node
+	out.offset = blockLen
+	return out, err
+}
@@ -0,0 +1,439 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCompressBlock(t *testing.T) {
+	compressible := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog "), 100)
+	incompressible := make([]byte, 512)
+	_, err := rand.Read(incompressible)
+	require.Nil(t, err)
+
+	for _, compression := range []Compression{CompressionNone, CompressionSnappy, CompressionZstd} {
+		t.Run(compression.String(), func(t *testing.T) {
+			for _, input := range [][]byte{compressible, incompressible, {}} {
+				block, err := compression.compressBlock(input)
+				require.Nil(t, err)
+				assert.LessOrEqual(t, len(block), len(input)+compressedBlockHeaderSize)
+
+				// trailing data must be ignored
+				withTrailer := append(append([]byte{}, block...), 0x1, 0x2, 0x3)
+				raw, blockLen, err := decompressBlock(withTrailer)
+				require.Nil(t, err)
+				assert.Equal(t, len(block), blockLen)
+				assert.Equal(t, len(input), len(raw))
+				assert.True(t, bytes.Equal(input, raw))
+
+				raw, blockLen, err = readCompressedBlock(bytes.NewReader(withTrailer))
+				require.Nil(t, err)
+				assert.Equal(t, len(block), blockLen)
+				assert.True(t, bytes.Equal(input, raw))
+			}
+
+			block, err := compression.compressBlock(compressible)
+			require.Nil(t, err)
+			if compression == CompressionNone {
+				assert.Equal(t, CompressionNone, Compression(block[0]))
+			} else {
+				assert.Equal(t, compression, Compression(block[0]))
+				assert.Less(t, len(block), len(compressible)/4)
+			}
+
+			block, err = compression.compressBlock(incompressible)
+			require.Nil(t, err)
+			assert.Equal(t, CompressionNone, Compression(block[0]),
+				"incompressible data is stored uncompressed")
+		})
+	}
+
+	t.Run("truncated block", func(t *testing.T) {
+		block, err := CompressionZstd.compressBlock(compressible)
+		require.Nil(t, err)
+
+		_, _, err = decompressBlock(block[:len(block)-1])
+		assert.NotNil(t, err)
+		_, _, err = decompressBlock(block[:3])
+		assert.NotNil(t, err)
+	})
+}
+
+func TestParseCompression(t *testing.T) {
+	for in, expected := range map[string]Compression{
+		"":       CompressionNone,
+		"none":   CompressionNone,
+		"snappy": CompressionSnappy,
+		"zstd":   CompressionZstd,
+	} {
+		c, err := ParseCompression(in)
+		require.Nil(t, err)
+		assert.Equal(t, expected, c)
+	}
+
+	_, err := ParseCompression("lz4")
+	assert.NotNil(t, err)
+}
+
+func TestBucketCompression(t *testing.T) {
+	ctx := context.Background()
+	for _, compression := range []Compression{CompressionSnappy, CompressionZstd} {
+		compression := compression
+		tests := bucketTests{
+			{
+				name: "compressedReplaceBucket",
+				f:    compressedReplaceBucket,
+				opts: []BucketOption{
+					WithStrategy(StrategyReplace),
+					WithSecondaryIndices(1),
+					WithCompression(compression),
+				},
+			},
+			{
+				name: "compressedSetBucket",
+				f:    compressedSetBucket,
+				opts: []BucketOption{
+					WithStrategy(StrategySetCollection),
+					WithCompression(compression),
+				},
+			},
+			{
+				name: "compressedMapBucket",
+				f:    compressedMapBucket,
+				opts: []BucketOption{
+					WithStrategy(StrategyMapCollection),
+					WithCompression(compression),
+				},
+			},
+			{
+				name: "compressingLegacyReplaceBucket",
+				f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
+					compressingLegacyReplaceBucket(ctx, t, opts, compression)
+				},
+				opts: []BucketOption{
+					WithStrategy(StrategyReplace),
+				},
+			},
+		}
+		t.Run(compression.String(), func(t *testing.T) {
+			tests.run(ctx, t)
+		})
+	}
+}
+
+func compressibleValue(i int) []byte {
+	return bytes.Repeat([]byte(fmt.Sprintf("value %d with a rather repetitive payload ", i)), 20)
+}
+
+func newCompressionTestBucket(ctx context.Context, t *testing.T, dir string,
+	opts []BucketOption,
+) *Bucket {
+	logger, _ := test.NewNullLogger()
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	return b
+}
+
+func assertSegmentVersions(t *testing.T, b *Bucket, expected uint16) {
+	b.disk.maintenanceLock.RLock()
+	defer b.disk.maintenanceLock.RUnlock()
+
+	require.NotEmpty(t, b.disk.segments)
+	for _, seg := range b.disk.segments {
+		assert.Equal(t, expected, seg.version, seg.path)
+	}
+}
+
+func compactUntilNoLongerEligible(t *testing.T, b *Bucket) {
+	var compacted bool
+	var err error
+	for compacted, err = b.disk.compactOnce(); err == nil && compacted; compacted, err = b.disk.compactOnce() {
+	}
+	require.Nil(t, err)
+}
+
+func compressedReplaceBucket(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	b := newCompressionTestBucket(ctx, t, dir, opts)
+
+	const segments, perSegment = 3, 50
+	expected := map[string][]byte{}
+	for s := 0; s < segments; s++ {
+		for i := 0; i < perSegment; i++ {
+			// every segment overwrites half of the keys of the previous one
+			id := s*perSegment/2 + i
+			key := []byte(fmt.Sprintf("key-%04d", id))
+			val := compressibleValue(id*10 + s)
+			require.Nil(t, b.Put(key, val,
+				WithSecondaryKey(0, []byte(fmt.Sprintf("secondary-%04d", id)))))
+			expected[string(key)] = val
+		}
+		require.Nil(t, b.Delete([]byte(fmt.Sprintf("key-%04d", s*perSegment/2))))
+		delete(expected, fmt.Sprintf("key-%04d", s*perSegment/2))
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for key, val := range expected {
+			res, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, val, res, key)
+
+			secondary := fmt.Sprintf("secondary-%s", key[len("key-"):])
+			res, err = b.GetBySecondary(0, []byte(secondary))
+			require.Nil(t, err)
+			assert.Equal(t, val, res, secondary)
+		}
+
+		res, err := b.Get([]byte("key-0000"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		count := 0
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, expected[string(k)], v)
+			count++
+		}
+		c.Close()
+		assert.Equal(t, len(expected), count)
+
+		c = b.Cursor()
+		k, v := c.Seek([]byte("key-0030"))
+		assert.Equal(t, []byte("key-0030"), k)
+		assert.Equal(t, expected["key-0030"], v)
+		c.Close()
+
+		assert.Equal(t, len(expected), b.Count())
+	}
+
+	t.Run("verify before compaction", func(t *testing.T) {
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t, b)
+	})
+
+	t.Run("verify after compaction", func(t *testing.T) {
+		compactUntilNoLongerEligible(t, b)
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t, b)
+	})
+
+	t.Run("verify after restart", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+		b = newCompressionTestBucket(ctx, t, dir, opts)
+		defer b.Shutdown(ctx)
+		verify(t, b)
+	})
+}
+
+func compressedSetBucket(ctx context.Context, t *testing.T, opts []BucketOption) {
+	b := newCompressionTestBucket(ctx, t, t.TempDir(), opts)
+	defer b.Shutdown(ctx)
+
+	expected := map[string][][]byte{}
+	for s := 0; s < 3; s++ {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			values := [][]byte{compressibleValue(s*100 + i)}
+			require.Nil(t, b.SetAdd([]byte(key), values))
+			expected[key] = append(expected[key], values...)
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	verify := func(t *testing.T) {
+		for key, values := range expected {
+			res, err := b.SetList([]byte(key))
+			require.Nil(t, err)
+			assert.ElementsMatch(t, values, res, key)
+		}
+
+		count := 0
+		c := b.SetCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.ElementsMatch(t, expected[string(k)], v)
+			count++
+		}
+		c.Close()
+		assert.Equal(t, len(expected), count)
+	}
+
+	t.Run("verify before compaction", func(t *testing.T) {
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t)
+	})
+
+	t.Run("verify after compaction", func(t *testing.T) {
+		compactUntilNoLongerEligible(t, b)
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t)
+	})
+}
+
+func compressedMapBucket(ctx context.Context, t *testing.T, opts []BucketOption) {
+	b := newCompressionTestBucket(ctx, t, t.TempDir(), opts)
+	defer b.Shutdown(ctx)
+
+	expected := map[string]map[string][]byte{}
+	for s := 0; s < 3; s++ {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			mapKey := fmt.Sprintf("map-key-%03d", s)
+			val := compressibleValue(s*100 + i)
+			require.Nil(t, b.MapSet([]byte(key), MapPair{Key: []byte(mapKey), Value: val}))
+			if expected[key] == nil {
+				expected[key] = map[string][]byte{}
+			}
+			expected[key][mapKey] = val
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	verifyPairs := func(t *testing.T, key []byte, pairs []MapPair) {
+		require.Len(t, pairs, len(expected[string(key)]))
+		for _, pair := range pairs {
+			assert.Equal(t, expected[string(key)][string(pair.Key)], pair.Value)
+		}
+	}
+
+	verify := func(t *testing.T) {
+		for key := range expected {
+			res, err := b.MapList([]byte(key))
+			require.Nil(t, err)
+			verifyPairs(t, []byte(key), res)
+		}
+
+		count := 0
+		c := b.MapCursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			verifyPairs(t, k, v)
+			count++
+		}
+		c.Close()
+		assert.Equal(t, len(expected), count)
+	}
+
+	t.Run("verify before compaction", func(t *testing.T) {
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t)
+	})
+
+	t.Run("verify after compaction", func(t *testing.T) {
+		compactUntilNoLongerEligible(t, b)
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t)
+	})
+}
+
+// compressingLegacyReplaceBucket starts out with an uncompressed bucket and
+// then enables compression, so that old and new segment versions have to be
+// read side by side until they are compacted into a single compressed one.
+func compressingLegacyReplaceBucket(ctx context.Context, t *testing.T,
+	opts []BucketOption, compression Compression,
+) {
+	dir := t.TempDir()
+	expected := map[string][]byte{}
+
+	put := func(t *testing.T, b *Bucket, from, to int) {
+		for i := from; i < to; i++ {
+			key := fmt.Sprintf("key-%04d", i)
+			val := compressibleValue(i)
+			require.Nil(t, b.Put([]byte(key), val))
+			expected[key] = val
+		}
+		require.Nil(t, b.FlushMemtable())
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for key, val := range expected {
+			res, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			assert.Equal(t, val, res, key)
+		}
+
+		count := 0
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, expected[string(k)], v)
+			count++
+		}
+		c.Close()
+		assert.Equal(t, len(expected), count)
+		assert.Equal(t, len(expected), b.Count())
+	}
+
+	b := newCompressionTestBucket(ctx, t, dir, opts)
+	put(t, b, 0, 100)
+	assertSegmentVersions(t, b, segmentindex.SegmentVersionUncompressed)
+	uncompressedSize := dirSegmentSize(t, dir)
+	require.Nil(t, b.Shutdown(ctx))
+
+	b = newCompressionTestBucket(ctx, t, dir,
+		append(opts, WithCompression(compression)))
+	defer b.Shutdown(ctx)
+
+	put(t, b, 50, 150)
+
+	t.Run("mixed segment versions", func(t *testing.T) {
+		versions := map[uint16]int{}
+		b.disk.maintenanceLock.RLock()
+		for _, seg := range b.disk.segments {
+			versions[seg.version]++
+		}
+		b.disk.maintenanceLock.RUnlock()
+		assert.Equal(t, map[uint16]int{
+			segmentindex.SegmentVersionUncompressed:     1,
+			segmentindex.SegmentVersionCompressedBlocks: 1,
+		}, versions)
+		verify(t, b)
+	})
+
+	t.Run("compacted into compressed segment", func(t *testing.T) {
+		compactUntilNoLongerEligible(t, b)
+		assertSegmentVersions(t, b, segmentindex.SegmentVersionCompressedBlocks)
+		verify(t, b)
+
+		// the compacted segment holds 50% more keys than the original
+		// uncompressed one, yet is still considerably smaller
+		assert.Less(t, dirSegmentSize(t, dir), uncompressedSize/2)
+	})
+}
+
+func dirSegmentSize(t *testing.T, dir string) int64 {
+	files, err := os.ReadDir(dir)
+	require.Nil(t, err)
+
+	var size int64
+	for _, f := range files {
+		if filepath.Ext(f.Name()) != ".db" {
+			continue
+		}
+		info, err := f.Info()
+		require.Nil(t, err)
+		size += info.Size()
+	}
+	return size
+}
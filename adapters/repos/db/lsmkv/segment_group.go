@@ -63,6 +63,16 @@ type SegmentGroup struct {
 	compactLeftOverSegments bool // see bucket for more datails
 
 	allocChecker memwatch.AllocChecker
+
+	compression Compression // see bucket for more datails
+
+	compactionStrategy   CompactionStrategy
+	compactionIOLimiters []*IOLimiter
+
+	// compactionCtx is cancelled on shutdown, so that a compaction which is
+	// waiting for its IO budget doesn't block the shutdown
+	compactionCtx    context.Context
+	cancelCompaction context.CancelFunc
 }
 
 type sgConfig struct {
@@ -75,6 +85,9 @@ type sgConfig struct {
 	useBloomFilter        bool
 	calcCountNetAdditions bool
 	forceCompaction       bool
+	compression           Compression
+	compactionStrategy    CompactionStrategy
+	compactionIOLimiters  []*IOLimiter
 }
 
 func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
@@ -99,9 +112,17 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		useBloomFilter:          cfg.useBloomFilter,
 		calcCountNetAdditions:   cfg.calcCountNetAdditions,
 		compactLeftOverSegments: cfg.forceCompaction,
+		compression:             cfg.compression,
+		compactionStrategy:      cfg.compactionStrategy,
+		compactionIOLimiters:    cfg.compactionIOLimiters,
 		allocChecker:            allocChecker,
 	}
 
+	if sg.compactionStrategy == nil {
+		sg.compactionStrategy = LevelCompactionStrategy{}
+	}
+	sg.compactionCtx, sg.cancelCompaction = context.WithCancel(context.Background())
+
 	segmentIndex := 0
 
 	segmentsAlreadyRecoveredFromCompaction := make(map[string]struct{})
@@ -124,12 +145,15 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		jointSegments := segmentID(potentialCompactedSegmentFileName)
 		jointSegmentsIDs := strings.Split(jointSegments, "_")
 
-		if len(jointSegmentsIDs) != 2 {
+		if len(jointSegmentsIDs) < 2 {
 			return nil, fmt.Errorf("invalid compacted segment file name %q", entry.Name())
 		}
 
+		// compactions merging more than two segments also list the ids of all
+		// segments in between
+		lastID := len(jointSegmentsIDs) - 1
 		leftSegmentFilename := fmt.Sprintf("segment-%s.db", jointSegmentsIDs[0])
-		rightSegmentFilename := fmt.Sprintf("segment-%s.db", jointSegmentsIDs[1])
+		rightSegmentFilename := fmt.Sprintf("segment-%s.db", jointSegmentsIDs[lastID])
 
 		leftSegmentPath := filepath.Join(sg.dir, leftSegmentFilename)
 		rightSegmentPath := filepath.Join(sg.dir, rightSegmentFilename)
@@ -156,6 +180,38 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		}
 
 		if !leftSegmentFound && rightSegmentFound {
+			// segments are dropped from oldest to newest, so some of the segments
+			// in between may still exist
+			for _, id := range jointSegmentsIDs[1:lastID] {
+				middleSegmentFilename := fmt.Sprintf("segment-%s.db", id)
+				middleSegmentPath := filepath.Join(sg.dir, middleSegmentFilename)
+				segmentsAlreadyRecoveredFromCompaction[middleSegmentFilename] = struct{}{}
+
+				middleSegmentFound, err := fileExists(middleSegmentPath)
+				if err != nil {
+					return nil, fmt.Errorf("check for presence of segment %s: %w", middleSegmentFilename, err)
+				}
+				if !middleSegmentFound {
+					continue
+				}
+
+				// the segment is only loaded to drop it along with its derived files,
+				// so there is no need to compute those
+				middleSegment, err := newSegment(middleSegmentPath, logger,
+					metrics, nil, sg.mmapContents, false, false, false)
+				if err != nil {
+					return nil, fmt.Errorf("init segment %s: %w", middleSegmentFilename, err)
+				}
+
+				if err := middleSegment.close(); err != nil {
+					return nil, fmt.Errorf("close already compacted segment %s: %w", middleSegmentFilename, err)
+				}
+
+				if err := middleSegment.drop(); err != nil {
+					return nil, fmt.Errorf("delete already compacted segment %s: %w", middleSegmentFilename, err)
+				}
+			}
+
 			rightSegment, err := newSegment(rightSegmentPath, logger,
 				metrics, sg.makeExistsOnLower(segmentIndex),
 				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
@@ -429,7 +485,20 @@ func (sg *SegmentGroup) count() int {
 	return count
 }
 
+func (sg *SegmentGroup) size() int64 {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	var size int64
+	for _, seg := range sg.segments {
+		size += seg.size
+	}
+
+	return size
+}
+
 func (sg *SegmentGroup) shutdown(ctx context.Context) error {
+	sg.cancelCompaction()
 	if err := sg.compactionCallbackCtrl.Unregister(ctx); err != nil {
 		return fmt.Errorf("long-running compaction in progress: %w", ctx.Err())
 	}
@@ -13,7 +13,6 @@ package lsmkv
 
 import (
 	"fmt"
-	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -27,7 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 )
 
-func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
+func (sg *SegmentGroup) bestCompactionCandidates() []int {
 	sg.maintenanceLock.RLock()
 	defer sg.maintenanceLock.RUnlock()
 
@@ -44,58 +43,37 @@ func (sg *SegmentGroup) bestCompactionCandidatePair() []int {
 		return nil
 	}
 
-	// first determine the lowest level with candidates
-	levels := map[uint16]int{}
-	lowestPairLevel := uint16(math.MaxUint16)
-	lowestLevel := uint16(math.MaxUint16)
-	lowestIndex := -1
-	secondLowestIndex := -1
-	pairExists := false
-
-	for ind, seg := range sg.segments {
-		levels[seg.level]++
-		val := levels[seg.level]
-		if val > 1 {
-			if seg.level < lowestPairLevel {
-				lowestPairLevel = seg.level
-				pairExists = true
-			}
-		}
-
-		if seg.level < lowestLevel {
-			secondLowestIndex = lowestIndex
-			lowestLevel = seg.level
-			lowestIndex = ind
-		}
+	segments := make([]CompactionSegment, len(sg.segments))
+	for i, seg := range sg.segments {
+		segments[i] = CompactionSegment{Level: seg.level, Size: seg.size}
 	}
 
-	if pairExists {
-		// now pick any two segments which match the level
-		var res []int
-
-		for i, segment := range sg.segments {
-			if len(res) >= 2 {
-				break
-			}
+	candidates := sg.compactionStrategy.Candidates(segments, sg.compactLeftOverSegments)
+	if len(candidates) < 2 {
+		return nil
+	}
 
-			if segment.level == lowestPairLevel {
-				res = append(res, i)
-			}
-		}
+	maxCandidates := maxCompactionFanIn
+	if sg.strategy == StrategyRoaringSet {
+		// the roaring set compactor can only merge pairs
+		maxCandidates = 2
+	}
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
 
-		return res
-	} else {
-		if sg.compactLeftOverSegments {
-			// Some segments exist, but none are of the same level
-			// Merge the two lowest segments
-
-			return []int{secondLowestIndex, lowestIndex}
-		} else {
-			// No segments of the same level exist, and we are not allowed to merge the lowest segments
-			// This means we cannot compact.  Set COMPACT_LEFTOVER_SEGMENTS to true to compact the remaining segments
+	for i, pos := range candidates {
+		if pos < 0 || pos >= len(sg.segments) || (i > 0 && pos <= candidates[i-1]) {
+			sg.logger.WithField("action", "lsm_compaction").
+				WithField("path", sg.dir).
+				WithField("compaction_strategy", sg.compactionStrategy.Name()).
+				Errorf("invalid compaction candidates %v for %d segments",
+					candidates, len(sg.segments))
 			return nil
 		}
 	}
+
+	return candidates
 }
 
 // segmentAtPos retrieves the segment for the given position using a read-lock
@@ -118,8 +96,8 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	// that the array contents stay stable over the duration of an entire
 	// compaction. We do however need to protect against a read-while-write (race
 	// condition) on the array. Thus any read from sg.segments need to protected
-	pair := sg.bestCompactionCandidatePair()
-	if pair == nil {
+	candidates := sg.bestCompactionCandidates()
+	if candidates == nil {
 		// nothing to do
 		return false, nil
 	}
@@ -149,15 +127,30 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	sg.logger.WithField("action", "lsm_compaction").WithField("compaction", compID).Infof("start lsm compaction")
 	defer sg.logger.WithField("action", "lsm_compaction_finished").WithField("compaction", compID).Infof("start lsm compaction")
 
-	leftSegment := sg.segmentAtPos(pair[0])
-	rightSegment := sg.segmentAtPos(pair[1])
+	segments := make([]*segment, len(candidates))
+	ids := make([]string, len(candidates))
+	for i, pos := range candidates {
+		segments[i] = sg.segmentAtPos(pos)
+		ids[i] = segmentID(segments[i].path)
+	}
+	leftSegment := segments[0]
+	rightSegment := segments[len(segments)-1]
 
-	path := filepath.Join(sg.dir, "segment-"+segmentID(leftSegment.path)+"_"+segmentID(rightSegment.path)+".db.tmp")
+	path := filepath.Join(sg.dir, "segment-"+strings.Join(ids, "_")+".db.tmp")
 
 	f, err := os.Create(path)
 	if err != nil {
 		return false, err
 	}
+	// remove the partially written segment if the compaction fails, e.g.
+	// because it was cancelled on shutdown
+	closed := false
+	defer func() {
+		if !closed {
+			f.Close()
+			os.Remove(path)
+		}
+	}()
 
 	scratchSpacePath := rightSegment.path + "compaction.scratch.d"
 
@@ -165,12 +158,21 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	level := leftSegment.level
 	secondaryIndices := leftSegment.secondaryIndexCount
 
-	if level == rightSegment.level {
+	sameLevel := true
+	for _, seg := range segments[1:] {
+		if seg.level != level {
+			sameLevel = false
+			break
+		}
+	}
+	if sameLevel {
 		level = level + 1
 	}
 
 	strategy := leftSegment.strategy
-	cleanupTombstones := !sg.keepTombstones && pair[0] == 0
+	cleanupTombstones := !sg.keepTombstones && candidates[0] == 0
+
+	w := newThrottledWriteSeeker(sg.compactionCtx, f, sg.compactionIOLimiters...)
 
 	pathLabel := "n/a"
 	if sg.metrics != nil && !sg.metrics.groupClasses {
@@ -181,8 +183,12 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	// TODO: call metrics just once with variable strategy label
 
 	case segmentindex.StrategyReplace:
-		c := newCompactorReplace(f, leftSegment.newCursor(),
-			rightSegment.newCursor(), level, secondaryIndices, scratchSpacePath, cleanupTombstones)
+		cursors := make([]*segmentCursorReplace, len(segments))
+		for i, seg := range segments {
+			cursors[i] = seg.newCursor()
+		}
+		c := newCompactorReplace(w, cursors, level, secondaryIndices,
+			scratchSpacePath, cleanupTombstones, sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -193,9 +199,12 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategySetCollection:
-		c := newCompactorSetCollection(f, leftSegment.newCollectionCursor(),
-			rightSegment.newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath, cleanupTombstones)
+		cursors := make([]*segmentCursorCollection, len(segments))
+		for i, seg := range segments {
+			cursors[i] = seg.newCollectionCursor()
+		}
+		c := newCompactorSetCollection(w, cursors, level, secondaryIndices,
+			scratchSpacePath, cleanupTombstones, sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionSet.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -206,10 +215,13 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategyMapCollection:
-		c := newCompactorMapCollection(f,
-			leftSegment.newCollectionCursorReusable(),
-			rightSegment.newCollectionCursorReusable(),
-			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting, cleanupTombstones)
+		cursors := make([]*segmentCursorCollectionReusable, len(segments))
+		for i, seg := range segments {
+			cursors[i] = seg.newCollectionCursorReusable()
+		}
+		c := newCompactorMapCollection(w, cursors,
+			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting, cleanupTombstones,
+			sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -223,7 +235,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		leftCursor := leftSegment.newRoaringSetCursor()
 		rightCursor := rightSegment.newRoaringSetCursor()
 
-		c := roaringset.NewCompactor(f, leftCursor, rightCursor,
+		c := roaringset.NewCompactor(w, leftCursor, rightCursor,
 			level, scratchSpacePath, cleanupTombstones)
 
 		if sg.metrics != nil {
@@ -246,20 +258,23 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	if err := f.Close(); err != nil {
 		return false, errors.Wrap(err, "close compacted segment file")
 	}
+	closed = true
 
-	if err := sg.replaceCompactedSegments(pair[0], pair[1], path); err != nil {
+	if err := sg.replaceCompactedSegments(candidates, path); err != nil {
 		return false, errors.Wrap(err, "replace compacted segments")
 	}
 
 	return true, nil
 }
 
-func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
+func (sg *SegmentGroup) replaceCompactedSegments(old []int,
 	newPathTmp string,
 ) error {
 	sg.maintenanceLock.RLock()
-	updatedCountNetAdditions := sg.segments[old1].countNetAdditions +
-		sg.segments[old2].countNetAdditions
+	updatedCountNetAdditions := 0
+	for _, pos := range old {
+		updatedCountNetAdditions += sg.segments[pos].countNetAdditions
+	}
 	sg.maintenanceLock.RUnlock()
 
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
@@ -272,34 +287,31 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	sg.maintenanceLock.Lock()
 	defer sg.maintenanceLock.Unlock()
 
-	leftSegment := sg.segments[old1]
-	rightSegment := sg.segments[old2]
-
-	if err := leftSegment.close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
-	}
+	// Segments need to be dropped from oldest to newest. On startup an
+	// interrupted compaction is recovered based on which of the old segments
+	// still exist (see newSegmentGroup).
+	ids := make([]string, len(old))
+	for i, pos := range old {
+		seg := sg.segments[pos]
+		ids[i] = segmentID(seg.path)
 
-	if err := rightSegment.close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
-	}
+		if err := seg.close(); err != nil {
+			return errors.Wrap(err, "close disk segment")
+		}
 
-	if err := leftSegment.drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
-	}
+		if err := seg.drop(); err != nil {
+			return errors.Wrap(err, "drop disk segment")
+		}
 
-	if err := rightSegment.drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
+		sg.segments[pos] = nil
 	}
 
-	sg.segments[old1] = nil
-	sg.segments[old2] = nil
-
 	var newPath string
 	// the old segments have been deleted, we can now safely remove the .tmp
 	// extension from the new segment itself and the pre-computed files which
-	// carried the name of the second old segment
+	// carried the name of the newest old segment
 	for i, path := range precomputedFiles {
-		updated, err := sg.stripTmpExtension(path, segmentID(leftSegment.path), segmentID(rightSegment.path))
+		updated, err := sg.stripTmpExtension(path, ids)
 		if err != nil {
 			return errors.Wrap(err, "strip .tmp extension of new segment")
 		}
@@ -316,21 +328,30 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		return errors.Wrap(err, "create new segment")
 	}
 
-	sg.segments[old2] = seg
+	// the new segment takes the position of the newest old segment, all other
+	// old segments are removed
+	newest := old[len(old)-1]
+	sg.segments[newest] = seg
 
-	sg.segments = append(sg.segments[:old1], sg.segments[old1+1:]...)
+	updated := sg.segments[:0]
+	for _, s := range sg.segments {
+		if s != nil {
+			updated = append(updated, s)
+		}
+	}
+	sg.segments = updated
 
 	return nil
 }
 
-func (sg *SegmentGroup) stripTmpExtension(oldPath, left, right string) (string, error) {
+func (sg *SegmentGroup) stripTmpExtension(oldPath string, ids []string) (string, error) {
 	ext := filepath.Ext(oldPath)
 	if ext != ".tmp" {
 		return "", errors.Errorf("segment %q did not have .tmp extension", oldPath)
 	}
 	newPath := oldPath[:len(oldPath)-len(ext)]
 
-	newPath = strings.ReplaceAll(newPath, fmt.Sprintf("%s_%s", left, right), right)
+	newPath = strings.ReplaceAll(newPath, strings.Join(ids, "_"), ids[len(ids)-1])
 
 	if err := os.Rename(oldPath, newPath); err != nil {
 		return "", errors.Wrapf(err, "rename %q -> %q", oldPath, newPath)
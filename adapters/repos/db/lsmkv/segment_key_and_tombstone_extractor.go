@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"bytes"
 	"encoding/binary"
 )
 
@@ -157,3 +158,28 @@ func (e *bufferedKeyAndTombstoneExtractor) flushAndCallback() {
 
 	e.callbackCycle++
 }
+
+// extractCompressedKeysAndTombstones is the equivalent of the
+// bufferedKeyAndTombstoneExtractor for segments with compressed blocks. As
+// each block needs to be decompressed anyway, there is nothing to gain from
+// reading the raw segment in a single pass, so the nodes are simply parsed one
+// by one.
+func (s *segment) extractCompressedKeysAndTombstones(callback keyAndTombstoneCallbackFn) error {
+	offset := s.dataStartPos
+	for offset < s.dataEndPos {
+		raw, blockLen, err := decompressBlock(s.contents[offset:s.dataEndPos])
+		if err != nil {
+			return err
+		}
+
+		node, err := ParseReplaceNode(bytes.NewReader(raw), s.secondaryIndexCount)
+		if err != nil {
+			return err
+		}
+
+		callback(node.primaryKey, node.tombstone)
+		offset += uint64(blockLen)
+	}
+
+	return nil
+}
@@ -94,10 +94,16 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	if s.compressed() {
+		if err := s.extractCompressedKeysAndTombstones(cb); err != nil {
+			return fmt.Errorf("extract keys from compressed segment: %w", err)
+		}
+	} else {
+		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	}
 
 	s.countNetAdditions = countNet
 
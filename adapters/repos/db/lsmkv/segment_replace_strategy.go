@@ -67,6 +67,12 @@ func (s *segment) get(key []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if s.compressed() {
+		if contentsCopy, _, err = decompressBlock(contentsCopy); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.replaceStratParseData(contentsCopy)
 }
 
@@ -108,7 +114,13 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 	if err = s.copyNode(contentsCopy, nodeOffset{node.Start, node.End}); err != nil {
 		return nil, err, nil
 	}
-	currContent, err := s.replaceStratParseData(contentsCopy)
+	nodeContents := contentsCopy
+	if s.compressed() {
+		if nodeContents, _, err = decompressBlock(contentsCopy); err != nil {
+			return nil, err, nil
+		}
+	}
+	currContent, err := s.replaceStratParseData(nodeContents)
 	return currContent, err, contentsCopy
 }
 
@@ -25,6 +25,16 @@ import (
 // for the pointer to the index part
 const HeaderSize = 16
 
+const (
+	// SegmentVersionUncompressed is the original segment layout, where each
+	// node is written to the data section as is
+	SegmentVersionUncompressed uint16 = 0
+	// SegmentVersionCompressedBlocks stores each node as a compressed block.
+	// The index keys point at the block boundaries rather than the node
+	// itself.
+	SegmentVersionCompressedBlocks uint16 = 1
+)
+
 type Header struct {
 	Level            uint16
 	Version          uint16
@@ -121,7 +131,8 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
-	if out.Version != 0 {
+	if out.Version != SegmentVersionUncompressed &&
+		out.Version != SegmentVersionCompressedBlocks {
 		return nil, fmt.Errorf("unsupported version %d", out.Version)
 	}
 
@@ -49,22 +49,27 @@ type Store struct {
 	// Prevent concurrent manipulations to the same Bucket, specially if there is
 	// action on the bucket in the meantime.
 	bucketsLocks *wsync.KeyLocker
+	// applied to every bucket before the bucket-specific options
+	defaultBucketOpts []BucketOption
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
 // disk, it is loaded, if the folder is empty a new store is initialized in
-// there.
+// there. The optional defaultBucketOpts are applied to every bucket of the
+// store, ahead of the options passed when the bucket is created.
 func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	shardCompactionCallbacks, shardFlushCallbacks cyclemanager.CycleCallbackGroup,
+	defaultBucketOpts ...BucketOption,
 ) (*Store, error) {
 	s := &Store{
-		dir:           dir,
-		rootDir:       rootDir,
-		bucketsByName: map[string]*Bucket{},
-		bucketsLocks:  wsync.New(),
-		bcreator:      NewBucketCreator(),
-		logger:        logger,
-		metrics:       metrics,
+		dir:               dir,
+		rootDir:           rootDir,
+		bucketsByName:     map[string]*Bucket{},
+		bucketsLocks:      wsync.New(),
+		bcreator:          NewBucketCreator(),
+		logger:            logger,
+		metrics:           metrics,
+		defaultBucketOpts: defaultBucketOpts,
 	}
 	s.initCycleCallbacks(shardCompactionCallbacks, shardFlushCallbacks)
 
@@ -135,7 +140,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOpts(opts)...)
 	if err != nil {
 		return err
 	}
@@ -145,6 +150,14 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	return nil
 }
 
+func (s *Store) bucketOpts(opts []BucketOption) []BucketOption {
+	if len(s.defaultBucketOpts) == 0 {
+		return opts
+	}
+	return append(append(make([]BucketOption, 0, len(s.defaultBucketOpts)+len(opts)),
+		s.defaultBucketOpts...), opts...)
+}
+
 func (s *Store) setBucket(name string, b *Bucket) {
 	s.bucketAccessLock.Lock()
 	defer s.bucketAccessLock.Unlock()
@@ -308,7 +321,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := s.bcreator.NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOpts(opts)...)
 	if err != nil {
 		return err
 	}
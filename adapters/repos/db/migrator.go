@@ -51,20 +51,27 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 
 	idx, err := NewIndex(ctx,
 		IndexConfig{
-			ClassName:                 schema.ClassName(class.Class),
-			RootPath:                  m.db.config.RootPath,
-			ResourceUsage:             m.db.config.ResourceUsage,
-			QueryMaximumResults:       m.db.config.QueryMaximumResults,
-			QueryNestedRefLimit:       m.db.config.QueryNestedRefLimit,
-			MemtablesFlushDirtyAfter:  m.db.config.MemtablesFlushDirtyAfter,
-			MemtablesInitialSizeMB:    m.db.config.MemtablesInitialSizeMB,
-			MemtablesMaxSizeMB:        m.db.config.MemtablesMaxSizeMB,
-			MemtablesMinActiveSeconds: m.db.config.MemtablesMinActiveSeconds,
-			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
-			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
-			AvoidMMap:                 m.db.config.AvoidMMap,
-			DisableLazyLoadShards:     m.db.config.DisableLazyLoadShards,
-			ReplicationFactor:         class.ReplicationConfig.Factor,
+			ClassName:                      schema.ClassName(class.Class),
+			RootPath:                       m.db.config.RootPath,
+			ResourceUsage:                  m.db.config.ResourceUsage,
+			QueryMaximumResults:            m.db.config.QueryMaximumResults,
+			QueryNestedRefLimit:            m.db.config.QueryNestedRefLimit,
+			MemtablesFlushDirtyAfter:       m.db.config.MemtablesFlushDirtyAfter,
+			MemtablesInitialSizeMB:         m.db.config.MemtablesInitialSizeMB,
+			MemtablesMaxSizeMB:             m.db.config.MemtablesMaxSizeMB,
+			MemtablesMinActiveSeconds:      m.db.config.MemtablesMinActiveSeconds,
+			MemtablesMaxActiveSeconds:      m.db.config.MemtablesMaxActiveSeconds,
+			TrackVectorDimensions:          m.db.config.TrackVectorDimensions,
+			AvoidMMap:                      m.db.config.AvoidMMap,
+			DisableLazyLoadShards:          m.db.config.DisableLazyLoadShards,
+			ChangeLog:                      m.db.config.ChangeLog,
+			QueryCache:                     m.db.config.QueryCache,
+			LSMCompactionStrategy:          m.db.lsmCompactionStrategy,
+			LSMCompactionIOLimiter:         m.db.lsmCompactionIOLimiter,
+			LSMSegmentCompression:          m.db.lsmSegmentCompression,
+			HNSWDisableSnapshots:           m.db.config.HNSWDisableSnapshots,
+			HNSWSnapshotMinDeltaCommitlogs: m.db.config.HNSWSnapshotMinDeltaCommitlogs,
+			ReplicationFactor:              class.ReplicationConfig.Factor,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
@@ -522,6 +529,9 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return hnsw.ValidateUserConfigUpdate(old, updated)
 	case "flat":
 		return flat.ValidateUserConfigUpdate(old, updated)
+	case "sparse":
+		// sparse vector indexes do not have any settings which could change
+		return nil
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
@@ -17,6 +17,7 @@ import (
 	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -209,6 +210,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			Name:                 name,
 			Class:                shard.Index().Config.ClassName.String(),
 			ObjectCount:          objectCount,
+			ObjectStorageBytes:   objectStorageBytes(shard),
 			VectorIndexingStatus: shard.GetStatus().String(),
 			VectorQueueLength:    queueLen,
 			Compressed:           compressed,
@@ -220,3 +222,11 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 	})
 	return
 }
+
+func objectStorageBytes(shard ShardLike) int64 {
+	b := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	if b == nil {
+		return 0
+	}
+	return b.DiskSize()
+}
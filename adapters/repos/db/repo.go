@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -33,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/querycache"
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -87,6 +89,11 @@ type DB struct {
 	// in the case of metrics grouping we need to observe some metrics
 	// node-centric, rather than shard-centric
 	metricsObserver *nodeWideMetricsObserver
+
+	// shared by the LSM stores of all shards on this node
+	lsmCompactionStrategy  lsmkv.CompactionStrategy
+	lsmCompactionIOLimiter *lsmkv.IOLimiter
+	lsmSegmentCompression  lsmkv.Compression
 }
 
 func (db *DB) GetSchemaGetter() schemaUC.SchemaGetter {
@@ -159,6 +166,20 @@ func New(logger logrus.FieldLogger, config Config,
 	if db.maxNumberGoroutines == 0 {
 		return db, errors.New("no workers to add batch-jobs configured.")
 	}
+
+	compactionStrategy, err := lsmkv.ParseCompactionStrategy(config.LSMCompactionStrategy)
+	if err != nil {
+		return db, errors.Wrap(err, "lsm compaction config")
+	}
+	db.lsmCompactionStrategy = compactionStrategy
+	db.lsmCompactionIOLimiter = lsmkv.NewIOLimiter(config.LSMCompactionMaxBytesPerSecond)
+
+	segmentCompression, err := lsmkv.ParseCompression(config.LSMSegmentCompression)
+	if err != nil {
+		return db, errors.Wrap(err, "lsm segment compression config")
+	}
+	db.lsmSegmentCompression = segmentCompression
+
 	if !asyncEnabled() {
 		db.jobQueueCh = make(chan job, 100000)
 		db.shutDownWg.Add(db.maxNumberGoroutines)
@@ -202,6 +223,15 @@ type Config struct {
 	AvoidMMap                 bool
 	DisableLazyLoadShards     bool
 	Replication               replication.GlobalConfig
+	ChangeLog                 config.ChangeLog
+	QueryCache                *querycache.Cache
+
+	LSMCompactionStrategy          string
+	LSMCompactionMaxBytesPerSecond int
+	LSMSegmentCompression          string
+
+	HNSWDisableSnapshots           bool
+	HNSWSnapshotMinDeltaCommitlogs int
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
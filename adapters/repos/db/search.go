@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -105,7 +107,7 @@ func (db *DB) Search(ctx context.Context, params dto.GetParams) ([]search.Result
 func (db *DB) VectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
-	if params.SearchVector == nil {
+	if params.SearchVector == nil && params.SearchMultiVector == nil {
 		return db.Search(ctx, params)
 	}
 
@@ -120,7 +122,8 @@ func (db *DB) VectorSearch(ctx context.Context,
 	}
 
 	targetDist := extractDistanceFromParams(params)
-	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector, params.TargetVector,
+	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector,
+		params.SearchMultiVector, params.TargetVector,
 		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
 		params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
 	if err != nil {
@@ -164,7 +167,7 @@ func (db *DB) DenseObjectSearch(ctx context.Context, class string, vector []floa
 	}
 
 	// TODO: groupBy think of this
-	objs, dist, err := index.objectVectorSearch(ctx, vector, targetVector, 0,
+	objs, dist, err := index.objectVectorSearch(ctx, vector, nil, targetVector, 0,
 		totalLimit, filters, nil, nil, addl, nil, tenant)
 	if err != nil {
 		return nil, nil, fmt.Errorf("search index %s: %w", index.ID(), err)
@@ -190,7 +193,7 @@ func (db *DB) CrossClassVectorSearch(ctx context.Context, vector []float32, targ
 		f := func() {
 			defer wg.Done()
 
-			objs, dist, err := index.objectVectorSearch(ctx, vector, targetVector,
+			objs, dist, err := index.objectVectorSearch(ctx, vector, nil, targetVector,
 				0, totalLimit, filters, nil, nil,
 				additional.Properties{}, nil, "")
 			if err != nil {
@@ -340,6 +343,11 @@ func (db *DB) ResolveReferences(ctx context.Context, objs search.Results,
 		return objs, nil
 	}
 
+	before := time.Now()
+	defer func() {
+		profile.FromContext(ctx).ObservePhase(profile.PhaseReferences, time.Since(before))
+	}()
+
 	if groupBy != nil {
 		res, err := refcache.NewResolverWithGroup(refcache.NewCacherWithGroup(db, db.logger, tenant)).
 			Do(ctx, objs, props, addl)
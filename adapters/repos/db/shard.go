@@ -18,6 +18,7 @@ import (
 	"os"
 	"path"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -39,6 +40,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -70,6 +72,7 @@ type ShardLike interface {
 	FindUUIDs(ctx context.Context, filters *filters.LocalFilter) ([]strfmt.UUID, error) // Search and return document ids
 
 	Counter() *indexcounter.Counter
+	ChangeLog() *changelog.Log
 	ObjectCount() int
 	ObjectCountAsync() int
 	GetPropertyLengthTracker() *inverted.JsonPropertyLengthTracker
@@ -79,7 +82,7 @@ type ShardLike interface {
 	ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error)
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 	ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties) ([]*storobj.Object, []float32, error)
-	ObjectVectorSearch(ctx context.Context, searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error)
+	ObjectVectorSearch(ctx context.Context, searchVector []float32, searchMultiVector [][]float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error)
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
@@ -150,6 +153,9 @@ type ShardLike interface {
 	updatePropertySpecificIndices(object *storobj.Object, status objectInsertStatus) error
 	updateVectorIndexIgnoreDelete(vector []float32, status objectInsertStatus) error
 	updateVectorIndexesIgnoreDelete(vectors map[string][]float32, status objectInsertStatus) error
+	validateMultiVectors(object *storobj.Object) error
+	validateSparseVectors(object *storobj.Object) error
+	updateMultiVectorIndexesIgnoreDelete(ctx context.Context, multiVectors map[string][][]float32, status objectInsertStatus) error
 	hasGeoIndex() bool
 
 	Metrics() *Metrics
@@ -173,6 +179,9 @@ type Shard struct {
 	propertyIndices  propertyspecific.Indices
 	propLenTracker   *inverted.JsonPropertyLengthTracker
 	versioner        *shardVersioner
+	changeLog        *changelog.Log
+	// queryVersion changes with every write, see bumpQueryVersion
+	queryVersion atomic.Uint64
 
 	status              storagestate.Status
 	statusLock          sync.Mutex
@@ -198,15 +207,27 @@ type Shard struct {
 
 	cycleCallbacks *shardCycleCallbacks
 	bitmapFactory  *roaringset.BitmapFactory
+
+	// set on the staging shard of a bulk load, see bulk_load.go
+	bulkLoad bool
 }
 
 func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	shardName string, index *Index, class *models.Class, jobQueueCh chan job,
 	indexCheckpoints *indexcheckpoint.Checkpoints,
+) (*Shard, error) {
+	return newShard(ctx, promMetrics, shardName, index, class, jobQueueCh,
+		indexCheckpoints, false)
+}
+
+func newShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
+	shardName string, index *Index, class *models.Class, jobQueueCh chan job,
+	indexCheckpoints *indexcheckpoint.Checkpoints, bulkLoad bool,
 ) (*Shard, error) {
 	before := time.Now()
 	var err error
 	s := &Shard{
+		bulkLoad:    bulkLoad,
 		index:       index,
 		name:        shardName,
 		promMetrics: promMetrics,
@@ -218,11 +239,18 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		indexCheckpoints: indexCheckpoints,
 	}
 	s.initCycleCallbacks()
+	s.bumpQueryVersion()
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
 
 	defer s.metrics.ShardStartup(before)
 
+	if !bulkLoad {
+		if err := recoverBulkLoad(s.path(), s.index.logger); err != nil {
+			return nil, errors.Wrapf(err, "init shard %q", s.ID())
+		}
+	}
+
 	_, err = os.Stat(s.path())
 	exists := false
 	if err == nil {
@@ -255,7 +283,7 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	s.initDimensionTracking()
 
-	if asyncEnabled() {
+	if asyncEnabled() && !bulkLoad {
 		f := func() {
 			// preload unindexed objects in the background
 			if s.hasTargetVectors() {
@@ -297,6 +325,12 @@ func hasTargetVectors(cfg schemaConfig.VectorIndexConfig, targetCfgs map[string]
 func (s *Shard) initTargetVectors(ctx context.Context) error {
 	s.vectorIndexes = make(map[string]VectorIndex)
 	for targetVector, vectorIndexConfig := range s.index.vectorIndexUserConfigs {
+		if s.isSparseVector(targetVector) {
+			if err := s.initSparseVectorIndex(ctx, targetVector); err != nil {
+				return fmt.Errorf("cannot create sparse vector index for %q: %w", targetVector, err)
+			}
+			continue
+		}
 		vectorIndex, err := s.initVectorIndex(ctx, targetVector, vectorIndexConfig)
 		if err != nil {
 			return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
@@ -385,21 +419,26 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			vecIdxID := s.vectorIndexID(targetVector)
 
 			vi, err := hnsw.New(hnsw.Config{
-				Logger:               s.index.logger,
-				RootPath:             s.path(),
-				ID:                   vecIdxID,
-				ShardName:            s.name,
-				ClassName:            s.index.Config.ClassName.String(),
-				PrometheusMetrics:    s.promMetrics,
-				VectorForIDThunk:     s.vectorByIndexID,
-				TempVectorForIDThunk: s.readVectorByIndexIDIntoSlice,
-				DistanceProvider:     distProv,
+				Logger:                   s.index.logger,
+				RootPath:                 s.path(),
+				ID:                       vecIdxID,
+				ShardName:                s.name,
+				ClassName:                s.index.Config.ClassName.String(),
+				PrometheusMetrics:        s.promMetrics,
+				VectorForIDThunk:         s.vectorByIndexID,
+				TempVectorForIDThunk:     s.readVectorByIndexIDIntoSlice,
+				DistanceProvider:         distProv,
+				MultiVectorForDocIDThunk: s.multiVectorByIndexID(targetVector),
 				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+					opts := []hnsw.CommitlogOption{hnsw.WithAllocChecker(s.index.allocChecker)}
+					if !s.index.Config.HNSWDisableSnapshots {
+						opts = append(opts, hnsw.WithSnapshots(s.index.Config.HNSWSnapshotMinDeltaCommitlogs))
+					}
 					return hnsw.NewCommitLogger(s.path(), vecIdxID,
-						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
-						hnsw.WithAllocChecker(s.index.allocChecker))
+						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks, opts...)
 				},
-				AllocChecker: s.index.allocChecker,
+				AllocChecker:     s.index.allocChecker,
+				SnapshotsEnabled: !s.index.Config.HNSWDisableSnapshots,
 			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
 				s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.store)
 			if err != nil {
@@ -470,6 +509,19 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 
 	s.propLenTracker = tracker
 
+	if s.index.Config.ChangeLog.Enabled && !s.bulkLoad {
+		var tenant string
+		if s.index.partitioningEnabled {
+			tenant = s.name
+		}
+		changeLog, err := changelog.New(s.path(), s.index.Config.ClassName.String(),
+			tenant, s.index.Config.ChangeLog.MaxEventsPerShard)
+		if err != nil {
+			return errors.Wrapf(err, "init shard %q: change log", s.ID())
+		}
+		s.changeLog = changeLog
+	}
+
 	if err := s.initProperties(class); err != nil {
 		return errors.Wrapf(err, "init shard %q: init per property indices", s.ID())
 	}
@@ -514,7 +566,8 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 	}
 
 	store, err := lsmkv.New(s.pathLSM(), s.path(), annotatedLogger, metrics,
-		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks)
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks,
+		lsmkv.WithWriteAheadLog(!s.bulkLoad))
 	if err != nil {
 		return errors.Wrapf(err, "init lsmkv store at %s", s.pathLSM())
 	}
@@ -523,6 +576,7 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
 		lsmkv.WithSecondaryIndices(1),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithKeepTombstones(true),
 		s.dynamicMemtableSizing(),
 		s.memtableDirtyConfig(),
@@ -601,6 +655,12 @@ func (s *Shard) drop() error {
 		return errors.Wrapf(err, "remove version at %s", s.path())
 	}
 
+	// delete change log, this also stops all change stream readers
+	err = s.changeLog.Drop()
+	if err != nil {
+		return errors.Wrapf(err, "remove change log at %s", s.path())
+	}
+
 	if s.hasTargetVectors() {
 		// TODO run in parallel?
 		for targetVector, queue := range s.queues {
@@ -652,6 +712,7 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 		s.memtableDirtyConfig(),
 		lsmkv.WithStrategy(lsmkv.StrategySetCollection),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	)
 }
@@ -667,6 +728,7 @@ func (s *Shard) addDimensionsProperty(ctx context.Context) error {
 		helpers.DimensionsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	)
 	if err != nil {
@@ -697,6 +759,7 @@ func (s *Shard) addCreationTimeUnixProperty(ctx context.Context) error {
 		s.memtableDirtyConfig(),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	)
 }
@@ -707,6 +770,7 @@ func (s *Shard) addLastUpdateTimeUnixProperty(ctx context.Context) error {
 		s.memtableDirtyConfig(),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	)
 }
@@ -716,6 +780,24 @@ func (s *Shard) memtableDirtyConfig() lsmkv.BucketOption {
 		time.Duration(s.index.Config.MemtablesFlushDirtyAfter) * time.Second)
 }
 
+// compactionConfig applies the node-wide compaction strategy, IO limit and
+// compression of newly written segments
+func (s *Shard) compactionConfig() lsmkv.BucketOption {
+	return func(b *lsmkv.Bucket) error {
+		if strategy := s.index.Config.LSMCompactionStrategy; strategy != nil {
+			if err := lsmkv.WithCompactionStrategy(strategy)(b); err != nil {
+				return err
+			}
+		}
+
+		if err := lsmkv.WithCompression(s.index.Config.LSMSegmentCompression)(b); err != nil {
+			return err
+		}
+
+		return lsmkv.WithSharedCompactionIOLimiter(s.index.Config.LSMCompactionIOLimiter)(b)
+	}
+}
+
 func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 	return lsmkv.WithDynamicMemtableSizing(
 		s.index.Config.MemtablesInitialSizeMB,
@@ -773,6 +855,7 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		s.memtableDirtyConfig(),
 		s.dynamicMemtableSizing(),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	}
 
@@ -833,6 +916,7 @@ func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Prop
 		helpers.BucketFromPropNameLengthLSM(prop.Name),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	)
 }
@@ -846,6 +930,7 @@ func (s *Shard) createPropertyNullIndex(ctx context.Context, prop *models.Proper
 		helpers.BucketFromPropNameNullLSM(prop.Name),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 	)
 }
@@ -861,6 +946,7 @@ func (s *Shard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfi
 	}
 
 	return s.VectorIndex().UpdateUserConfig(updated, func() {
+		s.bumpQueryVersion()
 		s.UpdateStatus(storagestate.StatusReady.String())
 	})
 }
@@ -876,6 +962,10 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 	wg := new(sync.WaitGroup)
 	var err error
 	for targetName, targetCfg := range updated {
+		// sparse vectors have no configurable vector index
+		if s.isSparseVector(targetName) {
+			continue
+		}
 		wg.Add(1)
 		if err = s.VectorIndexForName(targetName).UpdateUserConfig(targetCfg, wg.Done); err != nil {
 			break
@@ -884,6 +974,7 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 
 	f := func() {
 		wg.Wait()
+		s.bumpQueryVersion()
 		s.UpdateStatus(storagestate.StatusReady.String())
 	}
 	enterrors.GoWrapper(f, s.index.logger)
@@ -903,6 +994,10 @@ func (s *Shard) Shutdown(ctx context.Context) error {
 		return errors.Wrap(err, "close prop length tracker")
 	}
 
+	if err = s.changeLog.Close(); err != nil {
+		return errors.Wrap(err, "close change log")
+	}
+
 	if s.hasTargetVectors() {
 		// TODO run in parallel?
 		for targetVector, queue := range s.queues {
@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -62,6 +63,11 @@ func (s *Shard) Counter() *indexcounter.Counter {
 	return s.counter
 }
 
+// The ordered log of object mutations, nil if the change log is disabled
+func (s *Shard) ChangeLog() *changelog.Log {
+	return s.changeLog
+}
+
 // Tracks the lengths of all properties.  Must be updated on inserts/deletes.
 func (s *Shard) GetPropertyLengthTracker() *inverted.JsonPropertyLengthTracker {
 	return s.propLenTracker
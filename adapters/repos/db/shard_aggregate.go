@@ -16,14 +16,20 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/aggregator"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params) (*aggregation.Result, error) {
+func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params) (_ *aggregation.Result, err error) {
+	ctx, span := tracing.Start(ctx, "shard.Aggregate",
+		attribute.String("class", s.index.Config.ClassName.String()),
+		attribute.String("shard", s.name))
+	defer func() { tracing.End(span, err) }()
+
 	var queue *IndexQueue
 
 	// we only need the index queue for vector search
 	if params.NearObject != nil || params.NearVector != nil || params.Hybrid != nil || params.GroupBy != nil || params.SearchVector != nil {
-		var err error
 		queue, err = s.getIndexQueue(params.TargetVector)
 		if err != nil {
 			return nil, err
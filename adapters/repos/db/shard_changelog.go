@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/changelog"
+)
+
+// recordChange appends the mutation to the shard's change log (if enabled).
+// At this point the write has already been persisted, so a failure to record
+// the change is logged, but does not fail the write itself.
+func recordChange(shard ShardLike, op changelog.Operation, id strfmt.UUID) {
+	if err := shard.ChangeLog().Append(op, id); err != nil {
+		shard.Index().logger.WithField("action", "change_log_append").
+			WithField("shard", shard.Name()).
+			WithField("id", id).
+			WithError(err).
+			Warn("failed to record change")
+	}
+}
+
+func recordDeleteFromBytes(shard ShardLike, idBytes []byte) {
+	id, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return
+	}
+	recordChange(shard, changelog.OperationDelete, strfmt.UUID(id.String()))
+}
+
+// recordChanges records all objects of the batch which were actually written,
+// i.e. did not fail, were not skipped as unchanged and are no duplicates.
+func (ob *objectsBatcher) recordChanges() {
+	if ob.shard.ChangeLog() == nil {
+		return
+	}
+
+	for i, object := range ob.objects {
+		if ob.shouldSkipInAdditionalStorage(i, ob.statuses[object.ID()]) {
+			continue
+		}
+		recordChange(ob.shard, changelog.OperationPut, object.ID())
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -155,6 +156,11 @@ func (l *LazyLoadShard) Counter() *indexcounter.Counter {
 	return l.shard.Counter()
 }
 
+func (l *LazyLoadShard) ChangeLog() *changelog.Log {
+	l.mustLoad()
+	return l.shard.ChangeLog()
+}
+
 func (l *LazyLoadShard) ObjectCount() int {
 	l.mustLoad()
 	return l.shard.ObjectCount()
@@ -210,11 +216,11 @@ func (l *LazyLoadShard) ObjectSearch(ctx context.Context, limit int, filters *fi
 	return l.shard.ObjectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional)
 }
 
-func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error) {
+func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVector []float32, searchMultiVector [][]float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error) {
 	if err := l.Load(ctx); err != nil {
 		return nil, nil, err
 	}
-	return l.shard.ObjectVectorSearch(ctx, searchVector, targetVector, targetDist, limit, filters, sort, groupBy, additional)
+	return l.shard.ObjectVectorSearch(ctx, searchVector, searchMultiVector, targetVector, targetDist, limit, filters, sort, groupBy, additional)
 }
 
 func (l *LazyLoadShard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
@@ -585,6 +591,23 @@ func (l *LazyLoadShard) updateVectorIndexesIgnoreDelete(vectors map[string][]flo
 	return l.shard.updateVectorIndexesIgnoreDelete(vectors, status)
 }
 
+func (l *LazyLoadShard) validateMultiVectors(object *storobj.Object) error {
+	l.mustLoad()
+	return l.shard.validateMultiVectors(object)
+}
+
+func (l *LazyLoadShard) validateSparseVectors(object *storobj.Object) error {
+	l.mustLoad()
+	return l.shard.validateSparseVectors(object)
+}
+
+func (l *LazyLoadShard) updateMultiVectorIndexesIgnoreDelete(ctx context.Context,
+	multiVectors map[string][][]float32, status objectInsertStatus,
+) error {
+	l.mustLoad()
+	return l.shard.updateMultiVectorIndexesIgnoreDelete(ctx, multiVectors, status)
+}
+
 func (l *LazyLoadShard) hasGeoIndex() bool {
 	l.mustLoad()
 	return l.shard.hasGeoIndex()
@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// MultiVectorIndex is implemented by vector indexes which hold a variable
+// number of vectors per object and score objects with late interaction
+type MultiVectorIndex interface {
+	AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error
+	SearchByMultiVector(vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+func (s *Shard) isMultiVector(targetVector string) bool {
+	cfg, ok := s.index.vectorIndexUserConfigs[targetVector].(hnswent.UserConfig)
+	return ok && cfg.Multivector.Enabled
+}
+
+func (s *Shard) multiVectorIndexForName(targetVector string) (MultiVectorIndex, error) {
+	if !s.isMultiVector(targetVector) {
+		return nil, fmt.Errorf("target vector %q is not configured as multivector", targetVector)
+	}
+	vectorIndex, ok := s.VectorIndexForName(targetVector).(MultiVectorIndex)
+	if !ok {
+		return nil, fmt.Errorf("vector index of target vector %q does not support multivectors", targetVector)
+	}
+	return vectorIndex, nil
+}
+
+// validateMultiVectors makes sure that multi-vectors are only provided for,
+// and regular vectors are not provided for, multivector target vectors
+func (s *Shard) validateMultiVectors(object *storobj.Object) error {
+	for targetVector := range object.Vectors {
+		if s.isMultiVector(targetVector) {
+			return fmt.Errorf("target vector %q expects a multi-vector", targetVector)
+		}
+	}
+
+	for targetVector, vectors := range object.MultiVectors {
+		if _, err := s.multiVectorIndexForName(targetVector); err != nil {
+			return err
+		}
+		vectorIndex := s.VectorIndexForName(targetVector)
+		for _, vector := range vectors {
+			if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
+				return errors.Wrapf(err, "validate multi-vector %s", targetVector)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) updateMultiVectorIndexes(ctx context.Context,
+	multiVectors map[string][][]float32, status objectInsertStatus,
+) error {
+	for targetVector, vectors := range multiVectors {
+		if err := s.updateMultiVectorIndex(ctx, vectors, status, targetVector); err != nil {
+			return errors.Wrapf(err, "update multi-vector index for target vector %s", targetVector)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) updateMultiVectorIndex(ctx context.Context, vectors [][]float32,
+	status objectInsertStatus, targetVector string,
+) error {
+	vectorIndex, err := s.multiVectorIndexForName(targetVector)
+	if err != nil {
+		return err
+	}
+
+	if status.docIDChanged {
+		if err := s.VectorIndexForName(targetVector).Delete(status.oldDocID); err != nil {
+			return errors.Wrapf(err, "delete doc id %d from vector index", status.oldDocID)
+		}
+	}
+
+	return s.addMultiVector(ctx, vectorIndex, vectors, status, targetVector)
+}
+
+// updateMultiVectorIndexesIgnoreDelete only performs the insertions, deletes
+// of previous doc ids are assumed to be taken care of by the caller
+func (s *Shard) updateMultiVectorIndexesIgnoreDelete(ctx context.Context,
+	multiVectors map[string][][]float32, status objectInsertStatus,
+) error {
+	for targetVector, vectors := range multiVectors {
+		vectorIndex, err := s.multiVectorIndexForName(targetVector)
+		if err != nil {
+			return err
+		}
+		if err := s.addMultiVector(ctx, vectorIndex, vectors, status, targetVector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) addMultiVector(ctx context.Context, vectorIndex MultiVectorIndex,
+	vectors [][]float32, status objectInsertStatus, targetVector string,
+) error {
+	// multi-vector was not changed, object was updated without changing docID
+	if status.docIDPreserved || status.skipUpsert {
+		return nil
+	}
+
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	// multi-vectors bypass the index queue, as every document is split into
+	// several nodes which the queue can't represent
+	if err := vectorIndex.AddMulti(ctx, status.docID, vectors); err != nil {
+		return errors.Wrapf(err, "insert doc id %d to vector index for target vector %s",
+			status.docID, targetVector)
+	}
+
+	return nil
+}
+
+// multiVectorByIndexID returns the thunk which multivector indexes use to
+// resolve the vectors of a document
+func (s *Shard) multiVectorByIndexID(targetVector string) common.MultiVectorForDocID {
+	return func(ctx context.Context, indexID uint64) ([][]float32, error) {
+		keyBuf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(keyBuf, indexID)
+
+		bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).GetBySecondary(0, keyBuf)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes == nil {
+			return nil, storobj.NewErrNotFoundf(indexID,
+				"no object for doc id, it could have been deleted")
+		}
+
+		return storobj.MultiVectorFromBinary(bytes, targetVector)
+	}
+}
+
+func (s *Shard) multiVectorSearch(searchMultiVector [][]float32, targetVector string,
+	targetDist float32, limit int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vectorIndex, err := s.multiVectorIndexForName(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if limit >= 0 {
+		return vectorIndex.SearchByMultiVector(searchMultiVector, limit, allowList)
+	}
+
+	// a search by distance, MaxSim distances are sums over all query vectors
+	// so there is no graph-level cut-off, filter the best results instead
+	ids, dists, err := vectorIndex.SearchByMultiVector(searchMultiVector,
+		int(s.index.Config.QueryMaximumResults), allowList)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range dists {
+		if dists[i] > targetDist {
+			return ids[:i], dists[:i], nil
+		}
+	}
+	return ids, dists, nil
+}
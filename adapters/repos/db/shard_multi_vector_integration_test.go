@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_MultiVector(t *testing.T) {
+	ctx := context.Background()
+	className := "MultiVectorClass"
+	targetVector := "tokens"
+
+	class := &models.Class{
+		Class: className,
+		VectorConfig: map[string]models.VectorConfig{
+			targetVector: {VectorIndexType: "hnsw"},
+		},
+	}
+	userConfig := hnsw.NewDefaultUserConfig()
+	userConfig.Distance = common.DistanceDot
+	userConfig.Multivector.Enabled = true
+
+	shard, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{}, false, true,
+		func(idx *Index) {
+			idx.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				targetVector: userConfig,
+			}
+		})
+
+	newObject := func(id strfmt.UUID, multiVector [][]float32) *storobj.Object {
+		return &storobj.Object{
+			MarshallerVersion: 1,
+			Object: models.Object{
+				ID:    id,
+				Class: className,
+			},
+			MultiVectors: map[string][][]float32{targetVector: multiVector},
+		}
+	}
+	search := func(t *testing.T, query [][]float32) []*storobj.Object {
+		res, _, err := shard.ObjectVectorSearch(ctx, nil, query, targetVector, 0, 10,
+			nil, nil, nil, additional.Properties{})
+		require.NoError(t, err)
+		return res
+	}
+
+	idA := strfmt.UUID(uuid.NewString())
+	idB := strfmt.UUID(uuid.NewString())
+	idC := strfmt.UUID(uuid.NewString())
+
+	t.Run("put single object", func(t *testing.T) {
+		err := shard.PutObject(ctx, newObject(idA, [][]float32{{1, 0, 0}, {0, 1, 0}}))
+		require.NoError(t, err)
+	})
+
+	t.Run("put batch", func(t *testing.T) {
+		errs := shard.PutObjectBatch(ctx, []*storobj.Object{
+			newObject(idB, [][]float32{{0, 0, 1}}),
+			newObject(idC, [][]float32{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}}),
+		})
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("search scores all query vectors", func(t *testing.T) {
+		res := search(t, [][]float32{{1, 0, 0}, {0, 0, 1}})
+		require.Len(t, res, 3)
+		assert.Equal(t, idC, res[0].ID())
+	})
+
+	t.Run("object holds its multi-vector", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, idA, nil, additional.Properties{Vector: true})
+		require.NoError(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, [][]float32{{1, 0, 0}, {0, 1, 0}}, obj.MultiVectors[targetVector])
+	})
+
+	t.Run("update replaces the multi-vector", func(t *testing.T) {
+		err := shard.PutObject(ctx, newObject(idB, [][]float32{{1, 0, 0}, {0, 0, 1}}))
+		require.NoError(t, err)
+
+		res := search(t, [][]float32{{1, 0, 0}, {0, 0, 1}})
+		require.Len(t, res, 3)
+		assert.ElementsMatch(t, []strfmt.UUID{idB, idC}, []strfmt.UUID{res[0].ID(), res[1].ID()})
+	})
+
+	t.Run("delete removes the object from results", func(t *testing.T) {
+		require.NoError(t, shard.DeleteObject(ctx, idC))
+
+		res := search(t, [][]float32{{1, 0, 0}, {0, 0, 1}})
+		require.Len(t, res, 2)
+		assert.Equal(t, idB, res[0].ID())
+	})
+
+	t.Run("regular vector for multivector target is rejected", func(t *testing.T) {
+		obj := newObject(strfmt.UUID(uuid.NewString()), nil)
+		obj.MultiVectors = nil
+		obj.Vectors = map[string][]float32{targetVector: {1, 0, 0}}
+		require.Error(t, shard.PutObject(ctx, obj))
+	})
+}
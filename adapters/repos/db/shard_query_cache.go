@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+// queryVersions hands out the query versions of all shards of the node.
+// Versions are unique across shards, so that a shard which is dropped and
+// created again never matches results cached for its predecessor.
+var queryVersions atomic.Uint64
+
+// bumpQueryVersion must be called after every write to the shard. It
+// invalidates all results of the shard in the query cache.
+func (s *Shard) bumpQueryVersion() {
+	s.queryVersion.Store(queryVersions.Add(1))
+}
+
+// cachedSearch returns the results of search from the query cache, if the
+// same query was run before and the shard was not written to since then.
+// The query is identified by the key parts, which must contain everything
+// that affects the results. Searches with a filter on a reference are not
+// cached, as their results depend on the referenced objects, which are
+// written without invalidating the results of this shard.
+func (s *Shard) cachedSearch(filter *filters.LocalFilter, keyParts []interface{},
+	search func() ([]*storobj.Object, []float32, error),
+) ([]*storobj.Object, []float32, error) {
+	cache := s.index.Config.QueryCache
+	if cache == nil || filterFollowsReferences(filter) {
+		return search()
+	}
+
+	key, ok := querycache.Key(append([]interface{}{s.index.Config.ClassName, s.name}, keyParts...)...)
+	if !ok {
+		return search()
+	}
+
+	// the version must be read before searching, so that a concurrent write
+	// invalidates the results
+	version := s.queryVersion.Load()
+	if objs, dists, ok := cache.Results(key, version); ok {
+		return objs, dists, nil
+	}
+
+	objs, dists, err := search()
+	if err != nil {
+		return nil, nil, err
+	}
+	cache.AddResults(key, version, objs, dists)
+	return objs, dists, nil
+}
+
+// filterFollowsReferences returns whether any clause of filter is on a path
+// through a reference property, such as hasAuthor.Author.name
+func filterFollowsReferences(filter *filters.LocalFilter) bool {
+	if filter == nil || filter.Root == nil {
+		return false
+	}
+	var follows func(c *filters.Clause) bool
+	follows = func(c *filters.Clause) bool {
+		if c.On != nil && c.On.Child != nil {
+			return true
+		}
+		for i := range c.Operands {
+			if follows(&c.Operands[i]) {
+				return true
+			}
+		}
+		return false
+	}
+	return follows(filter.Root)
+}
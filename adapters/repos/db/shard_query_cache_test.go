@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+func TestShard_QueryCache(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+
+	cache, err := querycache.New(config.QueryCache{Enabled: true, MaxVectors: 10, MaxResults: 10}, nil)
+	require.Nil(t, err)
+
+	shd, idx := testShard(t, ctx, className, func(idx *Index) {
+		idx.Config.QueryCache = cache
+	})
+	defer func() {
+		require.Nil(t, idx.drop())
+	}()
+
+	search := func() int {
+		objs, _, err := shd.ObjectSearch(ctx, 10, nil, nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		return len(objs)
+	}
+
+	obj := testObject(className)
+	require.Nil(t, shd.PutObject(ctx, obj))
+	require.Nil(t, shd.PutObject(ctx, testObject(className)))
+
+	t.Run("repeated searches return the cached results", func(t *testing.T) {
+		require.Equal(t, 2, search())
+		require.Equal(t, 2, search())
+	})
+
+	t.Run("put invalidates the cached results", func(t *testing.T) {
+		require.Nil(t, shd.PutObject(ctx, testObject(className)))
+		require.Equal(t, 3, search())
+	})
+
+	t.Run("delete invalidates the cached results", func(t *testing.T) {
+		require.Nil(t, shd.DeleteObject(ctx, obj.ID()))
+		require.Equal(t, 2, search())
+	})
+}
+
+func TestFilterFollowsReferences(t *testing.T) {
+	prop := &filters.Path{Class: "Book", Property: "title"}
+	ref := &filters.Path{
+		Class: "Book", Property: "hasAuthor",
+		Child: &filters.Path{Class: "Author", Property: "name"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   *filters.LocalFilter
+		expected bool
+	}{
+		{name: "no filter", filter: nil},
+		{
+			name:   "property",
+			filter: &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorEqual, On: prop}},
+		},
+		{
+			name:     "reference",
+			filter:   &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorEqual, On: ref}},
+			expected: true,
+		},
+		{
+			name: "nested reference",
+			filter: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorAnd,
+				Operands: []filters.Clause{
+					{Operator: filters.OperatorEqual, On: prop},
+					{Operator: filters.OperatorOr, Operands: []filters.Clause{
+						{Operator: filters.OperatorEqual, On: ref},
+					}},
+				},
+			}},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, filterFollowsReferences(test.filter))
+		})
+	}
+}
@@ -27,12 +27,17 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
@@ -164,7 +169,29 @@ func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64
 func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	additional additional.Properties,
-) ([]*storobj.Object, []float32, error) {
+) (res []*storobj.Object, _ []float32, err error) {
+	ctx, span := tracing.Start(ctx, "shard.ObjectSearch",
+		attribute.String("class", s.index.Config.ClassName.String()),
+		attribute.String("shard", s.name))
+	defer func() { tracing.End(span, err) }()
+
+	ctx, sp := s.startProfile(ctx, objectSearchKind(keywordRanking, filters))
+	defer func() { sp.End(len(res)) }()
+
+	return s.cachedSearch(filters, []interface{}{
+		"object", limit, filters, keywordRanking, sort, cursor, additional,
+		// BM25 parameters and stopwords can be changed without writing to
+		// the shard
+		s.index.getInvertedIndexConfig(),
+	}, func() ([]*storobj.Object, []float32, error) {
+		return s.objectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional)
+	})
+}
+
+func (s *Shard) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
+	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
+	additional additional.Properties,
+) (_ []*storobj.Object, _ []float32, err error) {
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf(
@@ -174,7 +201,6 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 
 		var bm25objs []*storobj.Object
 		var bm25count []float32
-		var err error
 		var objs helpers.AllowList
 		var filterDocIds helpers.AllowList
 
@@ -192,6 +218,13 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			filterDocIds = objs
 		}
 
+		if keywordRanking.Type == searchparams.KeywordRankingTypeSparse {
+			if keywordRanking.SparseVector == nil {
+				return nil, nil, errors.Errorf("sparse search: missing sparse vector")
+			}
+			return s.sparseVectorSearch(ctx, filterDocIds, *keywordRanking.SparseVector, limit)
+		}
+
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
@@ -211,6 +244,19 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			cursor, additional, s.index.Config.ClassName)
 		return objs, nil, err
 	}
+	if cursor != nil {
+		// a cursor combined with a filter pages through the matching objects
+		// in the order of their UUIDs, as used by collection exports
+		allow, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+			s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
+			s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
+			DocIDs(ctx, filters, additional, s.index.Config.ClassName)
+		if err != nil {
+			return nil, nil, err
+		}
+		objs, err := s.cursorObjectList(ctx, cursor, allow)
+		return objs, nil, err
+	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
@@ -218,6 +264,27 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	return objs, nil, err
 }
 
+// objectSearchKind describes a non-vector search for query profiles
+func objectSearchKind(keywordRanking *searchparams.KeywordRanking, filters *filters.LocalFilter) string {
+	switch {
+	case keywordRanking != nil && keywordRanking.Type == searchparams.KeywordRankingTypeSparse:
+		return profile.SearchSparse
+	case keywordRanking != nil:
+		return profile.SearchBM25
+	case filters != nil:
+		return profile.SearchFilter
+	default:
+		return profile.SearchList
+	}
+}
+
+// startProfile adds a search of this shard to the query profile, if the query
+// is profiled
+func (s *Shard) startProfile(ctx context.Context, search string) (context.Context, *profile.Shard) {
+	return profile.FromContext(ctx).StartShard(ctx, s.index.Config.ClassName.String(),
+		s.name, s.index.getSchema.NodeName(), search)
+}
+
 func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 	if s.hasTargetVectors() {
 		if targetVector == "" {
@@ -232,11 +299,36 @@ func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 	return s.queue, nil
 }
 
-func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error) {
+func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32, searchMultiVector [][]float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) (res []*storobj.Object, _ []float32, err error) {
+	ctx, span := tracing.Start(ctx, "shard.ObjectVectorSearch",
+		attribute.String("class", s.index.Config.ClassName.String()),
+		attribute.String("shard", s.name))
+	defer func() { tracing.End(span, err) }()
+
+	ctx, sp := s.startProfile(ctx, profile.SearchVector)
+	defer func() { sp.End(len(res)) }()
+
+	return s.cachedSearch(filters, []interface{}{
+		"vector", searchVector, searchMultiVector, targetVector, targetDist, limit,
+		filters, sort, groupBy, additional,
+		// parameters such as ef can be changed without writing to the shard
+		s.index.getVectorIndexConfig(targetVector),
+	}, func() ([]*storobj.Object, []float32, error) {
+		return s.objectVectorSearch(ctx, searchVector, searchMultiVector, targetVector,
+			targetDist, limit, filters, sort, groupBy, additional)
+	})
+}
+
+func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
+	searchMultiVector [][]float32, targetVector string, targetDist float32, limit int,
+	filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	additional additional.Properties,
+) (_ []*storobj.Object, _ []float32, err error) {
+	sp := profile.ShardFromContext(ctx)
+
 	var (
 		ids       []uint64
 		dists     []float32
-		err       error
 		allowList helpers.AllowList
 	)
 
@@ -250,24 +342,12 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
 	}
 
-	queue, err := s.getIndexQueue(targetVector)
+	beforeVector := time.Now()
+	ids, dists, err = s.searchVectorIndex(ctx, searchVector, searchMultiVector,
+		targetVector, targetDist, limit, allowList)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	beforeVector := time.Now()
-	if limit < 0 {
-		ids, dists, err = queue.SearchByVectorDistance(
-			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search by distance")
-		}
-	} else {
-		ids, dists, err = queue.SearchByVector(searchVector, limit, allowList)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "vector search")
-		}
-	}
 	if len(ids) == 0 {
 		return nil, nil, nil
 	}
@@ -287,6 +367,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search sort")
 		}
+		sp.ObserveSort(time.Since(beforeSort))
 		if filters != nil {
 			s.metrics.FilteredVectorSort(time.Since(beforeSort))
 		}
@@ -307,12 +388,76 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 	return objs, dists, nil
 }
 
+func (s *Shard) searchVectorIndex(ctx context.Context, searchVector []float32,
+	searchMultiVector [][]float32, targetVector string, targetDist float32, limit int,
+	allowList helpers.AllowList,
+) (ids []uint64, dists []float32, err error) {
+	_, span := tracing.Start(ctx, "vector.Search",
+		attribute.String("targetVector", targetVector),
+		attribute.Int("limit", limit),
+		attribute.Bool("filtered", allowList != nil))
+	defer func() { tracing.End(span, err) }()
+
+	vp := profile.ShardFromContext(ctx).StartVector(s.vectorIndexType(targetVector))
+	if vp != nil {
+		vp.Flat = vp.Index == vectorindex.VectorIndexTypeFLAT
+		before := time.Now()
+		defer func() { vp.Took = time.Since(before) }()
+	}
+
+	if len(searchMultiVector) > 0 {
+		ids, dists, err = s.multiVectorSearch(searchMultiVector, targetVector,
+			targetDist, limit, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "multi-vector search")
+		}
+		return ids, dists, nil
+	}
+
+	queue, err := s.getIndexQueue(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if limit < 0 {
+		ids, dists, err = queue.SearchByVectorDistance(
+			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "vector search by distance")
+		}
+		return ids, dists, nil
+	}
+
+	if vp != nil {
+		ids, dists, err = queue.SearchByVectorWithProfile(searchVector, limit, allowList, vp)
+	} else {
+		ids, dists, err = queue.SearchByVector(searchVector, limit, allowList)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "vector search")
+	}
+	return ids, dists, nil
+}
+
+func (s *Shard) vectorIndexType(targetVector string) string {
+	cfg := s.index.vectorIndexUserConfig
+	if s.hasTargetVectors() {
+		cfg = s.index.vectorIndexUserConfigs[targetVector]
+	}
+	if cfg == nil {
+		return ""
+	}
+	return cfg.IndexType()
+}
+
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
 	if len(sort) > 0 {
+		beforeSort := time.Now()
 		docIDs, err := s.sortedObjectList(ctx, limit, sort, className)
 		if err != nil {
 			return nil, err
 		}
+		profile.ShardFromContext(ctx).ObserveSort(time.Since(beforeSort))
 		bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 		return storobj.ObjectsByDocID(bucket, docIDs, additional)
 	}
@@ -320,12 +465,14 @@ func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	if cursor == nil {
 		cursor = &filters.Cursor{After: "", Limit: limit}
 	}
-	return s.cursorObjectList(ctx, cursor, additional, className)
+	return s.cursorObjectList(ctx, cursor, nil)
 }
 
+// cursorObjectList returns up to c.Limit objects following c.After in the
+// order of their UUIDs. If allow is set, only the objects it contains are
+// returned.
 func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
-	additional additional.Properties,
-	className schema.ClassName,
+	allow helpers.AllowList,
 ) ([]*storobj.Object, error) {
 	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
 	defer cursor.Close()
@@ -349,6 +496,16 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	out := make([]*storobj.Object, c.Limit)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
+		if allow != nil {
+			docID, err := storobj.DocIDFromBinary(val)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unmarshal doc id of item %d", i)
+			}
+			if !allow.Contains(docID) {
+				continue
+			}
+		}
+
 		obj, err := storobj.FromBinary(val)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
@@ -420,6 +577,8 @@ func (s *Shard) uuidFromDocID(docID uint64) (strfmt.UUID, error) {
 }
 
 func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
+	defer s.bumpQueryVersion()
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -466,6 +625,8 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		}
 	}
 
+	recordChange(s, changelog.OperationDelete, id)
+
 	return nil
 }
 
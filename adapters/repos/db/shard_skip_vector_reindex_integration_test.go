@@ -507,7 +507,7 @@ func TestShard_SkipVectorReindex(t *testing.T) {
 
 		return func(t *testing.T) {
 			t.Run("to be found", func(t *testing.T) {
-				found, _, err := shard.ObjectVectorSearch(ctx, vectorToBeFound, targetVector,
+				found, _, err := shard.ObjectVectorSearch(ctx, vectorToBeFound, nil, targetVector,
 					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{})
 				require.NoError(t, err)
 				require.Len(t, found, 1)
@@ -515,7 +515,7 @@ func TestShard_SkipVectorReindex(t *testing.T) {
 			})
 
 			t.Run("not to be found", func(t *testing.T) {
-				found, _, err := shard.ObjectVectorSearch(ctx, vectorNotToBeFound, targetVector,
+				found, _, err := shard.ObjectVectorSearch(ctx, vectorNotToBeFound, nil, targetVector,
 					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{})
 				require.NoError(t, err)
 				require.Len(t, found, 0)
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// Sparse vectors are not held by a vector index, but in an inverted index
+// (one bucket per target vector) which is kept up to date together with the
+// inverted indexes of the properties.
+
+func (s *Shard) isSparseVector(targetVector string) bool {
+	_, ok := s.index.vectorIndexUserConfigs[targetVector].(sparse.UserConfig)
+	return ok
+}
+
+func (s *Shard) initSparseVectorIndex(ctx context.Context, targetVector string) error {
+	return s.store.CreateOrLoadBucket(ctx,
+		helpers.BucketSparseVectorFromTargetVectorLSM(targetVector),
+		s.memtableDirtyConfig(),
+		s.dynamicMemtableSizing(),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.compactionConfig(),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+	)
+}
+
+// validateSparseVectors makes sure that sparse vectors are only provided for,
+// and regular vectors are not provided for, sparse target vectors
+func (s *Shard) validateSparseVectors(object *storobj.Object) error {
+	for targetVector := range object.Vectors {
+		if s.isSparseVector(targetVector) {
+			return fmt.Errorf("target vector %q expects a sparse vector", targetVector)
+		}
+	}
+
+	for targetVector, vector := range object.SparseVectors {
+		if !s.isSparseVector(targetVector) {
+			return fmt.Errorf("target vector %q is not configured as sparse", targetVector)
+		}
+		if err := inverted.ValidateSparseVector(vector.Indices, vector.Values); err != nil {
+			return errors.Wrapf(err, "validate sparse vector %s", targetVector)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) updateSparseVectorIndexes(prevObject, object *storobj.Object,
+	status objectInsertStatus,
+) error {
+	var prevVectors map[string]models.SparseVector
+	if prevObject != nil {
+		prevVectors = prevObject.SparseVectors
+	}
+
+	// only the changed dimensions need to be updated if the docID was kept,
+	// the entries of the previous docID are deleted entirely otherwise
+	if status.docIDPreserved {
+		for targetVector, prev := range prevVectors {
+			next := object.SparseVectors[targetVector]
+			if err := s.deleteSparseVector(targetVector, sparseVectorDelta(prev, next),
+				status.oldDocID); err != nil {
+				return err
+			}
+		}
+	} else if prevObject != nil {
+		for targetVector, prev := range prevVectors {
+			if err := s.deleteSparseVector(targetVector, prev.Indices, status.oldDocID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for targetVector, next := range object.SparseVectors {
+		if err := s.putSparseVector(targetVector, next, status.docID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) deleteSparseVectors(sparseVectors map[string]models.SparseVector, docID uint64) error {
+	for targetVector, vector := range sparseVectors {
+		if err := s.deleteSparseVector(targetVector, vector.Indices, docID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) putSparseVector(targetVector string, vector models.SparseVector, docID uint64) error {
+	bucket, err := s.sparseVectorBucket(targetVector)
+	if err != nil {
+		return err
+	}
+
+	for i, index := range vector.Indices {
+		if err := bucket.MapSet(inverted.SparseVectorKey(index),
+			inverted.SparseVectorPair(docID, vector.Values[i])); err != nil {
+			return errors.Wrapf(err, "put sparse vector %s: index %d", targetVector, index)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) deleteSparseVector(targetVector string, indices []uint32, docID uint64) error {
+	bucket, err := s.sparseVectorBucket(targetVector)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indices {
+		pair := inverted.SparseVectorPair(docID, 0)
+		if err := bucket.MapDeleteKey(inverted.SparseVectorKey(index), pair.Key); err != nil {
+			return errors.Wrapf(err, "delete sparse vector %s: index %d", targetVector, index)
+		}
+	}
+	return nil
+}
+
+func (s *Shard) sparseVectorBucket(targetVector string) (*lsmkv.Bucket, error) {
+	bucket := s.store.Bucket(helpers.BucketSparseVectorFromTargetVectorLSM(targetVector))
+	if bucket == nil {
+		return nil, fmt.Errorf("no sparse vector index for target vector %q", targetVector)
+	}
+	return bucket, nil
+}
+
+// sparseVectorDelta returns the indices of prev which are not part of next,
+// all indices of next are (re-)written as their weights could have changed
+func sparseVectorDelta(prev, next models.SparseVector) []uint32 {
+	nextIndices := make(map[uint32]struct{}, len(next.Indices))
+	for _, index := range next.Indices {
+		nextIndices[index] = struct{}{}
+	}
+
+	var deleted []uint32
+	for _, index := range prev.Indices {
+		if _, ok := nextIndices[index]; !ok {
+			deleted = append(deleted, index)
+		}
+	}
+	return deleted
+}
+
+func (s *Shard) sparseVectorSearch(ctx context.Context, filterDocIds helpers.AllowList,
+	query searchparams.NearSparseVector, limit int,
+) ([]*storobj.Object, []float32, error) {
+	if !s.isSparseVector(query.TargetVector) {
+		return nil, nil, fmt.Errorf("target vector %q is not configured as sparse", query.TargetVector)
+	}
+
+	logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
+	return inverted.NewSparseSearcher(s.store, logger).Search(ctx, filterDocIds, query, limit)
+}
+
+func sparseVectorsEqual(prevVectors, nextVectors map[string]models.SparseVector) bool {
+	if len(prevVectors) != len(nextVectors) {
+		return false
+	}
+	for targetVector, prev := range prevVectors {
+		next, ok := nextVectors[targetVector]
+		if !ok || len(prev.Indices) != len(next.Indices) {
+			return false
+		}
+		for i := range prev.Indices {
+			if prev.Indices[i] != next.Indices[i] || prev.Values[i] != next.Values[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
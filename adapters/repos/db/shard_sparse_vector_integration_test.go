@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+func TestShard_SparseVector(t *testing.T) {
+	ctx := context.Background()
+	className := "SparseVectorClass"
+	targetVector := "splade"
+
+	class := &models.Class{
+		Class: className,
+		VectorConfig: map[string]models.VectorConfig{
+			targetVector: {VectorIndexType: "sparse"},
+		},
+	}
+
+	shard, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{}, false, true,
+		func(idx *Index) {
+			idx.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				targetVector: sparse.NewDefaultUserConfig(),
+			}
+		})
+
+	newObject := func(id strfmt.UUID, indices []uint32, values []float32) *storobj.Object {
+		return &storobj.Object{
+			MarshallerVersion: 1,
+			Object: models.Object{
+				ID:    id,
+				Class: className,
+			},
+			SparseVectors: map[string]models.SparseVector{
+				targetVector: {Indices: indices, Values: values},
+			},
+		}
+	}
+	search := func(t *testing.T, indices []uint32, values []float32) ([]*storobj.Object, []float32) {
+		res, scores, err := shard.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type: searchparams.KeywordRankingTypeSparse,
+			SparseVector: &searchparams.NearSparseVector{
+				Indices:      indices,
+				Values:       values,
+				TargetVector: targetVector,
+			},
+		}, nil, nil, additional.Properties{})
+		require.NoError(t, err)
+		return res, scores
+	}
+
+	idA := strfmt.UUID(uuid.NewString())
+	idB := strfmt.UUID(uuid.NewString())
+	idC := strfmt.UUID(uuid.NewString())
+
+	t.Run("put single object", func(t *testing.T) {
+		err := shard.PutObject(ctx, newObject(idA, []uint32{1, 5}, []float32{1, 2}))
+		require.NoError(t, err)
+	})
+
+	t.Run("put batch", func(t *testing.T) {
+		errs := shard.PutObjectBatch(ctx, []*storobj.Object{
+			newObject(idB, []uint32{5, 9}, []float32{0.5, 3}),
+			newObject(idC, []uint32{1}, []float32{4}),
+		})
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("search scores by dot product", func(t *testing.T) {
+		res, scores := search(t, []uint32{1, 5}, []float32{1, 1})
+		require.Len(t, res, 3)
+		assert.Equal(t, []strfmt.UUID{idC, idA, idB}, []strfmt.UUID{res[0].ID(), res[1].ID(), res[2].ID()})
+		assert.InDeltaSlice(t, []float32{4, 3, 0.5}, scores, 1e-5)
+	})
+
+	t.Run("object holds its sparse vector", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, idA, nil, additional.Properties{Vector: true})
+		require.NoError(t, err)
+		require.NotNil(t, obj)
+		assert.Equal(t, models.SparseVector{Indices: []uint32{1, 5}, Values: []float32{1, 2}},
+			obj.SparseVectors[targetVector])
+	})
+
+	t.Run("update replaces the sparse vector", func(t *testing.T) {
+		err := shard.PutObject(ctx, newObject(idA, []uint32{9}, []float32{1}))
+		require.NoError(t, err)
+
+		res, scores := search(t, []uint32{1, 5}, []float32{1, 1})
+		require.Len(t, res, 2)
+		assert.Equal(t, []strfmt.UUID{idC, idB}, []strfmt.UUID{res[0].ID(), res[1].ID()})
+		assert.InDeltaSlice(t, []float32{4, 0.5}, scores, 1e-5)
+
+		res, _ = search(t, []uint32{9}, []float32{1})
+		require.Len(t, res, 2)
+		assert.Equal(t, []strfmt.UUID{idB, idA}, []strfmt.UUID{res[0].ID(), res[1].ID()})
+	})
+
+	t.Run("delete removes the object from results", func(t *testing.T) {
+		require.NoError(t, shard.DeleteObject(ctx, idC))
+
+		res, _ := search(t, []uint32{1}, []float32{1})
+		require.Len(t, res, 0)
+	})
+
+	t.Run("negative weight is rejected", func(t *testing.T) {
+		obj := newObject(strfmt.UUID(uuid.NewString()), []uint32{1}, []float32{-1})
+		require.Error(t, shard.PutObject(ctx, obj))
+	})
+
+	t.Run("regular vector for sparse target is rejected", func(t *testing.T) {
+		obj := newObject(strfmt.UUID(uuid.NewString()), nil, nil)
+		obj.SparseVectors = nil
+		obj.Vectors = map[string][]float32{targetVector: {1, 0, 0}}
+		require.Error(t, shard.PutObject(ctx, obj))
+	})
+}
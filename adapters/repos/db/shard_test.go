@@ -27,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -167,6 +168,30 @@ func TestShard_ReadOnly_HaltCompaction(t *testing.T) {
 
 // tests adding multiple larger batches in parallel using different settings of the goroutine factor.
 // In all cases all objects should be added
+func TestShard_SegmentCompression(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShard(t, ctx, className, func(idx *Index) {
+		idx.Config.LSMSegmentCompression = lsmkv.CompressionZstd
+	})
+	defer func() {
+		require.Nil(t, idx.drop())
+	}()
+
+	bucket := shd.Store().Bucket(helpers.ObjectsBucketLSM)
+	require.Equal(t, lsmkv.CompressionZstd, bucket.GetCompression())
+
+	obj := testObject(className)
+	require.Nil(t, shd.PutObject(ctx, obj))
+	require.Nil(t, bucket.FlushAndSwitch())
+
+	// the object is read from the compressed segment
+	res, err := shd.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+	require.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, obj.ID(), res.ID())
+}
+
 func TestShard_ParallelBatches(t *testing.T) {
 	r := getRandomSeed()
 	batches := make([][]*storobj.Object, 4)
@@ -52,6 +52,8 @@ func asyncEnabled() bool {
 func (s *Shard) putBatch(ctx context.Context,
 	objects []*storobj.Object,
 ) []error {
+	defer s.bumpQueryVersion()
+
 	if asyncEnabled() {
 		return s.putBatchAsync(ctx, objects)
 	}
@@ -64,6 +66,7 @@ func (s *Shard) putBatch(ctx context.Context,
 	// block until all objects of batch have been added
 	batcher.wg.Wait()
 	s.metrics.VectorIndex(batcher.batchStartTime)
+	batcher.recordChanges()
 
 	return err
 }
@@ -79,6 +82,7 @@ func (s *Shard) putBatchAsync(ctx context.Context, objects []*storobj.Object) []
 	batcher.markDeletedInVectorStorage(ctx)
 	batcher.storeAdditionalStorageWithAsyncQueue(ctx)
 	batcher.flushWALs(ctx)
+	batcher.recordChanges()
 
 	return batcher.errs
 }
@@ -194,6 +198,12 @@ func (ob *objectsBatcher) storeObjectOfBatchInLSM(ctx context.Context,
 	if _, ok := ob.duplicates[objectIndex]; ok {
 		return nil
 	}
+	if err := ob.shard.validateMultiVectors(object); err != nil {
+		return errors.Wrap(err, "validate multi-vectors")
+	}
+	if err := ob.shard.validateSparseVectors(object); err != nil {
+		return errors.Wrap(err, "validate sparse vectors")
+	}
 	uuidParsed, err := uuid.Parse(object.ID().String())
 	if err != nil {
 		return errors.Wrap(err, "invalid id")
@@ -325,6 +335,13 @@ func (ob *objectsBatcher) storeAdditionalStorageWithAsyncQueue(ctx context.Conte
 			continue
 		}
 
+		if len(object.MultiVectors) > 0 {
+			if err := ob.shard.updateMultiVectorIndexesIgnoreDelete(ctx, object.MultiVectors, status); err != nil {
+				ob.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), i)
+				continue
+			}
+		}
+
 		if len(object.Vector) == 0 && len(object.Vectors) == 0 {
 			continue
 		}
@@ -442,6 +459,13 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 		}
 	}
 
+	if len(object.MultiVectors) > 0 {
+		if err := ob.shard.updateMultiVectorIndexesIgnoreDelete(ctx, object.MultiVectors, status); err != nil {
+			ob.setErrorAtIndex(errors.Wrap(err, "insert to vector index"), index)
+			return
+		}
+	}
+
 	if err := ob.shard.updatePropertySpecificIndices(object, status); err != nil {
 		ob.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
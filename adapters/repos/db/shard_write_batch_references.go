@@ -31,6 +31,7 @@ func (s *Shard) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 	if s.isReadOnly() {
 		return []error{errors.Errorf("shard is read-only")}
 	}
+	defer s.bumpQueryVersion()
 
 	return newReferencesBatcher(s).References(ctx, refs)
 }
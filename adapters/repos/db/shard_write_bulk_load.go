@@ -0,0 +1,334 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// bulkBuilder is implemented by vector indexes which can build their graph
+// from all vectors at once, see hnsw.BulkBuild
+type bulkBuilder interface {
+	BulkBuild(ctx context.Context, ids []uint64, vectors [][]float32) error
+}
+
+// shardBulkWriter writes the objects of a bulk load into the staging shard.
+// It mirrors the regular put path of a new object, but writes all LSM
+// buckets through an lsmkv.BulkWriter, which sorts the keys of each bucket
+// and writes them as segments directly. The vectors are collected and added
+// to each vector index in a single pass once all objects were written.
+// Property-specific (geo) and multi-vector indexes are updated per object
+// like in a regular batch.
+type shardBulkWriter struct {
+	shard   *Shard
+	buckets map[string]*lsmkv.BulkWriter
+	// vectors by target vector, the legacy vector uses the empty name
+	vectors map[string][]vectorDescriptor
+}
+
+func newShardBulkWriter(s *Shard) *shardBulkWriter {
+	return &shardBulkWriter{
+		shard:   s,
+		buckets: map[string]*lsmkv.BulkWriter{},
+		vectors: map[string][]vectorDescriptor{},
+	}
+}
+
+func (w *shardBulkWriter) bucket(name string) (*lsmkv.BulkWriter, error) {
+	if bw, ok := w.buckets[name]; ok {
+		return bw, nil
+	}
+
+	b := w.shard.store.Bucket(name)
+	if b == nil {
+		return nil, errors.Errorf("no bucket %q found", name)
+	}
+	bw := b.NewBulkWriter()
+	w.buckets[name] = bw
+	return bw, nil
+}
+
+func (w *shardBulkWriter) put(ctx context.Context, object *storobj.Object) error {
+	s := w.shard
+
+	if err := s.validateMultiVectors(object); err != nil {
+		return errors.Wrap(err, "validate multi-vectors")
+	}
+	if err := s.validateSparseVectors(object); err != nil {
+		return errors.Wrap(err, "validate sparse vectors")
+	}
+	uuidParsed, err := uuid.Parse(object.ID().String())
+	if err != nil {
+		return errors.Wrap(err, "invalid id")
+	}
+	idBytes, err := uuidParsed.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	objects, err := w.bucket(helpers.ObjectsBucketLSM)
+	if err != nil {
+		return err
+	}
+	if exists, err := objects.Has(idBytes); err != nil {
+		return err
+	} else if exists {
+		return fmt.Errorf("duplicate object id %s", object.ID())
+	}
+
+	docID, err := s.counter.GetAndInc()
+	if err != nil {
+		return errors.Wrap(err, "get new doc id from counter")
+	}
+	object.DocID = docID
+
+	objBinary, err := object.MarshalBinary()
+	if err != nil {
+		return errors.Wrapf(err, "marshal object %s to binary", object.ID())
+	}
+	docIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docIDBytes, docID)
+	if err := objects.Put(idBytes, objBinary, lsmkv.WithSecondaryKey(0, docIDBytes)); err != nil {
+		return errors.Wrap(err, "put object data")
+	}
+
+	if err := w.putInvertedIndices(object, docID); err != nil {
+		return err
+	}
+
+	status := objectInsertStatus{docID: docID}
+	if s.hasGeoIndex() {
+		if err := s.updatePropertySpecificIndices(object, status); err != nil {
+			return errors.Wrap(err, "update prop-specific indices")
+		}
+	}
+	if len(object.MultiVectors) > 0 {
+		if err := s.updateMultiVectorIndexesIgnoreDelete(ctx, object.MultiVectors, status); err != nil {
+			return errors.Wrap(err, "insert to vector index")
+		}
+	}
+
+	if s.hasTargetVectors() {
+		for targetVector, vector := range object.Vectors {
+			w.vectors[targetVector] = append(w.vectors[targetVector],
+				vectorDescriptor{id: docID, vector: vector})
+		}
+	} else if len(object.Vector) > 0 {
+		w.vectors[""] = append(w.vectors[""], vectorDescriptor{id: docID, vector: object.Vector})
+	}
+
+	return nil
+}
+
+func (w *shardBulkWriter) putInvertedIndices(object *storobj.Object, docID uint64) error {
+	s := w.shard
+
+	props, nilProps, err := s.AnalyzeObject(object)
+	if err != nil {
+		return errors.Wrap(err, "analyze object")
+	}
+	if err := s.SetPropertyLengths(props); err != nil {
+		return errors.Wrap(err, "store field length values for props")
+	}
+
+	cfg := s.index.invertedIndexConfig
+	for _, prop := range inverted.DedupItems(props) {
+		if err := w.putPropertyValue(docID, prop); err != nil {
+			return err
+		}
+
+		if isMetaCountProperty(prop) || isInternalProperty(prop) {
+			continue
+		}
+		if cfg.IndexPropertyLength && prop.Length >= 0 {
+			if err := w.putPropertyLength(prop.Name, docID, prop.Length); err != nil {
+				return errors.Wrap(err, "add indexed property length")
+			}
+		}
+		if cfg.IndexNullState {
+			if err := w.putPropertyNull(prop.Name, docID, prop.Length == 0); err != nil {
+				return errors.Wrap(err, "add indexed null state")
+			}
+		}
+	}
+
+	for _, nilProp := range nilProps {
+		if cfg.IndexPropertyLength && nilProp.AddToPropertyLength {
+			if err := w.putPropertyLength(nilProp.Name, docID, 0); err != nil {
+				return errors.Wrap(err, "add indexed property length")
+			}
+		}
+		if cfg.IndexNullState {
+			if err := w.putPropertyNull(nilProp.Name, docID, true); err != nil {
+				return errors.Wrap(err, "add indexed null state")
+			}
+		}
+	}
+
+	for targetVector, vector := range object.SparseVectors {
+		bw, err := w.bucket(helpers.BucketSparseVectorFromTargetVectorLSM(targetVector))
+		if err != nil {
+			return err
+		}
+		for i, index := range vector.Indices {
+			if err := bw.MapSet(inverted.SparseVectorKey(index),
+				inverted.SparseVectorPair(docID, vector.Values[i])); err != nil {
+				return errors.Wrapf(err, "put sparse vector %s: index %d", targetVector, index)
+			}
+		}
+	}
+
+	if s.index.Config.TrackVectorDimensions {
+		if s.hasTargetVectors() {
+			for vecName, vec := range object.Vectors {
+				if err := w.putDimensions(len(vec), docID, vecName); err != nil {
+					return fmt.Errorf("track dimensions of '%s': %w", vecName, err)
+				}
+			}
+		} else if err := w.putDimensions(len(object.Vector), docID, ""); err != nil {
+			return fmt.Errorf("track dimensions: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *shardBulkWriter) putPropertyValue(docID uint64, prop inverted.Property) error {
+	if prop.HasFilterableIndex {
+		bw, err := w.bucket(helpers.BucketFromPropNameLSM(prop.Name))
+		if err != nil {
+			return err
+		}
+		for _, item := range prop.Items {
+			if err := putDocIDInSetBucket(bw, docID, item.Data); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+			}
+		}
+	}
+
+	if prop.HasSearchableIndex {
+		bw, err := w.bucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+		if err != nil {
+			return err
+		}
+		propLen := float32(len(prop.Items))
+		for _, item := range prop.Items {
+			pair := w.shard.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+			if err := bw.MapSet(item.Data, pair); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", prop.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *shardBulkWriter) putPropertyLength(propName string, docID uint64, length int) error {
+	bw, err := w.bucket(helpers.BucketFromPropNameLengthLSM(propName))
+	if err != nil {
+		return err
+	}
+	key, err := bucketKeyPropertyLength(length)
+	if err != nil {
+		return errors.Wrapf(err, "failed creating key for prop '%s' length", propName)
+	}
+	return putDocIDInSetBucket(bw, docID, key)
+}
+
+func (w *shardBulkWriter) putPropertyNull(propName string, docID uint64, isNull bool) error {
+	bw, err := w.bucket(helpers.BucketFromPropNameNullLSM(propName))
+	if err != nil {
+		return err
+	}
+	key, err := bucketKeyPropertyNull(isNull)
+	if err != nil {
+		return errors.Wrapf(err, "failed creating key for prop '%s' null", propName)
+	}
+	return putDocIDInSetBucket(bw, docID, key)
+}
+
+// putDimensions uses the same keys as addToDimensionBucket
+func (w *shardBulkWriter) putDimensions(dimLength int, docID uint64, vecName string) error {
+	bw, err := w.bucket(helpers.DimensionsBucketLSM)
+	if err != nil {
+		return err
+	}
+
+	tv := []byte(vecName)
+	buf := make([]byte, 12+len(tv))
+	binary.LittleEndian.PutUint64(buf[:8], docID)
+	binary.LittleEndian.PutUint32(buf[8+len(tv):], uint32(dimLength))
+	copy(buf[8:], tv)
+
+	return bw.MapSet(buf[8:], lsmkv.MapPair{Key: buf[:8], Value: []byte{}})
+}
+
+// putDocIDInSetBucket is the bulk equivalent of addToPropertySetBucket
+func putDocIDInSetBucket(bw *lsmkv.BulkWriter, docID uint64, key []byte) error {
+	lsmkv.CheckExpectedStrategy(bw.Strategy(), lsmkv.StrategySetCollection, lsmkv.StrategyRoaringSet)
+
+	if bw.Strategy() == lsmkv.StrategyRoaringSet {
+		return bw.RoaringSetAddOne(key, docID)
+	}
+
+	docIDBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(docIDBytes, docID)
+	return bw.SetAdd(key, [][]byte{docIDBytes})
+}
+
+// flush writes the remaining runs of all buckets as segments and adds all
+// collected vectors to the vector indexes
+func (w *shardBulkWriter) flush(ctx context.Context) error {
+	for name, bw := range w.buckets {
+		if err := bw.Flush(); err != nil {
+			return fmt.Errorf("flush bucket %q: %w", name, err)
+		}
+	}
+
+	for targetVector, vectors := range w.vectors {
+		vectorIndex := w.shard.VectorIndex()
+		if targetVector != "" {
+			vectorIndex = w.shard.VectorIndexForName(targetVector)
+		}
+		if vectorIndex == nil {
+			return fmt.Errorf("vector index not found for target vector %s", targetVector)
+		}
+
+		ids := make([]uint64, len(vectors))
+		vecs := make([][]float32, len(vectors))
+		for i, v := range vectors {
+			ids[i], vecs[i] = v.id, v.vector
+		}
+
+		var err error
+		if builder, ok := vectorIndex.(bulkBuilder); ok {
+			err = builder.BulkBuild(ctx, ids, vecs)
+		} else {
+			err = vectorIndex.AddBatch(ctx, ids, vecs)
+		}
+		if err != nil {
+			return fmt.Errorf("insert to vector index %q: %w", targetVector, err)
+		}
+		delete(w.vectors, targetVector)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -27,6 +28,7 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
 	}
+	defer s.bumpQueryVersion()
 
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
@@ -86,6 +88,8 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 		}
 	}
 
+	recordChange(s, changelog.OperationDelete, id)
+
 	return nil
 }
 
@@ -114,6 +118,8 @@ func (s *Shard) canDeleteOne(ctx context.Context, id strfmt.UUID) (bucket *lsmkv
 }
 
 func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idBytes []byte, docID uint64) error {
+	defer s.bumpQueryVersion()
+
 	if obj == nil || bucket == nil {
 		return nil
 	}
@@ -151,6 +157,8 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		}
 	}
 
+	recordDeleteFromBytes(s, idBytes)
+
 	return nil
 }
 
@@ -174,6 +182,10 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		return fmt.Errorf("put inverted indices props: %w", err)
 	}
 
+	if err = s.deleteSparseVectors(previousObject.SparseVectors, docID); err != nil {
+		return fmt.Errorf("delete sparse vector indices: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		if s.hasTargetVectors() {
 			for vecName, vec := range previousObject.Vectors {
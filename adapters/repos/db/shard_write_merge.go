@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -60,6 +61,8 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 }
 
 func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocument) error {
+	defer s.bumpQueryVersion()
+
 	obj, status, err := s.mergeObjectInStorage(doc, idBytes)
 	if err != nil {
 		return err
@@ -77,6 +80,9 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
 			}
 		}
+		if err := s.updateMultiVectorIndexes(ctx, obj.MultiVectors, status); err != nil {
+			return err
+		}
 	} else {
 		if err := s.updateVectorIndex(obj.Vector, status); err != nil {
 			return errors.Wrap(err, "update vector index")
@@ -91,6 +97,8 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
 
+	recordChange(s, changelog.OperationMerge, obj.ID())
+
 	return nil
 }
 
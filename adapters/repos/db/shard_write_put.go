@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -42,6 +43,8 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
+	defer s.bumpQueryVersion()
+
 	if s.hasTargetVectors() {
 		if len(object.Vectors) > 0 {
 			for targetVector, vector := range object.Vectors {
@@ -61,6 +64,12 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 			}
 		}
 	}
+	if err := s.validateMultiVectors(object); err != nil {
+		return errors.Wrapf(err, "Validate multi-vectors for %s", object.ID())
+	}
+	if err := s.validateSparseVectors(object); err != nil {
+		return errors.Wrapf(err, "Validate sparse vectors for %s", object.ID())
+	}
 
 	status, err := s.putObjectLSM(object, uuid)
 	if err != nil {
@@ -79,6 +88,9 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
 			}
 		}
+		if err := s.updateMultiVectorIndexes(ctx, object.MultiVectors, status); err != nil {
+			return err
+		}
 	} else {
 		if err := s.updateVectorIndex(object.Vector, status); err != nil {
 			return errors.Wrap(err, "update vector index")
@@ -97,6 +109,8 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 		return errors.Wrap(err, "flush prop length tracker to disk")
 	}
 
+	recordChange(s, changelog.OperationPut, object.ID())
+
 	return nil
 }
 
@@ -460,6 +474,10 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 	}
 	s.metrics.InvertedExtend(before, len(propsToAdd))
 
+	if err := s.updateSparseVectorIndexes(prevObject, object, status); err != nil {
+		return fmt.Errorf("put sparse vector indices: %w", err)
+	}
+
 	if s.index.Config.TrackVectorDimensions {
 		if s.hasTargetVectors() {
 			for vecName, vec := range object.Vectors {
@@ -495,6 +513,12 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetVectorsEqual(prevObj.Vectors, nextObj.Vectors) {
 		return false, false
 	}
+	if !multiVectorsEqual(prevObj.MultiVectors, nextObj.MultiVectors) {
+		return false, false
+	}
+	if !sparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return true, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
@@ -580,6 +604,26 @@ func targetVectorsEqual(prevTargetVectors, nextTargetVectors map[string][]float3
 	return true
 }
 
+func multiVectorsEqual(prevMultiVectors, nextMultiVectors map[string][][]float32) bool {
+	if len(prevMultiVectors) != len(nextMultiVectors) {
+		return false
+	}
+
+	for vecName, prevVecs := range prevMultiVectors {
+		nextVecs, ok := nextMultiVectors[vecName]
+		if !ok || len(prevVecs) != len(nextVecs) {
+			return false
+		}
+		for i := range prevVecs {
+			if !common.VectorsEqual(prevVecs[i], nextVecs[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func addPropsEqual(prevAddProps, nextAddProps models.AdditionalProperties) bool {
 	return reflect.DeepEqual(prevAddProps, nextAddProps)
 }
@@ -11,7 +11,11 @@
 
 package sorter
 
-import "github.com/weaviate/weaviate/entities/storobj"
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
 
 type comparable struct {
 	docID uint64
@@ -23,12 +27,15 @@ type comparable struct {
 }
 
 type comparableCreator struct {
-	extractor *comparableValueExtractor
-	propNames []string
+	extractor         *comparableValueExtractor
+	propNames         []string
+	geoDistancePoints []*models.GeoCoordinates
 }
 
-func newComparableCreator(extractor *comparableValueExtractor, propNames []string) *comparableCreator {
-	return &comparableCreator{extractor, propNames}
+func newComparableCreator(extractor *comparableValueExtractor, propNames []string,
+	geoDistancePoints []*models.GeoCoordinates,
+) *comparableCreator {
+	return &comparableCreator{extractor, propNames, geoDistancePoints}
 }
 
 func (c *comparableCreator) createFromBytes(docID uint64, objData []byte) *comparable {
@@ -38,7 +45,7 @@ func (c *comparableCreator) createFromBytes(docID uint64, objData []byte) *compa
 func (c *comparableCreator) createFromBytesWithPayload(docID uint64, objData []byte, payload interface{}) *comparable {
 	values := make([]interface{}, len(c.propNames))
 	for level, propName := range c.propNames {
-		values[level] = c.extractor.extractFromBytes(objData, propName)
+		values[level] = c.geoDistance(level, c.extractor.extractFromBytes(objData, propName))
 	}
 	return &comparable{docID, values, payload}
 }
@@ -50,11 +57,36 @@ func (c *comparableCreator) createFromBytesWithPayload(docID uint64, objData []b
 func (c *comparableCreator) createFromObjectWithPayload(object *storobj.Object, payload interface{}) *comparable {
 	values := make([]interface{}, len(c.propNames))
 	for level, propName := range c.propNames {
-		values[level] = c.extractor.extractFromObject(object, propName)
+		values[level] = c.geoDistance(level, c.extractor.extractFromObject(object, propName))
 	}
 	return &comparable{object.DocID, values, payload}
 }
 
+// geoDistance replaces extracted geo coordinates with their distance to the
+// point of the sort level, if the level is sorted by geo distance
+func (c *comparableCreator) geoDistance(level int, value interface{}) interface{} {
+	point := c.geoDistancePoints[level]
+	if point == nil {
+		return value
+	}
+
+	// geo coordinates are extracted as longitude, latitude
+	coordinates, ok := value.(*[]float64)
+	if !ok || coordinates == nil || point.Latitude == nil || point.Longitude == nil {
+		return (*float64)(nil)
+	}
+
+	dist, _, err := distancer.NewGeoProvider().SingleDist(
+		[]float32{*point.Latitude, *point.Longitude},
+		[]float32{float32((*coordinates)[1]), float32((*coordinates)[0])})
+	if err != nil {
+		return (*float64)(nil)
+	}
+
+	d := float64(dist)
+	return &d
+}
+
 func (c *comparableCreator) extractDocIDs(comparables []*comparable) []uint64 {
 	docIDs := make([]uint64, len(comparables))
 	for i, comparable := range comparables {
@@ -84,9 +84,10 @@ func (s *lsmSorter) createHelper(sort []filters.Sort, limit int) (*lsmSorterHelp
 	if err != nil {
 		return nil, err
 	}
+	geoDistancePoints := extractGeoDistancePoints(sort)
 
-	comparator := newComparator(s.dataTypesHelper, propNames, orders)
-	creator := newComparableCreator(s.valueExtractor, propNames)
+	comparator := newComparator(s.dataTypesHelper, propNames, orders, geoDistancePoints)
+	creator := newComparableCreator(s.valueExtractor, propNames, geoDistancePoints)
 	return newLsmSorterHelper(s.bucket, comparator, creator, limit), nil
 }
 
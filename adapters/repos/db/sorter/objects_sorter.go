@@ -43,12 +43,13 @@ func (s objectsSorter) Sort(objects []*storobj.Object,
 	if err != nil {
 		return nil, nil, err
 	}
+	geoDistancePoints := extractGeoDistancePoints(sort)
 
 	class := s.readOnlyClass(objects[0].Class().String())
 	dataTypesHelper := newDataTypesHelper(class)
 	valueExtractor := newComparableValueExtractor(dataTypesHelper)
-	comparator := newComparator(dataTypesHelper, propNames, orders)
-	creator := newComparableCreator(valueExtractor, propNames)
+	comparator := newComparator(dataTypesHelper, propNames, orders, geoDistancePoints)
+	creator := newComparableCreator(valueExtractor, propNames, geoDistancePoints)
 
 	return newObjectsSorterHelper(comparator, creator, limit).
 		sort(objects, scores)
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -224,6 +225,20 @@ func TestObjectsSorter(t *testing.T) {
 			wantObjs:  []*storobj.Object{cityWroclaw, cityBerlin, cityAmsterdam, cityNewYork, cityNil2, cityNil},
 			wantDists: []float32{0.1, 0.2, 0.4, 0.3, 0.0, 0.0},
 		},
+		{
+			name:      "sort by location distance from paris asc",
+			sort:      sortGeoDistance("location", "asc", 48.8566, 2.3522),
+			limit:     4,
+			wantObjs:  []*storobj.Object{cityNil2, cityNil, cityAmsterdam, cityBerlin, cityWroclaw, cityNewYork},
+			wantDists: []float32{0.0, 0.0, 0.4, 0.2, 0.1, 0.3},
+		},
+		{
+			name:      "sort by location distance from paris desc",
+			sort:      sortGeoDistance("location", "desc", 48.8566, 2.3522),
+			limit:     3,
+			wantObjs:  []*storobj.Object{cityNewYork, cityWroclaw, cityBerlin, cityAmsterdam, cityNil2, cityNil},
+			wantDists: []float32{0.3, 0.1, 0.2, 0.4, 0.0, 0.0},
+		},
 		{
 			name:      "sort by special id property asc",
 			sort:      sort1("id", "asc"),
@@ -385,6 +400,12 @@ func sort1(property, order string) []filters.Sort {
 	return []filters.Sort{createSort(property, order)}
 }
 
+func sortGeoDistance(property, order string, lat, lon float32) []filters.Sort {
+	srt := createSort(property, order)
+	srt.GeoDistanceFrom = &models.GeoCoordinates{Latitude: &lat, Longitude: &lon}
+	return []filters.Sort{srt}
+}
+
 func sort2(property1, order1, property2, order2 string) []filters.Sort {
 	return []filters.Sort{
 		createSort(property1, order1),
@@ -14,6 +14,7 @@ package sorter
 import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error) {
@@ -33,6 +34,16 @@ func extractPropNamesAndOrders(sort []filters.Sort) ([]string, []string, error)
 	return propNames, orders, nil
 }
 
+// extractGeoDistancePoints returns the point to calculate the distance from
+// per sort level, nil for levels which are not sorted by geo distance
+func extractGeoDistancePoints(sort []filters.Sort) []*models.GeoCoordinates {
+	points := make([]*models.GeoCoordinates, len(sort))
+	for i, srt := range sort {
+		points[i] = srt.GeoDistanceFrom
+	}
+	return points
+}
+
 func validateLimit(limit, elementsCount int) int {
 	if limit > elementsCount {
 		return elementsCount
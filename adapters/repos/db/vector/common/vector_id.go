@@ -29,6 +29,7 @@ type (
 	VectorForID[T float32 | byte | uint64] func(ctx context.Context, id uint64) ([]T, error)
 	TempVectorForID                        func(ctx context.Context, id uint64, container *VectorSlice) ([]float32, error)
 	MultiVectorForID                       func(ctx context.Context, ids []uint64) ([][]float32, []error)
+	MultiVectorForDocID                    func(ctx context.Context, docID uint64) ([][]float32, error)
 )
 
 type TempVectorsPool struct {
@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// boundarySamples is the number of points per edge of an area's bounds
+	// which are considered to find the radius of the candidate circle
+	boundarySamples = 16
+	// radiusMargin enlarges the candidate circle slightly, so that rounding
+	// errors can never exclude points on the boundary of an area
+	radiusMargin = 1.01
+)
+
+// area is a region on the surface of the earth which can be checked exactly
+// for containment of a point. Longitudes are unwrapped, i.e. an area crossing
+// the antimeridian has a longitude range exceeding [-180, 180]
+type area interface {
+	// bounds returns the min and max latitude and the min and max unwrapped
+	// longitude of the area
+	bounds() (minLat, maxLat, minLon, maxLon float64)
+	contains(lat, lon float64) bool
+}
+
+// WithinPolygon searches the index for all points inside the polygon. The
+// edges of the polygon are straight lines in latitude/longitude space and
+// always take the shorter way around the globe, polygons enclosing a pole
+// are not supported. It is thread-safe and can be called concurrently.
+func (i *Index) WithinPolygon(ctx context.Context,
+	polygon filters.GeoPolygon,
+) ([]uint64, error) {
+	a, err := newPolygonArea(polygon)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.withinArea(ctx, a)
+}
+
+// WithinBoundingBox searches the index for all points inside the bounding
+// box. It is thread-safe and can be called concurrently.
+func (i *Index) WithinBoundingBox(ctx context.Context,
+	box filters.GeoBoundingBox,
+) ([]uint64, error) {
+	a, err := newBoxArea(box)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid arguments")
+	}
+
+	return i.withinArea(ctx, a)
+}
+
+// withinArea uses a range search around the center of the area's bounds to
+// find candidates and then filters them by an exact containment check
+func (i *Index) withinArea(ctx context.Context, a area) ([]uint64, error) {
+	center, radius, err := candidateCircle(a)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := i.WithinRange(ctx, filters.GeoRange{
+		GeoCoordinates: center,
+		Distance:       radius,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "search candidates")
+	}
+
+	out := make([]uint64, 0, len(candidates))
+	for _, id := range candidates {
+		coordinates, err := i.config.CoordinatesForID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get coordinates for candidate %d", id)
+		}
+
+		if coordinates == nil || coordinates.Latitude == nil || coordinates.Longitude == nil {
+			continue
+		}
+
+		if a.contains(float64(*coordinates.Latitude), float64(*coordinates.Longitude)) {
+			out = append(out, id)
+		}
+	}
+
+	return out, nil
+}
+
+// candidateCircle returns a circle which encloses the bounds of the area. The
+// farthest point from the center is not necessarily a corner of the bounds,
+// so the entire boundary is sampled.
+func candidateCircle(a area) (*models.GeoCoordinates, float32, error) {
+	minLat, maxLat, minLon, maxLon := a.bounds()
+	centerLat := (minLat + maxLat) / 2
+	centerLon := normalizeLongitude((minLon + maxLon) / 2)
+	center := []float32{float32(centerLat), float32(centerLon)}
+
+	provider := distancer.NewGeoProvider()
+	var radius float32
+	for s := 0; s <= boundarySamples; s++ {
+		frac := float64(s) / boundarySamples
+		lat := minLat + frac*(maxLat-minLat)
+		lon := minLon + frac*(maxLon-minLon)
+		for _, point := range [][2]float64{
+			{minLat, lon}, {maxLat, lon}, {lat, minLon}, {lat, maxLon},
+		} {
+			dist, _, err := provider.SingleDist(center,
+				[]float32{float32(point[0]), float32(normalizeLongitude(point[1]))})
+			if err != nil {
+				return nil, 0, errors.Wrap(err, "calculate candidate radius")
+			}
+			if dist > radius {
+				radius = dist
+			}
+		}
+	}
+
+	lat, lon := float32(centerLat), float32(centerLon)
+	return &models.GeoCoordinates{Latitude: &lat, Longitude: &lon},
+		radius * radiusMargin, nil
+}
+
+type polygonArea struct {
+	// lats and lons of the vertices, lons are unwrapped relative to the first
+	// vertex
+	lats, lons []float64
+}
+
+func newPolygonArea(polygon filters.GeoPolygon) (*polygonArea, error) {
+	if len(polygon.Points) < 3 {
+		return nil, fmt.Errorf("polygon needs at least 3 points, got %d",
+			len(polygon.Points))
+	}
+
+	p := &polygonArea{
+		lats: make([]float64, len(polygon.Points)),
+		lons: make([]float64, len(polygon.Points)),
+	}
+	for j, point := range polygon.Points {
+		if point == nil {
+			return nil, fmt.Errorf("point at position %d must be set", j)
+		}
+		v, err := geoCoordiantesToVector(point)
+		if err != nil {
+			return nil, errors.Wrapf(err, "point at position %d", j)
+		}
+
+		p.lats[j] = float64(v[0])
+		p.lons[j] = float64(v[1])
+		if j > 0 {
+			// take the shorter way, so that edges can cross the antimeridian
+			p.lons[j] = p.lons[j-1] + normalizeLongitude(p.lons[j]-p.lons[j-1])
+		}
+	}
+
+	return p, nil
+}
+
+func (p *polygonArea) bounds() (float64, float64, float64, float64) {
+	minLat, maxLat := p.lats[0], p.lats[0]
+	minLon, maxLon := p.lons[0], p.lons[0]
+	for j := range p.lats {
+		minLat, maxLat = math.Min(minLat, p.lats[j]), math.Max(maxLat, p.lats[j])
+		minLon, maxLon = math.Min(minLon, p.lons[j]), math.Max(maxLon, p.lons[j])
+	}
+	return minLat, maxLat, minLon, maxLon
+}
+
+func (p *polygonArea) contains(lat, lon float64) bool {
+	// the point may match the unwrapped polygon in any of its windings
+	for _, shift := range []float64{0, -360, 360} {
+		if p.containsUnwrapped(lat, lon+shift) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsUnwrapped is a ray casting point-in-polygon check
+func (p *polygonArea) containsUnwrapped(lat, lon float64) bool {
+	inside := false
+	for j, k := 0, len(p.lats)-1; j < len(p.lats); k, j = j, j+1 {
+		if (p.lats[j] > lat) != (p.lats[k] > lat) {
+			crossing := p.lons[j] + (lat-p.lats[j])*(p.lons[k]-p.lons[j])/(p.lats[k]-p.lats[j])
+			if lon < crossing {
+				inside = !inside
+			}
+		}
+	}
+	return inside
+}
+
+type boxArea struct {
+	minLat, maxLat float64
+	// minLon and maxLon are unwrapped, maxLon exceeds 180 if the box crosses
+	// the antimeridian
+	minLon, maxLon float64
+}
+
+func newBoxArea(box filters.GeoBoundingBox) (*boxArea, error) {
+	if box.TopLeft == nil || box.BottomRight == nil {
+		return nil, fmt.Errorf("bounding box needs topLeft and bottomRight to be set")
+	}
+
+	topLeft, err := geoCoordiantesToVector(box.TopLeft)
+	if err != nil {
+		return nil, errors.Wrap(err, "topLeft")
+	}
+	bottomRight, err := geoCoordiantesToVector(box.BottomRight)
+	if err != nil {
+		return nil, errors.Wrap(err, "bottomRight")
+	}
+
+	if topLeft[0] < bottomRight[0] {
+		return nil, fmt.Errorf("topLeft latitude %v must not be south of bottomRight latitude %v",
+			topLeft[0], bottomRight[0])
+	}
+
+	b := &boxArea{
+		minLat: float64(bottomRight[0]),
+		maxLat: float64(topLeft[0]),
+		minLon: float64(topLeft[1]),
+		maxLon: float64(bottomRight[1]),
+	}
+	if b.maxLon < b.minLon {
+		b.maxLon += 360
+	}
+
+	return b, nil
+}
+
+func (b *boxArea) bounds() (float64, float64, float64, float64) {
+	return b.minLat, b.maxLat, b.minLon, b.maxLon
+}
+
+func (b *boxArea) contains(lat, lon float64) bool {
+	if lat < b.minLat || lat > b.maxLat {
+		return false
+	}
+	if lon < b.minLon {
+		lon += 360
+	}
+	return lon <= b.maxLon
+}
+
+// normalizeLongitude maps any longitude into the range [-180, 180)
+func normalizeLongitude(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
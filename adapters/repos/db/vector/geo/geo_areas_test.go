@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package geo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGeoAreas(t *testing.T) {
+	elements := []models.GeoCoordinates{
+		{Latitude: ptFloat32(48.13743), Longitude: ptFloat32(11.57549)},  // munich
+		{Latitude: ptFloat32(48.78232), Longitude: ptFloat32(9.17702)},   // stuttgart
+		{Latitude: ptFloat32(52.52001), Longitude: ptFloat32(13.40495)},  // berlin
+		{Latitude: ptFloat32(-17.7134), Longitude: ptFloat32(178.0650)},  // fiji
+		{Latitude: ptFloat32(-13.7590), Longitude: ptFloat32(-172.1046)}, // samoa
+	}
+
+	getCoordinates := func(ctx context.Context, id uint64) (*models.GeoCoordinates, error) {
+		return &elements[id], nil
+	}
+
+	geoIndex, err := NewIndex(Config{
+		ID:                 "unit-test",
+		CoordinatesForID:   getCoordinates,
+		DisablePersistence: true,
+		RootPath:           "doesnt-matter-persistence-is-off",
+	},
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	for id, coordinates := range elements {
+		err := geoIndex.Add(uint64(id), &coordinates)
+		require.Nil(t, err)
+	}
+
+	point := func(lat, lon float32) *models.GeoCoordinates {
+		return &models.GeoCoordinates{Latitude: ptFloat32(lat), Longitude: ptFloat32(lon)}
+	}
+
+	t.Run("polygon around southern germany", func(t *testing.T) {
+		res, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Points: []*models.GeoCoordinates{
+				point(47, 8), point(49.5, 8), point(49.5, 13), point(47, 13),
+			},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1}, res)
+	})
+
+	t.Run("triangle excluding stuttgart", func(t *testing.T) {
+		// the diagonal edge runs between munich and stuttgart
+		res, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Points: []*models.GeoCoordinates{
+				point(47, 8), point(47, 13), point(50, 13),
+			},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0}, res)
+	})
+
+	t.Run("polygon crossing the antimeridian", func(t *testing.T) {
+		res, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Points: []*models.GeoCoordinates{
+				point(-10, 175), point(-10, -170), point(-20, -170), point(-20, 175),
+			},
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{3, 4}, res)
+	})
+
+	t.Run("bounding box around germany", func(t *testing.T) {
+		res, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     point(55, 6),
+			BottomRight: point(47, 15),
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, res)
+	})
+
+	t.Run("bounding box crossing the antimeridian", func(t *testing.T) {
+		res, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     point(-15, 175),
+			BottomRight: point(-20, -175),
+		})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{3}, res)
+	})
+
+	t.Run("polygon with too few points", func(t *testing.T) {
+		_, err := geoIndex.WithinPolygon(context.Background(), filters.GeoPolygon{
+			Points: []*models.GeoCoordinates{point(47, 8), point(49.5, 8)},
+		})
+		assert.EqualError(t, err, "invalid arguments: polygon needs at least 3 points, got 2")
+	})
+
+	t.Run("bounding box with swapped corners", func(t *testing.T) {
+		_, err := geoIndex.WithinBoundingBox(context.Background(), filters.GeoBoundingBox{
+			TopLeft:     point(47, 6),
+			BottomRight: point(55, 15),
+		})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// BulkBuild builds the graph of an empty index from all given vectors in a
+// single pass. Unlike AddBatch, the vectors are inserted concurrently without
+// writing every single insert and link change to the commit log. Only the
+// final graph is written to the commit log once the build is complete. The
+// index must not be used otherwise while it is being built, and it can
+// neither be compressed nor be a multivector index.
+func (h *hnsw) BulkBuild(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if h.multivector != nil {
+		return errors.Errorf("bulk build is not supported for multivector indexes")
+	}
+	if h.compressed.Load() {
+		return errors.Errorf("bulk build is not supported for compressed indexes")
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if !h.isEmpty() {
+		return errors.Errorf("bulk build requires an empty index")
+	}
+
+	h.trackDimensionsOnce.Do(func() {
+		atomic.StoreInt32(&h.dims, int32(len(vectors[0])))
+	})
+	dims := int(atomic.LoadInt32(&h.dims))
+	levels := make([]int, len(ids))
+	maxId := uint64(0)
+	for i, id := range ids {
+		if len(vectors[i]) == 0 {
+			return errors.Errorf("insert called with nil-vector")
+		}
+		if len(vectors[i]) != dims {
+			return errors.Errorf("node %d has a vector with length %v. "+
+				"Other nodes have vectors with length %v", id, len(vectors[i]), dims)
+		}
+		if maxId < id {
+			maxId = id
+		}
+		levels[i] = int(math.Floor(-math.Log(h.randFunc()) * h.levelNormalizer))
+	}
+
+	h.Lock()
+	if err := h.growIndexToAccomodateNode(maxId, h.logger); err != nil {
+		h.Unlock()
+		return errors.Wrapf(err, "grow HNSW index to accommodate node %d", maxId)
+	}
+	commitLog := h.commitLog
+	h.commitLog = &NoopCommitLogger{}
+	h.Unlock()
+
+	err := h.bulkInsert(ctx, ids, vectors, levels)
+
+	h.Lock()
+	h.commitLog = commitLog
+	h.Unlock()
+
+	if err != nil {
+		return err
+	}
+	return h.writeGraphToCommitLog()
+}
+
+func (h *hnsw) bulkInsert(ctx context.Context, ids []uint64, vectors [][]float32,
+	levels []int,
+) error {
+	next := atomic.Int64{}
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	for w := 0; w < runtime.GOMAXPROCS(0); w++ {
+		eg.Go(func() error {
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(ids) {
+					return nil
+				}
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				h.metrics.InsertVector()
+				node := &vertex{id: ids[i], level: levels[i]}
+				if err := h.addOne(h.normalizeVec(vectors[i]), node); err != nil {
+					return errors.Wrapf(err, "insert node %d", ids[i])
+				}
+			}
+		})
+	}
+	return eg.Wait()
+}
+
+// writeGraphToCommitLog writes all nodes, their links and the entrypoint to
+// the commit log, so that the graph can be restored from it
+func (h *hnsw) writeGraphToCommitLog() error {
+	h.RLock()
+	defer h.RUnlock()
+
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		err := h.commitLog.AddNode(node)
+		for level := 0; err == nil && level < len(node.connections); level++ {
+			err = h.commitLog.ReplaceLinksAtLevel(node.id, level, node.connections[level])
+		}
+		node.Unlock()
+		if err != nil {
+			return errors.Wrapf(err, "write node %d to commit log", node.id)
+		}
+	}
+
+	if err := h.commitLog.SetEntryPointWithMaxLayer(h.entryPointID,
+		h.currentMaximumLayer); err != nil {
+		return errors.Wrap(err, "write entrypoint to commit log")
+	}
+
+	return h.commitLog.Flush()
+}
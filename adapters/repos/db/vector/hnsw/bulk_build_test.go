@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestHnswBulkBuild(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	indexID := "bulk-build-test"
+
+	logger, _ := test.NewNullLogger()
+	newIndex := func(t *testing.T) *hnsw {
+		index, err := New(Config{
+			RootPath: dirName,
+			ID:       indexID,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(dirName, indexID, logger,
+					cyclemanager.NewCallbackGroupNoop())
+			},
+			DistanceProvider: distancer.NewCosineDistanceProvider(),
+			VectorForIDThunk: testVectorForID,
+		}, ent.UserConfig{
+			MaxConnections: 30,
+			EFConstruction: 60,
+		}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		require.Nil(t, err)
+		return index
+	}
+
+	// see index_test.go for more context
+	expectedResults := []uint64{
+		3, 5, 4, // cluster 2
+		7, 8, 6, // cluster 3
+		2, 1, 0, // cluster 1
+	}
+
+	ids := make([]uint64, len(testVectors))
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+
+	index := newIndex(t)
+
+	t.Run("build the graph", func(t *testing.T) {
+		require.Nil(t, index.BulkBuild(ctx, ids, testVectors))
+
+		res, _, err := index.knnSearchByVector(testVectors[3], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+	})
+
+	t.Run("a second build is rejected", func(t *testing.T) {
+		err := index.BulkBuild(ctx, ids, testVectors)
+		assert.ErrorContains(t, err, "requires an empty index")
+	})
+
+	t.Run("the graph is restored from the commit log", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+
+		restored := newIndex(t)
+		defer restored.Shutdown(ctx)
+
+		res, _, err := restored.knnSearchByVector(testVectors[3], 50, 36, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedResults, res)
+	})
+}
+
+func TestHnswBulkBuildRandomVectors(t *testing.T) {
+	ctx := context.Background()
+	vectors, _ := testinghelpers.RandomVecs(1000, 0, 32)
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "bulk-build-random-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections: 16,
+		EFConstruction: 64,
+		EF:             64,
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(ctx)
+
+	ids := make([]uint64, len(vectors))
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+	require.Nil(t, index.BulkBuild(ctx, ids, vectors))
+
+	// every vector needs to be reachable through the graph
+	for i, vec := range vectors {
+		res, _, err := index.SearchByVector(vec, 1, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, uint64(i), res[0])
+	}
+}
@@ -14,6 +14,7 @@ package hnsw
 import (
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -47,7 +48,14 @@ func (c *CommitLogCombiner) Do() (bool, error) {
 			return executed, errors.Wrap(err, "obtain files names")
 		}
 
-		ok, err := c.combineFirstMatch(fileNames)
+		// logs on both sides of the latest snapshot must stay separate, the
+		// snapshot would otherwise cover only part of the combined log
+		coveredUntil, err := newSnapshotter(c.rootPath, c.id, c.logger).coveredUntil()
+		if err != nil {
+			return executed, errors.Wrap(err, "obtain snapshot")
+		}
+
+		ok, err := c.combineFirstMatch(fileNames, coveredUntil)
 		if err != nil {
 			return executed, err
 		}
@@ -62,7 +70,9 @@ func (c *CommitLogCombiner) Do() (bool, error) {
 	return executed, nil
 }
 
-func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error) {
+func (c *CommitLogCombiner) combineFirstMatch(fileNames []string,
+	snapshotCoveredUntil int64,
+) (bool, error) {
 	for i, fileName := range fileNames {
 		if !strings.HasSuffix(fileName, ".condensed") {
 			// not an already condensed file, so no candidate for combining
@@ -80,6 +90,14 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 			continue
 		}
 
+		spans, err := spansSnapshotBoundary(fileName, fileNames[i+1], snapshotCoveredUntil)
+		if err != nil {
+			return false, err
+		}
+		if spans {
+			continue
+		}
+
 		currentStat, err := os.Stat(fileName)
 		if err != nil {
 			return false, errors.Wrapf(err, "stat file %q", fileName)
@@ -110,6 +128,23 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 	return false, nil
 }
 
+func spansSnapshotBoundary(first, second string, coveredUntil int64) (bool, error) {
+	if coveredUntil < 0 {
+		return false, nil
+	}
+
+	ts1, err := asTimeStamp(filepath.Base(first))
+	if err != nil {
+		return false, err
+	}
+	ts2, err := asTimeStamp(filepath.Base(second))
+	if err != nil {
+		return false, err
+	}
+
+	return ts1 <= coveredUntil && ts2 > coveredUntil, nil
+}
+
 func (c *CommitLogCombiner) combine(first, second string) error {
 	// all names are based on the first file, so that once file1 + file2 are
 	// combined it is as if file2 had never existed and file 1 was just always
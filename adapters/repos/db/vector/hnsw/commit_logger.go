@@ -260,6 +260,9 @@ type hnswCommitLogger struct {
 	condenseLogsCallbackCtrl cyclemanager.CycleCallbackCtrl
 
 	allocChecker memwatch.AllocChecker
+
+	snapshotsEnabled bool
+	snapshotMinDelta int
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -434,7 +437,16 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs(shouldAbort cyclemanager.
 			WithField("action", "hnsw_commit_log_condensing").
 			Error("hnsw commit log maintenance (condensing) failed")
 	}
-	return executed1 || executed2
+
+	// snapshots run in the same callback as combining, so that the combiner
+	// always sees the boundary of the latest snapshot
+	executed3, err := l.createSnapshot()
+	if err != nil {
+		l.logger.WithError(err).
+			WithField("action", "hnsw_snapshot").
+			Error("hnsw commit log maintenance (snapshot) failed")
+	}
+	return executed1 || executed2 || executed3
 }
 
 func (l *hnswCommitLogger) SwitchCommitLogs(force bool) error {
@@ -554,6 +566,25 @@ func (l *hnswCommitLogger) combineLogs() (bool, error) {
 	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger).Do()
 }
 
+func (l *hnswCommitLogger) createSnapshot() (bool, error) {
+	if !l.snapshotsEnabled {
+		return false, nil
+	}
+
+	files, err := getCommitFileNames(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+
+	if len(files) <= 1 {
+		// the last file is still in use and can't be part of a snapshot
+		return false, nil
+	}
+
+	return newSnapshotter(l.rootPath, l.id, l.logger).
+		createFromLogs(files[:len(files)-1], l.snapshotMinDelta, l.allocChecker)
+}
+
 func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 	if err := l.commitLogger.Close(); err != nil {
 		return errors.Wrap(err, "close hnsw commit logger prior to delete")
@@ -572,7 +603,8 @@ func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 			return errors.Wrap(err, "delete commit files directory")
 		}
 	}
-	return nil
+
+	return newSnapshotter(l.rootPath, l.id, l.logger).remove()
 }
 
 func (l *hnswCommitLogger) Flush() error {
@@ -35,3 +35,13 @@ func WithAllocChecker(mm memwatch.AllocChecker) CommitlogOption {
 		return nil
 	}
 }
+
+// WithSnapshots makes the maintenance cycle write a new graph snapshot once
+// at least minDelta read-only commit logs are not covered by the latest one.
+func WithSnapshots(minDelta int) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.snapshotsEnabled = true
+		l.snapshotMinDelta = minDelta
+		return nil
+	}
+}
@@ -35,6 +35,16 @@ type Config struct {
 	PrometheusMetrics     *monitoring.PrometheusMetrics
 	AllocChecker          memwatch.AllocChecker
 
+	// MultiVectorForDocIDThunk returns all vectors of a document, it is only
+	// required if multivector is enabled in the user config
+	MultiVectorForDocIDThunk common.MultiVectorForDocID
+
+	// SnapshotsEnabled makes startup load the latest graph snapshot instead of
+	// replaying all commit logs, and write a new one once the logs have been
+	// replayed. Periodic snapshots are controlled by the commit logger, see
+	// WithSnapshots.
+	SnapshotsEnabled bool
+
 	// metadata for monitoring
 	ShardName string
 	ClassName string
@@ -56,6 +56,14 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "multivector.enabled",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Enabled },
+		},
+		{
+			name:     "multivector.aggregation",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Aggregation },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -99,6 +107,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
 
 	if !parsed.PQ.Enabled && !parsed.BQ.Enabled {
 		callback()
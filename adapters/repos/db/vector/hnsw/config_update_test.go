@@ -63,6 +63,18 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name: "attempting to enable multivector",
+				initial: ent.UserConfig{
+					Multivector: ent.MultivectorConfig{Enabled: false},
+				},
+				update: ent.UserConfig{
+					Multivector: ent.MultivectorConfig{Enabled: true},
+				},
+				expectedError: errors.Errorf(
+					"multivector.enabled is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -36,6 +36,15 @@ type breakCleanUpTombstonedNodesFunc func() bool
 // Delete attaches a tombstone to an item so it can be periodically cleaned up
 // later and the edges reassigned
 func (h *hnsw) Delete(ids ...uint64) error {
+	if h.multivector != nil {
+		// ids are docIDs, the graph needs to drop the nodes of each document
+		nodeIDs, err := h.multivector.removeDocs(ids)
+		if err != nil {
+			return err
+		}
+		ids = nodeIDs
+	}
+
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
@@ -94,6 +94,10 @@ type hnsw struct {
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
+	// use the filter-aware (ACORN) traversal on filtered searches instead of
+	// sweeping the unfiltered graph
+	acornSearch atomic.Bool
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -170,6 +174,12 @@ type hnsw struct {
 	store              *lsmkv.Store
 
 	allocChecker memwatch.AllocChecker
+
+	snapshotsEnabled bool
+
+	// multivector maps nodes to documents if every document holds several
+	// vectors, nil otherwise
+	multivector *multiVectorNodes
 }
 
 type CommitLogger interface {
@@ -224,6 +234,18 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		normalizeOnRead = true
 	}
 
+	var multivector *multiVectorNodes
+	if uc.Multivector.Enabled {
+		var err error
+		multivector, err = newMultiVectorNodes(cfg.ID, store, cfg.MultiVectorForDocIDThunk)
+		if err != nil {
+			return nil, errors.Wrap(err, "init multivector")
+		}
+		// the graph is built on node ids, resolve them through the document
+		cfg.VectorForIDThunk = multivector.vectorForNode
+		cfg.TempVectorForIDThunk = multivector.tempVectorForNode
+	}
+
 	vectorCache := cache.NewShardedFloat32LockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
 		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
 
@@ -277,8 +299,12 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		shardFlushCallbacks:      shardFlushCallbacks,
 		store:                    store,
 		allocChecker:             cfg.AllocChecker,
+		snapshotsEnabled:         cfg.SnapshotsEnabled,
+		multivector:              multivector,
 	}
 
+	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
+
 	if uc.BQ.Enabled {
 		var err error
 		index.compressor, err = compressionhelpers.NewBQCompressor(
@@ -434,7 +460,7 @@ func (h *hnsw) findBestEntrypointForNode(currentMaxLevel, targetLevel int,
 		}
 
 		eps.Insert(entryPointID, dist)
-		res, err := h.searchLayerByVectorWithDistancer(nodeVec, eps, 1, level, nil, distancer)
+		res, err := h.searchLayerByVectorWithDistancer(nodeVec, eps, 1, level, nil, distancer, nil)
 		if err != nil {
 			return 0,
 				errors.Wrapf(err, "update candidate: search layer at level %d", level)
@@ -684,6 +710,10 @@ func (h *hnsw) DistanceBetweenVectors(x, y []float32) (float32, bool, error) {
 }
 
 func (h *hnsw) ContainsNode(id uint64) bool {
+	if h.multivector != nil {
+		return h.multivector.containsDoc(id)
+	}
+
 	h.RLock()
 	defer h.RUnlock()
 	h.shardedNodeLocks.RLock(id)
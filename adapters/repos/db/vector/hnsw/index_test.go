@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/profile"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -70,6 +71,17 @@ func TestHnswIndex(t *testing.T) {
 		_, _, err := index.knnSearchByVector(testVectors[position], -1, 36, nil)
 		require.Error(t, err)
 	})
+
+	t.Run("searching with a profile", func(t *testing.T) {
+		position := 3
+		vp := &profile.Vector{}
+		res, _, err := index.SearchByVectorWithProfile(testVectors[position], 3, nil, vp)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{3, 4, 5}, res)
+		assert.False(t, vp.Flat)
+		assert.Greater(t, vp.Ef, 0)
+		assert.Greater(t, vp.Visited, 0)
+	})
 }
 
 func TestHnswIndexGrow(t *testing.T) {
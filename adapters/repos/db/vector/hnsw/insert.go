@@ -41,6 +41,13 @@ func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
 }
 
 func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if h.multivector != nil {
+		return errors.Errorf("multivector index: vectors must be added with AddMulti")
+	}
+	return h.addBatch(ctx, ids, vectors)
+}
+
+func (h *hnsw) addBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -0,0 +1,374 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// multiVectorNodesBucketPrefix names the bucket which maps the nodes of a
+// multivector index back to the document they belong to
+const multiVectorNodesBucketPrefix = "multivector_nodes"
+
+// multiVectorNode identifies a single vector of a document in a multivector
+// index
+type multiVectorNode struct {
+	docID uint64
+	pos   uint32
+}
+
+// multiVectorNodes keeps track of which graph nodes belong to which document.
+// In a multivector index every vector of a document is inserted as its own
+// node, node ids are therefore assigned by the index and are independent of
+// the docID.
+type multiVectorNodes struct {
+	sync.RWMutex
+	bucket        *lsmkv.Bucket
+	vectorsForDoc common.MultiVectorForDocID
+	nextID        uint64
+	nodes         map[uint64]multiVectorNode
+	docs          map[uint64][]uint64
+}
+
+func newMultiVectorNodes(id string, store *lsmkv.Store,
+	vectorsForDoc common.MultiVectorForDocID,
+) (*multiVectorNodes, error) {
+	if store == nil {
+		return nil, fmt.Errorf("multivector index requires a store")
+	}
+	if vectorsForDoc == nil {
+		return nil, fmt.Errorf("multivector index requires multiVectorForDocIDThunk")
+	}
+
+	bucketName := fmt.Sprintf("%s_%s", multiVectorNodesBucketPrefix, id)
+	if err := store.CreateOrLoadBucket(context.Background(), bucketName,
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+	); err != nil {
+		return nil, errors.Wrapf(err, "create or load bucket %s", bucketName)
+	}
+
+	m := &multiVectorNodes{
+		bucket:        store.Bucket(bucketName),
+		vectorsForDoc: vectorsForDoc,
+		nodes:         map[uint64]multiVectorNode{},
+		docs:          map[uint64][]uint64{},
+	}
+
+	c := m.bucket.Cursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 || len(v) != 12 {
+			return nil, fmt.Errorf("corrupt multivector node entry of length %d/%d",
+				len(k), len(v))
+		}
+		nodeID := binary.BigEndian.Uint64(k)
+		node := multiVectorNode{
+			docID: binary.BigEndian.Uint64(v[:8]),
+			pos:   binary.BigEndian.Uint32(v[8:]),
+		}
+		m.nodes[nodeID] = node
+		m.docs[node.docID] = append(m.docs[node.docID], nodeID)
+		if nodeID >= m.nextID {
+			m.nextID = nodeID + 1
+		}
+	}
+
+	// cursor order is by node id, which is not necessarily the order of the
+	// vectors within the document
+	for _, nodeIDs := range m.docs {
+		sort.Slice(nodeIDs, func(a, b int) bool {
+			return m.nodes[nodeIDs[a]].pos < m.nodes[nodeIDs[b]].pos
+		})
+	}
+
+	return m, nil
+}
+
+// addDoc assigns and persists one node id per vector of the document
+func (m *multiVectorNodes) addDoc(docID uint64, count int) ([]uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.docs[docID]; ok {
+		return nil, fmt.Errorf("doc id %d is already indexed", docID)
+	}
+
+	nodeIDs := make([]uint64, count)
+	for i := range nodeIDs {
+		nodeID := m.nextID
+		// the memtable keeps references to key and value, so they can't be
+		// reused across puts
+		key := make([]byte, 8)
+		value := make([]byte, 12)
+		binary.BigEndian.PutUint64(key, nodeID)
+		binary.BigEndian.PutUint64(value[:8], docID)
+		binary.BigEndian.PutUint32(value[8:], uint32(i))
+		if err := m.bucket.Put(key, value); err != nil {
+			return nil, errors.Wrapf(err, "persist node %d of doc id %d", nodeID, docID)
+		}
+
+		m.nextID++
+		m.nodes[nodeID] = multiVectorNode{docID: docID, pos: uint32(i)}
+		nodeIDs[i] = nodeID
+	}
+	m.docs[docID] = nodeIDs
+
+	return nodeIDs, nil
+}
+
+// removeDocs drops the documents from the mapping and returns the ids of the
+// nodes which belonged to them
+func (m *multiVectorNodes) removeDocs(docIDs []uint64) ([]uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	var nodeIDs []uint64
+	for _, docID := range docIDs {
+		for _, nodeID := range m.docs[docID] {
+			key := make([]byte, 8)
+			binary.BigEndian.PutUint64(key, nodeID)
+			if err := m.bucket.Delete(key); err != nil {
+				return nil, errors.Wrapf(err, "delete node %d of doc id %d", nodeID, docID)
+			}
+			delete(m.nodes, nodeID)
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+		delete(m.docs, docID)
+	}
+
+	return nodeIDs, nil
+}
+
+func (m *multiVectorNodes) containsDoc(docID uint64) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	_, ok := m.docs[docID]
+	return ok
+}
+
+func (m *multiVectorNodes) docOf(nodeID uint64) (multiVectorNode, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	node, ok := m.nodes[nodeID]
+	return node, ok
+}
+
+func (m *multiVectorNodes) nodesOf(docID uint64) []uint64 {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.docs[docID]
+}
+
+// nodeAllowList translates an allow list of docIDs into an allow list of the
+// nodes belonging to those documents
+func (m *multiVectorNodes) nodeAllowList(allow helpers.AllowList) helpers.AllowList {
+	m.RLock()
+	defer m.RUnlock()
+
+	nodes := helpers.NewAllowList()
+	it := allow.Iterator()
+	for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+		nodes.Insert(m.docs[docID]...)
+	}
+	return nodes
+}
+
+// vectorForNode resolves the vector of a single node by looking up the
+// document it belongs to. It is used in place of the regular VectorForID
+// thunk, so that the vector cache keeps working on node ids.
+func (m *multiVectorNodes) vectorForNode(ctx context.Context, nodeID uint64) ([]float32, error) {
+	node, ok := m.docOf(nodeID)
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(nodeID, "node does not belong to any document")
+	}
+
+	vectors, err := m.vectorsForDoc(ctx, node.docID)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			// report the node rather than the doc, so the caller tombstones the
+			// right entry
+			return nil, storobj.NewErrNotFoundf(nodeID, "%s", e.OriginalMsg)
+		}
+		return nil, err
+	}
+
+	if int(node.pos) >= len(vectors) {
+		return nil, storobj.NewErrNotFoundf(nodeID,
+			"doc id %d has %d vectors, node points to position %d",
+			node.docID, len(vectors), node.pos)
+	}
+
+	return vectors[node.pos], nil
+}
+
+func (m *multiVectorNodes) tempVectorForNode(ctx context.Context, nodeID uint64,
+	container *common.VectorSlice,
+) ([]float32, error) {
+	vec, err := m.vectorForNode(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	container.Slice = append(container.Slice[:0], vec...)
+	return container.Slice, nil
+}
+
+// AddMulti inserts every vector of the document as its own node. It is the
+// only way of adding vectors to an index with multivector enabled.
+func (h *hnsw) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	if h.multivector == nil {
+		return fmt.Errorf("multivector is not enabled on this index")
+	}
+	if len(vectors) == 0 {
+		return errors.Errorf("insert called with no vectors")
+	}
+
+	nodeIDs, err := h.multivector.addDoc(docID, len(vectors))
+	if err != nil {
+		return err
+	}
+
+	return h.addBatch(ctx, nodeIDs, vectors)
+}
+
+// SearchByMultiVector runs a late-interaction search: every query vector is
+// used to find candidate nodes, the documents those nodes belong to are then
+// re-scored with all their vectors. The score of a document is the sum of the
+// distances between each query vector and its closest document vector, which
+// is the distance equivalent of MaxSim.
+func (h *hnsw) SearchByMultiVector(vectors [][]float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if h.multivector == nil {
+		return nil, nil, fmt.Errorf("multivector is not enabled on this index")
+	}
+	if len(vectors) == 0 {
+		return nil, nil, fmt.Errorf("multivector search requires at least one vector")
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	var nodeAllowList helpers.AllowList
+	if allowList != nil {
+		nodeAllowList = h.multivector.nodeAllowList(allowList)
+	}
+
+	queries := make([][]float32, len(vectors))
+	for i, vec := range vectors {
+		queries[i] = h.normalizeVec(vec)
+	}
+
+	// every document vector is a node, so a document can be found multiple
+	// times per query vector. Over-fetch to end up with enough documents.
+	perQuery := h.searchTimeEF(k)
+	candidates := map[uint64]struct{}{}
+	for _, query := range queries {
+		nodeIDs, _, err := h.searchNodes(query, perQuery, nodeAllowList, nil)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "search candidate nodes")
+		}
+		for _, nodeID := range nodeIDs {
+			if node, ok := h.multivector.docOf(nodeID); ok {
+				candidates[node.docID] = struct{}{}
+			}
+		}
+	}
+
+	type scoredDoc struct {
+		docID uint64
+		dist  float32
+	}
+	scored := make([]scoredDoc, 0, len(candidates))
+	for docID := range candidates {
+		dist, ok, err := h.maxSimDistance(queries, docID)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "score doc id %d", docID)
+		}
+		if !ok {
+			continue
+		}
+		scored = append(scored, scoredDoc{docID: docID, dist: dist})
+	}
+
+	sort.Slice(scored, func(a, b int) bool {
+		if scored[a].dist == scored[b].dist {
+			return scored[a].docID < scored[b].docID
+		}
+		return scored[a].dist < scored[b].dist
+	})
+	if len(scored) > k {
+		scored = scored[:k]
+	}
+
+	ids := make([]uint64, len(scored))
+	dists := make([]float32, len(scored))
+	for i, doc := range scored {
+		ids[i] = doc.docID
+		dists[i] = doc.dist
+	}
+	return ids, dists, nil
+}
+
+// maxSimDistance scores a document against all query vectors. ok is false if
+// the document has been deleted in the meantime.
+func (h *hnsw) maxSimDistance(queries [][]float32, docID uint64) (float32, bool, error) {
+	nodeIDs := h.multivector.nodesOf(docID)
+	if len(nodeIDs) == 0 {
+		return 0, false, nil
+	}
+
+	docVecs := make([][]float32, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		vec, err := h.vectorForID(context.Background(), nodeID)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				h.handleDeletedNode(e.DocID)
+				return 0, false, nil
+			}
+			return 0, false, err
+		}
+		docVecs = append(docVecs, vec)
+	}
+
+	var sum float32
+	for _, query := range queries {
+		var best float32
+		for i, vec := range docVecs {
+			dist, _, err := h.distancerProvider.SingleDist(query, vec)
+			if err != nil {
+				return 0, false, err
+			}
+			if i == 0 || dist < best {
+				best = dist
+			}
+		}
+		sum += best
+	}
+
+	return sum, true, nil
+}
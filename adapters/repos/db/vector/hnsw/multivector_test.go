@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestMultiVectorSearch(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	randomVecs := func(n int) [][]float32 {
+		vecs := make([][]float32, n)
+		for i := range vecs {
+			vecs[i] = make([]float32, 16)
+			for j := range vecs[i] {
+				vecs[i][j] = r.Float32()
+			}
+		}
+		return vecs
+	}
+	docs := make([][][]float32, 400)
+	for i := range docs {
+		docs[i] = randomVecs(3 + r.Intn(4))
+	}
+	queries := make([][][]float32, 10)
+	for i := range queries {
+		queries[i] = randomVecs(4)
+	}
+	k := 10
+	provider := distancer.NewL2SquaredProvider()
+	store := testinghelpers.NewDummyStore(t)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.Multivector.Enabled = true
+
+	deleted := map[uint64]struct{}{}
+	vectorsForDoc := func(ctx context.Context, docID uint64) ([][]float32, error) {
+		if _, ok := deleted[docID]; ok {
+			return nil, storobj.NewErrNotFoundf(docID, "deleted")
+		}
+		return docs[docID], nil
+	}
+
+	index, err := New(Config{
+		RootPath:                 t.TempDir(),
+		ID:                       "multivector",
+		MakeCommitLoggerThunk:    MakeNoopCommitLogger,
+		DistanceProvider:         provider,
+		VectorForIDThunk:         testVectorForID,
+		MultiVectorForDocIDThunk: vectorsForDoc,
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+
+	for i, doc := range docs {
+		require.Nil(t, index.AddMulti(context.Background(), uint64(i), doc))
+	}
+
+	maxSimTruth := func(query [][]float32, allowed func(uint64) bool) []uint64 {
+		ids := []uint64{}
+		dists := map[uint64]float32{}
+		for i, doc := range docs {
+			id := uint64(i)
+			if !allowed(id) {
+				continue
+			}
+			var sum float32
+			for _, q := range query {
+				best := float32(-1)
+				for _, vec := range doc {
+					d, _, _ := provider.SingleDist(q, vec)
+					if best < 0 || d < best {
+						best = d
+					}
+				}
+				sum += best
+			}
+			ids = append(ids, id)
+			dists[id] = sum
+		}
+		sort.Slice(ids, func(a, b int) bool { return dists[ids[a]] < dists[ids[b]] })
+		return ids[:k]
+	}
+
+	recall := func(allowList helpers.AllowList, allowed func(uint64) bool) float32 {
+		found := 0
+		for _, query := range queries {
+			res, dists, err := index.SearchByMultiVector(query, k, allowList)
+			require.Nil(t, err)
+			require.Len(t, res, k)
+			assert.True(t, sort.SliceIsSorted(dists, func(a, b int) bool { return dists[a] < dists[b] }))
+			truth := maxSimTruth(query, allowed)
+			for _, id := range res {
+				require.True(t, allowed(id), "doc id %d is not allowed", id)
+				for _, want := range truth {
+					if id == want {
+						found++
+						break
+					}
+				}
+			}
+		}
+		return float32(found) / float32(k*len(queries))
+	}
+
+	t.Run("documents are re-scored with MaxSim", func(t *testing.T) {
+		assert.GreaterOrEqual(t, recall(nil, func(uint64) bool { return true }), float32(0.9))
+	})
+
+	t.Run("allow list is applied to documents", func(t *testing.T) {
+		allowed := []uint64{}
+		for i := range docs {
+			if i%4 == 0 {
+				allowed = append(allowed, uint64(i))
+			}
+		}
+		allowList := helpers.NewAllowList(allowed...)
+		assert.GreaterOrEqual(t, recall(allowList, allowList.Contains), float32(0.9))
+	})
+
+	t.Run("single vectors are not accepted", func(t *testing.T) {
+		assert.NotNil(t, index.Add(10000, docs[0][0]))
+	})
+
+	t.Run("deleting a document removes all its nodes", func(t *testing.T) {
+		res, _, err := index.SearchByMultiVector(docs[5], 1, nil)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{5}, res)
+
+		deleted[5] = struct{}{}
+		require.Nil(t, index.Delete(5))
+		assert.False(t, index.ContainsNode(5))
+		assert.True(t, index.ContainsNode(6))
+
+		res, _, err = index.SearchByMultiVector(docs[5], k, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, res, uint64(5))
+	})
+
+	t.Run("node mapping is restored from the store", func(t *testing.T) {
+		restored, err := newMultiVectorNodes("multivector", store, vectorsForDoc)
+		require.Nil(t, err)
+
+		assert.False(t, restored.containsDoc(5))
+		for i := range docs {
+			if i == 5 {
+				continue
+			}
+			nodeIDs := restored.nodesOf(uint64(i))
+			require.Len(t, nodeIDs, len(docs[i]))
+			for pos, nodeID := range nodeIDs {
+				vec, err := restored.vectorForNode(context.Background(), nodeID)
+				require.Nil(t, err)
+				assert.Equal(t, docs[i][pos], vec)
+			}
+		}
+		assert.Equal(t, index.multivector.nextID, restored.nextID)
+	})
+}
@@ -221,7 +221,7 @@ func (n *neighborFinderConnector) doAtLevel(level int) error {
 		var err error
 
 		results, err = n.graph.searchLayerByVectorWithDistancer(n.nodeVec, eps, n.graph.efConstruction,
-			level, nil, n.distancer)
+			level, nil, n.distancer, nil)
 		if err != nil {
 			return errors.Wrapf(err, "search layer at level %d", level)
 		}
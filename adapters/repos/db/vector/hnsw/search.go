@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
@@ -62,15 +63,50 @@ func (h *hnsw) autoEfFromK(k int) int {
 }
 
 func (h *hnsw) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+	if h.multivector != nil {
+		// a single vector is a query with one token
+		return h.SearchByMultiVector([][]float32{vector}, k, allowList)
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	return h.searchNodes(h.normalizeVec(vector), k, allowList, nil)
+}
+
+// SearchByVectorWithProfile is SearchByVector, but additionally describes in
+// vp how the graph was searched
+func (h *hnsw) SearchByVectorWithProfile(vector []float32, k int, allowList helpers.AllowList,
+	vp *profile.Vector,
+) ([]uint64, []float32, error) {
+	if h.multivector != nil {
+		return h.SearchByVector(vector, k, allowList)
+	}
+
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
-	vector = h.normalizeVec(vector)
+	return h.searchNodes(h.normalizeVec(vector), k, allowList, vp)
+}
+
+// searchNodes searches the graph with an already normalized vector, the
+// results and the allow list are node ids
+func (h *hnsw) searchNodes(vector []float32, k int, allowList helpers.AllowList,
+	vp *profile.Vector,
+) ([]uint64, []float32, error) {
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		if vp != nil {
+			vp.Flat = true
+		}
 		return h.flatSearch(vector, k, allowList)
 	}
-	return h.knnSearchByVector(vector, k, h.searchTimeEF(k), allowList)
+
+	ef := h.searchTimeEF(k)
+	if vp != nil {
+		vp.Ef = ef
+	}
+	return h.knnSearch(vector, k, ef, allowList, vp)
 }
 
 // SearchByVectorDistance wraps SearchByVector, and calls it recursively until
@@ -167,12 +203,16 @@ func (h *hnsw) searchLayerByVector(queryVector []float32,
 		compressorDistancer, returnFn = h.compressor.NewDistancer(queryVector)
 		defer returnFn()
 	}
-	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, compressorDistancer)
+	return h.searchLayerByVectorWithDistancer(queryVector, entrypoints, ef, level, allowList, compressorDistancer, nil)
 }
 
+// searchLayerByVectorWithDistancer counts the visited nodes in vp, if it is
+// set
 func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	entrypoints *priorityqueue.Queue[any], ef int, level int,
-	allowList helpers.AllowList, compressorDistancer compressionhelpers.CompressorDistancer) (*priorityqueue.Queue[any], error,
+	allowList helpers.AllowList, compressorDistancer compressionhelpers.CompressorDistancer,
+	vp *profile.Vector,
+) (*priorityqueue.Queue[any], error,
 ) {
 	h.pools.visitedListsLock.RLock()
 	visited := h.pools.visitedLists.Borrow()
@@ -206,6 +246,15 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	}
 	connectionsReusable := make([]uint64, h.maximumConnectionsLayerZero)
 
+	// with the filter-aware strategy only allowed nodes are ever evaluated on
+	// the lowest level, see acornNeighbors
+	acorn := level == 0 && allowList != nil && h.acornSearch.Load()
+	var acornReusable, secondHopReusable []uint64
+	if acorn {
+		acornReusable = make([]uint64, 0, h.maximumConnectionsLayerZero)
+		secondHopReusable = make([]uint64, 0, h.maximumConnectionsLayerZero)
+	}
+
 	for candidates.Len() > 0 {
 		var dist float32
 		candidate := candidates.Pop()
@@ -255,7 +304,14 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 		copy(connectionsReusable, candidateNode.connections[level])
 		candidateNode.Unlock()
 
-		for _, neighborID := range connectionsReusable {
+		neighbors := connectionsReusable
+		if acorn {
+			acornReusable, secondHopReusable = h.acornNeighbors(connectionsReusable,
+				allowList, visited, acornReusable[:0], secondHopReusable)
+			neighbors = acornReusable
+		}
+
+		for _, neighborID := range neighbors {
 
 			if ok := visited.Visited(neighborID); ok {
 				// skip if we've already visited this neighbor
@@ -264,6 +320,9 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 
 			// make sure we never visit this neighbor again
 			visited.Visit(neighborID)
+			if vp != nil {
+				vp.Visited++
+			}
 			var distance float32
 			var ok bool
 			var err error
@@ -472,6 +531,12 @@ func (h *hnsw) handleDeletedNode(docID uint64) {
 
 func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 	ef int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return h.knnSearch(searchVec, k, ef, allowList, nil)
+}
+
+func (h *hnsw) knnSearch(searchVec []float32, k int,
+	ef int, allowList helpers.AllowList, vp *profile.Vector,
 ) ([]uint64, []float32, error) {
 	if h.isEmpty() {
 		return nil, nil, nil
@@ -507,7 +572,7 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 		eps := priorityqueue.NewMin[any](10)
 		eps.Insert(entryPointID, entryPointDistance)
 
-		res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, 1, level, nil, compressorDistancer)
+		res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, 1, level, nil, compressorDistancer, vp)
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", level)
 		}
@@ -550,7 +615,12 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin[any](10)
 	eps.Insert(entryPointID, entryPointDistance)
-	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, compressorDistancer)
+	if allowList != nil && h.acornSearch.Load() && !allowList.Contains(entryPointID) {
+		if err := h.acornSeedEntrypoints(eps, searchVec, allowList); err != nil {
+			return nil, nil, errors.Wrap(err, "knn search: seed filtered entrypoints")
+		}
+	}
+	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, compressorDistancer, vp)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
 	}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+)
+
+// acornEntrypointSeeds is the number of additional entrypoints taken from the
+// allow list when the regular entrypoint does not match the filter. Without
+// them the search could start in a region of the graph that is entirely
+// filtered out.
+const acornEntrypointSeeds = 8
+
+// acornNeighbors implements the filter-aware neighbor expansion inspired by
+// ACORN (https://arxiv.org/abs/2403.04871). Instead of evaluating every
+// neighbor and discarding the ones that are not on the allow list, only
+// allowed neighbors are returned. Neighbors which are filtered out are not
+// evaluated at all, but act as a bridge to their own neighbors, so that the
+// search can still make progress through regions of the graph where most
+// nodes are filtered out.
+//
+// The returned slice is appended to out and may contain duplicates, the
+// caller is expected to skip already visited nodes. neighbors must not be
+// modified while this is running, secondHop is a scratch buffer.
+func (h *hnsw) acornNeighbors(neighbors []uint64, allowList helpers.AllowList,
+	visited visited.ListSet, out, secondHop []uint64,
+) ([]uint64, []uint64) {
+	limit := h.maximumConnectionsLayerZero
+
+	for _, id := range neighbors {
+		if visited.Visited(id) || !allowList.Contains(id) {
+			continue
+		}
+
+		out = append(out, id)
+		if len(out) >= limit {
+			return out, secondHop
+		}
+	}
+
+	for _, id := range neighbors {
+		if visited.Visited(id) || allowList.Contains(id) {
+			continue
+		}
+
+		// a filtered node is never a result, so there is no need to ever look
+		// at it again
+		visited.Visit(id)
+
+		secondHop = h.copyConnectionsAtLevelZero(id, secondHop)
+		for _, secondID := range secondHop {
+			if visited.Visited(secondID) || !allowList.Contains(secondID) {
+				continue
+			}
+
+			out = append(out, secondID)
+			if len(out) >= limit {
+				return out, secondHop
+			}
+		}
+	}
+
+	return out, secondHop
+}
+
+func (h *hnsw) copyConnectionsAtLevelZero(id uint64, buf []uint64) []uint64 {
+	buf = buf[:0]
+
+	h.shardedNodeLocks.RLock(id)
+	var node *vertex
+	if id < uint64(len(h.nodes)) {
+		node = h.nodes[id]
+	}
+	h.shardedNodeLocks.RUnlock(id)
+
+	if node == nil {
+		return buf
+	}
+
+	node.Lock()
+	defer node.Unlock()
+
+	if len(node.connections) == 0 {
+		return buf
+	}
+
+	return append(buf, node.connections[0]...)
+}
+
+// acornSeedEntrypoints adds nodes from the allow list as additional
+// entrypoints for the search on the lowest level.
+func (h *hnsw) acornSeedEntrypoints(eps *priorityqueue.Queue[any],
+	searchVec []float32, allowList helpers.AllowList,
+) error {
+	it := allowList.Iterator()
+	seeded := 0
+	for id, ok := it.Next(); ok && seeded < acornEntrypointSeeds; id, ok = it.Next() {
+		if h.nodeByID(id) == nil || h.hasTombstone(id) {
+			continue
+		}
+
+		dist, ok, err := h.distBetweenNodeAndVec(id, searchVec)
+		if err != nil {
+			return errors.Wrapf(err, "distance to seed entrypoint %d", id)
+		}
+		if !ok {
+			continue
+		}
+
+		eps.Insert(id, dist)
+		seeded++
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestAcornFilteredSearch(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(3000, 20, 16)
+	k := 10
+	provider := distancer.NewL2SquaredProvider()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.FilterStrategy = ent.FilterStrategyAcorn
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "acorn",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      provider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	index.forbidFlat = true
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+
+	// every 20th vector, i.e. a selectivity of 5%
+	allowed := []uint64{}
+	for i := range vectors {
+		if i%20 == 0 {
+			allowed = append(allowed, uint64(i))
+		}
+	}
+	allowList := helpers.NewAllowList(allowed...)
+
+	filteredTruth := func(query []float32) []uint64 {
+		ids := append([]uint64{}, allowed...)
+		dists := make(map[uint64]float32, len(ids))
+		for _, id := range ids {
+			dists[id], _, _ = provider.SingleDist(query, vectors[id])
+		}
+		sort.Slice(ids, func(a, b int) bool { return dists[ids[a]] < dists[ids[b]] })
+		return ids[:k]
+	}
+
+	t.Run("only allowed nodes are returned with good recall", func(t *testing.T) {
+		var matches uint64
+		for _, query := range queries {
+			res, _, err := index.SearchByVector(query, k, allowList)
+			require.Nil(t, err)
+			require.Len(t, res, k)
+			for _, id := range res {
+				assert.True(t, allowList.Contains(id), "result %d is not allowed", id)
+			}
+			matches += testinghelpers.MatchesInLists(filteredTruth(query), res)
+		}
+
+		recall := float32(matches) / float32(len(queries)*k)
+		assert.GreaterOrEqual(t, recall, float32(0.9))
+	})
+
+	t.Run("entrypoint outside of the filter is complemented with seeds", func(t *testing.T) {
+		notAllowed := helpers.NewAllowList(allowed[1:]...)
+		if notAllowed.Contains(index.entryPointID) {
+			t.Skip("entrypoint happens to be on the allow list")
+		}
+
+		res, _, err := index.SearchByVector(queries[0], k, notAllowed)
+		require.Nil(t, err)
+		assert.Len(t, res, k)
+	})
+
+	t.Run("filter strategy can be switched at runtime", func(t *testing.T) {
+		uc.FilterStrategy = ent.FilterStrategySweeping
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.False(t, index.acornSearch.Load())
+
+		res, _, err := index.SearchByVector(queries[0], k, allowList)
+		require.Nil(t, err)
+		for _, id := range res {
+			assert.True(t, allowList.Contains(id), "result %d is not allowed", id)
+		}
+
+		uc.FilterStrategy = ent.FilterStrategyAcorn
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.True(t, index.acornSearch.Load())
+	})
+}
@@ -0,0 +1,509 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+// A snapshot is a point-in-time copy of the graph that was built from all
+// commit logs up to and including the one it is named after. On startup the
+// latest snapshot is loaded and only the commit logs written after it are
+// replayed. The commit logs themselves are never deleted because of a
+// snapshot, so a corrupt or stale snapshot can always be discarded in favor of
+// a full replay.
+//
+// The file starts with a fixed size header, followed by the graph encoded as
+// a sequence of regular commit log entries:
+//
+//	| version (1 byte) | body length (8 bytes) | body crc32 (4 bytes) | body |
+const (
+	snapshotVersion    = uint8(1)
+	snapshotHeaderSize = 1 + 8 + 4
+	snapshotSuffix     = ".snapshot"
+	snapshotTmpSuffix  = ".snapshot.tmp"
+)
+
+// DefaultSnapshotMinDeltaCommitlogs is the number of read-only commit logs
+// which need to accumulate after the latest snapshot before the maintenance
+// cycle creates a new one.
+const DefaultSnapshotMinDeltaCommitlogs = 5
+
+func snapshotDirectory(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.snapshot.d", rootPath, name)
+}
+
+type snapshotter struct {
+	rootPath string
+	id       string
+	logger   logrus.FieldLogger
+}
+
+func newSnapshotter(rootPath, id string, logger logrus.FieldLogger) *snapshotter {
+	return &snapshotter{rootPath: rootPath, id: id, logger: logger}
+}
+
+func (s *snapshotter) dir() string {
+	return snapshotDirectory(s.rootPath, s.id)
+}
+
+// latest returns the path and the covered commit log timestamp of the most
+// recent snapshot. If there is no snapshot, ok is false.
+func (s *snapshotter) latest() (path string, coveredUntil int64, ok bool, err error) {
+	entries, err := os.ReadDir(s.dir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", 0, false, nil
+		}
+		return "", 0, false, errors.Wrap(err, "browse snapshot directory")
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, snapshotSuffix) {
+			continue
+		}
+
+		ts, err := strconv.ParseInt(strings.TrimSuffix(name, snapshotSuffix), 10, 64)
+		if err != nil {
+			// not one of ours, ignore
+			continue
+		}
+
+		if !ok || ts > coveredUntil {
+			path, coveredUntil, ok = filepath.Join(s.dir(), name), ts, true
+		}
+	}
+
+	return path, coveredUntil, ok, nil
+}
+
+// coveredUntil returns the commit log timestamp covered by the latest
+// snapshot, or -1 if there is none.
+func (s *snapshotter) coveredUntil() (int64, error) {
+	_, ts, ok, err := s.latest()
+	if err != nil || !ok {
+		return -1, err
+	}
+	return ts, nil
+}
+
+// remove deletes all snapshots of this index
+func (s *snapshotter) remove() error {
+	if err := os.RemoveAll(s.dir()); err != nil {
+		return errors.Wrap(err, "delete snapshot directory")
+	}
+	return nil
+}
+
+// cleanup removes all snapshots and temporary files except for the snapshot
+// at keep.
+func (s *snapshotter) cleanup(keep string) error {
+	entries, err := os.ReadDir(s.dir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "browse snapshot directory")
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(s.dir(), entry.Name())
+		if path == keep {
+			continue
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return errors.Wrapf(err, "delete outdated snapshot %q", path)
+		}
+	}
+
+	return nil
+}
+
+// load reads the latest snapshot and validates it against the commit logs
+// that are currently on disk. If there is no snapshot, ok is false. A
+// snapshot that fails validation is deleted and reported as an error, the
+// caller is expected to fall back to a full replay.
+func (s *snapshotter) load(fileNames []string) (state *DeserializationResult,
+	coveredUntil int64, ok bool, err error,
+) {
+	path, coveredUntil, ok, err := s.latest()
+	if err != nil || !ok {
+		return nil, 0, false, err
+	}
+
+	state, err = s.read(path, coveredUntil, fileNames)
+	if err != nil {
+		if rmErr := s.remove(); rmErr != nil {
+			s.logger.WithError(rmErr).
+				WithField("action", "hnsw_snapshot_cleanup").
+				WithField("path", path).
+				Error("could not delete invalid snapshot")
+		}
+		return nil, 0, false, errors.Wrapf(err, "snapshot %q", path)
+	}
+
+	return state, coveredUntil, true, nil
+}
+
+func (s *snapshotter) read(path string, coveredUntil int64,
+	fileNames []string,
+) (*DeserializationResult, error) {
+	// The snapshot covers a prefix of the commit logs. If none of them exist
+	// anymore, the snapshot belongs to a different history, e.g. because the
+	// commit logs were replaced by a restore.
+	covers := false
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err == nil && ts <= coveredUntil {
+			covers = true
+			break
+		}
+	}
+	if !covers {
+		return nil, errors.Errorf("no commit log up to %d present", coveredUntil)
+	}
+
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open")
+	}
+	defer fd.Close()
+
+	header := make([]byte, snapshotHeaderSize)
+	if _, err := io.ReadFull(fd, header); err != nil {
+		return nil, errors.Wrap(err, "read header")
+	}
+
+	if header[0] != snapshotVersion {
+		return nil, errors.Errorf("unsupported version %d", header[0])
+	}
+	bodyLen := binary.LittleEndian.Uint64(header[1:9])
+	checksum := binary.LittleEndian.Uint32(header[9:13])
+
+	// verify the checksum before decoding anything, a corrupt body could
+	// otherwise lead to arbitrarily large allocations
+	hash := crc32.NewIEEE()
+	n, err := io.Copy(hash, bufio.NewReaderSize(fd, 256*1024))
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+	if uint64(n) != bodyLen {
+		return nil, errors.Errorf("body length mismatch: expected %d, got %d",
+			bodyLen, n)
+	}
+	if hash.Sum32() != checksum {
+		return nil, errors.Errorf("checksum mismatch")
+	}
+
+	if _, err := fd.Seek(snapshotHeaderSize, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "seek body")
+	}
+
+	state, valid, err := NewDeserializer(s.logger).Do(
+		bufio.NewReaderSize(fd, 256*1024), nil, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "deserialize body")
+	}
+	if uint64(valid) != bodyLen {
+		return nil, errors.Errorf("deserialized %d of %d bytes", valid, bodyLen)
+	}
+
+	if len(state.Nodes) > 0 && state.Entrypoint >= uint64(len(state.Nodes)) {
+		return nil, errors.Errorf("entrypoint %d out of range of %d nodes",
+			state.Entrypoint, len(state.Nodes))
+	}
+
+	return state, nil
+}
+
+// write persists state as the snapshot covering all commit logs up to and
+// including coveredUntil. Older snapshots are removed once the new one is in
+// place.
+func (s *snapshotter) write(state *DeserializationResult, coveredUntil int64) error {
+	before := time.Now()
+
+	if err := os.MkdirAll(s.dir(), os.ModePerm); err != nil {
+		return errors.Wrap(err, "create snapshot directory")
+	}
+
+	name := strconv.FormatInt(coveredUntil, 10)
+	tmpPath := filepath.Join(s.dir(), name+snapshotTmpSuffix)
+	finalPath := filepath.Join(s.dir(), name+snapshotSuffix)
+
+	if err := s.writeFile(tmpPath, state); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, finalPath); err != nil {
+		return errors.Wrapf(err, "rename %q to %q", tmpPath, finalPath)
+	}
+
+	if err := s.cleanup(finalPath); err != nil {
+		return err
+	}
+
+	s.logger.WithFields(logrus.Fields{
+		"action":        "hnsw_snapshot_written",
+		"id":            s.id,
+		"path":          finalPath,
+		"covered_until": coveredUntil,
+		"took":          time.Since(before),
+	}).Info("wrote hnsw snapshot")
+
+	return nil
+}
+
+func (s *snapshotter) writeFile(path string, state *DeserializationResult) error {
+	fd, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "create snapshot file %q", path)
+	}
+	defer fd.Close()
+
+	// reserve space for the header, it is filled in once the body is complete
+	if _, err := fd.Write(make([]byte, snapshotHeaderSize)); err != nil {
+		return errors.Wrap(err, "write snapshot header")
+	}
+
+	w := NewMemoryCondensor(s.logger)
+	w.newLog = NewWriterSize(fd, 1*1024*1024)
+	if err := writeSnapshotBody(w, state); err != nil {
+		return errors.Wrap(err, "write snapshot body")
+	}
+	if err := w.newLog.Flush(); err != nil {
+		return errors.Wrap(err, "flush snapshot body")
+	}
+
+	if _, err := fd.Seek(snapshotHeaderSize, io.SeekStart); err != nil {
+		return errors.Wrap(err, "seek snapshot body")
+	}
+	hash := crc32.NewIEEE()
+	n, err := io.Copy(hash, bufio.NewReaderSize(fd, 256*1024))
+	if err != nil {
+		return errors.Wrap(err, "checksum snapshot body")
+	}
+
+	header := make([]byte, snapshotHeaderSize)
+	header[0] = snapshotVersion
+	binary.LittleEndian.PutUint64(header[1:9], uint64(n))
+	binary.LittleEndian.PutUint32(header[9:13], hash.Sum32())
+	if _, err := fd.WriteAt(header, 0); err != nil {
+		return errors.Wrap(err, "write snapshot header")
+	}
+
+	if err := fd.Sync(); err != nil {
+		return errors.Wrap(err, "sync snapshot file")
+	}
+
+	return fd.Close()
+}
+
+// writeSnapshotBody encodes the full state using commit log entries. Unlike
+// the condensor, all links are written as replacements, so the snapshot can be
+// read without any prior state.
+func writeSnapshotBody(w *MemoryCondensor, state *DeserializationResult) error {
+	if state.Compressed {
+		if err := w.AddPQ(state.PQData); err != nil {
+			return fmt.Errorf("write pq data: %w", err)
+		}
+	}
+
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+
+		if err := w.AddNode(node); err != nil {
+			return errors.Wrapf(err, "write node %d", node.id)
+		}
+
+		for level, links := range node.connections {
+			if len(links) == 0 {
+				continue
+			}
+			if err := w.SetLinksAtLevel(node.id, level, links); err != nil {
+				return errors.Wrapf(err, "write links for node %d at level %d",
+					node.id, level)
+			}
+		}
+	}
+
+	if err := w.SetEntryPointWithMaxLayer(state.Entrypoint, int(state.Level)); err != nil {
+		return errors.Wrap(err, "write entrypoint")
+	}
+
+	tombstones := make([]uint64, 0, len(state.Tombstones))
+	for ts := range state.Tombstones {
+		tombstones = append(tombstones, ts)
+	}
+	sort.Slice(tombstones, func(a, b int) bool { return tombstones[a] < tombstones[b] })
+	for _, ts := range tombstones {
+		if err := w.AddTombstone(ts); err != nil {
+			return errors.Wrapf(err, "write tombstone for node %d", ts)
+		}
+	}
+
+	return nil
+}
+
+// createFromLogs builds a new snapshot from the latest existing one and the
+// given read-only commit logs. Nothing happens if fewer than minDelta of those
+// logs are not yet covered by the latest snapshot.
+func (s *snapshotter) createFromLogs(fileNames []string, minDelta int,
+	allocChecker memwatch.AllocChecker,
+) (bool, error) {
+	if len(fileNames) == 0 {
+		return false, nil
+	}
+	if minDelta < 1 {
+		minDelta = 1
+	}
+
+	path, coveredUntil, ok, err := s.latest()
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		coveredUntil = -1
+	}
+
+	delta, err := logsAfter(fileNames, coveredUntil)
+	if err != nil {
+		return false, err
+	}
+	if len(delta) < minDelta {
+		return false, nil
+	}
+
+	if allocChecker != nil {
+		// allocChecker is optional, so we can only check this if it's actually
+		// set. Same estimate as for the condensor: about 1B of memory for every
+		// byte on disk.
+		required, err := totalFileSize(delta)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			st, err := os.Stat(path)
+			if err != nil {
+				return false, errors.Wrapf(err, "stat snapshot %q", path)
+			}
+			required += st.Size()
+		}
+
+		if err := allocChecker.CheckAlloc(required); err != nil {
+			s.logger.WithFields(logrus.Fields{
+				"action": "hnsw_snapshot",
+				"event":  "snapshot_skipped_oom",
+				"id":     s.id,
+				"size":   required,
+			}).WithError(err).
+				Warnf("skipping hnsw snapshot due to memory pressure")
+			return false, nil
+		}
+	}
+
+	var state *DeserializationResult
+	if ok {
+		state, _, ok, err = s.load(fileNames)
+		if err != nil {
+			s.logger.WithError(err).
+				WithField("action", "hnsw_snapshot").
+				WithField("id", s.id).
+				Warn("discarding invalid snapshot, rebuilding from all commit logs")
+			coveredUntil = -1
+			delta = fileNames
+		}
+	}
+
+	for _, fileName := range delta {
+		state, err = s.replay(fileName, state)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	last, err := asTimeStamp(filepath.Base(delta[len(delta)-1]))
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.write(state, last); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *snapshotter) replay(fileName string,
+	state *DeserializationResult,
+) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open commit log %q for reading", fileName)
+	}
+	defer fd.Close()
+
+	state, _, err = NewDeserializer(s.logger).Do(bufio.NewReaderSize(fd, 256*1024),
+		state, false)
+	if err != nil {
+		// read-only commit logs are only ever truncated on startup, anything
+		// still unreadable here must not end up in a snapshot
+		return nil, errors.Wrapf(err, "deserialize commit log %q", fileName)
+	}
+
+	return state, nil
+}
+
+// logsAfter returns the commit logs that are not covered by a snapshot which
+// covers everything up to coveredUntil.
+func logsAfter(fileNames []string, coveredUntil int64) ([]string, error) {
+	out := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return nil, err
+		}
+		if ts > coveredUntil {
+			out = append(out, fileName)
+		}
+	}
+	return out, nil
+}
+
+func totalFileSize(fileNames []string) (int64, error) {
+	var total int64
+	for _, fileName := range fileNames {
+		st, err := os.Stat(fileName)
+		if err != nil {
+			return 0, errors.Wrapf(err, "stat %q", fileName)
+		}
+		total += st.Size()
+	}
+	return total, nil
+}
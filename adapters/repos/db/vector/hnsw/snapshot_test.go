@@ -0,0 +1,270 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSnapshots(t *testing.T) {
+	ctx := context.Background()
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 8)
+	logger, _ := test.NewNullLogger()
+	indexID := "snapshots"
+
+	newIndex := func(t *testing.T, rootPath string, snapshots bool) *hnsw {
+		index, err := New(Config{
+			RootPath: rootPath,
+			ID:       indexID,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(rootPath, indexID, logger,
+					cyclemanager.NewCallbackGroupNoop())
+			},
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			SnapshotsEnabled: snapshots,
+		}, ent.UserConfig{
+			MaxConnections:        16,
+			EFConstruction:        64,
+			VectorCacheMaxObjects: 1e12,
+		}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		require.Nil(t, err)
+		return index
+	}
+
+	closeIndex := func(t *testing.T, index *hnsw) {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+	}
+
+	addRange := func(t *testing.T, index *hnsw, from, to int) {
+		for i := from; i < to; i++ {
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+	}
+
+	// commit logs are named after the unix timestamp they were created at, to
+	// avoid waiting for seconds to pass the tests name them explicitly
+	startCommitLog := func(t *testing.T, rootPath string, ts string) {
+		f, err := os.Create(commitLogFileName(rootPath, indexID, ts))
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+	}
+
+	// builds a history of three commit logs with a snapshot covering the first
+	rootPath := t.TempDir()
+	index := newIndex(t, rootPath, false)
+	addRange(t, index, 0, 100)
+	closeIndex(t, index)
+
+	fileNames, err := getCommitFileNames(rootPath, indexID)
+	require.Nil(t, err)
+	require.Len(t, fileNames, 1)
+	require.Nil(t, os.Rename(fileNames[0], commitLogFileName(rootPath, indexID, "1000")))
+	startCommitLog(t, rootPath, "1001")
+
+	t.Run("startup writes snapshot covering all but the active log", func(t *testing.T) {
+		index := newIndex(t, rootPath, true)
+		addRange(t, index, 100, 200)
+		require.Nil(t, index.Delete(3, 7, 150))
+		closeIndex(t, index)
+
+		assertSnapshots(t, rootPath, indexID, "1000.snapshot")
+	})
+
+	startCommitLog(t, rootPath, "1002")
+
+	t.Run("restoring from snapshot matches full replay", func(t *testing.T) {
+		fullReplayPath := t.TempDir()
+		copyCommitLogs(t, rootPath, fullReplayPath, indexID)
+
+		fromSnapshot := newIndex(t, rootPath, true)
+		fullReplay := newIndex(t, fullReplayPath, false)
+
+		assertSameGraph(t, fullReplay, fromSnapshot)
+		assert.Len(t, fromSnapshot.tombstones, 3)
+		assertSnapshots(t, rootPath, indexID, "1001.snapshot")
+
+		addRange(t, fromSnapshot, 200, 250)
+		closeIndex(t, fromSnapshot)
+		closeIndex(t, fullReplay)
+	})
+
+	startCommitLog(t, rootPath, "1003")
+
+	t.Run("maintenance cycle writes snapshot", func(t *testing.T) {
+		cl, err := NewCommitLogger(rootPath, indexID, logger,
+			cyclemanager.NewCallbackGroupNoop(), WithSnapshots(2))
+		require.Nil(t, err)
+
+		executed, err := cl.createSnapshot()
+		require.Nil(t, err)
+		assert.False(t, executed, "only a single log is not covered yet")
+		assertSnapshots(t, rootPath, indexID, "1001.snapshot")
+
+		startCommitLog(t, rootPath, "1004")
+		executed, err = cl.createSnapshot()
+		require.Nil(t, err)
+		assert.True(t, executed)
+		assertSnapshots(t, rootPath, indexID, "1003.snapshot")
+		require.Nil(t, cl.Shutdown(ctx))
+	})
+
+	t.Run("corrupt snapshot falls back to full replay", func(t *testing.T) {
+		fullReplayPath := t.TempDir()
+		copyCommitLogs(t, rootPath, fullReplayPath, indexID)
+
+		path := filepath.Join(snapshotDirectory(rootPath, indexID), "1003.snapshot")
+		contents, err := os.ReadFile(path)
+		require.Nil(t, err)
+		contents[len(contents)/2] ^= 0xFF
+		require.Nil(t, os.WriteFile(path, contents, 0o666))
+
+		fromSnapshot := newIndex(t, rootPath, true)
+		fullReplay := newIndex(t, fullReplayPath, false)
+
+		assertSameGraph(t, fullReplay, fromSnapshot)
+		assertSnapshots(t, rootPath, indexID, "1003.snapshot")
+		closeIndex(t, fromSnapshot)
+		closeIndex(t, fullReplay)
+	})
+
+	t.Run("disabling snapshots removes them", func(t *testing.T) {
+		index := newIndex(t, rootPath, false)
+		closeIndex(t, index)
+
+		_, err := os.Stat(snapshotDirectory(rootPath, indexID))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestSnapshotStaleCommitLogs(t *testing.T) {
+	rootPath := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	s := newSnapshotter(rootPath, "main", logger)
+
+	state := &DeserializationResult{
+		Nodes:      []*vertex{{id: 0, connections: [][]uint64{{1}}}, {id: 1, connections: [][]uint64{{0}}}},
+		Tombstones: map[uint64]struct{}{},
+	}
+	require.Nil(t, s.write(state, 1000))
+
+	t.Run("snapshot covering present commit logs", func(t *testing.T) {
+		restored, coveredUntil, ok, err := s.load([]string{"1000", "1001"})
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, int64(1000), coveredUntil)
+		assert.Equal(t, []uint64{1}, restored.Nodes[0].connections[0])
+		assert.Equal(t, []uint64{0}, restored.Nodes[1].connections[0])
+	})
+
+	t.Run("snapshot without any covered commit log is discarded", func(t *testing.T) {
+		_, _, ok, err := s.load([]string{"2000"})
+		require.NotNil(t, err)
+		assert.False(t, ok)
+
+		_, _, ok, err = s.latest()
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestCombinerRespectsSnapshotBoundary(t *testing.T) {
+	type test struct {
+		first, second string
+		coveredUntil  int64
+		expected      bool
+	}
+
+	tests := []test{
+		{"1000.condensed", "1001.condensed", -1, false},
+		{"1000.condensed", "1001.condensed", 1000, true},
+		{"1000.condensed", "1001.condensed", 1001, false},
+		{"1000.condensed", "1001.condensed", 999, false},
+	}
+
+	for _, test := range tests {
+		spans, err := spansSnapshotBoundary(test.first, test.second, test.coveredUntil)
+		require.Nil(t, err)
+		assert.Equal(t, test.expected, spans, "%s + %s with snapshot at %d",
+			test.first, test.second, test.coveredUntil)
+	}
+}
+
+func assertSnapshots(t *testing.T, rootPath, indexID string, expected ...string) {
+	entries, err := os.ReadDir(snapshotDirectory(rootPath, indexID))
+	require.Nil(t, err)
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	assert.ElementsMatch(t, expected, names)
+}
+
+func copyCommitLogs(t *testing.T, src, dst, indexID string) {
+	require.Nil(t, os.MkdirAll(commitLogDirectory(dst, indexID), os.ModePerm))
+	fileNames, err := getCommitFileNames(src, indexID)
+	require.Nil(t, err)
+	for _, fileName := range fileNames {
+		contents, err := os.ReadFile(fileName)
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(commitLogFileName(dst, indexID,
+			filepath.Base(fileName)), contents, 0o666))
+	}
+}
+
+func assertSameGraph(t *testing.T, expected, actual *hnsw) {
+	assert.Equal(t, expected.entryPointID, actual.entryPointID)
+	assert.Equal(t, expected.currentMaximumLayer, actual.currentMaximumLayer)
+	assert.Equal(t, expected.tombstones, actual.tombstones)
+
+	nodes := func(h *hnsw) map[uint64]*vertex {
+		out := map[uint64]*vertex{}
+		for _, node := range h.nodes {
+			if node != nil {
+				out[node.id] = node
+			}
+		}
+		return out
+	}
+
+	expectedNodes, actualNodes := nodes(expected), nodes(actual)
+	require.Equal(t, len(expectedNodes), len(actualNodes))
+	for id, node := range expectedNodes {
+		other, ok := actualNodes[id]
+		require.True(t, ok, "node %d missing", id)
+		assert.Equal(t, node.level, other.level, "level of node %d", id)
+		for level, links := range node.connections {
+			if len(links) == 0 {
+				assert.True(t, len(other.connections) <= level ||
+					len(other.connections[level]) == 0, "links of node %d at level %d", id, level)
+				continue
+			}
+			assert.Equal(t, links, other.connections[level], "links of node %d at level %d", id, level)
+		}
+	}
+}
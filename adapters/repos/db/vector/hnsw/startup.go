@@ -16,6 +16,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -73,8 +74,18 @@ func (h *hnsw) restoreFromDisk() error {
 		return errors.Wrap(err, "corrupted commit log fixer")
 	}
 
-	var state *DeserializationResult
+	state, fileNames, err := h.restoreFromSnapshot(fileNames)
+	if err != nil {
+		return err
+	}
+
 	for i, fileName := range fileNames {
+		if h.snapshotsEnabled && i == len(fileNames)-1 && i > 0 {
+			// everything but the active commit log has been replayed, which is
+			// exactly what the next startup can skip
+			h.writeStartupSnapshot(state, fileNames[i-1])
+		}
+
 		beforeIndividual := time.Now()
 
 		fd, err := os.Open(fileName)
@@ -117,6 +128,10 @@ func (h *hnsw) restoreFromDisk() error {
 		h.metrics.TrackStartupIndividual(beforeIndividual)
 	}
 
+	if state == nil {
+		return nil
+	}
+
 	h.Lock()
 	h.shardedNodeLocks.LockAll()
 	h.nodes = state.Nodes
@@ -176,6 +191,71 @@ func (h *hnsw) restoreFromDisk() error {
 	return nil
 }
 
+// restoreFromSnapshot loads the latest snapshot, if any, and returns it
+// together with the commit logs that still need to be replayed on top of it.
+// Without a usable snapshot the state is nil and all commit logs are returned.
+func (h *hnsw) restoreFromSnapshot(fileNames []string) (*DeserializationResult, []string, error) {
+	snapshots := newSnapshotter(h.rootPath, h.id, h.logger)
+
+	if !h.snapshotsEnabled {
+		// without snapshots the commit log combiner is free to merge logs across
+		// the boundary of an existing snapshot, so it can't be trusted anymore
+		if err := snapshots.remove(); err != nil {
+			return nil, nil, err
+		}
+		return nil, fileNames, nil
+	}
+
+	before := time.Now()
+	state, coveredUntil, ok, err := snapshots.load(fileNames)
+	if err != nil {
+		h.logger.WithError(err).
+			WithField("action", "hnsw_load_snapshot").
+			WithField("id", h.id).
+			Warn("discarding invalid snapshot, falling back to full commit log replay")
+		return nil, fileNames, nil
+	}
+	if !ok {
+		return nil, fileNames, nil
+	}
+
+	remaining, err := logsAfter(fileNames, coveredUntil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action":         "hnsw_load_snapshot",
+		"id":             h.id,
+		"covered_until":  coveredUntil,
+		"skipped_logs":   len(fileNames) - len(remaining),
+		"remaining_logs": len(remaining),
+		"took":           time.Since(before),
+	}).Info("loaded hnsw snapshot")
+
+	return state, remaining, nil
+}
+
+// writeStartupSnapshot persists the state restored so far, which covers all
+// commit logs up to and including lastFileName. Failing to do so is not fatal,
+// the next startup simply has more commit logs to replay.
+func (h *hnsw) writeStartupSnapshot(state *DeserializationResult, lastFileName string) {
+	if state == nil {
+		return
+	}
+
+	coveredUntil, err := asTimeStamp(filepath.Base(lastFileName))
+	if err == nil {
+		err = newSnapshotter(h.rootPath, h.id, h.logger).write(state, coveredUntil)
+	}
+	if err != nil {
+		h.logger.WithError(err).
+			WithField("action", "hnsw_write_snapshot").
+			WithField("id", h.id).
+			Error("could not write hnsw snapshot on startup")
+	}
+}
+
 func (h *hnsw) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
 	if h.allocChecker != nil {
 		// allocChecker is optional, we can only check if it was actually set
@@ -16,6 +16,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/profile"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 )
 
@@ -42,3 +43,10 @@ type VectorIndex interface {
 	ContainsNode(id uint64) bool
 	DistancerProvider() distancer.Provider
 }
+
+// profiledVectorIndex is implemented by vector indexes which can describe how
+// a search was executed for query profiles
+type profiledVectorIndex interface {
+	SearchByVectorWithProfile(vector []float32, k int, allow helpers.AllowList,
+		vp *profile.Vector) ([]uint64, []float32, error)
+}
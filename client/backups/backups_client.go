@@ -49,6 +49,12 @@ type ClientService interface {
 
 	BackupsRestoreStatus(params *BackupsRestoreStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsRestoreStatusOK, error)
 
+	BackupsSchedulesDelete(params *BackupsSchedulesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesDeleteNoContent, error)
+
+	BackupsSchedulesList(params *BackupsSchedulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesListOK, error)
+
+	BackupsSchedulesPut(params *BackupsSchedulesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesPutOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -208,6 +214,123 @@ func (a *Client) BackupsRestoreStatus(params *BackupsRestoreStatusParams, authIn
 	panic(msg)
 }
 
+/*
+BackupsSchedulesDelete Deletes a backup schedule. Backups which were already created by the schedule are kept.
+*/
+func (a *Client) BackupsSchedulesDelete(params *BackupsSchedulesDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesDeleteNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesDeleteParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.delete",
+		Method:             "DELETE",
+		PathPattern:        "/backup-schedules/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesDeleteNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesList Returns all backup schedules of the cluster including the status of their last run
+*/
+func (a *Client) BackupsSchedulesList(params *BackupsSchedulesListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.list",
+		Method:             "GET",
+		PathPattern:        "/backup-schedules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+BackupsSchedulesPut Creates a backup schedule or replaces an existing one. The leader of the cluster starts the backups of the schedule and prunes them according to its retention policy.
+*/
+func (a *Client) BackupsSchedulesPut(params *BackupsSchedulesPutParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BackupsSchedulesPutOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBackupsSchedulesPutParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "backups.schedules.put",
+		Method:             "PUT",
+		PathPattern:        "/backup-schedules/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BackupsSchedulesPutReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BackupsSchedulesPutOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for backups.schedules.put: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
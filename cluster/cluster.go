@@ -37,15 +37,22 @@ type Service struct {
 func New(cfg store.Config) *Service {
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.RPCPort)
 	cl := transport.NewClient(transport.NewRPCResolver(cfg.IsLocalHost, cfg.RPCPort))
+	if cfg.ServerTLS != nil {
+		cl.WithTLS(cfg.ClientTLS)
+	}
 	fsm := store.New(cfg)
 	server := store.NewService(&fsm, cl)
+	rpcService := transport.New(&fsm, server, addr, cfg.Logger)
+	if cfg.ServerTLS != nil {
+		rpcService.WithTLS(cfg.ServerTLS)
+	}
 	return &Service{
 		Service:  server,
 		raftAddr: fmt.Sprintf("%s:%d", cfg.Host, cfg.RaftPort),
 
 		config:     &cfg,
 		client:     cl,
-		rpcService: transport.New(&fsm, server, addr, cfg.Logger),
+		rpcService: rpcService,
 		logger:     cfg.Logger,
 	}
 }
@@ -23,17 +23,21 @@ const (
 type ApplyRequest_Type int32
 
 const (
-	ApplyRequest_TYPE_UNSPECIFIED         ApplyRequest_Type = 0
-	ApplyRequest_TYPE_ADD_CLASS           ApplyRequest_Type = 1
-	ApplyRequest_TYPE_UPDATE_CLASS        ApplyRequest_Type = 2
-	ApplyRequest_TYPE_DELETE_CLASS        ApplyRequest_Type = 3
-	ApplyRequest_TYPE_RESTORE_CLASS       ApplyRequest_Type = 4
-	ApplyRequest_TYPE_ADD_PROPERTY        ApplyRequest_Type = 5
-	ApplyRequest_TYPE_UPDATE_SHARD_STATUS ApplyRequest_Type = 10
-	ApplyRequest_TYPE_ADD_TENANT          ApplyRequest_Type = 16
-	ApplyRequest_TYPE_UPDATE_TENANT       ApplyRequest_Type = 17
-	ApplyRequest_TYPE_DELETE_TENANT       ApplyRequest_Type = 18
-	ApplyRequest_TYPE_STORE_SCHEMA_V1     ApplyRequest_Type = 99
+	ApplyRequest_TYPE_UNSPECIFIED                   ApplyRequest_Type = 0
+	ApplyRequest_TYPE_ADD_CLASS                     ApplyRequest_Type = 1
+	ApplyRequest_TYPE_UPDATE_CLASS                  ApplyRequest_Type = 2
+	ApplyRequest_TYPE_DELETE_CLASS                  ApplyRequest_Type = 3
+	ApplyRequest_TYPE_RESTORE_CLASS                 ApplyRequest_Type = 4
+	ApplyRequest_TYPE_ADD_PROPERTY                  ApplyRequest_Type = 5
+	ApplyRequest_TYPE_UPDATE_SHARD_STATUS           ApplyRequest_Type = 10
+	ApplyRequest_TYPE_ADD_TENANT                    ApplyRequest_Type = 16
+	ApplyRequest_TYPE_UPDATE_TENANT                 ApplyRequest_Type = 17
+	ApplyRequest_TYPE_DELETE_TENANT                 ApplyRequest_Type = 18
+	ApplyRequest_TYPE_RESTORE_TENANT                ApplyRequest_Type = 19
+	ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE           ApplyRequest_Type = 20
+	ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE        ApplyRequest_Type = 21
+	ApplyRequest_TYPE_UPDATE_BACKUP_SCHEDULE_STATUS ApplyRequest_Type = 22
+	ApplyRequest_TYPE_STORE_SCHEMA_V1               ApplyRequest_Type = 99
 )
 
 // Enum value maps for ApplyRequest_Type.
@@ -49,20 +53,28 @@ var (
 		16: "TYPE_ADD_TENANT",
 		17: "TYPE_UPDATE_TENANT",
 		18: "TYPE_DELETE_TENANT",
+		19: "TYPE_RESTORE_TENANT",
+		20: "TYPE_PUT_BACKUP_SCHEDULE",
+		21: "TYPE_DELETE_BACKUP_SCHEDULE",
+		22: "TYPE_UPDATE_BACKUP_SCHEDULE_STATUS",
 		99: "TYPE_STORE_SCHEMA_V1",
 	}
 	ApplyRequest_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED":         0,
-		"TYPE_ADD_CLASS":           1,
-		"TYPE_UPDATE_CLASS":        2,
-		"TYPE_DELETE_CLASS":        3,
-		"TYPE_RESTORE_CLASS":       4,
-		"TYPE_ADD_PROPERTY":        5,
-		"TYPE_UPDATE_SHARD_STATUS": 10,
-		"TYPE_ADD_TENANT":          16,
-		"TYPE_UPDATE_TENANT":       17,
-		"TYPE_DELETE_TENANT":       18,
-		"TYPE_STORE_SCHEMA_V1":     99,
+		"TYPE_UNSPECIFIED":                   0,
+		"TYPE_ADD_CLASS":                     1,
+		"TYPE_UPDATE_CLASS":                  2,
+		"TYPE_DELETE_CLASS":                  3,
+		"TYPE_RESTORE_CLASS":                 4,
+		"TYPE_ADD_PROPERTY":                  5,
+		"TYPE_UPDATE_SHARD_STATUS":           10,
+		"TYPE_ADD_TENANT":                    16,
+		"TYPE_UPDATE_TENANT":                 17,
+		"TYPE_DELETE_TENANT":                 18,
+		"TYPE_RESTORE_TENANT":                19,
+		"TYPE_PUT_BACKUP_SCHEDULE":           20,
+		"TYPE_DELETE_BACKUP_SCHEDULE":        21,
+		"TYPE_UPDATE_BACKUP_SCHEDULE_STATUS": 22,
+		"TYPE_STORE_SCHEMA_V1":               99,
 	}
 )
 
@@ -901,7 +913,7 @@ var file_api_message_proto_rawDesc = []byte{
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
 	0x14, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x03, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xae, 0x04, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
 	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
@@ -911,7 +923,7 @@ var file_api_message_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
 	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f,
 	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73,
-	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x04, 0x54, 0x79,
+	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x8a, 0x03, 0x0a, 0x04, 0x54, 0x79,
 	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
 	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45,
 	0x5f, 0x41, 0x44, 0x44, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
@@ -926,7 +938,15 @@ var file_api_message_proto_rawDesc = []byte{
 	0x41, 0x44, 0x44, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x10, 0x12, 0x16, 0x0a, 0x12,
 	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x41,
 	0x4e, 0x54, 0x10, 0x11, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c,
-	0x45, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14,
+	0x45, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x12, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x4e,
+	0x41, 0x4e, 0x54, 0x10, 0x13, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55,
+	0x54, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x10, 0x14, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x10, 0x15, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x16, 0x12, 0x18, 0x0a, 0x14,
 	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d,
 	0x41, 0x5f, 0x56, 0x31, 0x10, 0x63, 0x22, 0x41, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
@@ -12,6 +12,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -73,3 +75,32 @@ type QueryShardOwnerResponse struct {
 	ShardVersion uint64
 	Owner        string
 }
+
+// BackupSchedule is the state of a backup schedule in the cluster store
+type BackupSchedule struct {
+	Schedule *models.BackupSchedule
+	Status   models.BackupScheduleStatus
+	// Backups lists the backups created by the schedule which have not been pruned yet
+	Backups []ScheduledBackup
+}
+
+// ScheduledBackup is a backup created by a backup schedule
+type ScheduledBackup struct {
+	ID        string
+	StartedAt time.Time
+	Status    string
+}
+
+type PutBackupScheduleRequest struct {
+	Schedule *models.BackupSchedule
+}
+
+type DeleteBackupScheduleRequest struct {
+	ID string
+}
+
+type UpdateBackupScheduleStatusRequest struct {
+	ID      string
+	Status  models.BackupScheduleStatus
+	Backups []ScheduledBackup
+}
@@ -163,6 +163,26 @@ func (db *localDB) AddTenants(cmd *command.ApplyRequest, schemaOnly bool) error
 		schemaOnly)
 }
 
+// RestoreTenants moves the restored files of tenants in place before adding
+// them to an existing class
+func (db *localDB) RestoreTenants(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := &command.AddTenantsRequest{}
+	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+
+	if err := db.store.RestoreClassDir(cmd.Class); err != nil {
+		db.log.Error("restore tenant directories from backup %s: "+err.Error(), "class", cmd.Class)
+		// continue since we need to add tenants to the schema anyway
+	}
+
+	return db.apply(
+		cmd.GetType().String(),
+		func() error { return db.Schema.addTenants(cmd.Class, cmd.Version, req) },
+		func() error { return db.store.AddTenants(cmd.Class, req) },
+		schemaOnly)
+}
+
 func (db *localDB) UpdateTenants(cmd *command.ApplyRequest, schemaOnly bool) (n int, err error) {
 	req := &command.UpdateTenantsRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
@@ -189,6 +209,48 @@ func (db *localDB) DeleteTenants(cmd *command.ApplyRequest, schemaOnly bool) err
 		schemaOnly)
 }
 
+func (db *localDB) PutBackupSchedule(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.PutBackupScheduleRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+	if req.Schedule == nil || req.Schedule.ID == "" {
+		return fmt.Errorf("%w: empty backup schedule", errBadRequest)
+	}
+
+	return db.apply(
+		cmd.GetType().String(),
+		func() error { return db.Schema.putBackupSchedule(req.Schedule) },
+		nil,
+		schemaOnly)
+}
+
+func (db *localDB) DeleteBackupSchedule(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.DeleteBackupScheduleRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+
+	return db.apply(
+		cmd.GetType().String(),
+		func() error { return db.Schema.deleteBackupSchedule(req.ID) },
+		nil,
+		schemaOnly)
+}
+
+func (db *localDB) UpdateBackupScheduleStatus(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.UpdateBackupScheduleStatusRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", errBadRequest, err)
+	}
+
+	return db.apply(
+		cmd.GetType().String(),
+		func() error { return db.Schema.updateBackupScheduleStatus(&req) },
+		nil,
+		schemaOnly)
+}
+
 func (db *localDB) Load(ctx context.Context, nodeID string) error {
 	if err := db.store.Open(ctx); err != nil {
 		return err
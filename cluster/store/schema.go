@@ -14,6 +14,7 @@ package store
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
@@ -28,6 +29,7 @@ var (
 	errClassExists         = errors.New("class already exists")
 	errShardNotFound       = errors.New("shard not found")
 	errSchemaVersionTooLow = errors.New("minimum requested schema version could not be reached")
+	errScheduleNotFound    = errors.New("backup schedule not found")
 )
 
 type ClassInfo struct {
@@ -44,7 +46,8 @@ type schema struct {
 	nodeID      string
 	shardReader shardReader
 	sync.RWMutex
-	Classes map[string]*metaClass
+	Classes         map[string]*metaClass
+	BackupSchedules map[string]*command.BackupSchedule
 }
 
 func (s *schema) ClassInfo(class string, version uint64) (ClassInfo, uint64) {
@@ -193,9 +196,10 @@ type shardReader interface {
 
 func NewSchema(nodeID string, shardReader shardReader) *schema {
 	return &schema{
-		nodeID:      nodeID,
-		Classes:     make(map[string]*metaClass, 128),
-		shardReader: shardReader,
+		nodeID:          nodeID,
+		Classes:         make(map[string]*metaClass, 128),
+		BackupSchedules: make(map[string]*command.BackupSchedule),
+		shardReader:     shardReader,
 	}
 }
 
@@ -338,4 +342,60 @@ func (s *schema) clear() {
 	for k := range s.Classes {
 		delete(s.Classes, k)
 	}
+	for k := range s.BackupSchedules {
+		delete(s.BackupSchedules, k)
+	}
+}
+
+// backupSchedules returns a copy of all backup schedules sorted by their ID.
+// The schedule definitions are shared and must not be modified.
+func (s *schema) backupSchedules() []command.BackupSchedule {
+	s.RLock()
+	defer s.RUnlock()
+	xs := make([]command.BackupSchedule, 0, len(s.BackupSchedules))
+	for _, x := range s.BackupSchedules {
+		cp := *x
+		cp.Backups = append([]command.ScheduledBackup(nil), x.Backups...)
+		xs = append(xs, cp)
+	}
+	sort.Slice(xs, func(i, j int) bool { return xs[i].Schedule.ID < xs[j].Schedule.ID })
+	return xs
+}
+
+// putBackupSchedule adds a new schedule or replaces the definition of an
+// existing one. The status and the backups of an existing schedule are kept.
+func (s *schema) putBackupSchedule(x *models.BackupSchedule) error {
+	s.Lock()
+	defer s.Unlock()
+	if old, ok := s.BackupSchedules[x.ID]; ok {
+		old.Schedule = x
+		return nil
+	}
+	s.BackupSchedules[x.ID] = &command.BackupSchedule{
+		Schedule: x,
+		Status:   models.BackupScheduleStatus{ID: x.ID},
+	}
+	return nil
+}
+
+func (s *schema) deleteBackupSchedule(id string) error {
+	s.Lock()
+	defer s.Unlock()
+	if _, ok := s.BackupSchedules[id]; !ok {
+		return fmt.Errorf("%w: %q", errScheduleNotFound, id)
+	}
+	delete(s.BackupSchedules, id)
+	return nil
+}
+
+func (s *schema) updateBackupScheduleStatus(req *command.UpdateBackupScheduleStatusRequest) error {
+	s.Lock()
+	defer s.Unlock()
+	x, ok := s.BackupSchedules[req.ID]
+	if !ok {
+		return fmt.Errorf("%w: %q", errScheduleNotFound, req.ID)
+	}
+	x.Status = req.Status
+	x.Backups = req.Backups
+	return nil
 }
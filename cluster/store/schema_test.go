@@ -15,9 +15,11 @@ import (
 	"bytes"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -135,6 +137,12 @@ func TestSchemaSnapshot(t *testing.T) {
 	)
 	ss.SetLocalName(node)
 	assert.Nil(t, sc.addClass(cls, ss, 1))
+	assert.Nil(t, sc.putBackupSchedule(&models.BackupSchedule{ID: "daily", Cron: "@daily", Backend: "s3"}))
+	assert.Nil(t, sc.updateBackupScheduleStatus(&cmd.UpdateBackupScheduleStatusRequest{
+		ID:      "daily",
+		Status:  models.BackupScheduleStatus{ID: "daily", LastBackupID: "daily-1", LastStatus: "SUCCESS"},
+		Backups: []cmd.ScheduledBackup{{ID: "daily-1", StartedAt: time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC), Status: "SUCCESS"}},
+	}))
 	parser.On("ParseClass", mock.Anything).Return(nil)
 
 	// Create Snapshot
@@ -145,6 +153,7 @@ func TestSchemaSnapshot(t *testing.T) {
 	sc2 := NewSchema("N1", &MockIndexer{})
 	assert.Nil(t, sc2.Restore(sink, parser))
 	assert.Equal(t, sc.Classes, sc2.Classes)
+	assert.Equal(t, sc.backupSchedules(), sc2.backupSchedules())
 
 	// Encoding error
 	sink2 := &MockSnapshotSink{wErr: errAny, rErr: errAny}
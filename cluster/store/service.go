@@ -186,6 +186,23 @@ func (s *Service) AddTenants(class string, req *cmd.AddTenantsRequest) (uint64,
 	return s.Execute(command)
 }
 
+// RestoreTenants adds tenants restored from a backup to an existing class
+func (s *Service) RestoreTenants(class string, req *cmd.AddTenantsRequest) (uint64, error) {
+	if class == "" || req == nil {
+		return 0, fmt.Errorf("empty class name or nil request : %w", errBadRequest)
+	}
+	subCommand, err := proto.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_RESTORE_TENANT,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
 func (s *Service) UpdateTenants(class string, req *cmd.UpdateTenantsRequest) (uint64, error) {
 	if class == "" || req == nil {
 		return 0, fmt.Errorf("empty class name or nil request : %w", errBadRequest)
@@ -218,6 +235,66 @@ func (s *Service) DeleteTenants(class string, req *cmd.DeleteTenantsRequest) (ui
 	return s.Execute(command)
 }
 
+// PutBackupSchedule adds a backup schedule or replaces the definition of an existing one
+func (s *Service) PutBackupSchedule(x *models.BackupSchedule) (uint64, error) {
+	if x == nil || x.ID == "" {
+		return 0, fmt.Errorf("nil schedule or empty schedule id : %w", errBadRequest)
+	}
+	req := cmd.PutBackupScheduleRequest{Schedule: x}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+func (s *Service) DeleteBackupSchedule(id string) (uint64, error) {
+	if id == "" {
+		return 0, fmt.Errorf("empty schedule id : %w", errBadRequest)
+	}
+	req := cmd.DeleteBackupScheduleRequest{ID: id}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+// UpdateBackupScheduleStatus records the outcome of a run of a backup schedule
+// together with the backups of the schedule which have not been pruned yet
+func (s *Service) UpdateBackupScheduleStatus(req *cmd.UpdateBackupScheduleStatusRequest) (uint64, error) {
+	if req == nil || req.ID == "" {
+		return 0, fmt.Errorf("nil request or empty schedule id : %w", errBadRequest)
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPDATE_BACKUP_SCHEDULE_STATUS,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+// BackupSchedules returns the backup schedules known to the local store
+func (s *Service) BackupSchedules() []cmd.BackupSchedule {
+	return s.store.db.Schema.backupSchedules()
+}
+
+// IsLeader returns whether this node is the leader of the cluster
+func (s *Service) IsLeader() bool {
+	return s.store.IsLeader()
+}
+
 func (s *Service) StoreSchemaV1() error {
 	command := &cmd.ApplyRequest{
 		Type: cmd.ApplyRequest_TYPE_STORE_SCHEMA_V1,
@@ -17,6 +17,7 @@ import (
 	"io"
 
 	"github.com/hashicorp/raft"
+	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -25,6 +26,8 @@ type snapshot struct {
 	NodeID     string                `json:"node_id"`
 	SnapshotID string                `json:"snapshot_id"`
 	Classes    map[string]*metaClass `json:"classes"`
+
+	BackupSchedules map[string]*api.BackupSchedule `json:"backup_schedules,omitempty"`
 }
 
 func (s *schema) Restore(r io.Reader, parser Parser) error {
@@ -42,6 +45,10 @@ func (s *schema) Restore(r io.Reader, parser Parser) error {
 	s.Lock()
 	defer s.Unlock()
 	s.Classes = snap.Classes
+	s.BackupSchedules = snap.BackupSchedules
+	if s.BackupSchedules == nil {
+		s.BackupSchedules = make(map[string]*api.BackupSchedule)
+	}
 
 	return nil
 }
@@ -57,6 +64,8 @@ func (s *schema) Persist(sink raft.SnapshotSink) (err error) {
 		NodeID:     s.nodeID,
 		SnapshotID: sink.ID(),
 		Classes:    s.Classes,
+
+		BackupSchedules: s.BackupSchedules,
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
@@ -13,6 +13,7 @@ package store
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -122,6 +123,11 @@ type Config struct {
 	SaveLegacySchema SaveLegacySchema
 	// IsLocalHost only required when running Weaviate from the console in localhost
 	IsLocalHost bool
+
+	// ServerTLS enables TLS for the Raft and RPC transports if set.
+	// ClientTLS provides the client configuration for every new connection.
+	ServerTLS *tls.Config
+	ClientTLS func() *tls.Config
 }
 
 type Store struct {
@@ -521,12 +527,24 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 	case api.ApplyRequest_TYPE_ADD_TENANT:
 		ret.Error = st.db.AddTenants(&cmd, schemaOnly)
 
+	case api.ApplyRequest_TYPE_RESTORE_TENANT:
+		ret.Error = st.db.RestoreTenants(&cmd, schemaOnly)
+
 	case api.ApplyRequest_TYPE_UPDATE_TENANT:
 		ret.Data, ret.Error = st.db.UpdateTenants(&cmd, schemaOnly)
 
 	case api.ApplyRequest_TYPE_DELETE_TENANT:
 		ret.Error = st.db.DeleteTenants(&cmd, schemaOnly)
 
+	case api.ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE:
+		ret.Error = st.db.PutBackupSchedule(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE:
+		ret.Error = st.db.DeleteBackupSchedule(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_UPDATE_BACKUP_SCHEDULE_STATUS:
+		ret.Error = st.db.UpdateBackupScheduleStatus(&cmd, schemaOnly)
+
 	case api.ApplyRequest_TYPE_STORE_SCHEMA_V1:
 		ret.Error = st.StoreSchemaV1()
 
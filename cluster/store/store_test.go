@@ -693,6 +693,86 @@ func TestStoreApply(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name:     "PutBackupSchedule/EmptySchedule",
+			req:      raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE, cmd.PutBackupScheduleRequest{}, nil)},
+			resp:     Response{Error: errBadRequest},
+			doBefore: doFirst,
+		},
+		{
+			name: "PutBackupSchedule/Success",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_PUT_BACKUP_SCHEDULE,
+				cmd.PutBackupScheduleRequest{Schedule: &models.BackupSchedule{ID: "daily", Cron: "@hourly"}}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				m.indexer.On("Open", mock.Anything).Return(nil)
+				m.store.db.Schema.putBackupSchedule(&models.BackupSchedule{ID: "daily", Cron: "@daily"})
+				m.store.db.Schema.updateBackupScheduleStatus(&cmd.UpdateBackupScheduleStatusRequest{
+					ID: "daily", Backups: []cmd.ScheduledBackup{{ID: "daily-1"}},
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				x := ms.store.db.Schema.BackupSchedules["daily"]
+				if x == nil || x.Schedule.Cron != "@hourly" {
+					return fmt.Errorf("schedule has not been replaced")
+				}
+				if len(x.Backups) != 1 {
+					return fmt.Errorf("backups of the schedule must be kept")
+				}
+				return nil
+			},
+		},
+		{
+			name: "DeleteBackupSchedule/NotFound",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE,
+				cmd.DeleteBackupScheduleRequest{ID: "daily"}, nil)},
+			resp:     Response{Error: errSchema},
+			doBefore: doFirst,
+		},
+		{
+			name: "DeleteBackupSchedule/Success",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE,
+				cmd.DeleteBackupScheduleRequest{ID: "daily"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				m.indexer.On("Open", mock.Anything).Return(nil)
+				m.store.db.Schema.putBackupSchedule(&models.BackupSchedule{ID: "daily"})
+			},
+			doAfter: func(ms *MockStore) error {
+				if len(ms.store.db.Schema.BackupSchedules) != 0 {
+					return fmt.Errorf("schedule must be deleted")
+				}
+				return nil
+			},
+		},
+		{
+			name: "UpdateBackupScheduleStatus/NotFound",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_UPDATE_BACKUP_SCHEDULE_STATUS,
+				cmd.UpdateBackupScheduleStatusRequest{ID: "daily"}, nil)},
+			resp:     Response{Error: errSchema},
+			doBefore: doFirst,
+		},
+		{
+			name: "UpdateBackupScheduleStatus/Success",
+			req: raft.Log{Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_UPDATE_BACKUP_SCHEDULE_STATUS,
+				cmd.UpdateBackupScheduleStatusRequest{
+					ID:      "daily",
+					Status:  models.BackupScheduleStatus{ID: "daily", LastBackupID: "daily-2", LastStatus: "SUCCESS"},
+					Backups: []cmd.ScheduledBackup{{ID: "daily-1"}, {ID: "daily-2"}},
+				}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				m.indexer.On("Open", mock.Anything).Return(nil)
+				m.store.db.Schema.putBackupSchedule(&models.BackupSchedule{ID: "daily"})
+			},
+			doAfter: func(ms *MockStore) error {
+				x := ms.store.db.Schema.BackupSchedules["daily"]
+				if x.Status.LastBackupID != "daily-2" || len(x.Backups) != 2 {
+					return fmt.Errorf("status has not been updated: %+v", x)
+				}
+				return nil
+			},
+		},
 	}
 
 	for _, tc := range tests {
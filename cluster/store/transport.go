@@ -12,6 +12,8 @@
 package store
 
 import (
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -31,6 +33,9 @@ type addrResolver struct {
 	// IsLocalCluster is cluster running Weaviate from the console in localhost
 	IsLocalCluster   bool
 	NodeName2PortMap map[string]int
+
+	serverTLS *tls.Config
+	clientTLS func() *tls.Config
 }
 
 func newAddrResolver(cfg *Config) *addrResolver {
@@ -39,6 +44,8 @@ func newAddrResolver(cfg *Config) *addrResolver {
 		RaftPort:         cfg.RaftPort,
 		IsLocalCluster:   cfg.IsLocalHost,
 		NodeName2PortMap: cfg.ServerName2PortMap,
+		serverTLS:        cfg.ServerTLS,
+		clientTLS:        cfg.ClientTLS,
 	}
 }
 
@@ -68,6 +75,49 @@ func (a *addrResolver) NewTCPTransport(
 		MaxPool:               tcpMaxPool,
 		Timeout:               tcpTimeout,
 	}
+	if a.serverTLS == nil {
+		return raft.NewTCPTransportWithConfig(bindAddr, advertise, cfg)
+	}
+
+	ln, err := net.Listen("tcp", bindAddr)
+	if err != nil {
+		return nil, err
+	}
+	stream := &tlsStreamLayer{
+		Listener:  tls.NewListener(ln, a.serverTLS),
+		advertise: advertise,
+		clientTLS: a.clientTLS,
+	}
+	if addr, ok := stream.Addr().(*net.TCPAddr); !ok || addr.IP == nil || addr.IP.IsUnspecified() {
+		ln.Close()
+		return nil, errors.New("local bind address is not advertisable")
+	}
+	cfg.Stream = stream
+	return raft.NewNetworkTransportWithConfig(cfg), nil
+}
 
-	return raft.NewTCPTransportWithConfig(bindAddr, advertise, cfg)
+// tlsStreamLayer is a raft.StreamLayer encrypting the traffic between nodes
+type tlsStreamLayer struct {
+	net.Listener
+	advertise net.Addr
+	clientTLS func() *tls.Config
+}
+
+func (l *tlsStreamLayer) Addr() net.Addr {
+	if l.advertise != nil {
+		return l.advertise
+	}
+	return l.Listener.Addr()
+}
+
+func (l *tlsStreamLayer) Dial(address raft.ServerAddress, timeout time.Duration) (net.Conn, error) {
+	cfg := l.clientTLS()
+	if cfg.ServerName == "" {
+		host, _, err := net.SplitHostPort(string(address))
+		if err != nil {
+			return nil, err
+		}
+		cfg.ServerName = host
+	}
+	return tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", string(address), cfg)
 }
@@ -13,12 +13,14 @@ package transport
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"strconv"
 
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -59,12 +61,27 @@ type rpcAddressResolver interface {
 // Client is used for communication with remote nodes in a RAFT cluster.
 type Client struct {
 	rpc rpcAddressResolver
+	tls func() *tls.Config
 }
 
 func NewClient(r rpcAddressResolver) *Client {
 	return &Client{rpc: r}
 }
 
+// WithTLS makes the client connect to remote nodes using TLS. cfg is called
+// for every connection.
+func (cl *Client) WithTLS(cfg func() *tls.Config) *Client {
+	cl.tls = cfg
+	return cl
+}
+
+func (cl *Client) credentials() grpc.DialOption {
+	if cl.tls == nil {
+		return grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(cl.tls()))
+}
+
 // Join joins this node to an existing cluster identified by its leader's address.
 // If a new leader has been elected, the request is redirected to the new leader.
 func (cl *Client) Join(ctx context.Context, leaderAddr string, req *cmd.JoinPeerRequest) (*cmd.JoinPeerResponse, error) {
@@ -73,7 +90,7 @@ func (cl *Client) Join(ctx context.Context, leaderAddr string, req *cmd.JoinPeer
 		return nil, fmt.Errorf("resolve address: %w", err)
 	}
 
-	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, addr, cl.credentials())
 	if err != nil {
 		return nil, fmt.Errorf("dial: %w", err)
 	}
@@ -89,7 +106,7 @@ func (cl *Client) Notify(ctx context.Context, rAddr string, req *cmd.NotifyPeerR
 		return nil, fmt.Errorf("resolve address: %w", err)
 	}
 
-	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, addr, cl.credentials())
 	if err != nil {
 		return nil, fmt.Errorf("dial: %w", err)
 	}
@@ -106,7 +123,7 @@ func (cl *Client) Remove(ctx context.Context, leaderAddress string, req *cmd.Rem
 		return nil, fmt.Errorf("resolve address: %w", err)
 	}
 
-	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.DialContext(ctx, addr, cl.credentials())
 	if err != nil {
 		return nil, fmt.Errorf("dial: %w", err)
 	}
@@ -125,7 +142,7 @@ func (cl *Client) Apply(leaderAddr string, req *cmd.ApplyRequest) (*cmd.ApplyRes
 	conn, err := grpc.DialContext(
 		ctx,
 		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cl.credentials(),
 		grpc.WithDefaultServiceConfig(serviceConfig),
 	)
 	if err != nil {
@@ -145,7 +162,7 @@ func (cl *Client) Query(ctx context.Context, leaderAddress string, req *cmd.Quer
 	conn, err := grpc.DialContext(
 		ctx,
 		addr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cl.credentials(),
 		grpc.WithDefaultServiceConfig(serviceConfig),
 	)
 	if err != nil {
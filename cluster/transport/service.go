@@ -13,6 +13,7 @@ package transport
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -22,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/store"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 )
 
@@ -44,6 +46,7 @@ type Service struct {
 	ln         net.Listener
 	grpcServer *grpc.Server
 	log        *slog.Logger
+	tls        *tls.Config
 }
 
 func New(ms members, ex executor, address string, l *slog.Logger) *Service {
@@ -55,6 +58,12 @@ func New(ms members, ex executor, address string, l *slog.Logger) *Service {
 	}
 }
 
+// WithTLS makes the service accept TLS connections only
+func (s *Service) WithTLS(cfg *tls.Config) *Service {
+	s.tls = cfg
+	return s
+}
+
 func (s *Service) JoinPeer(_ context.Context, req *cmd.JoinPeerRequest) (*cmd.JoinPeerResponse, error) {
 	err := s.members.Join(req.Id, req.Address, req.Voter)
 	if err == nil {
@@ -108,7 +117,11 @@ func (s *Service) Open() error {
 	}
 
 	s.ln = ln
-	s.grpcServer = grpc.NewServer()
+	var opts []grpc.ServerOption
+	if s.tls != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tls)))
+	}
+	s.grpcServer = grpc.NewServer(opts...)
 	cmd.RegisterClusterServiceServer(s.grpcServer, s)
 	go func() {
 		if err := s.grpcServer.Serve(s.ln); err != nil {
@@ -36,6 +36,7 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Profile            bool                   `json:"profile"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
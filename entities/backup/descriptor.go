@@ -13,6 +13,7 @@ package backup
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -35,6 +36,8 @@ type DistributedBackupDescriptor struct {
 	ServerVersion string                     `json:"serverVersion"`
 	Leader        string                     `json:"leader"`
 	Error         string                     `json:"error"`
+	// BaseBackupID is the backup an incremental backup is based on
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -224,6 +227,52 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// Segments lists the immutable LSM segment files of the shard. Segments
+	// which did not change since the base backup of an incremental backup
+	// are not contained in Files, they are restored from the backup which
+	// stores them.
+	Segments []SegmentFile `json:"segments,omitempty"`
+}
+
+// SegmentFile describes an immutable LSM segment file of a shard
+type SegmentFile struct {
+	Path    string `json:"path"`
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"modTime"` // unix nano
+	// BackupID is the backup storing the file, empty if it is stored in the
+	// backup this descriptor belongs to
+	BackupID string `json:"backupId,omitempty"`
+	// Chunk of BackupID containing the file
+	Chunk int32 `json:"chunk,omitempty"`
+}
+
+// Unchanged reports whether f describes the same file as other
+func (f *SegmentFile) Unchanged(other *SegmentFile) bool {
+	return f.Path == other.Path && f.Size == other.Size && f.ModTime == other.ModTime
+}
+
+// BaseSegments returns the segment files of s which are stored in other
+// backups, indexed by the backup and chunk containing them
+func (s *ShardDescriptor) BaseSegments() map[BackupChunk][]string {
+	var out map[BackupChunk][]string
+	for _, f := range s.Segments {
+		if f.BackupID == "" {
+			continue
+		}
+		if out == nil {
+			out = make(map[BackupChunk][]string)
+		}
+		key := BackupChunk{BackupID: f.BackupID, Chunk: f.Chunk}
+		out[key] = append(out[key], f.Path)
+	}
+	return out
+}
+
+// BackupChunk identifies a chunk of the shard files of a class in a backup
+type BackupChunk struct {
+	BackupID string
+	Chunk    int32
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -259,6 +308,42 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	// BaseBackupID is the backup an incremental backup is based on
+	BaseBackupID string `json:"baseBackupId,omitempty"`
+	// Compression is the codec of the chunks, empty for backups created
+	// before the codec was selectable, which use gzip
+	Compression string `json:"compression,omitempty"`
+	// Encryption describes the encryption of the chunks, nil if they are
+	// stored in plaintext
+	Encryption *Encryption `json:"encryption,omitempty"`
+}
+
+// Encryption describes how the chunks of a backup are encrypted
+type Encryption struct {
+	Algorithm string `json:"algorithm"`
+	// KeyID identifies the key without revealing it
+	KeyID string `json:"keyId"`
+}
+
+// BaseBackups returns the IDs of all backups storing files which are
+// referenced by d. They are required to restore d.
+func (d *BackupDescriptor) BaseBackups() []string {
+	set := map[string]struct{}{}
+	for _, c := range d.Classes {
+		for _, s := range c.Shards {
+			for _, f := range s.Segments {
+				if f.BackupID != "" {
+					set[f.BackupID] = struct{}{}
+				}
+			}
+		}
+	}
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
 }
 
 // List all existing classes in d
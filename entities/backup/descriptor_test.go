@@ -473,3 +473,34 @@ func TestShardDescriptorClear(t *testing.T) {
 	s.ClearTemporary()
 	assert.Equal(t, want, s)
 }
+
+func TestBaseSegments(t *testing.T) {
+	sd := ShardDescriptor{Segments: []SegmentFile{
+		{Path: "a/segment-1.db"},
+		{Path: "a/segment-2.db", BackupID: "b1", Chunk: 1},
+		{Path: "a/segment-2.bloom", BackupID: "b1", Chunk: 1},
+		{Path: "a/segment-3.db", BackupID: "b2", Chunk: 4},
+	}}
+	assert.Equal(t, map[BackupChunk][]string{
+		{BackupID: "b1", Chunk: 1}: {"a/segment-2.db", "a/segment-2.bloom"},
+		{BackupID: "b2", Chunk: 4}: {"a/segment-3.db"},
+	}, sd.BaseSegments())
+	assert.Nil(t, (&ShardDescriptor{}).BaseSegments())
+
+	d := BackupDescriptor{Classes: []ClassDescriptor{
+		{Name: "A", Shards: []*ShardDescriptor{&sd}},
+		{Name: "B", Shards: []*ShardDescriptor{{Segments: []SegmentFile{
+			{Path: "b/segment-1.db", BackupID: "b0"},
+		}}}},
+	}}
+	assert.Equal(t, []string{"b0", "b1", "b2"}, d.BaseBackups())
+	assert.Empty(t, (&BackupDescriptor{}).BaseBackups())
+}
+
+func TestSegmentFileUnchanged(t *testing.T) {
+	f := SegmentFile{Path: "segment-1.db", Size: 10, ModTime: 100}
+	assert.True(t, f.Unchanged(&SegmentFile{Path: "segment-1.db", Size: 10, ModTime: 100, BackupID: "b1"}))
+	assert.False(t, f.Unchanged(&SegmentFile{Path: "segment-1.db", Size: 11, ModTime: 100}))
+	assert.False(t, f.Unchanged(&SegmentFile{Path: "segment-1.db", Size: 10, ModTime: 101}))
+	assert.False(t, f.Unchanged(&SegmentFile{Path: "segment-2.db", Size: 10, ModTime: 100}))
+}
@@ -0,0 +1,476 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changelog
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// offsetReservation is the number of offsets that are persisted ahead of the
+// current position. Instead of writing the offset file on every append, the
+// log only writes it once all reserved offsets have been used up. After a
+// restart the log continues at the reserved watermark, so offsets stay
+// strictly monotonic, but may contain gaps.
+const offsetReservation = 1024
+
+const (
+	// headerSize is the size of the events file header, which contains the
+	// capacity the file was written with
+	headerSize = 8
+	// recordSize is the size of a single event in the events file: offset+1
+	// (so that zeroed, never written slots can be told apart), operation,
+	// timestamp in unix nanoseconds and the object id
+	recordSize = 8 + 1 + 8 + 16
+	// readBatchSize is the max number of events a reader reads from disk
+	// while holding the lock
+	readBatchSize = 1000
+)
+
+var (
+	// ErrOffsetOutOfRange is returned when a reader requests an offset that is
+	// no longer retained (or has never been written). The reader needs to
+	// re-sync, e.g. using the cursor API, and resume from the oldest offset.
+	ErrOffsetOutOfRange = errors.New("offset out of range")
+
+	// ErrClosed is returned to readers once the log has been closed, e.g.
+	// because the owning shard is shut down or dropped.
+	ErrClosed = errors.New("change log closed")
+)
+
+type Operation uint8
+
+const (
+	OperationPut Operation = iota + 1
+	OperationMerge
+	OperationDelete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationPut:
+		return "put"
+	case OperationMerge:
+		return "merge"
+	case OperationDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Event describes a single mutation of an object in a shard. The log itself
+// only stores the id of the mutated object. Object is not set by the log, but
+// may be filled in by readers which load the current state of the object
+// from the shard. It is nil for deletes and objects deleted in the meantime.
+type Event struct {
+	Offset    uint64
+	Operation Operation
+	ID        strfmt.UUID
+	Class     string
+	Tenant    string
+	Timestamp time.Time
+	Object    *storobj.Object
+}
+
+// Log is an ordered, bounded log of the object mutations of a single shard.
+// Every event is assigned a monotonically increasing offset which readers
+// can use to resume a stream. Only the most recent events are retained;
+// older events are evicted once the capacity is reached.
+//
+// Events are stored in a fixed-size ring file next to the shard, so they
+// survive restarts and don't take up any memory. The file only grows as
+// events are appended.
+//
+// All methods are safe to call on a nil *Log, in which case appends are
+// no-ops. This allows callers to skip any checks when the change log is
+// disabled.
+type Log struct {
+	sync.Mutex
+	class    string
+	tenant   string
+	capacity uint64
+	// start is the offset the log was started with, retainedFirst and
+	// retainedLast are the range of offsets found in the events file on
+	// startup, if any. They are retained until their slots are overwritten.
+	start         uint64
+	retainedFirst uint64
+	retainedLast  uint64
+	hasRetained   bool
+	next          uint64
+	// reserved is the offset persisted to disk, all offsets below it may be
+	// handed out without touching the file again
+	reserved uint64
+	notify   chan struct{}
+	closed   bool
+	f        *os.File
+	events   *os.File
+	// hasHeader is set once the header of the events file has been written
+	hasHeader bool
+}
+
+// New creates a change log for a shard that retains up to capacity events.
+// The offset position and the events are persisted in files in shardPath, so
+// that offsets keep increasing and events are retained across restarts. If
+// the capacity changed since the events were written, they are discarded.
+func New(shardPath, class, tenant string, capacity int) (*Log, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("invalid change log capacity %d", capacity)
+	}
+
+	fileName := fmt.Sprintf("%s/changelog.offset", shardPath)
+	f, err := os.OpenFile(fileName, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var next uint64
+	if stat.Size() > 0 {
+		// the file has existed before, continue after the previously reserved
+		// offsets
+		if err := binary.Read(f, binary.LittleEndian, &next); err != nil {
+			return nil, fmt.Errorf("read change log offset from file: %w", err)
+		}
+	}
+
+	eventsFileName := fmt.Sprintf("%s/changelog.events", shardPath)
+	events, err := os.OpenFile(eventsFileName, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Log{
+		class:    class,
+		tenant:   tenant,
+		capacity: uint64(capacity),
+		start:    next,
+		next:     next,
+		reserved: next,
+		notify:   make(chan struct{}),
+		f:        f,
+		events:   events,
+	}
+
+	if err := l.init(); err != nil {
+		return nil, fmt.Errorf("read change log events from file: %w", err)
+	}
+
+	return l, nil
+}
+
+// init finds the events retained in the events file. Events of a
+// different capacity can't be mapped to their slots, so they are dropped.
+func (l *Log) init() error {
+	stat, err := l.events.Stat()
+	if err != nil {
+		return err
+	}
+	if stat.Size() == 0 {
+		return nil
+	}
+
+	header := make([]byte, headerSize)
+	if _, err := l.events.ReadAt(header, 0); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if binary.LittleEndian.Uint64(header) != l.capacity {
+		return l.events.Truncate(0)
+	}
+	l.hasHeader = true
+
+	r := bufio.NewReader(io.NewSectionReader(l.events, headerSize, stat.Size()-headerSize))
+	rec := make([]byte, recordSize)
+	for {
+		if _, err := io.ReadFull(r, rec); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return err
+		}
+
+		ev, ok := l.parseRecord(rec)
+		if !ok || ev.Offset >= l.next {
+			continue
+		}
+		if !l.hasRetained || ev.Offset < l.retainedFirst {
+			l.retainedFirst = ev.Offset
+		}
+		if !l.hasRetained || ev.Offset > l.retainedLast {
+			l.retainedLast = ev.Offset
+		}
+		l.hasRetained = true
+	}
+	return nil
+}
+
+// Append adds an event for the given object to the log and wakes up all
+// waiting readers.
+func (l *Log) Append(op Operation, id strfmt.UUID) error {
+	if l == nil {
+		return nil
+	}
+
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return fmt.Errorf("parse id: %w", err)
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return ErrClosed
+	}
+
+	if l.next >= l.reserved {
+		if err := l.reserve(); err != nil {
+			return err
+		}
+	}
+
+	if !l.hasHeader {
+		if _, err := l.events.WriteAt(binary.LittleEndian.AppendUint64(nil, l.capacity), 0); err != nil {
+			return fmt.Errorf("write change log header: %w", err)
+		}
+		l.hasHeader = true
+	}
+
+	rec := make([]byte, 0, recordSize)
+	rec = binary.LittleEndian.AppendUint64(rec, l.next+1)
+	rec = append(rec, byte(op))
+	rec = binary.LittleEndian.AppendUint64(rec, uint64(time.Now().UnixNano()))
+	rec = append(rec, parsed[:]...)
+	if _, err := l.events.WriteAt(rec, l.position(l.next)); err != nil {
+		return fmt.Errorf("write change log event: %w", err)
+	}
+	l.next++
+
+	close(l.notify)
+	l.notify = make(chan struct{})
+	return nil
+}
+
+func (l *Log) reserve() error {
+	reserved := l.next + offsetReservation
+	if _, err := l.f.WriteAt(binary.LittleEndian.AppendUint64(nil, reserved), 0); err != nil {
+		return fmt.Errorf("persist change log offset: %w", err)
+	}
+	l.reserved = reserved
+	return nil
+}
+
+func (l *Log) position(offset uint64) int64 {
+	return int64(headerSize + (offset%l.capacity)*recordSize)
+}
+
+func (l *Log) parseRecord(rec []byte) (Event, bool) {
+	stored := binary.LittleEndian.Uint64(rec[0:8])
+	if stored == 0 {
+		// slot has never been written
+		return Event{}, false
+	}
+
+	id, err := uuid.FromBytes(rec[17:33])
+	if err != nil {
+		return Event{}, false
+	}
+
+	return Event{
+		Offset:    stored - 1,
+		Operation: Operation(rec[8]),
+		ID:        strfmt.UUID(id.String()),
+		Class:     l.class,
+		Tenant:    l.tenant,
+		Timestamp: time.Unix(0, int64(binary.LittleEndian.Uint64(rec[9:17]))),
+	}, true
+}
+
+// readEvents returns the retained events in [from, to). Offsets which were
+// skipped, e.g. because of a restart, are not contained. The caller needs to
+// hold the lock.
+func (l *Log) readEvents(from, to uint64) ([]Event, error) {
+	events := make([]Event, 0, to-from)
+	for from < to {
+		// read contiguous slots, i.e. until the end of the ring
+		end := to
+		if untilWrap := l.capacity - from%l.capacity; end-from > untilWrap {
+			end = from + untilWrap
+		}
+
+		buf := make([]byte, (end-from)*recordSize)
+		// slots at the end of the file might not have been written yet, they
+		// remain zeroed
+		if _, err := l.events.ReadAt(buf, l.position(from)); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		for offset := from; offset < end; offset++ {
+			pos := (offset - from) * recordSize
+			ev, ok := l.parseRecord(buf[pos : pos+recordSize])
+			if ok && ev.Offset == offset {
+				events = append(events, ev)
+			}
+		}
+		from = end
+	}
+	return events, nil
+}
+
+// Oldest returns the offset of the oldest event still retained in the log.
+func (l *Log) Oldest() uint64 {
+	if l == nil {
+		return 0
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	return l.oldest()
+}
+
+func (l *Log) oldest() uint64 {
+	written := l.next - l.start
+	if written >= l.capacity {
+		return l.next - l.capacity
+	}
+
+	// events from before the restart are retained until their slot has been
+	// overwritten by one of the events written since then
+	if l.hasRetained {
+		for offset := l.retainedFirst; offset <= l.retainedLast; offset++ {
+			distance := (offset%l.capacity + l.capacity - l.start%l.capacity) % l.capacity
+			if distance >= written {
+				return offset
+			}
+		}
+	}
+	return l.start
+}
+
+// Next returns the offset that will be assigned to the next event.
+func (l *Log) Next() uint64 {
+	if l == nil {
+		return 0
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	return l.next
+}
+
+// Read calls fn for every event starting at offset from, in order. Once all
+// retained events have been passed to fn, Read blocks and waits for new
+// events until ctx is cancelled, fn returns an error or the log is closed.
+// If from is no longer retained, ErrOffsetOutOfRange is returned.
+func (l *Log) Read(ctx context.Context, from uint64, fn func(Event) error) error {
+	if l == nil {
+		return ErrClosed
+	}
+
+	for {
+		l.Lock()
+		if l.closed {
+			l.Unlock()
+			return ErrClosed
+		}
+		if oldest := l.oldest(); from < oldest || from > l.next {
+			l.Unlock()
+			return fmt.Errorf("%w: requested %d, available [%d, %d]",
+				ErrOffsetOutOfRange, from, oldest, l.next)
+		}
+		// read the events while holding the lock, so that fn can be slow
+		// without blocking any writers
+		to := l.next
+		if to-from > readBatchSize {
+			to = from + readBatchSize
+		}
+		batch, err := l.readEvents(from, to)
+		more := to < l.next
+		wait := l.notify
+		l.Unlock()
+		if err != nil {
+			return fmt.Errorf("read change log events: %w", err)
+		}
+
+		for _, ev := range batch {
+			if err := fn(ev); err != nil {
+				return err
+			}
+		}
+		from = to
+
+		if more {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Close stops all readers. Subsequent appends fail with ErrClosed.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+	close(l.notify)
+	if err := l.events.Close(); err != nil {
+		l.f.Close()
+		return err
+	}
+	return l.f.Close()
+}
+
+// Drop closes the log and removes its files.
+func (l *Log) Drop() error {
+	if l == nil {
+		return nil
+	}
+
+	if err := l.Close(); err != nil {
+		return err
+	}
+	for _, name := range []string{l.f.Name(), l.events.Name()} {
+		if err := os.Remove(name); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("drop change log file: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	id1 = strfmt.UUID("8c29da7a-600a-43dc-85fb-83ab2b08c294")
+	id2 = strfmt.UUID("a2d37cd5-4b43-4e86-8fb8-e4e1e1f6d78c")
+	id3 = strfmt.UUID("3cd42a0b-dc67-4b7d-9c4a-f8a0c6a5f0e1")
+)
+
+var errStop = errors.New("stop")
+
+func readN(t *testing.T, l *Log, from uint64, n int) []Event {
+	t.Helper()
+
+	var events []Event
+	err := l.Read(context.Background(), from, func(ev Event) error {
+		events = append(events, ev)
+		if len(events) == n {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+	return events
+}
+
+func TestLog(t *testing.T) {
+	l, err := New(t.TempDir(), "MyClass", "", 10)
+	require.Nil(t, err)
+	defer l.Close()
+
+	require.Nil(t, l.Append(OperationPut, id1))
+	require.Nil(t, l.Append(OperationMerge, id1))
+	require.Nil(t, l.Append(OperationDelete, id2))
+
+	t.Run("read from the beginning", func(t *testing.T) {
+		events := readN(t, l, 0, 3)
+		assert.Equal(t, uint64(0), events[0].Offset)
+		assert.Equal(t, OperationPut, events[0].Operation)
+		assert.Equal(t, id1, events[0].ID)
+		assert.Equal(t, "MyClass", events[0].Class)
+		assert.Equal(t, OperationMerge, events[1].Operation)
+		assert.Equal(t, OperationDelete, events[2].Operation)
+		assert.Equal(t, id2, events[2].ID)
+	})
+
+	t.Run("resume from an offset", func(t *testing.T) {
+		events := readN(t, l, 2, 1)
+		assert.Equal(t, uint64(2), events[0].Offset)
+		assert.Equal(t, id2, events[0].ID)
+	})
+
+	t.Run("offset in the future", func(t *testing.T) {
+		err := l.Read(context.Background(), 4, func(Event) error { return nil })
+		assert.ErrorIs(t, err, ErrOffsetOutOfRange)
+	})
+}
+
+func TestLogWaitsForNewEvents(t *testing.T) {
+	l, err := New(t.TempDir(), "MyClass", "", 10)
+	require.Nil(t, err)
+	defer l.Close()
+
+	received := make(chan Event)
+	go l.Read(context.Background(), 0, func(ev Event) error {
+		received <- ev
+		return nil
+	})
+
+	require.Nil(t, l.Append(OperationPut, id3))
+
+	select {
+	case ev := <-received:
+		assert.Equal(t, id3, ev.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("reader was not notified about new event")
+	}
+}
+
+func TestLogEvictsOldEvents(t *testing.T) {
+	l, err := New(t.TempDir(), "MyClass", "tenant1", 2)
+	require.Nil(t, err)
+	defer l.Close()
+
+	require.Nil(t, l.Append(OperationPut, id1))
+	require.Nil(t, l.Append(OperationPut, id2))
+	require.Nil(t, l.Append(OperationPut, id3))
+
+	assert.Equal(t, uint64(1), l.Oldest())
+	assert.Equal(t, uint64(3), l.Next())
+
+	err = l.Read(context.Background(), 0, func(Event) error { return nil })
+	assert.ErrorIs(t, err, ErrOffsetOutOfRange)
+
+	events := readN(t, l, 1, 2)
+	assert.Equal(t, id2, events[0].ID)
+	assert.Equal(t, id3, events[1].ID)
+	assert.Equal(t, "tenant1", events[1].Tenant)
+}
+
+func TestLogSurvivesRestarts(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := New(dir, "MyClass", "", 3)
+	require.Nil(t, err)
+	require.Nil(t, l.Append(OperationPut, id1))
+	require.Nil(t, l.Append(OperationDelete, id2))
+	require.Nil(t, l.Close())
+
+	l, err = New(dir, "MyClass", "", 3)
+	require.Nil(t, err)
+	defer l.Close()
+
+	t.Run("events from before the restart are retained", func(t *testing.T) {
+		assert.Equal(t, uint64(0), l.Oldest())
+		events := readN(t, l, 0, 2)
+		assert.Equal(t, uint64(1), events[1].Offset)
+		assert.Equal(t, OperationDelete, events[1].Operation)
+		assert.Equal(t, id2, events[1].ID)
+	})
+
+	t.Run("offsets used before are not handed out again", func(t *testing.T) {
+		require.Nil(t, l.Append(OperationPut, id3))
+
+		// the new event has overwritten the slot of the second event
+		events := readN(t, l, 0, 2)
+		assert.Equal(t, id1, events[0].ID)
+		assert.Equal(t, id3, events[1].ID)
+		assert.Greater(t, events[1].Offset, uint64(1))
+	})
+
+	t.Run("events from before the restart are evicted", func(t *testing.T) {
+		require.Nil(t, l.Append(OperationPut, id1))
+		require.Nil(t, l.Append(OperationPut, id2))
+
+		events := readN(t, l, l.Oldest(), 3)
+		assert.Equal(t, []strfmt.UUID{id3, id1, id2},
+			[]strfmt.UUID{events[0].ID, events[1].ID, events[2].ID})
+	})
+}
+
+func TestLogDiscardsEventsOfOtherCapacity(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := New(dir, "MyClass", "", 3)
+	require.Nil(t, err)
+	require.Nil(t, l.Append(OperationPut, id1))
+	require.Nil(t, l.Close())
+
+	l, err = New(dir, "MyClass", "", 5)
+	require.Nil(t, err)
+	defer l.Close()
+
+	assert.Equal(t, l.Next(), l.Oldest())
+	require.Nil(t, l.Append(OperationPut, id2))
+
+	events := readN(t, l, l.Oldest(), 1)
+	assert.Equal(t, id2, events[0].ID)
+}
+
+func TestLogClose(t *testing.T) {
+	l, err := New(t.TempDir(), "MyClass", "", 10)
+	require.Nil(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- l.Read(context.Background(), 0, func(Event) error { return nil })
+	}()
+
+	require.Nil(t, l.Close())
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, ErrClosed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("reader was not stopped on close")
+	}
+
+	assert.ErrorIs(t, l.Append(OperationPut, id1), ErrClosed)
+}
+
+func TestNilLog(t *testing.T) {
+	var l *Log
+	assert.Nil(t, l.Append(OperationPut, id1))
+	assert.ErrorIs(t, l.Read(context.Background(), 0, nil), ErrClosed)
+	assert.Nil(t, l.Close())
+}
@@ -32,10 +32,12 @@ type GetParams struct {
 	Properties            search.SelectProperties
 	NearVector            *searchparams.NearVector
 	NearObject            *searchparams.NearObject
+	Recommend             *searchparams.Recommend
 	KeywordRanking        *searchparams.KeywordRanking
 	HybridSearch          *searchparams.HybridSearch
 	GroupBy               *searchparams.GroupBy
 	SearchVector          []float32
+	SearchMultiVector     [][]float32
 	TargetVector          string
 	Group                 *GroupParams
 	ModuleParams          map[string]interface{}
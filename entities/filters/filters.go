@@ -34,6 +34,8 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	OperatorWithinGeoPolygon
+	OperatorWithinGeoBoundingBox
 )
 
 func (o Operator) OnValue() bool {
@@ -48,7 +50,9 @@ func (o Operator) OnValue() bool {
 		OperatorLike,
 		OperatorIsNull,
 		ContainsAny,
-		ContainsAll:
+		ContainsAll,
+		OperatorWithinGeoPolygon,
+		OperatorWithinGeoBoundingBox:
 		return true
 	default:
 		return false
@@ -83,6 +87,10 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorWithinGeoPolygon:
+		return "WithinGeoPolygon"
+	case OperatorWithinGeoBoundingBox:
+		return "WithinGeoBoundingBox"
 	default:
 		panic("Unknown operator")
 	}
@@ -116,14 +124,11 @@ func (v *Value) UnmarshalJSON(data []byte) error {
 	}
 
 	if v.Type == schema.DataTypeGeoCoordinates {
-		temp := struct {
-			Value GeoRange `json:"value"`
-		}{}
-
-		if err := json.Unmarshal(data, &temp); err != nil {
+		geo, err := unmarshalGeoValue(data)
+		if err != nil {
 			return err
 		}
-		v.Value = temp.Value
+		v.Value = geo
 	}
 
 	return nil
@@ -142,3 +147,52 @@ type GeoRange struct {
 	*models.GeoCoordinates
 	Distance float32 `json:"distance"`
 }
+
+// GeoPolygon to be used with fields of type GeoCoordinates. Identifies an
+// area by the points of its outline. The polygon is closed implicitly, the
+// last point does not need to repeat the first one.
+type GeoPolygon struct {
+	Points []*models.GeoCoordinates `json:"points"`
+}
+
+// GeoBoundingBox to be used with fields of type GeoCoordinates. Identifies
+// an area by its top left (north west) and bottom right (south east)
+// corners. A box whose left edge is east of its right edge crosses the
+// antimeridian.
+type GeoBoundingBox struct {
+	TopLeft     *models.GeoCoordinates `json:"topLeft"`
+	BottomRight *models.GeoCoordinates `json:"bottomRight"`
+}
+
+// unmarshalGeoValue decides on the type of a geo value by its shape, since
+// range, polygon and bounding box values share the same data type
+func unmarshalGeoValue(data []byte) (interface{}, error) {
+	shape := struct {
+		Value map[string]json.RawMessage `json:"value"`
+	}{}
+	if err := json.Unmarshal(data, &shape); err != nil {
+		return nil, err
+	}
+
+	if _, ok := shape.Value["points"]; ok {
+		temp := struct {
+			Value GeoPolygon `json:"value"`
+		}{}
+		err := json.Unmarshal(data, &temp)
+		return temp.Value, err
+	}
+
+	if _, ok := shape.Value["topLeft"]; ok {
+		temp := struct {
+			Value GeoBoundingBox `json:"value"`
+		}{}
+		err := json.Unmarshal(data, &temp)
+		return temp.Value, err
+	}
+
+	temp := struct {
+		Value GeoRange `json:"value"`
+	}{}
+	err := json.Unmarshal(data, &temp)
+	return temp.Value, err
+}
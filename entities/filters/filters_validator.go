@@ -140,6 +140,60 @@ func validateClause(fn func(string) *models.Class, cw *clauseWrapper) error {
 			valueNameFromDataType(schema.DataType(prop.DataType[0])))
 	}
 
+	if cw.isType(schema.DataTypeGeoCoordinates) {
+		return validateGeoValue(cw)
+	}
+
+	return nil
+}
+
+func validateGeoValue(cw *clauseWrapper) error {
+	switch op := cw.getOperator(); op {
+	case OperatorWithinGeoPolygon:
+		polygon, ok := cw.getValue().(GeoPolygon)
+		if !ok {
+			return errors.Errorf("operator %s requires a geo polygon value", op.Name())
+		}
+		if len(polygon.Points) < 3 {
+			return errors.Errorf("a geo polygon needs at least 3 points, got %d",
+				len(polygon.Points))
+		}
+		for i, point := range polygon.Points {
+			if err := validateGeoPoint(point); err != nil {
+				return errors.Wrapf(err, "point at position %d", i)
+			}
+		}
+	case OperatorWithinGeoBoundingBox:
+		box, ok := cw.getValue().(GeoBoundingBox)
+		if !ok {
+			return errors.Errorf("operator %s requires a geo bounding box value", op.Name())
+		}
+		if err := validateGeoPoint(box.TopLeft); err != nil {
+			return errors.Wrap(err, "topLeft")
+		}
+		if err := validateGeoPoint(box.BottomRight); err != nil {
+			return errors.Wrap(err, "bottomRight")
+		}
+		if *box.TopLeft.Latitude < *box.BottomRight.Latitude {
+			return errors.Errorf("the topLeft latitude %v of a geo bounding box must not "+
+				"be south of the bottomRight latitude %v",
+				*box.TopLeft.Latitude, *box.BottomRight.Latitude)
+		}
+	}
+
+	return nil
+}
+
+func validateGeoPoint(point *models.GeoCoordinates) error {
+	if point == nil || point.Latitude == nil || point.Longitude == nil {
+		return errors.New("latitude and longitude must be set")
+	}
+	if *point.Latitude < -90 || *point.Latitude > 90 {
+		return errors.Errorf("latitude must be between -90 and 90, got %v", *point.Latitude)
+	}
+	if *point.Longitude < -180 || *point.Longitude > 180 {
+		return errors.Errorf("longitude must be between -180 and 180, got %v", *point.Longitude)
+	}
 	return nil
 }
 
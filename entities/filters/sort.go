@@ -11,10 +11,14 @@
 
 package filters
 
-// Sort contains path and order (asc, desc) information
+import "github.com/weaviate/weaviate/entities/models"
+
+// Sort contains path and order (asc, desc) information. If GeoDistanceFrom
+// is set, a geoCoordinates property is sorted by its distance from that point
 type Sort struct {
-	Path  []string `json:"path"`
-	Order string   `json:"order"`
+	Path            []string               `json:"path"`
+	Order           string                 `json:"order"`
+	GeoDistanceFrom *models.GeoCoordinates `json:"geoDistanceFrom,omitempty"`
 }
 
 // ExtractSortFromArgs gets the sort parameters
@@ -37,9 +41,26 @@ func ExtractSortFromArgs(in []interface{}) []Sort {
 			if ok {
 				order = orderParam.(string)
 			}
-			args = append(args, Sort{path, order})
+			var geoDistanceFrom *models.GeoCoordinates
+			if geoParam, ok := sortFilter["geoDistanceFrom"].(map[string]interface{}); ok {
+				geoDistanceFrom = extractGeoCoordinates(geoParam)
+			}
+			args = append(args, Sort{Path: path, Order: order, GeoDistanceFrom: geoDistanceFrom})
 		}
 	}
 
 	return args
 }
+
+func extractGeoCoordinates(in map[string]interface{}) *models.GeoCoordinates {
+	out := &models.GeoCoordinates{}
+	if lat, ok := in["latitude"].(float64); ok {
+		latitude := float32(lat)
+		out.Latitude = &latitude
+	}
+	if lon, ok := in["longitude"].(float64); ok {
+		longitude := float32(lon)
+		out.Longitude = &longitude
+	}
+	return out
+}
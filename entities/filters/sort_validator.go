@@ -57,6 +57,10 @@ func validateSortClause(getClass func(string) *models.Class, className schema.Cl
 		}
 		propName := schema.PropertyName(path[0])
 		if IsInternalProperty(propName) {
+			if sort.GeoDistanceFrom != nil {
+				return errors.Errorf("sorting by geo distance requires a geoCoordinates "+
+					"property, %q is an internal property", propName)
+			}
 			// handle internal properties
 			return nil
 		}
@@ -66,6 +70,16 @@ func validateSortClause(getClass func(string) *models.Class, className schema.Cl
 			return err
 		}
 
+		if sort.GeoDistanceFrom != nil {
+			if schema.DataType(prop.DataType[0]) != schema.DataTypeGeoCoordinates {
+				return errors.Errorf("sorting by geo distance requires a geoCoordinates "+
+					"property, %q is of type %q", propName, prop.DataType[0])
+			}
+			if err := validateGeoPoint(sort.GeoDistanceFrom); err != nil {
+				return errors.Wrap(err, "geoDistanceFrom")
+			}
+		}
+
 		if isUUIDType(prop.DataType[0]) {
 			return fmt.Errorf("prop %q is of type uuid/uuid[]: "+
 				"sorting by uuid is currently not supported - if you believe it should be, "+
@@ -21,9 +21,10 @@ import (
 
 func TestSortValidation(t *testing.T) {
 	tests := []struct {
-		name  string
-		prop  string
-		valid bool
+		name            string
+		prop            string
+		geoDistanceFrom *models.GeoCoordinates
+		valid           bool
 	}{
 		{
 			name:  "existing prop - string",
@@ -50,6 +51,24 @@ func TestSortValidation(t *testing.T) {
 			valid: false,
 			prop:  "my_idz",
 		},
+		{
+			name:            "geo distance on geo prop",
+			valid:           true,
+			prop:            "location",
+			geoDistanceFrom: &models.GeoCoordinates{Latitude: ptFloat32(51.5), Longitude: ptFloat32(-0.1)},
+		},
+		{
+			name:            "geo distance on non-geo prop",
+			valid:           false,
+			prop:            "horsepower",
+			geoDistanceFrom: &models.GeoCoordinates{Latitude: ptFloat32(51.5), Longitude: ptFloat32(-0.1)},
+		},
+		{
+			name:            "geo distance with invalid latitude",
+			valid:           false,
+			prop:            "location",
+			geoDistanceFrom: &models.GeoCoordinates{Latitude: ptFloat32(95), Longitude: ptFloat32(-0.1)},
+		},
 	}
 
 	for _, tt := range tests {
@@ -64,14 +83,16 @@ func TestSortValidation(t *testing.T) {
 							{Name: "horsepower", DataType: []string{"int"}},
 							{Name: "my_id", DataType: []string{"uuid"}},
 							{Name: "my_idz", DataType: []string{"uuid[]"}},
+							{Name: "location", DataType: []string{"geoCoordinates"}},
 						},
 					},
 				},
 			}}
 
 			sort := []Sort{{
-				Path:  []string{tt.prop},
-				Order: "asc",
+				Path:            []string{tt.prop},
+				Order:           "asc",
+				GeoDistanceFrom: tt.geoDistanceFrom,
 			}}
 
 			err := ValidateSort(sch.GetClass, schema.ClassName("Car"), sort)
@@ -41,6 +41,10 @@ type BackupConfig struct {
 	// Minimum: 2
 	ChunkSize int64 `json:"ChunkSize,omitempty"`
 
+	// compression algorithm of the backup chunks
+	// Enum: [gzip zstd none]
+	Codec string `json:"Codec,omitempty"`
+
 	// compression level used by compression algorithm
 	// Enum: [DefaultCompression BestSpeed BestCompression]
 	CompressionLevel string `json:"CompressionLevel,omitempty"`
@@ -58,6 +62,10 @@ func (m *BackupConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCodec(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCompressionLevel(formats); err != nil {
 		res = append(res, err)
 	}
@@ -100,6 +108,51 @@ func (m *BackupConfig) validateChunkSize(formats strfmt.Registry) error {
 	return nil
 }
 
+var backupConfigTypeCodecPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["gzip","zstd","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backupConfigTypeCodecPropEnum = append(backupConfigTypeCodecPropEnum, v)
+	}
+}
+
+const (
+
+	// BackupConfigCodecGzip captures enum value "gzip"
+	BackupConfigCodecGzip string = "gzip"
+
+	// BackupConfigCodecZstd captures enum value "zstd"
+	BackupConfigCodecZstd string = "zstd"
+
+	// BackupConfigCodecNone captures enum value "none"
+	BackupConfigCodecNone string = "none"
+)
+
+// prop value enum
+func (m *BackupConfig) validateCodecEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, backupConfigTypeCodecPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BackupConfig) validateCodec(formats strfmt.Registry) error {
+	if swag.IsZero(m.Codec) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCodecEnum("Codec", "body", m.Codec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var backupConfigTypeCompressionLevelPropEnum []interface{}
 
 func init() {
@@ -40,6 +40,9 @@ type BackupCreateRequest struct {
 
 	// List of classes to include in the backup creation process
 	Include []string `json:"include"`
+
+	// The ID of a completed backup on the same backend to use as the base of an incremental backup. Segment files that did not change since the base backup are referenced instead of uploaded again.
+	IncrementalBaseBackupID string `json:"incrementalBaseBackupId,omitempty"`
 }
 
 // Validate validates this backup create request
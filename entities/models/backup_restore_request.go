@@ -29,6 +29,9 @@ import (
 // swagger:model BackupRestoreRequest
 type BackupRestoreRequest struct {
 
+	// Restores classes under new names. Maps the name of a class in the backup to the name it is restored as.
+	ClassMapping map[string]string `json:"classMapping,omitempty"`
+
 	// Custom configuration for the backup restoration process
 	Config *RestoreConfig `json:"config,omitempty"`
 
@@ -38,8 +41,14 @@ type BackupRestoreRequest struct {
 	// List of classes to include in the backup restoration process
 	Include []string `json:"include"`
 
+	// Restores the tenants of multi-tenant classes into existing classes with a compatible schema instead of failing because the classes exist. The restored tenants must not exist yet.
+	MergeTenants bool `json:"mergeTenants,omitempty"`
+
 	// Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.
 	NodeMapping map[string]string `json:"node_mapping,omitempty"`
+
+	// Restores only the given tenants of multi-tenant classes. Maps the name of a class in the backup to the tenants to restore.
+	Tenants map[string][]string `json:"tenants,omitempty"`
 }
 
 // Validate validates this backup restore request
@@ -43,6 +43,9 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// The size of the objects stored in the shard in bytes.
+	ObjectStorageBytes int64 `json:"objectStorageBytes"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
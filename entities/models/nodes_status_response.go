@@ -30,6 +30,9 @@ import (
 // swagger:model NodesStatusResponse
 type NodesStatusResponse struct {
 
+	// Status of the backup schedules of the cluster
+	BackupSchedules []*BackupScheduleStatus `json:"backupSchedules"`
+
 	// nodes
 	Nodes []*NodeStatus `json:"nodes"`
 }
@@ -38,6 +41,10 @@ type NodesStatusResponse struct {
 func (m *NodesStatusResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateBackupSchedules(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNodes(formats); err != nil {
 		res = append(res, err)
 	}
@@ -48,6 +55,32 @@ func (m *NodesStatusResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NodesStatusResponse) validateBackupSchedules(formats strfmt.Registry) error {
+	if swag.IsZero(m.BackupSchedules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.BackupSchedules); i++ {
+		if swag.IsZero(m.BackupSchedules[i]) { // not required
+			continue
+		}
+
+		if m.BackupSchedules[i] != nil {
+			if err := m.BackupSchedules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("backupSchedules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("backupSchedules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodesStatusResponse) validateNodes(formats strfmt.Registry) error {
 	if swag.IsZero(m.Nodes) { // not required
 		return nil
@@ -78,6 +111,10 @@ func (m *NodesStatusResponse) validateNodes(formats strfmt.Registry) error {
 func (m *NodesStatusResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBackupSchedules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateNodes(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -88,6 +125,26 @@ func (m *NodesStatusResponse) ContextValidate(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *NodesStatusResponse) contextValidateBackupSchedules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.BackupSchedules); i++ {
+
+		if m.BackupSchedules[i] != nil {
+			if err := m.BackupSchedules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("backupSchedules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("backupSchedules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodesStatusResponse) contextValidateNodes(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Nodes); i++ {
@@ -37,6 +37,9 @@ type ReferenceMetaClassification struct {
 	// Closest distance of a neighbor from the winning group
 	ClosestWinningDistance float64 `json:"closestWinningDistance,omitempty"`
 
+	// Share of the (optionally distance-weighted) votes of the winning group, a number between 0 and 1
+	Confidence float64 `json:"confidence,omitempty"`
+
 	// size of the losing group, can be 0 if the winning group size equals k
 	LosingCount int64 `json:"losingCount,omitempty"`
 
@@ -44,4 +44,8 @@ type BackupBackend interface {
 
 	Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error)
+
+	// DeleteBackup removes all objects of a backup. It is used to prune
+	// backups created by backup schedules.
+	DeleteBackup(ctx context.Context, backupID string) error
 }
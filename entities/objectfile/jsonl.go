@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// JSONLReader reads objects in the JSON Lines format, one JSON encoded
+// object per line. Empty lines are ignored.
+type JSONLReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+func NewJSONLReader(r io.Reader) *JSONLReader {
+	return &JSONLReader{r: bufio.NewReaderSize(r, 1<<20)}
+}
+
+func (r *JSONLReader) Read() (*models.Object, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if len(line) == 0 && err != nil {
+			return nil, io.EOF
+		}
+		r.line++
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(line))
+		dec.UseNumber()
+		obj := &models.Object{}
+		if err := dec.Decode(obj); err != nil {
+			return nil, fmt.Errorf("line %d: %w", r.line, err)
+		}
+		return obj, nil
+	}
+}
+
+// JSONLWriter writes objects in the JSON Lines format.
+type JSONLWriter struct {
+	w *bufio.Writer
+}
+
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{w: bufio.NewWriterSize(w, 1<<20)}
+}
+
+func (w *JSONLWriter) Write(obj *models.Object) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	return w.w.WriteByte('\n')
+}
+
+// Close flushes buffered objects. It does not close the underlying writer.
+func (w *JSONLWriter) Close() error {
+	return w.w.Flush()
+}
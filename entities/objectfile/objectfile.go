@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package objectfile reads and writes files that hold one object per record.
+// They are used to bulk load and to export the objects of a class. The
+// supported formats are JSON Lines and Parquet.
+package objectfile
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type Format string
+
+const (
+	FormatJSONL   Format = "jsonl"
+	FormatParquet Format = "parquet"
+)
+
+// ParseFormat returns the format of the given name, such as "jsonl".
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(name)); f {
+	case FormatJSONL, FormatParquet:
+		return f, nil
+	case "ndjson":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("unsupported object file format %q", name)
+	}
+}
+
+// FormatFromPath derives the format from the extension of a file name.
+func FormatFromPath(path string) (Format, error) {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	if ext == "" {
+		return "", fmt.Errorf("cannot determine object file format of %q", path)
+	}
+	return ParseFormat(ext)
+}
+
+// Reader returns the objects of a file one at a time. Read returns io.EOF
+// once all objects were read.
+type Reader interface {
+	Read() (*models.Object, error)
+}
+
+type ReadCloser interface {
+	Reader
+	Close() error
+}
+
+// Writer appends objects to a file. Close must be called to flush buffered
+// objects and to complete the file.
+type Writer interface {
+	Write(obj *models.Object) error
+	Close() error
+}
+
+// NewReader returns a reader for the objects stored in the given format in
+// the first size bytes of r.
+func NewReader(r io.ReaderAt, size int64, format Format) (Reader, error) {
+	switch format {
+	case FormatJSONL:
+		return NewJSONLReader(io.NewSectionReader(r, 0, size)), nil
+	case FormatParquet:
+		return NewParquetReader(r, size)
+	default:
+		return nil, fmt.Errorf("unsupported object file format %q", format)
+	}
+}
+
+// NewWriter returns a writer for objects in the given format. Closing the
+// writer does not close w.
+func NewWriter(w io.Writer, format Format) (Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return NewJSONLWriter(w), nil
+	case FormatParquet:
+		return NewParquetWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported object file format %q", format)
+	}
+}
+
+// ResolvePath returns the absolute path of the file at path within dir, with
+// all symbolic links resolved. Relative paths are taken relative to dir.
+// Paths with ".." elements and paths that are or resolve to a file outside
+// of dir are rejected, so that clients cannot read arbitrary files.
+func ResolvePath(dir, path string) (string, error) {
+	if dir == "" {
+		return "", fmt.Errorf("no import directory is configured")
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(path), "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("path %q must not contain \"..\"", path)
+		}
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	path = filepath.Clean(path)
+	if !within(dir, path) {
+		return "", fmt.Errorf("path %q is outside of the import directory", path)
+	}
+
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("resolve import directory: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	if !within(resolvedDir, resolved) {
+		return "", fmt.Errorf("path %q links to a file outside of the import directory", path)
+	}
+	return resolved, nil
+}
+
+// within returns whether path is below dir. Both must be clean and absolute.
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != "." && rel != ".." &&
+		!strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Open opens the file at path for reading objects in the given format.
+func Open(path string, format Format) (ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r, err := NewReader(f, info.Size(), format)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	return &fileReader{Reader: r, f: f}, nil
+}
+
+// Create creates or truncates the file at path for writing objects in the
+// given format. Closing the returned writer also syncs and closes the file.
+func Create(path string, format Format) (Writer, error) {
+	if _, err := ParseFormat(string(format)); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := NewWriter(f, format)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &fileWriter{Writer: w, f: f}, nil
+}
+
+type fileReader struct {
+	Reader
+	f *os.File
+}
+
+func (r *fileReader) Close() error {
+	return r.f.Close()
+}
+
+type fileWriter struct {
+	Writer
+	f *os.File
+}
+
+func (w *fileWriter) Close() error {
+	if err := w.Writer.Close(); err != nil {
+		w.f.Close()
+		return err
+	}
+	if err := w.f.Sync(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
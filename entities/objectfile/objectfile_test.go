@@ -0,0 +1,361 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func testObjects(count int) []*models.Object {
+	objs := make([]*models.Object, count)
+	for i := range objs {
+		obj := &models.Object{
+			ID: strfmt.UUID(uuid.NewSHA1(uuid.Nil, []byte(fmt.Sprint(i))).String()),
+			Properties: map[string]interface{}{
+				"name":  fmt.Sprintf("object %d", i),
+				"count": json.Number(fmt.Sprint(i)),
+			},
+			CreationTimeUnix:   int64(1000 + i),
+			LastUpdateTimeUnix: int64(2000 + i),
+		}
+		// leave some fields unset to produce null values
+		if i%3 != 0 {
+			obj.Vector = models.C11yVector{float32(i), 0.5, -1}
+		}
+		if i%4 == 0 {
+			obj.Tenant = "tenant"
+			obj.Vectors = models.Vectors{"named": {1, 2, float32(i)}}
+		}
+		if i%5 == 0 {
+			obj.MultiVectors = models.MultiVectors{"colbert": {{1, 2}, {3, float32(i)}}}
+			obj.SparseVectors = models.SparseVectors{"splade": {
+				Indices: []uint32{3, 7}, Values: []float32{0.5, float32(i)},
+			}}
+		}
+		objs[i] = obj
+	}
+	return objs
+}
+
+func readAll(t *testing.T, r Reader) []*models.Object {
+	var objs []*models.Object
+	for {
+		obj, err := r.Read()
+		if err == io.EOF {
+			return objs
+		}
+		require.Nil(t, err)
+		objs = append(objs, obj)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	for _, count := range []int{0, 1, 2*parquetMaxRowGroupRows + 17} {
+		for _, format := range []Format{FormatJSONL, FormatParquet} {
+			t.Run(fmt.Sprintf("%s with %d objects", format, count), func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "objects."+string(format))
+				objs := testObjects(count)
+
+				w, err := Create(path, format)
+				require.Nil(t, err)
+				for _, obj := range objs {
+					require.Nil(t, w.Write(obj))
+				}
+				require.Nil(t, w.Close())
+
+				detected, err := FormatFromPath(path)
+				require.Nil(t, err)
+				r, err := Open(path, detected)
+				require.Nil(t, err)
+				defer r.Close()
+
+				read := readAll(t, r)
+				require.Len(t, read, len(objs))
+				for i := range objs {
+					assert.Equal(t, objs[i], read[i])
+				}
+			})
+		}
+	}
+}
+
+func TestJSONLReaderSkipsEmptyLines(t *testing.T) {
+	in := "\n{\"id\":\"8d5a3aa2-3c8d-4589-9ae1-3f638f506970\"}\n\n" +
+		"{\"id\":\"8d5a3aa2-3c8d-4589-9ae1-3f638f506971\"}"
+	read := readAll(t, NewJSONLReader(bytes.NewBufferString(in)))
+	require.Len(t, read, 2)
+	assert.Equal(t, strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506971"), read[1].ID)
+
+	r := NewJSONLReader(bytes.NewBufferString("{}\n{"))
+	_, err := r.Read()
+	require.Nil(t, err)
+	_, err = r.Read()
+	assert.ErrorContains(t, err, "line 2")
+}
+
+// TestParquetReaderTypedColumns reads a file laid out like those written by
+// pyarrow, with optional lists of optional elements, maps and properties in
+// columns of their own.
+func TestParquetReaderTypedColumns(t *testing.T) {
+	floats := parquet.List(parquet.Leaf(parquet.FloatType))
+	schema := parquet.NewSchema("objects", parquet.Group{
+		"id":      parquet.Optional(parquet.String()),
+		"vector":  parquet.Optional(parquet.List(parquet.Optional(parquet.Leaf(parquet.DoubleType)))),
+		"vectors": parquet.Optional(parquet.Map(parquet.String(), parquet.Optional(floats))),
+		"multiVectors": parquet.Optional(parquet.Map(parquet.String(),
+			parquet.Optional(parquet.List(floats)))),
+		"sparseVectors": parquet.Optional(parquet.Map(parquet.String(), parquet.Group{
+			"indices": parquet.List(parquet.Uint(32)),
+			"values":  floats,
+		})),
+		"creationTimeUnix": parquet.Optional(parquet.Timestamp(parquet.Microsecond)),
+		"name":             parquet.Optional(parquet.String()),
+		"count":            parquet.Optional(parquet.Int(64)),
+		"price":            parquet.Optional(parquet.Leaf(parquet.DoubleType)),
+		"active":           parquet.Optional(parquet.Leaf(parquet.BooleanType)),
+		"tags":             parquet.Optional(parquet.List(parquet.Optional(parquet.String()))),
+		"published":        parquet.Optional(parquet.Timestamp(parquet.Microsecond)),
+	})
+
+	// values are given with their repetition and definition levels
+	var row parquet.Row
+	add := func(path string, v parquet.Value, rep, def int) {
+		leaf, ok := schema.Lookup(strings.Split(path, ".")...)
+		require.True(t, ok, path)
+		row = append(row, v.Level(rep, def, leaf.ColumnIndex))
+	}
+	str := func(s string) parquet.Value { return parquet.ByteArrayValue([]byte(s)) }
+	null := parquet.NullValue()
+	published := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	add("id", str("a"), 0, 1)
+	add("vector.list.element", parquet.DoubleValue(1.5), 0, 3)
+	add("vector.list.element", parquet.DoubleValue(2), 1, 3)
+	add("vectors.key_value.key", str("n"), 0, 2)
+	add("vectors.key_value.key", str("m"), 1, 2)
+	add("vectors.key_value.value.list.element", parquet.FloatValue(3), 0, 4)
+	add("vectors.key_value.value.list.element", parquet.FloatValue(4), 2, 4)
+	add("vectors.key_value.value.list.element", null, 1, 3)
+	add("multiVectors.key_value.key", str("colbert"), 0, 2)
+	add("multiVectors.key_value.value.list.element.list.element", parquet.FloatValue(1), 0, 5)
+	add("multiVectors.key_value.value.list.element.list.element", parquet.FloatValue(2), 3, 5)
+	add("multiVectors.key_value.value.list.element.list.element", parquet.FloatValue(3), 2, 5)
+	add("sparseVectors.key_value.key", str("splade"), 0, 2)
+	add("sparseVectors.key_value.value.indices.list.element", parquet.Int32Value(3), 0, 3)
+	add("sparseVectors.key_value.value.indices.list.element", parquet.Int32Value(7), 2, 3)
+	add("sparseVectors.key_value.value.values.list.element", parquet.FloatValue(0.5), 0, 3)
+	add("sparseVectors.key_value.value.values.list.element", parquet.FloatValue(1), 2, 3)
+	add("creationTimeUnix", parquet.Int64Value(1_700_000_000_123_456), 0, 1)
+	add("name", str("first"), 0, 1)
+	add("count", parquet.Int64Value(7), 0, 1)
+	add("price", parquet.DoubleValue(1.25), 0, 1)
+	add("active", parquet.BooleanValue(true), 0, 1)
+	add("tags.list.element", str("x"), 0, 3)
+	add("tags.list.element", str("y"), 1, 3)
+	add("published", parquet.Int64Value(published.UnixMicro()), 0, 1)
+	first := row
+
+	// nulls everywhere but in the id, and an empty list of tags
+	row = nil
+	add("id", str("b"), 0, 1)
+	for _, path := range []string{
+		"vector.list.element", "vectors.key_value.key", "vectors.key_value.value.list.element",
+		"multiVectors.key_value.key", "multiVectors.key_value.value.list.element.list.element",
+		"sparseVectors.key_value.key", "sparseVectors.key_value.value.indices.list.element",
+		"sparseVectors.key_value.value.values.list.element", "creationTimeUnix", "name",
+		"count", "price", "active", "published",
+	} {
+		add(path, null, 0, 0)
+	}
+	add("tags.list.element", null, 0, 1)
+	second := row
+
+	buf := &bytes.Buffer{}
+	w := parquet.NewWriter(buf, schema)
+	for _, row := range []parquet.Row{first, second} {
+		sort.SliceStable(row, func(i, j int) bool { return row[i].Column() < row[j].Column() })
+		_, err := w.WriteRows([]parquet.Row{row})
+		require.Nil(t, err)
+	}
+	require.Nil(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), FormatParquet)
+	require.Nil(t, err)
+	read := readAll(t, r)
+	require.Len(t, read, 2)
+
+	assert.Equal(t, &models.Object{
+		ID:     "a",
+		Vector: models.C11yVector{1.5, 2},
+		Vectors: models.Vectors{
+			"n": {3, 4},
+			"m": nil,
+		},
+		MultiVectors: models.MultiVectors{"colbert": {{1, 2}, {3}}},
+		SparseVectors: models.SparseVectors{"splade": {
+			Indices: []uint32{3, 7}, Values: []float32{0.5, 1},
+		}},
+		CreationTimeUnix: 1_700_000_000_123,
+		Properties: map[string]interface{}{
+			"name":      "first",
+			"count":     json.Number("7"),
+			"price":     json.Number("1.25"),
+			"active":    true,
+			"tags":      []interface{}{"x", "y"},
+			"published": "2024-01-02T03:04:05Z",
+		},
+	}, read[0])
+	assert.Equal(t, &models.Object{
+		ID:         "b",
+		Properties: map[string]interface{}{"tags": []interface{}{}},
+	}, read[1])
+}
+
+// TestParquetReaderJSONProperties reads properties from a JSON column and the
+// vector from a bare repeated column, as written by parquet-go for a struct
+func TestParquetReaderJSONProperties(t *testing.T) {
+	type object struct {
+		ID               string    `parquet:"id"`
+		Properties       string    `parquet:"properties,json"`
+		Vector           []float32 `parquet:"vector"`
+		CreationTimeUnix int64     `parquet:"creationTimeUnix"`
+	}
+
+	buf := &bytes.Buffer{}
+	w := parquet.NewGenericWriter[object](buf)
+	_, err := w.Write([]object{
+		{ID: "a", Properties: `{"name":"first","count":7}`, Vector: []float32{1, 2}, CreationTimeUnix: 1000},
+		{ID: "b", Properties: `{}`},
+	})
+	require.Nil(t, err)
+	require.Nil(t, w.Close())
+
+	r, err := NewParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.Nil(t, err)
+	read := readAll(t, r)
+	require.Len(t, read, 2)
+	assert.Equal(t, &models.Object{
+		ID:               "a",
+		Properties:       map[string]interface{}{"name": "first", "count": json.Number("7")},
+		Vector:           models.C11yVector{1, 2},
+		CreationTimeUnix: 1000,
+	}, read[0])
+	assert.Equal(t, &models.Object{ID: "b"}, read[1])
+}
+
+func TestParquetWriterRequiresID(t *testing.T) {
+	w := NewParquetWriter(&bytes.Buffer{})
+	assert.ErrorContains(t, w.Write(&models.Object{}), "object has no id")
+}
+
+func TestParquetReaderRejectsInvalidFiles(t *testing.T) {
+	write := func(schema *parquet.Schema) []byte {
+		buf := &bytes.Buffer{}
+		require.Nil(t, parquet.NewWriter(buf, schema).Close())
+		return buf.Bytes()
+	}
+
+	for name, in := range map[string][]byte{
+		"empty":    nil,
+		"no magic": bytes.Repeat([]byte{1}, 32),
+		"vector of strings": write(parquet.NewSchema("objects", parquet.Group{
+			"vector": parquet.List(parquet.String()),
+		})),
+		"id of integers": write(parquet.NewSchema("objects", parquet.Group{
+			"id": parquet.Int(64),
+		})),
+		"nested property": write(parquet.NewSchema("objects", parquet.Group{
+			"address": parquet.Group{"city": parquet.String()},
+		})),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewParquetReader(bytes.NewReader(in), int64(len(in)))
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for in, expected := range map[string]Format{
+		"jsonl": FormatJSONL, "NDJSON": FormatJSONL, "parquet": FormatParquet,
+	} {
+		f, err := ParseFormat(in)
+		require.Nil(t, err)
+		assert.Equal(t, expected, f)
+	}
+	_, err := ParseFormat("csv")
+	assert.NotNil(t, err)
+	_, err = FormatFromPath("objects")
+	assert.NotNil(t, err)
+}
+
+func TestResolvePath(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	require.Nil(t, err)
+	dir := filepath.Join(root, "import")
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "nested"), 0o755))
+	for _, name := range []string{"import/objects.jsonl", "import/nested/objects.jsonl", "secret.jsonl"} {
+		require.Nil(t, os.WriteFile(filepath.Join(root, name), nil, 0o644))
+	}
+	require.Nil(t, os.Symlink(filepath.Join(root, "secret.jsonl"), filepath.Join(dir, "link.jsonl")))
+	require.Nil(t, os.Symlink(filepath.Join(dir, "objects.jsonl"), filepath.Join(dir, "inner.jsonl")))
+
+	t.Run("within the import directory", func(t *testing.T) {
+		for path, expected := range map[string]string{
+			"objects.jsonl":                            "objects.jsonl",
+			"./nested/objects.jsonl":                   "nested/objects.jsonl",
+			filepath.Join(dir, "objects.jsonl"):        "objects.jsonl",
+			"inner.jsonl":                              "objects.jsonl",
+			filepath.Join(dir, "nested/objects.jsonl"): "nested/objects.jsonl",
+		} {
+			resolved, err := ResolvePath(dir, path)
+			require.Nil(t, err, path)
+			assert.Equal(t, filepath.Join(dir, expected), resolved, path)
+		}
+	})
+
+	t.Run("outside of the import directory", func(t *testing.T) {
+		for _, path := range []string{
+			"../secret.jsonl",
+			"nested/../../secret.jsonl",
+			filepath.Join(root, "secret.jsonl"),
+			"/etc/passwd",
+			"link.jsonl",
+			dir,
+			"",
+		} {
+			_, err := ResolvePath(dir, path)
+			assert.NotNil(t, err, path)
+		}
+	})
+
+	t.Run("without an import directory", func(t *testing.T) {
+		_, err := ResolvePath("", filepath.Join(dir, "objects.jsonl"))
+		assert.NotNil(t, err)
+	})
+}
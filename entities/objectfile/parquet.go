@@ -0,0 +1,847 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/parquet-go/parquet-go"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Objects are stored with one typed column per top-level field of an object:
+//
+//	id                 string
+//	tenant             string
+//	properties         string (JSON)
+//	vector             list<float>
+//	vectors            map<string, list<float>>
+//	multiVectors       map<string, list<list<float>>>
+//	sparseVectors      map<string, struct<indices: list<uint32>, values: list<float>>>
+//	creationTimeUnix   timestamp (milliseconds)
+//	lastUpdateTimeUnix timestamp (milliseconds)
+//
+// All columns are optional when reading. Any other top-level column of a
+// primitive type or a list of a primitive type is read as a property of the
+// same name, so that files written by pyarrow, Spark or DuckDB can be loaded
+// without packing the properties into JSON first. Vectors may also be stored
+// as lists of doubles, timestamps may use any unit.
+//
+// The rows are decoded from the repetition and definition levels of the
+// column values rather than through struct reflection, which does not handle
+// optional and nested lists reliably.
+
+const (
+	parquetMaxRowGroupRows = 10_000
+	parquetReadBatchSize   = 128
+)
+
+var (
+	parquetFloats = parquet.List(parquet.Leaf(parquet.FloatType))
+	parquetSchema = parquet.NewSchema("object", parquet.Group{
+		"id":           parquet.String(),
+		"tenant":       parquet.Optional(parquet.String()),
+		"properties":   parquet.Optional(parquet.JSON()),
+		"vector":       parquet.Optional(parquetFloats),
+		"vectors":      parquet.Optional(parquet.Map(parquet.String(), parquetFloats)),
+		"multiVectors": parquet.Optional(parquet.Map(parquet.String(), parquet.List(parquetFloats))),
+		"sparseVectors": parquet.Optional(parquet.Map(parquet.String(), parquet.Group{
+			"indices": parquet.List(parquet.Uint(32)),
+			"values":  parquetFloats,
+		})),
+		"creationTimeUnix":   parquet.Optional(parquet.Timestamp(parquet.Millisecond)),
+		"lastUpdateTimeUnix": parquet.Optional(parquet.Timestamp(parquet.Millisecond)),
+	})
+)
+
+// parquetColumnIndex returns the index of a leaf column of parquetSchema
+func parquetColumnIndex(path ...string) int {
+	leaf, ok := parquetSchema.Lookup(path...)
+	if !ok {
+		panic(fmt.Sprintf("no parquet column %v", path))
+	}
+	return leaf.ColumnIndex
+}
+
+var (
+	parquetColumnID                 = parquetColumnIndex("id")
+	parquetColumnTenant             = parquetColumnIndex("tenant")
+	parquetColumnProperties         = parquetColumnIndex("properties")
+	parquetColumnVector             = parquetColumnIndex("vector", "list", "element")
+	parquetColumnVectorsKey         = parquetColumnIndex("vectors", "key_value", "key")
+	parquetColumnVectorsValue       = parquetColumnIndex("vectors", "key_value", "value", "list", "element")
+	parquetColumnMultiVectorsKey    = parquetColumnIndex("multiVectors", "key_value", "key")
+	parquetColumnMultiVectorsValue  = parquetColumnIndex("multiVectors", "key_value", "value", "list", "element", "list", "element")
+	parquetColumnSparseVectorsKey   = parquetColumnIndex("sparseVectors", "key_value", "key")
+	parquetColumnSparseIndices      = parquetColumnIndex("sparseVectors", "key_value", "value", "indices", "list", "element")
+	parquetColumnSparseValues       = parquetColumnIndex("sparseVectors", "key_value", "value", "values", "list", "element")
+	parquetColumnCreationTimeUnix   = parquetColumnIndex("creationTimeUnix")
+	parquetColumnLastUpdateTimeUnix = parquetColumnIndex("lastUpdateTimeUnix")
+)
+
+// ParquetWriter writes objects as a parquet file. Objects are buffered in
+// memory until a row group is complete.
+type ParquetWriter struct {
+	w *parquet.Writer
+}
+
+func NewParquetWriter(w io.Writer) *ParquetWriter {
+	return &ParquetWriter{w: parquet.NewWriter(w, parquetSchema,
+		parquet.Compression(&parquet.Snappy),
+		parquet.MaxRowsPerRowGroup(parquetMaxRowGroupRows),
+		parquet.CreatedBy("weaviate", "", ""))}
+}
+
+func (w *ParquetWriter) Write(obj *models.Object) error {
+	row, err := parquetRow(obj)
+	if err != nil {
+		return fmt.Errorf("object %s: %w", obj.ID, err)
+	}
+	_, err = w.w.WriteRows([]parquet.Row{row})
+	return err
+}
+
+// Close flushes buffered objects and writes the file footer. It does not
+// close the underlying writer.
+func (w *ParquetWriter) Close() error {
+	return w.w.Close()
+}
+
+// parquetRow encodes obj as a row of parquetSchema. The levels of the values
+// follow from the schema: all columns but id are optional, lists and maps
+// are absent when empty, and list elements are required.
+func parquetRow(obj *models.Object) (parquet.Row, error) {
+	if obj.ID == "" {
+		return nil, fmt.Errorf("object has no id")
+	}
+
+	var row parquet.Row
+	row = append(row, parquet.ByteArrayValue([]byte(obj.ID)).Level(0, 0, parquetColumnID))
+	row = appendParquetOptional(row, parquetColumnTenant, obj.Tenant != "",
+		parquet.ByteArrayValue([]byte(obj.Tenant)))
+
+	var props []byte
+	if obj.Properties != nil {
+		b, err := json.Marshal(obj.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("properties: %w", err)
+		}
+		props = b
+	}
+	row = appendParquetOptional(row, parquetColumnProperties, props != nil,
+		parquet.ByteArrayValue(props))
+
+	if len(obj.Vector) == 0 {
+		row = append(row, parquet.NullValue().Level(0, 0, parquetColumnVector))
+	} else {
+		row = appendParquetList(row, parquetColumnVector, 0, 1, 2, parquetFloatValues(obj.Vector))
+	}
+
+	if len(obj.Vectors) == 0 {
+		row = append(row,
+			parquet.NullValue().Level(0, 0, parquetColumnVectorsKey),
+			parquet.NullValue().Level(0, 0, parquetColumnVectorsValue))
+	}
+	for i, name := range sortedKeys(obj.Vectors) {
+		rep := min(i, 1)
+		row = append(row, parquet.ByteArrayValue([]byte(name)).Level(rep, 2, parquetColumnVectorsKey))
+		row = appendParquetList(row, parquetColumnVectorsValue, rep, 2, 3,
+			parquetFloatValues(obj.Vectors[name]))
+	}
+
+	if len(obj.MultiVectors) == 0 {
+		row = append(row,
+			parquet.NullValue().Level(0, 0, parquetColumnMultiVectorsKey),
+			parquet.NullValue().Level(0, 0, parquetColumnMultiVectorsValue))
+	}
+	for i, name := range sortedKeys(obj.MultiVectors) {
+		rep := min(i, 1)
+		row = append(row, parquet.ByteArrayValue([]byte(name)).Level(rep, 2, parquetColumnMultiVectorsKey))
+		vectors := obj.MultiVectors[name]
+		if len(vectors) == 0 {
+			row = append(row, parquet.NullValue().Level(rep, 2, parquetColumnMultiVectorsValue))
+		}
+		for j, vector := range vectors {
+			if j > 0 {
+				rep = 2
+			}
+			row = appendParquetList(row, parquetColumnMultiVectorsValue, rep, 3, 4,
+				parquetFloatValues(vector))
+		}
+	}
+
+	if len(obj.SparseVectors) == 0 {
+		row = append(row,
+			parquet.NullValue().Level(0, 0, parquetColumnSparseVectorsKey),
+			parquet.NullValue().Level(0, 0, parquetColumnSparseIndices),
+			parquet.NullValue().Level(0, 0, parquetColumnSparseValues))
+	}
+	for i, name := range sortedKeys(obj.SparseVectors) {
+		rep := min(i, 1)
+		vector := obj.SparseVectors[name]
+		indices := make([]parquet.Value, len(vector.Indices))
+		for j, index := range vector.Indices {
+			indices[j] = parquet.Int32Value(int32(index))
+		}
+		row = append(row, parquet.ByteArrayValue([]byte(name)).Level(rep, 2, parquetColumnSparseVectorsKey))
+		row = appendParquetList(row, parquetColumnSparseIndices, rep, 2, 3, indices)
+		row = appendParquetList(row, parquetColumnSparseValues, rep, 2, 3,
+			parquetFloatValues(vector.Values))
+	}
+
+	row = appendParquetOptional(row, parquetColumnCreationTimeUnix, obj.CreationTimeUnix != 0,
+		parquet.Int64Value(obj.CreationTimeUnix))
+	row = appendParquetOptional(row, parquetColumnLastUpdateTimeUnix, obj.LastUpdateTimeUnix != 0,
+		parquet.Int64Value(obj.LastUpdateTimeUnix))
+
+	// the values of a row are ordered by column, keeping the order of the
+	// values within a column
+	sort.SliceStable(row, func(i, j int) bool { return row[i].Column() < row[j].Column() })
+	return row, nil
+}
+
+func appendParquetOptional(row parquet.Row, column int, ok bool, v parquet.Value) parquet.Row {
+	if !ok {
+		return append(row, parquet.NullValue().Level(0, 0, column))
+	}
+	return append(row, v.Level(0, 1, column))
+}
+
+// appendParquetList appends the elements of a list. rep is the repetition
+// level of the first element, depth the one of the following elements and
+// def the definition level of an element. An empty list is a null value one
+// definition level below.
+func appendParquetList(row parquet.Row, column, rep, depth, def int, values []parquet.Value) parquet.Row {
+	if len(values) == 0 {
+		return append(row, parquet.NullValue().Level(rep, def-1, column))
+	}
+	for i, v := range values {
+		if i > 0 {
+			rep = depth
+		}
+		row = append(row, v.Level(rep, def, column))
+	}
+	return row
+}
+
+func parquetFloatValues(vector []float32) []parquet.Value {
+	values := make([]parquet.Value, len(vector))
+	for i, f := range vector {
+		values[i] = parquet.FloatValue(f)
+	}
+	return values
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ParquetReader reads objects from a parquet file.
+type ParquetReader struct {
+	fields    []parquetField
+	rowGroups []parquet.RowGroup
+	rows      parquet.Rows
+	buf       []parquet.Row
+	n, pos    int
+	row       int64
+	columns   [][]parquet.Value
+}
+
+// parquetField decodes a top-level column of a row into obj. columns holds
+// the values of the row by leaf column index.
+type parquetField func(obj *models.Object, columns [][]parquet.Value) error
+
+func NewParquetReader(r io.ReaderAt, size int64) (*ParquetReader, error) {
+	f, err := parquet.OpenFile(r, size,
+		parquet.SkipPageIndex(true), parquet.SkipBloomFilters(true))
+	if err != nil {
+		return nil, err
+	}
+
+	root := parquetNode{node: f.Schema()}
+	var fields []parquetField
+	column := 0
+	for _, field := range f.Schema().Fields() {
+		n := root.child(field, column)
+		column += parquetNumLeaves(field)
+
+		decode, err := parquetFieldOf(field.Name(), n)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", field.Name(), err)
+		}
+		fields = append(fields, decode)
+	}
+
+	return &ParquetReader{
+		fields:    fields,
+		rowGroups: f.RowGroups(),
+		buf:       make([]parquet.Row, parquetReadBatchSize),
+		columns:   make([][]parquet.Value, column),
+	}, nil
+}
+
+func (r *ParquetReader) Read() (*models.Object, error) {
+	for r.pos == r.n {
+		if err := r.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	row := r.buf[r.pos]
+	r.pos++
+	r.row++
+
+	row.Range(func(column int, values []parquet.Value) bool {
+		r.columns[column] = values
+		return true
+	})
+	obj := &models.Object{}
+	for _, decode := range r.fields {
+		if err := decode(obj, r.columns); err != nil {
+			return nil, fmt.Errorf("row %d: %w", r.row, err)
+		}
+	}
+	return obj, nil
+}
+
+// next reads the next batch of rows, moving on to the next row group once the
+// current one is exhausted.
+func (r *ParquetReader) next() error {
+	if r.rows == nil {
+		if len(r.rowGroups) == 0 {
+			return io.EOF
+		}
+		r.rows = r.rowGroups[0].Rows()
+		r.rowGroups = r.rowGroups[1:]
+	}
+
+	n, err := r.rows.ReadRows(r.buf)
+	r.n, r.pos = n, 0
+	if errors.Is(err, io.EOF) {
+		err = r.rows.Close()
+		r.rows = nil
+	}
+	return err
+}
+
+func parquetFieldOf(name string, n parquetNode) (parquetField, error) {
+	switch name {
+	case "id":
+		return parquetStringField(n, func(obj *models.Object, s string) {
+			obj.ID = strfmt.UUID(s)
+		})
+	case "tenant":
+		return parquetStringField(n, func(obj *models.Object, s string) {
+			obj.Tenant = s
+		})
+	case "properties":
+		return parquetPropertiesField(n)
+	case "vector":
+		return parquetVectorField(n)
+	case "vectors":
+		return parquetVectorsField(n)
+	case "multiVectors":
+		return parquetMultiVectorsField(n)
+	case "sparseVectors":
+		return parquetSparseVectorsField(n)
+	case "creationTimeUnix":
+		return parquetTimeField(n, func(obj *models.Object, ms int64) {
+			obj.CreationTimeUnix = ms
+		})
+	case "lastUpdateTimeUnix":
+		return parquetTimeField(n, func(obj *models.Object, ms int64) {
+			obj.LastUpdateTimeUnix = ms
+		})
+	default:
+		return parquetPropertyField(name, n)
+	}
+}
+
+func parquetStringField(n parquetNode, set func(obj *models.Object, s string)) (parquetField, error) {
+	if !n.isString() {
+		return nil, fmt.Errorf("expected a string, got %s", n.node.Type())
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		v := columns[n.column][0]
+		if !v.IsNull() {
+			set(obj, string(v.ByteArray()))
+		}
+		return nil
+	}, nil
+}
+
+func parquetPropertiesField(n parquetNode) (parquetField, error) {
+	if !n.isString() {
+		return nil, fmt.Errorf("expected a JSON string, got %s", n.node.Type())
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		v := columns[n.column][0]
+		if v.IsNull() {
+			return nil
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(v.ByteArray()))
+		dec.UseNumber()
+		var props map[string]interface{}
+		if err := dec.Decode(&props); err != nil {
+			return fmt.Errorf("properties: %w", err)
+		}
+		for name, value := range props {
+			setProperty(obj, name, value)
+		}
+		return nil
+	}, nil
+}
+
+func parquetVectorField(n parquetNode) (parquetField, error) {
+	elem, err := n.floatList()
+	if err != nil {
+		return nil, err
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		vector, err := elem.floats(columns[elem.column])
+		if err != nil {
+			return fmt.Errorf("vector: %w", err)
+		}
+		if len(vector) > 0 {
+			obj.Vector = vector
+		}
+		return nil
+	}, nil
+}
+
+func parquetVectorsField(n parquetNode) (parquetField, error) {
+	entry, key, value, err := n.mapEntry()
+	if err != nil {
+		return nil, err
+	}
+	elem, err := value.floatList()
+	if err != nil {
+		return nil, err
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		names := entry.split(columns[key.column])
+		vectors := entry.split(columns[elem.column])
+		for i, name := range names {
+			vector, err := elem.floats(vectors[i])
+			if err != nil {
+				return fmt.Errorf("vectors: %w", err)
+			}
+			if obj.Vectors == nil {
+				obj.Vectors = models.Vectors{}
+			}
+			obj.Vectors[string(name[0].ByteArray())] = vector
+		}
+		return nil
+	}, nil
+}
+
+func parquetMultiVectorsField(n parquetNode) (parquetField, error) {
+	entry, key, value, err := n.mapEntry()
+	if err != nil {
+		return nil, err
+	}
+	list, err := value.element()
+	if err != nil {
+		return nil, err
+	}
+	elem, err := list.floatList()
+	if err != nil {
+		return nil, err
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		names := entry.split(columns[key.column])
+		values := entry.split(columns[elem.column])
+		for i, name := range names {
+			var multiVector []models.Vector
+			for _, vectorValues := range list.split(values[i]) {
+				vector, err := elem.floats(vectorValues)
+				if err != nil {
+					return fmt.Errorf("multiVectors: %w", err)
+				}
+				multiVector = append(multiVector, vector)
+			}
+			if obj.MultiVectors == nil {
+				obj.MultiVectors = models.MultiVectors{}
+			}
+			obj.MultiVectors[string(name[0].ByteArray())] = multiVector
+		}
+		return nil
+	}, nil
+}
+
+func parquetSparseVectorsField(n parquetNode) (parquetField, error) {
+	entry, key, value, err := n.mapEntry()
+	if err != nil {
+		return nil, err
+	}
+	indicesNode, ok := value.field("indices")
+	if !ok {
+		return nil, fmt.Errorf("no indices field")
+	}
+	valuesNode, ok := value.field("values")
+	if !ok {
+		return nil, fmt.Errorf("no values field")
+	}
+	indicesElem, err := indicesNode.element()
+	if err != nil {
+		return nil, err
+	}
+	valuesElem, err := valuesNode.floatList()
+	if err != nil {
+		return nil, err
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		names := entry.split(columns[key.column])
+		indices := entry.split(columns[indicesElem.column])
+		values := entry.split(columns[valuesElem.column])
+		for i, name := range names {
+			vector := models.SparseVector{}
+			for _, v := range indices[i] {
+				if v.DefinitionLevel() < indicesElem.listDef() {
+					continue
+				}
+				index, err := parquetInt(v)
+				if err != nil {
+					return fmt.Errorf("sparseVectors: indices: %w", err)
+				}
+				vector.Indices = append(vector.Indices, uint32(index))
+			}
+			vals, err := valuesElem.floats(values[i])
+			if err != nil {
+				return fmt.Errorf("sparseVectors: values: %w", err)
+			}
+			vector.Values = vals
+			if obj.SparseVectors == nil {
+				obj.SparseVectors = models.SparseVectors{}
+			}
+			obj.SparseVectors[string(name[0].ByteArray())] = vector
+		}
+		return nil
+	}, nil
+}
+
+func parquetTimeField(n parquetNode, set func(obj *models.Object, ms int64)) (parquetField, error) {
+	if !n.node.Leaf() || n.node.Repeated() {
+		return nil, fmt.Errorf("expected a timestamp, got %s", n.node.Type())
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		v := columns[n.column][0]
+		if v.IsNull() {
+			return nil
+		}
+		t, err := parquetTime(n.node, v)
+		if err != nil {
+			return err
+		}
+		set(obj, t.UnixMilli())
+		return nil
+	}, nil
+}
+
+// parquetPropertyField reads a column which is not part of the object
+// schema as a property of the same name
+func parquetPropertyField(name string, n parquetNode) (parquetField, error) {
+	if n.node.Leaf() && !n.node.Repeated() {
+		return func(obj *models.Object, columns [][]parquet.Value) error {
+			v := columns[n.column][0]
+			if v.IsNull() {
+				return nil
+			}
+			value, err := parquetPropertyValue(n.node, v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			setProperty(obj, name, value)
+			return nil
+		}, nil
+	}
+
+	elem, err := n.element()
+	if err != nil || !elem.node.Leaf() {
+		return nil, fmt.Errorf("unsupported property type %s", n.node.Type())
+	}
+	return func(obj *models.Object, columns [][]parquet.Value) error {
+		values := columns[elem.column]
+		if values[0].DefinitionLevel() < n.def {
+			// a null list
+			return nil
+		}
+		list := []interface{}{}
+		for _, v := range values {
+			if v.DefinitionLevel() < elem.listDef() {
+				continue
+			}
+			if v.IsNull() {
+				return fmt.Errorf("%s: null element", name)
+			}
+			value, err := parquetPropertyValue(elem.node, v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			list = append(list, value)
+		}
+		setProperty(obj, name, list)
+		return nil
+	}, nil
+}
+
+func setProperty(obj *models.Object, name string, value interface{}) {
+	props, ok := obj.Properties.(map[string]interface{})
+	if !ok {
+		props = map[string]interface{}{}
+		obj.Properties = props
+	}
+	props[name] = value
+}
+
+// parquetPropertyValue converts v to the type the JSON decoder of an object
+// with numbers as json.Number would produce. Dates and timestamps become
+// RFC 3339 strings.
+func parquetPropertyValue(node parquet.Node, v parquet.Value) (interface{}, error) {
+	if lt := node.Type().LogicalType(); lt != nil && (lt.Timestamp != nil || lt.Date != nil) ||
+		v.Kind() == parquet.Int96 {
+		t, err := parquetTime(node, v)
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(time.RFC3339Nano), nil
+	}
+
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean(), nil
+	case parquet.Int32:
+		return json.Number(strconv.FormatInt(int64(v.Int32()), 10)), nil
+	case parquet.Int64:
+		return json.Number(strconv.FormatInt(v.Int64(), 10)), nil
+	case parquet.Float:
+		return json.Number(strconv.FormatFloat(float64(v.Float()), 'g', -1, 32)), nil
+	case parquet.Double:
+		return json.Number(strconv.FormatFloat(v.Double(), 'g', -1, 64)), nil
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		return string(v.ByteArray()), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", v.Kind())
+	}
+}
+
+func parquetFloat(v parquet.Value) (float32, error) {
+	switch v.Kind() {
+	case parquet.Float:
+		return v.Float(), nil
+	case parquet.Double:
+		return float32(v.Double()), nil
+	default:
+		return 0, fmt.Errorf("expected a float, got %s", v.Kind())
+	}
+}
+
+func parquetInt(v parquet.Value) (int64, error) {
+	switch v.Kind() {
+	case parquet.Int32:
+		return int64(v.Int32()), nil
+	case parquet.Int64:
+		return v.Int64(), nil
+	default:
+		return 0, fmt.Errorf("expected an integer, got %s", v.Kind())
+	}
+}
+
+// julianDayUnixEpoch is the julian day of 1970-01-01, used by INT96 timestamps
+const julianDayUnixEpoch = 2440588
+
+// parquetTime converts a timestamp or date value. Plain integers are taken
+// as milliseconds since the unix epoch.
+func parquetTime(node parquet.Node, v parquet.Value) (time.Time, error) {
+	if v.Kind() == parquet.Int96 {
+		// nanoseconds of the day followed by the julian day, as written by Spark
+		i96 := v.Int96()
+		nanos := int64(i96[1])<<32 | int64(i96[0])
+		days := int64(i96[2]) - julianDayUnixEpoch
+		return time.Unix(days*24*60*60, nanos).UTC(), nil
+	}
+
+	i, err := parquetInt(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	lt := node.Type().LogicalType()
+	switch {
+	case lt != nil && lt.Date != nil:
+		return time.Unix(i*24*60*60, 0).UTC(), nil
+	case lt != nil && lt.Timestamp != nil && lt.Timestamp.Unit.Micros != nil:
+		return time.UnixMicro(i).UTC(), nil
+	case lt != nil && lt.Timestamp != nil && lt.Timestamp.Unit.Nanos != nil:
+		return time.Unix(0, i).UTC(), nil
+	default:
+		return time.UnixMilli(i).UTC(), nil
+	}
+}
+
+// parquetNode is a node of the file schema together with the levels needed
+// to decode the values of its leaf columns
+type parquetNode struct {
+	node parquet.Node
+	// column is the index of the first leaf column below the node
+	column int
+	// def is the definition level at which the node is defined
+	def int
+	// repeated holds the definition level of each repeated node on the path
+	// from the root to this node
+	repeated []int
+}
+
+func (n parquetNode) child(field parquet.Field, column int) parquetNode {
+	c := parquetNode{node: field, column: column, def: n.def}
+	c.repeated = append([]int(nil), n.repeated...)
+	if field.Optional() {
+		c.def++
+	}
+	if field.Repeated() {
+		c.def++
+		c.repeated = append(c.repeated, c.def)
+	}
+	return c
+}
+
+func (n parquetNode) isString() bool {
+	return n.node.Leaf() && !n.node.Repeated() && n.node.Type().Kind() == parquet.ByteArray
+}
+
+func (n parquetNode) field(name string) (parquetNode, bool) {
+	column := n.column
+	for _, f := range n.node.Fields() {
+		if f.Name() == name {
+			return n.child(f, column), true
+		}
+		column += parquetNumLeaves(f)
+	}
+	return parquetNode{}, false
+}
+
+// element returns the element of a list. Besides the standard three-level
+// layout, legacy two-level lists and bare repeated fields are supported.
+func (n parquetNode) element() (parquetNode, error) {
+	if n.node.Repeated() {
+		return n, nil
+	}
+	fields := n.node.Fields()
+	if n.node.Leaf() || len(fields) != 1 || !fields[0].Repeated() {
+		return parquetNode{}, fmt.Errorf("expected a list, got %s", n.node.Type())
+	}
+
+	list := n.child(fields[0], n.column)
+	if list.node.Leaf() || len(list.node.Fields()) != 1 {
+		return list, nil
+	}
+	return list.child(list.node.Fields()[0], list.column), nil
+}
+
+func (n parquetNode) floatList() (parquetNode, error) {
+	elem, err := n.element()
+	if err != nil {
+		return parquetNode{}, err
+	}
+	if !elem.node.Leaf() {
+		return parquetNode{}, fmt.Errorf("expected a list of floats, got %s", n.node.Type())
+	}
+	switch kind := elem.node.Type().Kind(); kind {
+	case parquet.Float, parquet.Double:
+		return elem, nil
+	default:
+		return parquetNode{}, fmt.Errorf("expected a list of floats, got a list of %s", kind)
+	}
+}
+
+// mapEntry returns the repeated entry of a map with its key and value
+func (n parquetNode) mapEntry() (entry, key, value parquetNode, err error) {
+	fields := n.node.Fields()
+	if n.node.Leaf() || len(fields) != 1 || !fields[0].Repeated() {
+		return entry, key, value, fmt.Errorf("expected a map, got %s", n.node.Type())
+	}
+
+	entry = n.child(fields[0], n.column)
+	key, hasKey := entry.field("key")
+	value, hasValue := entry.field("value")
+	if !hasKey || !hasValue || !key.isString() {
+		return entry, key, value, fmt.Errorf("expected a map, got %s", n.node.Type())
+	}
+	return entry, key, value, nil
+}
+
+// listDef returns the definition level at which an element of the innermost
+// list around n exists
+func (n parquetNode) listDef() int {
+	return n.repeated[len(n.repeated)-1]
+}
+
+// split groups the values of a leaf column below the repeated node n by the
+// repetitions of n. Values of absent or empty lists are dropped.
+func (n parquetNode) split(values []parquet.Value) [][]parquet.Value {
+	depth := len(n.repeated)
+	var groups [][]parquet.Value
+	for _, v := range values {
+		if v.DefinitionLevel() < n.def {
+			continue
+		}
+		if v.RepetitionLevel() <= depth || len(groups) == 0 {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], v)
+	}
+	return groups
+}
+
+// floats returns the elements of the list of floats that values of the leaf
+// n belong to
+func (n parquetNode) floats(values []parquet.Value) ([]float32, error) {
+	var vector []float32
+	for _, v := range values {
+		if v.DefinitionLevel() < n.listDef() {
+			continue
+		}
+		if v.IsNull() {
+			return nil, fmt.Errorf("null element")
+		}
+		f, err := parquetFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		vector = append(vector, f)
+	}
+	return vector, nil
+}
+
+func parquetNumLeaves(n parquet.Node) int {
+	if n.Leaf() {
+		return 1
+	}
+	count := 0
+	for _, f := range n.Fields() {
+		count += parquetNumLeaves(f)
+	}
+	return count
+}
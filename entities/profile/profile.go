@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package profile collects per-shard timings and counts of a single query.
+// A Profile is carried in the context of a profiled query, every shard search
+// executed on behalf of the query adds a Shard to it. All methods can be
+// called on nil receivers, so that code paths don't have to distinguish
+// between profiled and regular queries.
+package profile
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Header is set on intra-cluster search requests of profiled queries. The
+// remote node responds with the JSON encoded shard profiles in the same header.
+const Header = "X-Weaviate-Profile"
+
+// Kinds of shard searches
+const (
+	SearchVector  = "vector"
+	SearchBM25    = "bm25"
+	SearchSparse  = "sparse"
+	SearchFilter  = "filter"
+	SearchList    = "list"
+	SearchUnknown = "unknown"
+)
+
+// Phases which run on the coordinating node after the shard searches
+const (
+	PhaseFusion     = "fusion"
+	PhaseSort       = "sort"
+	PhaseReferences = "referenceResolution"
+)
+
+type Profile struct {
+	sync.Mutex
+	Shards []*Shard
+	Phases map[string]time.Duration
+}
+
+type Shard struct {
+	Class   string        `json:"class"`
+	Shard   string        `json:"shard"`
+	Node    string        `json:"node"`
+	Search  string        `json:"search"`
+	Took    time.Duration `json:"took"`
+	Results int           `json:"results"`
+	Filter  *Filter       `json:"filter,omitempty"`
+	Vector  *Vector       `json:"vector,omitempty"`
+	BM25    *BM25         `json:"bm25,omitempty"`
+	Sort    time.Duration `json:"sort,omitempty"`
+
+	started time.Time
+}
+
+type Filter struct {
+	Took          time.Duration `json:"took"`
+	AllowListSize int           `json:"allowListSize"`
+}
+
+type Vector struct {
+	Took time.Duration `json:"took"`
+	// Index is the type of the vector index, e.g. hnsw or flat
+	Index string `json:"index"`
+	// Flat is set if the search did not use a graph, i.e. a flat index or
+	// a filtered hnsw search below the flat search cutoff
+	Flat    bool `json:"flat"`
+	Ef      int  `json:"ef"`
+	Visited int  `json:"visited"`
+}
+
+type BM25 struct {
+	Took            time.Duration `json:"took"`
+	Terms           int           `json:"terms"`
+	PostingsScanned int           `json:"postingsScanned"`
+}
+
+func New() *Profile {
+	return &Profile{Phases: map[string]time.Duration{}}
+}
+
+type (
+	profileKey struct{}
+	shardKey   struct{}
+)
+
+func NewContext(ctx context.Context, p *Profile) context.Context {
+	return context.WithValue(ctx, profileKey{}, p)
+}
+
+// FromContext returns the profile of the query, nil if it is not profiled
+func FromContext(ctx context.Context) *Profile {
+	p, _ := ctx.Value(profileKey{}).(*Profile)
+	return p
+}
+
+// ShardFromContext returns the profile of the shard search which is
+// currently executed, nil if the query is not profiled
+func ShardFromContext(ctx context.Context) *Shard {
+	s, _ := ctx.Value(shardKey{}).(*Shard)
+	return s
+}
+
+// StartShard adds a shard search to the profile. The returned context carries
+// the shard profile, so that the components of the search can report into it.
+func (p *Profile) StartShard(ctx context.Context, class, shard, node, search string,
+) (context.Context, *Shard) {
+	if p == nil {
+		return ctx, nil
+	}
+
+	s := &Shard{
+		Class:   class,
+		Shard:   shard,
+		Node:    node,
+		Search:  search,
+		started: time.Now(),
+	}
+	p.Lock()
+	p.Shards = append(p.Shards, s)
+	p.Unlock()
+	return context.WithValue(ctx, shardKey{}, s), s
+}
+
+// AddShards adds shard profiles which were collected on a remote node
+func (p *Profile) AddShards(shards []*Shard) {
+	if p == nil {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.Shards = append(p.Shards, shards...)
+}
+
+// ObservePhase adds the duration of a phase of the query, such as fusion.
+// Phases which run multiple times are summed up.
+func (p *Profile) ObservePhase(phase string, took time.Duration) {
+	if p == nil {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.Phases[phase] += took
+}
+
+// End completes the shard search
+func (s *Shard) End(results int) {
+	if s == nil {
+		return
+	}
+
+	s.Took = time.Since(s.started)
+	s.Results = results
+}
+
+func (s *Shard) ObserveFilter(took time.Duration, allowListSize int) {
+	if s == nil {
+		return
+	}
+
+	if s.Filter == nil {
+		s.Filter = &Filter{}
+	}
+	s.Filter.Took += took
+	s.Filter.AllowListSize = allowListSize
+}
+
+// StartVector returns the vector search profile of the shard, to be filled
+// by the vector index, nil if the query is not profiled
+func (s *Shard) StartVector(index string) *Vector {
+	if s == nil {
+		return nil
+	}
+
+	s.Vector = &Vector{Index: index}
+	return s.Vector
+}
+
+func (s *Shard) ObserveBM25(took time.Duration, terms, postingsScanned int) {
+	if s == nil {
+		return
+	}
+
+	s.BM25 = &BM25{Took: took, Terms: terms, PostingsScanned: postingsScanned}
+}
+
+func (s *Shard) ObserveSort(took time.Duration) {
+	if s == nil {
+		return
+	}
+
+	s.Sort += took
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package profile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileNotEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	p := FromContext(ctx)
+	assert.Nil(t, p)
+
+	ctx, s := p.StartShard(ctx, "Class", "shard", "node", SearchVector)
+	assert.Nil(t, s)
+	assert.Nil(t, ShardFromContext(ctx))
+
+	// none of these must panic
+	p.AddShards([]*Shard{{}})
+	p.ObservePhase(PhaseFusion, time.Second)
+	s.ObserveFilter(time.Second, 10)
+	s.ObserveBM25(time.Second, 1, 2)
+	s.ObserveSort(time.Second)
+	assert.Nil(t, s.StartVector("hnsw"))
+	s.End(10)
+}
+
+func TestProfile(t *testing.T) {
+	p := New()
+	ctx := NewContext(context.Background(), p)
+	require.Equal(t, p, FromContext(ctx))
+
+	shardCtx, s := FromContext(ctx).StartShard(ctx, "Class", "shard", "node", SearchBM25)
+	require.NotNil(t, s)
+	assert.Equal(t, s, ShardFromContext(shardCtx))
+	assert.Nil(t, ShardFromContext(ctx))
+
+	ShardFromContext(shardCtx).ObserveFilter(time.Millisecond, 10)
+	ShardFromContext(shardCtx).ObserveFilter(time.Millisecond, 5)
+	ShardFromContext(shardCtx).ObserveBM25(time.Millisecond, 2, 30)
+	vp := ShardFromContext(shardCtx).StartVector("hnsw")
+	vp.Visited++
+	s.End(3)
+
+	p.AddShards([]*Shard{{Shard: "remote"}})
+	p.ObservePhase(PhaseFusion, time.Millisecond)
+	p.ObservePhase(PhaseFusion, time.Millisecond)
+
+	require.Len(t, p.Shards, 2)
+	assert.Equal(t, "shard", p.Shards[0].Shard)
+	assert.Equal(t, 3, p.Shards[0].Results)
+	assert.Greater(t, p.Shards[0].Took, time.Duration(0))
+	assert.Equal(t, &Filter{Took: 2 * time.Millisecond, AllowListSize: 5}, p.Shards[0].Filter)
+	assert.Equal(t, &BM25{Took: time.Millisecond, Terms: 2, PostingsScanned: 30}, p.Shards[0].BM25)
+	assert.Equal(t, &Vector{Index: "hnsw", Visited: 1}, p.Shards[0].Vector)
+	assert.Equal(t, "remote", p.Shards[1].Shard)
+	assert.Equal(t, 2*time.Millisecond, p.Phases[PhaseFusion])
+}
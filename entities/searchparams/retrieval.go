@@ -12,18 +12,34 @@
 package searchparams
 
 type NearVector struct {
-	Vector        []float32 `json:"vector"`
-	Certainty     float64   `json:"certainty"`
-	Distance      float64   `json:"distance"`
-	WithDistance  bool      `json:"-"`
-	TargetVectors []string  `json:"targetVectors"`
+	Vector        []float32   `json:"vector"`
+	MultiVector   [][]float32 `json:"multiVector"`
+	Certainty     float64     `json:"certainty"`
+	Distance      float64     `json:"distance"`
+	WithDistance  bool        `json:"-"`
+	TargetVectors []string    `json:"targetVectors"`
 }
 
+const (
+	KeywordRankingTypeBM25   = "bm25"
+	KeywordRankingTypeSparse = "sparse"
+)
+
 type KeywordRanking struct {
 	Type                   string   `json:"type"`
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// SparseVector is set instead of Query for rankings of type "sparse"
+	SparseVector *NearSparseVector `json:"sparseVector,omitempty"`
+}
+
+// NearSparseVector ranks objects by the dot product of their sparse vector
+// with the given one
+type NearSparseVector struct {
+	Indices      []uint32  `json:"indices"`
+	Values       []float32 `json:"values"`
+	TargetVector string    `json:"targetVector"`
 }
 
 type WeightedSearchResult struct {
@@ -43,6 +59,9 @@ type HybridSearch struct {
 	FusionAlgorithm  int         `json:"fusionalgorithm"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// SparseVectorParams replaces the bm25 keyword search with a sparse
+	// vector search, if set
+	SparseVectorParams *NearSparseVector
 }
 
 type NearObject struct {
@@ -54,6 +73,31 @@ type NearObject struct {
 	TargetVectors []string `json:"targetVectors"`
 }
 
+const (
+	// RecommendStrategyAverage searches near the average of the positive
+	// examples, moved away from the average of the negative examples
+	RecommendStrategyAverage = "average"
+	// RecommendStrategyBestScore searches near each positive example and ranks
+	// the results by their distance to the closest example
+	RecommendStrategyBestScore = "best_score"
+)
+
+// Recommend searches for objects similar to the positive and dissimilar to
+// the negative examples. Examples are either IDs of objects of the searched
+// class, whose vectors are used, or raw vectors. The examples themselves are
+// never part of the results.
+type Recommend struct {
+	Positive        []string    `json:"positive"`
+	Negative        []string    `json:"negative"`
+	PositiveVectors [][]float32 `json:"positiveVectors"`
+	NegativeVectors [][]float32 `json:"negativeVectors"`
+	Strategy        string      `json:"strategy"`
+	Certainty       float64     `json:"certainty"`
+	Distance        float64     `json:"distance"`
+	WithDistance    bool        `json:"-"`
+	TargetVectors   []string    `json:"targetVectors"`
+}
+
 type ObjectMove struct {
 	ID     string
 	Beacon string
@@ -373,6 +373,12 @@ func parseRefClassificationMeta(in interface{}) (*models.ReferenceMetaClassifica
 		out.ClosestLosingDistance = &ccd
 	}
 
+	if conf, err := extractFloat64(asMap, "confidence"); err != nil {
+		return nil, err
+	} else {
+		out.Confidence = conf
+	}
+
 	if oc, err := extractFloat64(asMap, "overallCount"); err != nil {
 		return nil, err
 	} else {
@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package storobj
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+// The multi-vectors section is optional and appended after the target
+// vectors. It is only written if the object holds multi-vectors or sparse
+// vectors, so objects without them keep their previous binary representation:
+//
+// | segment length (uint32) | entry | entry | ... |
+//
+// with every entry being
+//
+// | name length (uint16) | name | vector count (uint16) | dims (uint16) | vectors (count*dims float32) |
+
+func multiVectorsSegmentLength(multiVectors map[string][][]float32) (uint32, error) {
+	length := uint32(0)
+	for name, vecs := range multiVectors {
+		if len(name) > math.MaxUint16 || len(vecs) > math.MaxUint16 {
+			return 0, fmt.Errorf("multi-vector %q exceeds size limits", name)
+		}
+		dims := 0
+		if len(vecs) > 0 {
+			dims = len(vecs[0])
+		}
+		for _, vec := range vecs {
+			if len(vec) != dims {
+				return 0, fmt.Errorf("multi-vector %q has vectors of different lengths %d and %d",
+					name, dims, len(vec))
+			}
+		}
+		length += 2 + uint32(len(name)) + 2 + 2 + 4*uint32(len(vecs)*dims)
+	}
+	return length, nil
+}
+
+func marshalMultiVectors(rw *byteops.ReadWriter, multiVectors map[string][][]float32,
+	segmentLength uint32,
+) error {
+	names := make([]string, 0, len(multiVectors))
+	for name := range multiVectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rw.WriteUint32(segmentLength)
+	for _, name := range names {
+		vecs := multiVectors[name]
+		rw.WriteUint16(uint16(len(name)))
+		if err := rw.CopyBytesToBuffer([]byte(name)); err != nil {
+			return fmt.Errorf("copy multi-vector name: %w", err)
+		}
+		dims := 0
+		if len(vecs) > 0 {
+			dims = len(vecs[0])
+		}
+		rw.WriteUint16(uint16(len(vecs)))
+		rw.WriteUint16(uint16(dims))
+		for _, vec := range vecs {
+			for _, v := range vec {
+				rw.WriteUint32(math.Float32bits(v))
+			}
+		}
+	}
+	return nil
+}
+
+// unmarshalMultiVectors reads the multi-vectors section, only the entry
+// matching onlyName is decoded if it is set
+func unmarshalMultiVectors(rw *byteops.ReadWriter, onlyName string) map[string][][]float32 {
+	// objects without multi-vectors end after the target vectors
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil
+	}
+
+	segmentLength := rw.ReadUint32()
+	if segmentLength == 0 {
+		return nil
+	}
+	end := rw.Position + uint64(segmentLength)
+
+	multiVectors := map[string][][]float32{}
+	for rw.Position < end {
+		name := string(rw.ReadBytesFromBuffer(uint64(rw.ReadUint16())))
+		count := rw.ReadUint16()
+		dims := rw.ReadUint16()
+		if onlyName != "" && name != onlyName {
+			rw.MoveBufferPositionForward(4 * uint64(count) * uint64(dims))
+			continue
+		}
+
+		vecs := make([][]float32, count)
+		for i := range vecs {
+			vecs[i] = make([]float32, dims)
+			for j := range vecs[i] {
+				vecs[i][j] = math.Float32frombits(rw.ReadUint32())
+			}
+		}
+		multiVectors[name] = vecs
+	}
+
+	return multiVectors
+}
+
+// MultiVectorFromBinary returns the vectors of a single multi-vector without
+// parsing the remaining object
+func MultiVectorFromBinary(in []byte, targetVector string) ([][]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	rw := byteops.NewReadWriter(in)
+	version := rw.ReadUint8()
+	if version != 1 {
+		return nil, fmt.Errorf("unsupported marshaller version %d", version)
+	}
+
+	// docID, kind, uuid, create and update time
+	rw.MoveBufferPositionForward(8 + 1 + 16 + 8 + 8)
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint16()) * 4)
+	rw.MoveBufferPositionForward(uint64(rw.ReadUint16())) // class name
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()  // properties
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()  // meta
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()  // vector weights
+
+	// target vectors, the section is missing entirely in older objects
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()
+	rw.DiscardBytesFromBufferWithUint32LengthIndicator()
+
+	return unmarshalMultiVectors(&rw, targetVector)[targetVector], nil
+}
+
+func multiVectorsFromModel(in models.MultiVectors) map[string][][]float32 {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string][][]float32, len(in))
+	for name, vecs := range in {
+		out[name] = make([][]float32, len(vecs))
+		for i, vec := range vecs {
+			out[name][i] = vec
+		}
+	}
+	return out
+}
+
+func multiVectorsToModel(in map[string][][]float32) models.MultiVectors {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(models.MultiVectors, len(in))
+	for name, vecs := range in {
+		out[name] = make([]models.Vector, len(vecs))
+		for i, vec := range vecs {
+			out[name][i] = vec
+		}
+	}
+	return out
+}
+
+func deepCopyMultiVectors(orig map[string][][]float32) map[string][][]float32 {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(map[string][][]float32, len(orig))
+	for name, vecs := range orig {
+		out[name] = make([][]float32, len(vecs))
+		for i, vec := range vecs {
+			out[name][i] = deepCopyVector(vec)
+		}
+	}
+	return out
+}
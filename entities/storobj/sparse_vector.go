@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package storobj
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+// The sparse vectors section is optional and appended after the
+// multi-vectors section, which is always written (possibly empty) if the
+// object holds sparse vectors:
+//
+// | segment length (uint32) | entry | entry | ... |
+//
+// with every entry being
+//
+// | name length (uint16) | name | count (uint32) | indices (count*uint32) | values (count*float32) |
+
+func sparseVectorsSegmentLength(sparseVectors map[string]models.SparseVector) (uint32, error) {
+	length := uint32(0)
+	for name, vec := range sparseVectors {
+		if len(name) > math.MaxUint16 {
+			return 0, fmt.Errorf("sparse vector %q exceeds size limits", name)
+		}
+		if len(vec.Indices) != len(vec.Values) {
+			return 0, fmt.Errorf("sparse vector %q has %d indices but %d values",
+				name, len(vec.Indices), len(vec.Values))
+		}
+		length += 2 + uint32(len(name)) + 4 + 8*uint32(len(vec.Indices))
+	}
+	return length, nil
+}
+
+func marshalSparseVectors(rw *byteops.ReadWriter, sparseVectors map[string]models.SparseVector,
+	segmentLength uint32,
+) error {
+	names := make([]string, 0, len(sparseVectors))
+	for name := range sparseVectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rw.WriteUint32(segmentLength)
+	for _, name := range names {
+		vec := sparseVectors[name]
+		rw.WriteUint16(uint16(len(name)))
+		if err := rw.CopyBytesToBuffer([]byte(name)); err != nil {
+			return fmt.Errorf("copy sparse vector name: %w", err)
+		}
+		rw.WriteUint32(uint32(len(vec.Indices)))
+		for _, index := range vec.Indices {
+			rw.WriteUint32(index)
+		}
+		for _, value := range vec.Values {
+			rw.WriteUint32(math.Float32bits(value))
+		}
+	}
+	return nil
+}
+
+func unmarshalSparseVectors(rw *byteops.ReadWriter) map[string]models.SparseVector {
+	// objects without sparse vectors end after the multi-vectors
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil
+	}
+
+	segmentLength := rw.ReadUint32()
+	end := rw.Position + uint64(segmentLength)
+
+	sparseVectors := map[string]models.SparseVector{}
+	for rw.Position < end {
+		name := string(rw.ReadBytesFromBuffer(uint64(rw.ReadUint16())))
+		count := rw.ReadUint32()
+
+		vec := models.SparseVector{
+			Indices: make([]uint32, count),
+			Values:  make([]float32, count),
+		}
+		for i := range vec.Indices {
+			vec.Indices[i] = rw.ReadUint32()
+		}
+		for i := range vec.Values {
+			vec.Values[i] = math.Float32frombits(rw.ReadUint32())
+		}
+		sparseVectors[name] = vec
+	}
+
+	return sparseVectors
+}
+
+func sparseVectorsFromModel(in models.SparseVectors) map[string]models.SparseVector {
+	if in == nil {
+		return nil
+	}
+
+	out := make(map[string]models.SparseVector, len(in))
+	for name, vec := range in {
+		out[name] = vec
+	}
+	return out
+}
+
+func sparseVectorsToModel(in map[string]models.SparseVector) models.SparseVectors {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make(models.SparseVectors, len(in))
+	for name, vec := range in {
+		out[name] = vec
+	}
+	return out
+}
+
+func deepCopySparseVectors(orig map[string]models.SparseVector) map[string]models.SparseVector {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(map[string]models.SparseVector, len(orig))
+	for name, vec := range orig {
+		indices := make([]uint32, len(vec.Indices))
+		copy(indices, vec.Indices)
+		out[name] = models.SparseVector{
+			Indices: indices,
+			Values:  deepCopyVector(vec.Values),
+		}
+	}
+	return out
+}
@@ -52,7 +52,9 @@ type Object struct {
 	BelongsToShard    string        `json:"-"`
 	IsConsistent      bool          `json:"-"`
 	DocID             uint64
-	Vectors           map[string][]float32 `json:"vectors"`
+	Vectors           map[string][]float32           `json:"vectors"`
+	MultiVectors      map[string][][]float32         `json:"multiVectors"`
+	SparseVectors     map[string]models.SparseVector `json:"sparseVectors"`
 }
 
 func New(docID uint64) *Object {
@@ -88,6 +90,8 @@ func FromObject(object *models.Object, vector []float32, vectors models.Vectors)
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 		Vectors:           vecs,
+		MultiVectors:      multiVectorsFromModel(object.MultiVectors),
+		SparseVectors:     sparseVectorsFromModel(object.SparseVectors),
 	}
 }
 
@@ -201,6 +205,9 @@ func FromBinaryOptional(data []byte,
 				ko.Object.Vectors[vecName] = vec
 			}
 		}
+
+		ko.MultiVectors = unmarshalMultiVectors(&rw, "")
+		ko.SparseVectors = unmarshalSparseVectors(&rw)
 	}
 
 	// some object members need additional "enrichment". Only do this if necessary, ie if they are actually present
@@ -403,13 +410,15 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 	}
 
 	return &search.Result{
-		ID:        ko.ID(),
-		DocID:     &ko.DocID,
-		ClassName: ko.Class().String(),
-		Schema:    ko.Properties(),
-		Vector:    ko.Vector,
-		Vectors:   ko.asVectors(ko.Vectors),
-		Dims:      ko.VectorLen,
+		ID:            ko.ID(),
+		DocID:         &ko.DocID,
+		ClassName:     ko.Class().String(),
+		Schema:        ko.Properties(),
+		Vector:        ko.Vector,
+		Vectors:       ko.asVectors(ko.Vectors),
+		MultiVectors:  multiVectorsToModel(ko.MultiVectors),
+		SparseVectors: sparseVectorsToModel(ko.SparseVectors),
+		Dims:          ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
@@ -591,6 +600,24 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		targetVectorsOffsetsLength = uint32(len(targetVectorsOffsets))
 	}
 
+	sparseVectorsLength := uint32(0)
+	if len(ko.SparseVectors) > 0 {
+		segmentLength, err := sparseVectorsSegmentLength(ko.SparseVectors)
+		if err != nil {
+			return nil, err
+		}
+		sparseVectorsLength = 4 + segmentLength
+	}
+
+	multiVectorsLength := uint32(0)
+	if len(ko.MultiVectors) > 0 || sparseVectorsLength > 0 {
+		segmentLength, err := multiVectorsSegmentLength(ko.MultiVectors)
+		if err != nil {
+			return nil, err
+		}
+		multiVectorsLength = 4 + segmentLength
+	}
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -598,7 +625,9 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + metaLength +
 		4 + vectorWeightsLength +
 		4 + targetVectorsOffsetsLength +
-		4 + targetVectorsSegmentLength
+		4 + targetVectorsSegmentLength +
+		multiVectorsLength +
+		sparseVectorsLength
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -659,6 +688,18 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	if multiVectorsLength > 0 {
+		if err := marshalMultiVectors(&rw, ko.MultiVectors, multiVectorsLength-4); err != nil {
+			return byteBuffer, err
+		}
+	}
+
+	if sparseVectorsLength > 0 {
+		if err := marshalSparseVectors(&rw, ko.SparseVectors, sparseVectorsLength-4); err != nil {
+			return byteBuffer, err
+		}
+	}
+
 	return byteBuffer, nil
 }
 
@@ -806,6 +847,8 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return err
 	}
 	ko.Vectors = vectors
+	ko.MultiVectors = unmarshalMultiVectors(&rw, "")
+	ko.SparseVectors = unmarshalSparseVectors(&rw)
 
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
@@ -982,6 +1025,8 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectors(ko.Vectors),
+		MultiVectors:      deepCopyMultiVectors(ko.MultiVectors),
+		SparseVectors:     deepCopySparseVectors(ko.SparseVectors),
 	}
 
 	return o
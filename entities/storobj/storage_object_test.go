@@ -624,3 +624,141 @@ func TestStorageMaxVectorDimensionsObjectMarshalling(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageObjectMultiVectorMarshalling(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			MultiVectors: models.MultiVectors{
+				"colbert": {{1, 2}, {3, 4}, {5, 6}},
+				"tokens":  {{7, 8, 9}},
+			},
+		},
+		nil,
+		models.Vectors{
+			"vector1": {1, 2, 3},
+		},
+	)
+	before.DocID = 7
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("roundtrip", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.Properties(), after.Properties())
+	})
+
+	t.Run("optional with vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vectors: []string{"vector1"}})
+		require.Nil(t, err)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+	})
+
+	t.Run("extract single multi-vector", func(t *testing.T) {
+		vecs, err := MultiVectorFromBinary(asBinary, "colbert")
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{1, 2}, {3, 4}, {5, 6}}, vecs)
+
+		vecs, err = MultiVectorFromBinary(asBinary, "missing")
+		require.Nil(t, err)
+		assert.Nil(t, vecs)
+	})
+
+	t.Run("objects without multi-vectors keep their layout", func(t *testing.T) {
+		withMulti := before.DeepCopyDangerous()
+		withoutMulti := before.DeepCopyDangerous()
+		withoutMulti.MultiVectors = nil
+
+		a, err := withMulti.MarshalBinary()
+		require.Nil(t, err)
+		b, err := withoutMulti.MarshalBinary()
+		require.Nil(t, err)
+		assert.Equal(t, b, a[:len(b)])
+
+		after, err := FromBinary(b)
+		require.Nil(t, err)
+		assert.Nil(t, after.MultiVectors)
+	})
+
+	t.Run("vectors of different lengths are rejected", func(t *testing.T) {
+		invalid := before.DeepCopyDangerous()
+		invalid.MultiVectors = map[string][][]float32{"colbert": {{1, 2}, {3}}}
+		_, err := invalid.MarshalBinary()
+		assert.NotNil(t, err)
+	})
+}
+
+func TestStorageObjectSparseVectorMarshalling(t *testing.T) {
+	sparseVectors := models.SparseVectors{
+		"splade": {Indices: []uint32{3, 17, 2048}, Values: []float32{0.5, 1.25, 0.125}},
+	}
+
+	t.Run("roundtrip without multi-vectors", func(t *testing.T) {
+		before := FromObject(
+			&models.Object{
+				Class:         "MyFavoriteClass",
+				ID:            strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+				SparseVectors: sparseVectors,
+			},
+			nil,
+			models.Vectors{"vector1": {1, 2, 3}},
+		)
+
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+		assert.Nil(t, after.MultiVectors)
+		assert.Equal(t, before.Vectors, after.Vectors)
+
+		after, err = FromBinaryOptional(asBinary, additional.Properties{Vectors: []string{"splade"}})
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+	})
+
+	t.Run("roundtrip with multi-vectors", func(t *testing.T) {
+		before := FromObject(
+			&models.Object{
+				Class:         "MyFavoriteClass",
+				ID:            strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+				SparseVectors: sparseVectors,
+				MultiVectors:  models.MultiVectors{"colbert": {{1, 2}, {3, 4}}},
+			},
+			nil,
+			nil,
+		)
+
+		asBinary, err := before.MarshalBinary()
+		require.Nil(t, err)
+
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+
+		vecs, err := MultiVectorFromBinary(asBinary, "colbert")
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{1, 2}, {3, 4}}, vecs)
+	})
+
+	t.Run("mismatching indices and values are rejected", func(t *testing.T) {
+		invalid := FromObject(&models.Object{Class: "MyFavoriteClass"}, nil, nil)
+		invalid.SparseVectors = map[string]models.SparseVector{
+			"splade": {Indices: []uint32{1, 2}, Values: []float32{0.5}},
+		}
+		_, err := invalid.MarshalBinary()
+		assert.NotNil(t, err)
+	})
+}
@@ -17,11 +17,13 @@ import (
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
+	VectorIndexTypeSPARSE  = "sparse"
 	DefaultVectorIndexType = VectorIndexTypeHNSW
 )
 
@@ -37,7 +39,9 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string) (schemaCo
 		return hnsw.ParseAndValidateConfig(input)
 	case VectorIndexTypeFLAT:
 		return flat.ParseAndValidateConfig(input)
+	case VectorIndexTypeSPARSE:
+		return sparse.ParseAndValidateConfig(input)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat and sparse", vectorIndexType)
 	}
 }
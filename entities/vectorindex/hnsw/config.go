@@ -30,27 +30,39 @@ const (
 	DefaultDynamicEFFactor        = 8
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
+	DefaultFilterStrategy         = FilterStrategySweeping
 
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
 	MinmumEFConstruction = 4
 )
 
+const (
+	// FilterStrategySweeping traverses the unfiltered graph and skips results
+	// which are not on the allow list
+	FilterStrategySweeping = "sweeping"
+	// FilterStrategyAcorn only evaluates nodes on the allow list and expands
+	// to two-hop neighbors when direct neighbors are filtered out
+	FilterStrategyAcorn = "acorn"
+)
+
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool     `json:"skip"`
-	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
-	MaxConnections         int      `json:"maxConnections"`
-	EFConstruction         int      `json:"efConstruction"`
-	EF                     int      `json:"ef"`
-	DynamicEFMin           int      `json:"dynamicEfMin"`
-	DynamicEFMax           int      `json:"dynamicEfMax"`
-	DynamicEFFactor        int      `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
-	Distance               string   `json:"distance"`
-	PQ                     PQConfig `json:"pq"`
-	BQ                     BQConfig `json:"bq"`
+	Skip                   bool              `json:"skip"`
+	CleanupIntervalSeconds int               `json:"cleanupIntervalSeconds"`
+	MaxConnections         int               `json:"maxConnections"`
+	EFConstruction         int               `json:"efConstruction"`
+	EF                     int               `json:"ef"`
+	DynamicEFMin           int               `json:"dynamicEfMin"`
+	DynamicEFMax           int               `json:"dynamicEfMax"`
+	DynamicEFFactor        int               `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int               `json:"vectorCacheMaxObjects"`
+	FlatSearchCutoff       int               `json:"flatSearchCutoff"`
+	FilterStrategy         string            `json:"filterStrategy"`
+	Distance               string            `json:"distance"`
+	PQ                     PQConfig          `json:"pq"`
+	BQ                     BQConfig          `json:"bq"`
+	Multivector            MultivectorConfig `json:"multivector"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -75,6 +87,7 @@ func (u *UserConfig) SetDefaults() {
 	u.DynamicEFMin = DefaultDynamicEFMin
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.FilterStrategy = DefaultFilterStrategy
 	u.Distance = vectorIndexCommon.DefaultDistanceMetric
 	u.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
@@ -90,6 +103,10 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ = BQConfig{
 		Enabled: DefaultBQEnabled,
 	}
+	u.Multivector = MultivectorConfig{
+		Enabled:     DefaultMultivectorEnabled,
+		Aggregation: DefaultMultivectorAggregation,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -161,6 +178,12 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
+		uc.FilterStrategy = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalBoolFromMap(asMap, "skip", func(v bool) {
 		uc.Skip = v
 	}); err != nil {
@@ -181,6 +204,10 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseMultivectorMap(asMap, &uc.Multivector); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -200,6 +227,15 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	switch u.FilterStrategy {
+	case FilterStrategySweeping, FilterStrategyAcorn:
+	default:
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"filterStrategy must be one of %q or %q, got %q",
+			FilterStrategySweeping, FilterStrategyAcorn, u.FilterStrategy,
+		))
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -209,6 +245,15 @@ func (u *UserConfig) validate() error {
 		return fmt.Errorf("invalid hnsw config: two compression methods enabled: PQ and BQ")
 	}
 
+	if u.Multivector.Enabled {
+		if err := u.Multivector.validate(); err != nil {
+			return fmt.Errorf("invalid hnsw config: %w", err)
+		}
+		if u.PQ.Enabled || u.BQ.Enabled {
+			return fmt.Errorf("invalid hnsw config: multivector cannot be combined with compression")
+		}
+	}
+
 	return nil
 }
 
@@ -42,6 +42,8 @@ func Test_UserConfig(t *testing.T) {
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
@@ -72,6 +74,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
@@ -112,6 +116,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -153,6 +159,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -194,6 +202,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -234,6 +244,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -283,6 +295,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -330,6 +344,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -347,6 +363,113 @@ func Test_UserConfig(t *testing.T) {
 			},
 		},
 
+		{
+			name: "with acorn filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "acorn",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         FilterStrategyAcorn,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+			},
+		},
+
+		{
+			name: "with invalid filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "bruteforce",
+			},
+			expectErr: true,
+			expectErrMsg: "invalid hnsw config: filterStrategy must be one of " +
+				"\"sweeping\" or \"acorn\", got \"bruteforce\"",
+		},
+
+		{
+			name: "with multivector",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     true,
+					Aggregation: MultivectorAggregationMaxSim,
+				},
+				DynamicEFMin:    DefaultDynamicEFMin,
+				DynamicEFMax:    DefaultDynamicEFMax,
+				DynamicEFFactor: DefaultDynamicEFFactor,
+				Distance:        common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+			},
+		},
+
+		{
+			name: "with invalid multivector aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "mean",
+				},
+			},
+			expectErr: true,
+			expectErrMsg: "invalid hnsw config: multivector aggregation must be " +
+				"\"maxSim\", got \"mean\"",
+		},
+
+		{
+			name: "with multivector and compression",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: multivector cannot be combined with compression",
+		},
+
 		{
 			name: "with invalid encoder",
 			input: map[string]interface{}{
@@ -396,6 +519,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  math.MaxInt64,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -472,6 +597,8 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				Multivector:            MultivectorConfig{Aggregation: DefaultMultivectorAggregation},
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMultivectorEnabled     = false
+	DefaultMultivectorAggregation = MultivectorAggregationMaxSim
+
+	// MultivectorAggregationMaxSim scores a document by summing, for every
+	// query vector, the similarity of its closest document vector
+	MultivectorAggregationMaxSim = "maxSim"
+)
+
+// MultivectorConfig turns the index into a late-interaction index. Every
+// object holds a variable number of vectors, each of which is indexed as its
+// own node and mapped back to the object's docID.
+type MultivectorConfig struct {
+	Enabled     bool   `json:"enabled"`
+	Aggregation string `json:"aggregation"`
+}
+
+func parseMultivectorMap(in map[string]interface{}, mv *MultivectorConfig) error {
+	mvConfigValue, ok := in["multivector"]
+	if !ok {
+		return nil
+	}
+
+	mvConfigMap, ok := mvConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(mvConfigMap, "enabled", func(v bool) {
+		mv.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalStringFromMap(mvConfigMap, "aggregation", func(v string) {
+		mv.Aggregation = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (mv MultivectorConfig) validate() error {
+	if mv.Aggregation != MultivectorAggregationMaxSim {
+		return fmt.Errorf("multivector aggregation must be %q, got %q",
+			MultivectorAggregationMaxSim, mv.Aggregation)
+	}
+	return nil
+}
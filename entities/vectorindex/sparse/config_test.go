@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SparseUserConfig(t *testing.T) {
+	type test struct {
+		name        string
+		input       interface{}
+		expected    UserConfig
+		expectErr   bool
+		expectedErr string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified",
+			input:    nil,
+			expected: UserConfig{},
+		},
+		{
+			name:     "empty map",
+			input:    map[string]interface{}{},
+			expected: UserConfig{},
+		},
+		{
+			name: "dot distance",
+			input: map[string]interface{}{
+				"distance": "dot",
+			},
+			expected: UserConfig{},
+		},
+		{
+			name: "unsupported distance",
+			input: map[string]interface{}{
+				"distance": "cosine",
+			},
+			expectErr:   true,
+			expectedErr: `sparse vector index only supports the "dot" distance`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, cfg)
+			assert.Equal(t, "sparse", cfg.IndexType())
+			assert.Equal(t, "dot", cfg.DistanceName())
+		})
+	}
+}
@@ -16,9 +16,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Weaviate_Search_FullMethodName       = "/weaviate.v1.Weaviate/Search"
-	Weaviate_BatchObjects_FullMethodName = "/weaviate.v1.Weaviate/BatchObjects"
-	Weaviate_BatchDelete_FullMethodName  = "/weaviate.v1.Weaviate/BatchDelete"
+	Weaviate_Search_FullMethodName        = "/weaviate.v1.Weaviate/Search"
+	Weaviate_BatchObjects_FullMethodName  = "/weaviate.v1.Weaviate/BatchObjects"
+	Weaviate_BatchDelete_FullMethodName   = "/weaviate.v1.Weaviate/BatchDelete"
+	Weaviate_StreamChanges_FullMethodName = "/weaviate.v1.Weaviate/StreamChanges"
+	Weaviate_BulkLoad_FullMethodName      = "/weaviate.v1.Weaviate/BulkLoad"
+	Weaviate_Export_FullMethodName        = "/weaviate.v1.Weaviate/Export"
+	Weaviate_Import_FullMethodName        = "/weaviate.v1.Weaviate/Import"
 )
 
 // WeaviateClient is the client API for Weaviate service.
@@ -28,6 +32,13 @@ type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
+	StreamChanges(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (Weaviate_StreamChangesClient, error)
+	// BulkLoad writes the objects of a file as sorted segments of new shards
+	// and swaps them in once complete. Nothing is imported if the node crashes
+	// before, the request has to be repeated then.
+	BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadReply, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (Weaviate_ImportClient, error)
 }
 
 type weaviateClient struct {
@@ -65,6 +76,110 @@ func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest
 	return out, nil
 }
 
+func (c *weaviateClient) StreamChanges(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (Weaviate_StreamChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], Weaviate_StreamChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateStreamChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_StreamChangesClient interface {
+	Recv() (*ChangeEvent, error)
+	grpc.ClientStream
+}
+
+type weaviateStreamChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateStreamChangesClient) Recv() (*ChangeEvent, error) {
+	m := new(ChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) BulkLoad(ctx context.Context, in *BulkLoadRequest, opts ...grpc.CallOption) (*BulkLoadReply, error) {
+	out := new(BulkLoadReply)
+	err := c.cc.Invoke(ctx, Weaviate_BulkLoad_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], Weaviate_Export_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ExportClient interface {
+	Recv() (*ExportReply, error)
+	grpc.ClientStream
+}
+
+type weaviateExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateExportClient) Recv() (*ExportReply, error) {
+	m := new(ExportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) Import(ctx context.Context, opts ...grpc.CallOption) (Weaviate_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[2], Weaviate_Import_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateImportClient{stream}
+	return x, nil
+}
+
+type Weaviate_ImportClient interface {
+	Send(*ImportRequest) error
+	Recv() (*ImportReply, error)
+	grpc.ClientStream
+}
+
+type weaviateImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateImportClient) Send(m *ImportRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateImportClient) Recv() (*ImportReply, error) {
+	m := new(ImportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
@@ -72,6 +187,13 @@ type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
+	StreamChanges(*ChangeStreamRequest, Weaviate_StreamChangesServer) error
+	// BulkLoad writes the objects of a file as sorted segments of new shards
+	// and swaps them in once complete. Nothing is imported if the node crashes
+	// before, the request has to be repeated then.
+	BulkLoad(context.Context, *BulkLoadRequest) (*BulkLoadReply, error)
+	Export(*ExportRequest, Weaviate_ExportServer) error
+	Import(Weaviate_ImportServer) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -88,6 +210,18 @@ func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRe
 func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
 }
+func (UnimplementedWeaviateServer) StreamChanges(*ChangeStreamRequest, Weaviate_StreamChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChanges not implemented")
+}
+func (UnimplementedWeaviateServer) BulkLoad(context.Context, *BulkLoadRequest) (*BulkLoadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkLoad not implemented")
+}
+func (UnimplementedWeaviateServer) Export(*ExportRequest, Weaviate_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedWeaviateServer) Import(Weaviate_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -155,6 +289,92 @@ func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_StreamChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangeStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).StreamChanges(m, &weaviateStreamChangesServer{stream})
+}
+
+type Weaviate_StreamChangesServer interface {
+	Send(*ChangeEvent) error
+	grpc.ServerStream
+}
+
+type weaviateStreamChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateStreamChangesServer) Send(m *ChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_BulkLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BulkLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_BulkLoad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BulkLoad(ctx, req.(*BulkLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).Export(m, &weaviateExportServer{stream})
+}
+
+type Weaviate_ExportServer interface {
+	Send(*ExportReply) error
+	grpc.ServerStream
+}
+
+type weaviateExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateExportServer) Send(m *ExportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).Import(&weaviateImportServer{stream})
+}
+
+type Weaviate_ImportServer interface {
+	Send(*ImportReply) error
+	Recv() (*ImportRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateImportServer) Send(m *ImportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateImportServer) Recv() (*ImportRequest, error) {
+	m := new(ImportRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -174,7 +394,28 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchDelete",
 			Handler:    _Weaviate_BatchDelete_Handler,
 		},
+		{
+			MethodName: "BulkLoad",
+			Handler:    _Weaviate_BulkLoad_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChanges",
+			Handler:       _Weaviate_StreamChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Weaviate_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Weaviate_Import_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
 }
@@ -259,6 +259,26 @@ func (a *azureClient) Read(ctx context.Context, backupID, key string, w io.Write
 	return read, nil
 }
 
+func (a *azureClient) DeleteBackup(ctx context.Context, backupID string) error {
+	prefix := a.makeObjectName(backupID) + "/"
+	pager := a.client.NewListBlobsFlatPager(a.config.Container, &azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, blob := range page.Segment.BlobItems {
+			_, err := a.client.DeleteBlob(ctx, a.config.Container, *blob.Name, nil)
+			if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob '%s'", *blob.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
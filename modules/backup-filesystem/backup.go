@@ -234,3 +234,14 @@ func (m *Module) createBackupsDir(backupsPath string) error {
 	}
 	return nil
 }
+
+func (m *Module) DeleteBackup(ctx context.Context, backupID string) error {
+	backupPath := m.makeBackupDirPath(backupID)
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	}
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup '%s'", backupPath))
+	}
+	return nil
+}
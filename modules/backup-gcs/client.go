@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -368,6 +369,28 @@ func (g *gcsClient) Read(ctx context.Context, backupID, key string, w io.WriteCl
 	return read, nil
 }
 
+func (g *gcsClient) DeleteBackup(ctx context.Context, backupID string) error {
+	prefix := g.makeObjectName(backupID) + "/"
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "find bucket '%s'", g.config.Bucket))
+	}
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		err = bucket.Object(attrs.Name).Delete(ctx)
+		if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
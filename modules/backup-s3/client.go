@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
@@ -224,6 +225,37 @@ func (s *s3Client) Read(ctx context.Context, backupID, key string, w io.WriteClo
 	return read, nil
 }
 
+func (s *s3Client) DeleteBackup(ctx context.Context, backupID string) error {
+	prefix := s.makeObjectName(backupID) + "/"
+	var listErr error
+	objects := make(chan minio.ObjectInfo)
+	enterrors.GoWrapper(func() {
+		defer close(objects)
+		opts := minio.ListObjectsOptions{Prefix: prefix, Recursive: true}
+		for obj := range s.client.ListObjects(ctx, s.config.Bucket, opts) {
+			if obj.Err != nil {
+				listErr = obj.Err
+				return
+			}
+			objects <- obj
+		}
+	}, s.logger)
+
+	var removeErr error
+	for rErr := range s.client.RemoveObjects(ctx, s.config.Bucket, objects, minio.RemoveObjectsOptions{}) {
+		if removeErr == nil {
+			removeErr = fmt.Errorf("remove object %q: %w", rErr.ObjectName, rErr.Err)
+		}
+	}
+	if listErr != nil {
+		return backup.NewErrInternal(errors.Wrapf(listErr, "list objects '%s'", prefix))
+	}
+	if removeErr != nil {
+		return backup.NewErrInternal(removeErr)
+	}
+	return nil
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
@@ -34,7 +34,7 @@ func (m *CentroidModule) PropertyConfigDefaults(dataType *schema.DataType) map[s
 func (m *CentroidModule) ValidateClass(ctx context.Context,
 	class *models.Class, classConfig moduletools.ClassConfig,
 ) error {
-	err := config.Validate(config.New(classConfig))
+	err := config.Validate(config.New(classConfig), class, m.classGetter)
 	if err != nil {
 		return fmt.Errorf("validate %q: %w", class.Class, err)
 	}
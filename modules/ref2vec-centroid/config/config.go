@@ -11,16 +11,30 @@
 
 package config
 
-import "github.com/weaviate/weaviate/entities/moduletools"
+import (
+	"encoding/json"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
 
 const (
-	MethodMean    = "mean"
-	MethodDefault = MethodMean
+	MethodMean         = "mean"
+	MethodWeightedMean = "weightedMean"
+	MethodMedoid       = "medoid"
+	MethodTimeDecay    = "timeDecay"
+	MethodDefault      = MethodMean
 )
 
+// DefaultHalfLife is the number of more recently added references to the
+// same property after which the weight of a reference is halved when using
+// MethodTimeDecay
+const DefaultHalfLife = 10.0
+
 const (
 	calculationMethodField   = "method"
 	referencePropertiesField = "referenceProperties"
+	weightPropertyField      = "weightProperty"
+	halfLifeField            = "halfLife"
 )
 
 func Default() map[string]interface{} {
@@ -54,3 +68,41 @@ func (c *Config) CalculationMethod() string {
 	calcMethod := props[calculationMethodField].(string)
 	return calcMethod
 }
+
+// WeightProperty is the numeric property of the referenced objects which
+// holds their weight when using MethodWeightedMean
+func (c *Config) WeightProperty() string {
+	props := c.class.Class()
+	weightProp, _ := props[weightPropertyField].(string)
+	return weightProp
+}
+
+// HalfLife is the number of more recently added references to the same
+// property after which the weight of a reference is halved when using
+// MethodTimeDecay
+func (c *Config) HalfLife() float64 {
+	props := c.class.Class()
+	halfLife, ok := asFloat64(props[halfLifeField])
+	if !ok {
+		return DefaultHalfLife
+	}
+	return halfLife
+}
+
+func asFloat64(in interface{}) (float64, bool) {
+	switch v := in.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -14,14 +14,24 @@ package config
 import (
 	"errors"
 	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 var errInvalidConfig = errors.New("invalid config")
 
-func Validate(cfg *Config) error {
+// ClassGetter returns the class with the given name, or nil if there is no
+// such class
+type ClassGetter func(name string) *models.Class
+
+// Validate validates the module config of class. getClass is used to look up
+// the classes targeted by the reference properties, it may be nil if they
+// cannot be looked up.
+func Validate(cfg *Config, class *models.Class, getClass ClassGetter) error {
 	// referencePropertiesField is a required field
-	class := cfg.class.Class()
-	refProps, ok := class[referencePropertiesField]
+	classCfg := cfg.class.Class()
+	refProps, ok := classCfg[referencePropertiesField]
 	if !ok {
 		return fmt.Errorf("%w: must have at least one value in the %q field",
 			errInvalidConfig, referencePropertiesField)
@@ -46,5 +56,94 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	return validateMethod(cfg, class, getClass)
+}
+
+func validateMethod(cfg *Config, class *models.Class, getClass ClassGetter) error {
+	classCfg := cfg.class.Class()
+	method, ok := classCfg[calculationMethodField]
+	if !ok {
+		return nil
+	}
+
+	methodStr, ok := method.(string)
+	if !ok {
+		return fmt.Errorf("%w: expected string for field %q, got %T",
+			errInvalidConfig, calculationMethodField, method)
+	}
+
+	switch methodStr {
+	case "", MethodMean, MethodMedoid:
+	case MethodWeightedMean:
+		weightProp, ok := classCfg[weightPropertyField].(string)
+		if !ok || weightProp == "" {
+			return fmt.Errorf("%w: method %q requires a property name in the %q field",
+				errInvalidConfig, MethodWeightedMean, weightPropertyField)
+		}
+		return validateWeightProperty(weightProp, cfg.ReferenceProperties(), class, getClass)
+	case MethodTimeDecay:
+		halfLife, ok := classCfg[halfLifeField]
+		if !ok {
+			return nil
+		}
+		if f, ok := asFloat64(halfLife); !ok || f <= 0 {
+			return fmt.Errorf("%w: expected positive number for field %q, got %v",
+				errInvalidConfig, halfLifeField, halfLife)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported method %q, must be one of %q, %q, %q or %q",
+			errInvalidConfig, methodStr, MethodMean, MethodWeightedMean, MethodMedoid,
+			MethodTimeDecay)
+	}
+
 	return nil
 }
+
+// validateWeightProperty checks that every class targeted by one of the
+// reference properties has a numeric property weightProp
+func validateWeightProperty(weightProp string, refProps map[string]struct{},
+	class *models.Class, getClass ClassGetter,
+) error {
+	for _, prop := range class.Properties {
+		if _, ok := refProps[prop.Name]; !ok {
+			continue
+		}
+
+		for _, targetName := range prop.DataType {
+			target := class
+			if targetName != class.Class {
+				if getClass == nil {
+					continue
+				}
+				if target = getClass(targetName); target == nil {
+					// unknown target classes are reported by the schema validation
+					continue
+				}
+			}
+
+			if err := validateNumericProperty(target, weightProp); err != nil {
+				return fmt.Errorf("%w: %q field of reference property %q: %v",
+					errInvalidConfig, weightPropertyField, prop.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateNumericProperty(class *models.Class, propName string) error {
+	for _, prop := range class.Properties {
+		if prop.Name != propName {
+			continue
+		}
+
+		dt, ok := schema.AsPrimitive(prop.DataType)
+		if !ok || (dt != schema.DataTypeNumber && dt != schema.DataTypeInt) {
+			return fmt.Errorf("property %q of class %q must be of type %q or %q, got %v",
+				propName, class.Class, schema.DataTypeNumber, schema.DataTypeInt, prop.DataType)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("class %q has no property %q", class.Class, propName)
+}
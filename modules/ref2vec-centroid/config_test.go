@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/modules/ref2vec-centroid/config"
 )
 
@@ -83,6 +84,58 @@ func TestConfigValidator(t *testing.T) {
 				"to contain strings, found int: [someRef 123]",
 				class.Class),
 		},
+		{
+			name:  "valid config - weighted mean",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "weightedMean",
+				"weightProperty":      "rating",
+			},
+		},
+		{
+			name:  "valid config - time decay",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "timeDecay",
+				"halfLife":            float64(5),
+			},
+		},
+		{
+			name:  "invalid config - unsupported method",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "median",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: unsupported method \"median\", "+
+				"must be one of \"mean\", \"weightedMean\", \"medoid\" or \"timeDecay\"",
+				class.Class),
+		},
+		{
+			name:  "invalid config - weighted mean without weight property",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "weightedMean",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: method \"weightedMean\" "+
+				"requires a property name in the \"weightProperty\" field",
+				class.Class),
+		},
+		{
+			name:  "invalid config - non-positive half life",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "timeDecay",
+				"halfLife":            float64(0),
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: expected positive number "+
+				"for field \"halfLife\", got 0",
+				class.Class),
+		},
 	}
 
 	for _, test := range tests {
@@ -97,3 +150,81 @@ func TestConfigValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidatorWeightProperty(t *testing.T) {
+	classes := map[string]*models.Class{
+		"Article": {
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "rating", DataType: schema.DataTypeNumber.PropString()},
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+			},
+		},
+		"Video": {
+			Class: "Video",
+			Properties: []*models.Property{
+				{Name: "rating", DataType: schema.DataTypeInt.PropString()},
+			},
+		},
+	}
+	class := &models.Class{
+		Class: "User",
+		Properties: []*models.Property{
+			{Name: "likes", DataType: []string{"Article", "Video"}},
+			{Name: "follows", DataType: []string{"User"}},
+			{Name: "rating", DataType: schema.DataTypeNumber.PropString()},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		refProps    []interface{}
+		weightProp  string
+		expectedErr string
+	}{
+		{
+			name:       "numeric property on all referenced classes",
+			refProps:   []interface{}{"likes"},
+			weightProp: "rating",
+		},
+		{
+			name:       "reference to the class itself",
+			refProps:   []interface{}{"follows"},
+			weightProp: "rating",
+		},
+		{
+			name:       "property of the wrong type",
+			refProps:   []interface{}{"likes"},
+			weightProp: "title",
+			expectedErr: "validate \"User\": invalid config: \"weightProperty\" field of " +
+				"reference property \"likes\": property \"title\" of class \"Article\" " +
+				"must be of type \"number\" or \"int\", got [text]",
+		},
+		{
+			name:       "property missing on a referenced class",
+			refProps:   []interface{}{"likes"},
+			weightProp: "ratin",
+			expectedErr: "validate \"User\": invalid config: \"weightProperty\" field of " +
+				"reference property \"likes\": class \"Article\" has no property \"ratin\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mod := New()
+			mod.classGetter = func(name string) *models.Class { return classes[name] }
+			cfg := fakeClassConfig{
+				"referenceProperties": test.refProps,
+				"method":              "weightedMean",
+				"weightProperty":      test.weightProp,
+			}
+
+			err := mod.ValidateClass(context.Background(), class, cfg)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
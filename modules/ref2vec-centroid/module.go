@@ -16,9 +16,11 @@ import (
 	"net/http"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/ref2vec-centroid/config"
 	"github.com/weaviate/weaviate/modules/ref2vec-centroid/vectorizer"
 )
 
@@ -31,7 +33,8 @@ func New() *CentroidModule {
 }
 
 type CentroidModule struct {
-	logger logrus.FieldLogger
+	logger      logrus.FieldLogger
+	classGetter config.ClassGetter
 }
 
 func (m *CentroidModule) Name() string {
@@ -40,6 +43,11 @@ func (m *CentroidModule) Name() string {
 
 func (m *CentroidModule) Init(ctx context.Context, params moduletools.ModuleInitParams) error {
 	m.logger = params.GetLogger()
+	// the schema is used to validate the weight property of the referenced
+	// classes, it is not available when running without the full app state
+	if appState, ok := params.GetAppState().(*state.State); ok && appState.SchemaManager != nil {
+		m.classGetter = appState.SchemaManager.ReadOnlyClass
+	}
 	return nil
 }
 
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func calculateWeightedMean(refVecs [][]float32, weights []float32) ([]float32, error) {
+	if len(refVecs) == 0 || len(refVecs[0]) == 0 {
+		return nil, nil
+	}
+
+	targetVecLen := len(refVecs[0])
+	meanVec := make([]float32, targetVecLen)
+
+	var weightSum float32
+	for i, vec := range refVecs {
+		if len(vec) != targetVecLen {
+			return nil, fmt.Errorf("calculate weighted mean: found vectors of different length: %d and %d",
+				targetVecLen, len(vec))
+		}
+
+		for j, val := range vec {
+			meanVec[j] += val * weights[i]
+		}
+		weightSum += weights[i]
+	}
+
+	if weightSum == 0 {
+		return nil, fmt.Errorf("calculate weighted mean: sum of weights is zero")
+	}
+
+	for i := range meanVec {
+		meanVec[i] /= weightSum
+	}
+
+	return meanVec, nil
+}
+
+// propertyWeight reads the weight of each reference from a numeric property
+// of the referenced object. References without the property are weighted
+// with 1, just like with the regular mean.
+func propertyWeight(propName string) weightFn {
+	return func(refs []*search.Result, _ []int) ([]float32, error) {
+		weights := make([]float32, len(refs))
+		for i, ref := range refs {
+			weight, err := refPropertyWeight(ref, propName)
+			if err != nil {
+				return nil, err
+			}
+			weights[i] = weight
+		}
+		return weights, nil
+	}
+}
+
+func refPropertyWeight(ref *search.Result, propName string) (float32, error) {
+	props, ok := ref.Schema.(map[string]interface{})
+	if !ok {
+		return 1, nil
+	}
+
+	val, ok := props[propName]
+	if !ok || val == nil {
+		return 1, nil
+	}
+
+	weight, ok := val.(float64)
+	if !ok {
+		return 0, fmt.Errorf("weight property %q of object %s must be a number, got %T",
+			propName, ref.ID, val)
+	}
+
+	if weight < 0 {
+		return 0, fmt.Errorf("weight property %q of object %s must not be negative, got %v",
+			propName, ref.ID, weight)
+	}
+
+	return float32(weight), nil
+}
+
+// decayWeight weighs references by how recently they were referenced, i.e.
+// by their age as returned by beaconsForVectorization. The weight halves for
+// every halfLife references that were added to the same property after a
+// reference. How old the referenced object itself is does not matter: a new
+// reference to an old object weighs just as much as one to a new object.
+func decayWeight(halfLife float64) weightFn {
+	return func(refs []*search.Result, ages []int) ([]float32, error) {
+		weights := make([]float32, len(refs))
+		for i := range refs {
+			weights[i] = float32(math.Pow(0.5, float64(ages[i])/halfLife))
+		}
+		return weights, nil
+	}
+}
@@ -14,6 +14,7 @@ package vectorizer
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -27,9 +28,15 @@ import (
 
 type calcFn func(vecs ...[]float32) ([]float32, error)
 
+// weightFn returns the weights of the referenced objects, for calculation
+// methods which do not weigh all references equally. ages holds the age of
+// each reference, see beaconsForVectorization.
+type weightFn func(refs []*search.Result, ages []int) ([]float32, error)
+
 type Vectorizer struct {
 	config       *config.Config
 	calcFn       calcFn
+	weightFn     weightFn
 	findObjectFn modulecapabilities.FindObjectFn
 }
 
@@ -42,6 +49,12 @@ func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) *V
 	switch v.config.CalculationMethod() {
 	case config.MethodMean:
 		v.calcFn = calculateMean
+	case config.MethodMedoid:
+		v.calcFn = calculateMedoid
+	case config.MethodWeightedMean:
+		v.weightFn = propertyWeight(v.config.WeightProperty())
+	case config.MethodTimeDecay:
+		v.weightFn = decayWeight(v.config.HalfLife())
 	default:
 		v.calcFn = calculateMean
 	}
@@ -52,17 +65,22 @@ func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) *V
 func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) ([]float32, error) {
 	props := v.config.ReferenceProperties()
 
-	refVecs, err := v.referenceVectorSearch(ctx, obj, props)
+	refs, ages, err := v.referenceVectorSearch(ctx, obj, props)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(refVecs) == 0 {
+	if len(refs) == 0 {
 		obj.Vector = nil
 		return nil, nil
 	}
 
-	vec, err := v.calcFn(refVecs...)
+	refVecs := make([][]float32, len(refs))
+	for i, ref := range refs {
+		refVecs[i] = ref.Vector
+	}
+
+	vec, err := v.calculate(refs, ages, refVecs)
 	if err != nil {
 		return nil, fmt.Errorf("calculate vector: %w", err)
 	}
@@ -70,29 +88,48 @@ func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) ([]float32,
 	return vec, nil
 }
 
+func (v *Vectorizer) calculate(refs []*search.Result, ages []int,
+	refVecs [][]float32,
+) ([]float32, error) {
+	if v.weightFn == nil {
+		return v.calcFn(refVecs...)
+	}
+
+	weights, err := v.weightFn(refs, ages)
+	if err != nil {
+		return nil, err
+	}
+
+	return calculateWeightedMean(refVecs, weights)
+}
+
+// referenceVectorSearch returns all referenced objects which have a vector,
+// in the order in which they are referenced, and the age of each reference
 func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 	obj *models.Object, refProps map[string]struct{},
-) ([][]float32, error) {
-	var refVecs [][]float32
+) ([]*search.Result, []int, error) {
+	var refs []*search.Result
+	var ages []int
 	props := obj.Properties.(map[string]interface{})
 
 	// use the ids from parent's beacons to find the referenced objects
-	beacons := beaconsForVectorization(props, refProps)
-	for _, beacon := range beacons {
+	beacons, beaconAges := beaconsForVectorization(props, refProps)
+	for i, beacon := range beacons {
 		res, err := v.findReferenceObject(ctx, beacon, obj.Tenant)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// if the ref'd object has a vector, we grab it.
 		// these will be used to compute the parent's
 		// vector eventually
 		if res.Vector != nil {
-			refVecs = append(refVecs, res.Vector)
+			refs = append(refs, res)
+			ages = append(ages, beaconAges[i])
 		}
 	}
 
-	return refVecs, nil
+	return refs, ages, nil
 }
 
 func (v *Vectorizer) findReferenceObject(ctx context.Context, beacon strfmt.URI, tenant string) (res *search.Result, err error) {
@@ -112,15 +149,29 @@ func (v *Vectorizer) findReferenceObject(ctx context.Context, beacon strfmt.URI,
 	return
 }
 
+// beaconsForVectorization returns the beacons of the target reference
+// properties and the age of each reference. New references are appended to
+// a reference property, so the age of a reference is the number of
+// references that follow it in the same property: the most recently added
+// reference has age 0.
 func beaconsForVectorization(allProps map[string]interface{},
 	targetRefProps map[string]struct{},
-) []strfmt.URI {
+) ([]strfmt.URI, []int) {
 	var beacons []strfmt.URI
+	var ages []int
+
+	// iterate the properties in a fixed order, so that methods which depend
+	// on the order of the references, such as medoid, produce stable results
+	propNames := make([]string, 0, len(targetRefProps))
+	for prop := range targetRefProps {
+		propNames = append(propNames, prop)
+	}
+	sort.Strings(propNames)
 
 	// add any refs that were supplied as a part of the parent
 	// object, like when caller is AddObject/UpdateObject
-	for prop, val := range allProps {
-		if _, ok := targetRefProps[prop]; ok {
+	for _, prop := range propNames {
+		if val, ok := allProps[prop]; ok {
 			switch refs := val.(type) {
 			case []interface{}:
 				// due to the fix introduced in https://github.com/weaviate/weaviate/pull/2320,
@@ -130,12 +181,13 @@ func beaconsForVectorization(allProps map[string]interface{},
 				// if we encounter []interface{}, assume it indicates an empty ref prop, and skip it.
 				continue
 			case models.MultipleRef:
-				for _, ref := range refs {
+				for i, ref := range refs {
 					beacons = append(beacons, ref.Beacon)
+					ages = append(ages, len(refs)-1-i)
 				}
 			}
 		}
 	}
 
-	return beacons
+	return beacons, ages
 }
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -168,6 +169,114 @@ func TestVectorizer_Object(t *testing.T) {
 	})
 }
 
+func TestVectorizer_Methods(t *testing.T) {
+	hour := time.Hour.Milliseconds()
+	refs := []*search.Result{
+		{
+			Vector: []float32{0, 0}, Schema: map[string]interface{}{"rating": float64(1)},
+			Created: 3 * hour, Updated: 3 * hour,
+		},
+		{
+			Vector: []float32{10, 10}, Schema: map[string]interface{}{},
+			Created: 2 * hour, Updated: 2 * hour,
+		},
+		{
+			// the most recent reference, even though it is to the oldest object
+			Vector: []float32{1, 1}, Schema: map[string]interface{}{"rating": float64(3)},
+			Created: hour, Updated: hour,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		cfg            fakeClassConfig
+		expectedResult []float32
+	}{
+		{
+			name:           "medoid",
+			cfg:            fakeClassConfig{"method": "medoid"},
+			expectedResult: []float32{1, 1},
+		},
+		{
+			// the second reference has no weight property and is weighted with 1
+			name:           "weighted mean",
+			cfg:            fakeClassConfig{"method": "weightedMean", "weightProperty": "rating"},
+			expectedResult: []float32{2.6, 2.6},
+		},
+		{
+			// weights are 0.25, 0.5 and 1, as 2, 1 and 0 references were added
+			// after them, regardless of when the objects were updated
+			name:           "time decay",
+			cfg:            fakeClassConfig{"method": "timeDecay", "halfLife": float64(1)},
+			expectedResult: []float32{6 / 1.75, 6 / 1.75},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &fakeObjectsRepo{}
+			test.cfg["referenceProperties"] = []interface{}{"toRef"}
+
+			modelRefs := make(models.MultipleRef, len(refs))
+			for i, res := range refs {
+				crossRef := crossref.New("localhost", "SomeClass",
+					strfmt.UUID(uuid.NewString()))
+				modelRefs[i] = crossRef.SingleRef()
+
+				repo.On("Object", ctx, crossRef.Class, crossRef.TargetID, "").
+					Return(res, nil)
+			}
+
+			obj := &models.Object{
+				Properties: map[string]interface{}{"toRef": modelRefs},
+			}
+
+			vec, err := New(test.cfg, repo.Object).Object(ctx, obj)
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, test.expectedResult, vec, 0.0001)
+		})
+	}
+
+	t.Run("weighted mean with negative weight", func(t *testing.T) {
+		weight := propertyWeight("rating")
+		_, err := weight([]*search.Result{{Schema: map[string]interface{}{"rating": float64(-1)}}}, []int{0})
+		assert.ErrorContains(t, err, "must not be negative")
+	})
+
+	t.Run("time decay per reference property", func(t *testing.T) {
+		ctx := context.Background()
+		repo := &fakeObjectsRepo{}
+		cfg := fakeClassConfig{
+			"method": "timeDecay", "halfLife": float64(1),
+			"referenceProperties": []interface{}{"likes", "views"},
+		}
+
+		props := map[string]interface{}{}
+		for prop, vecs := range map[string][][]float32{
+			"likes": {{0, 0}, {4, 4}},
+			"views": {{8, 8}},
+		} {
+			modelRefs := make(models.MultipleRef, len(vecs))
+			for i, vec := range vecs {
+				crossRef := crossref.New("localhost", "SomeClass",
+					strfmt.UUID(uuid.NewString()))
+				modelRefs[i] = crossRef.SingleRef()
+
+				repo.On("Object", ctx, crossRef.Class, crossRef.TargetID, "").
+					Return(&search.Result{Vector: vec}, nil)
+			}
+			props[prop] = modelRefs
+		}
+
+		// the last reference of each property is the most recent one, so the
+		// weights are 0.5 and 1 for likes and 1 for views
+		vec, err := New(cfg, repo.Object).Object(ctx, &models.Object{Properties: props})
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, []float32{12 / 2.5, 12 / 2.5}, vec, 0.0001)
+	})
+}
+
 func TestVectorizer_Tenant(t *testing.T) {
 	objectSearchResults := search.Result{Vector: []float32{}}
 	ctx := context.Background()
@@ -57,6 +57,18 @@ func additionalFeatureProjectionField(classname string) *graphql.Field {
 				Type:         graphql.Int,
 				DefaultValue: nil,
 			},
+			"neighbors": &graphql.ArgumentConfig{
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
+			"minDistance": &graphql.ArgumentConfig{
+				Type:         graphql.Float,
+				DefaultValue: nil,
+			},
+			"seed": &graphql.ArgumentConfig{
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
 		},
 		Type: graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalFeatureProjection", classname),
@@ -67,7 +67,10 @@ func TestFeatureProjectionField(t *testing.T) {
 		//   dimensions: 1,
 		//   learningRate: 2,
 		//   iterations: 3,
-		//   perplexity: 4
+		//   perplexity: 4,
+		//   neighbors: 5,
+		//   minDistance: 0.1,
+		//   seed: 6
 		// }
 		// Type: {
 		//   vector: [0, 1]
@@ -75,12 +78,15 @@ func TestFeatureProjectionField(t *testing.T) {
 		assert.NotNil(t, featureProjection)
 		assert.Equal(t, "ClassAdditionalFeatureProjection", featureProjection.Type.Name())
 		assert.NotNil(t, featureProjection.Args)
-		assert.Equal(t, 5, len(featureProjection.Args))
+		assert.Equal(t, 8, len(featureProjection.Args))
 		assert.NotNil(t, featureProjection.Args["algorithm"])
 		assert.NotNil(t, featureProjection.Args["dimensions"])
 		assert.NotNil(t, featureProjection.Args["learningRate"])
 		assert.NotNil(t, featureProjection.Args["iterations"])
 		assert.NotNil(t, featureProjection.Args["perplexity"])
+		assert.NotNil(t, featureProjection.Args["neighbors"])
+		assert.NotNil(t, featureProjection.Args["minDistance"])
+		assert.NotNil(t, featureProjection.Args["seed"])
 		featureProjectionObject, featureProjectionObjectOK := featureProjection.Type.(*graphql.Object)
 		assert.True(t, featureProjectionObjectOK)
 		assert.Equal(t, 1, len(featureProjectionObject.Fields()))
@@ -132,7 +132,7 @@ func (f *fakeVectorRepoKNN) GetUnclassified(ctx context.Context,
 }
 
 func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []float32,
-	class string, properties []string, k int,
+	class string, properties []string, k int, weighted bool,
 	filter *libfilters.LocalFilter,
 ) ([]usecasesclassfication.NeighborRef, error) {
 	f.Lock()
@@ -259,7 +259,7 @@ func (f *fakeVectorRepoContextual) GetUnclassified(ctx context.Context,
 }
 
 func (f *fakeVectorRepoContextual) AggregateNeighbors(ctx context.Context, vector []float32,
-	class string, properties []string, k int,
+	class string, properties []string, k int, weighted bool,
 	filter *libfilters.LocalFilter,
 ) ([]usecasesclassfication.NeighborRef, error) {
 	panic("not implemented")
@@ -43,6 +43,7 @@ func testGetSchemaWithoutClient(t *testing.T) {
 					"cleanupIntervalSeconds": float64(300),
 					"efConstruction":         float64(128),
 					"flatSearchCutoff":       float64(40000),
+					"filterStrategy":         "sweeping",
 					"ef":                     float64(-1),
 					"maxConnections":         float64(64),
 					"vectorCacheMaxObjects":  float64(1e12),
@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package audit records who changed what. Handlers log an event for every
+// schema change, object write or delete, backup and tenant status change
+// once the request has been authorized.
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// Sources of audited requests
+const (
+	SourceREST = "rest"
+	SourceGRPC = "grpc"
+)
+
+// Outcomes of audited operations
+const (
+	OutcomeSuccess = "success"
+	OutcomeDenied  = "denied"
+	OutcomeFailure = "failure"
+)
+
+// Audited operations
+const (
+	OpClassCreate          = "class.create"
+	OpClassUpdate          = "class.update"
+	OpClassDelete          = "class.delete"
+	OpPropertyAdd          = "class.property.add"
+	OpShardUpdate          = "shard.update"
+	OpTenantsCreate        = "tenants.create"
+	OpTenantsUpdate        = "tenants.update"
+	OpTenantsDelete        = "tenants.delete"
+	OpObjectCreate         = "object.create"
+	OpObjectUpdate         = "object.update"
+	OpObjectPatch          = "object.patch"
+	OpObjectDelete         = "object.delete"
+	OpReferenceAdd         = "reference.add"
+	OpReferenceUpdate      = "reference.update"
+	OpReferenceDelete      = "reference.delete"
+	OpBatchObjects         = "batch.objects"
+	OpBatchReferences      = "batch.references"
+	OpBatchDelete          = "batch.delete"
+	OpBulkLoad             = "bulk.load"
+	OpImport               = "import"
+	OpBackupCreate         = "backup.create"
+	OpBackupRestore        = "backup.restore"
+	OpBackupSchedulePut    = "backup.schedule.put"
+	OpBackupScheduleDelete = "backup.schedule.delete"
+)
+
+// anonymous is the principal of unauthenticated requests
+const anonymous = "anonymous"
+
+// Event is a single audited operation
+type Event struct {
+	Time      time.Time `json:"time"`
+	Source    string    `json:"source"`
+	Principal string    `json:"principal"`
+	Groups    []string  `json:"groups,omitempty"`
+	Operation string    `json:"operation"`
+	Class     string    `json:"class,omitempty"`
+	Tenants   []string  `json:"tenants,omitempty"`
+	// TenantStatus maps tenants to their requested activity status
+	TenantStatus map[string]string `json:"tenantStatus,omitempty"`
+	Shard        string            `json:"shard,omitempty"`
+	ShardStatus  string            `json:"shardStatus,omitempty"`
+	ObjectIDs    []strfmt.UUID     `json:"objectIds,omitempty"`
+	// Count is the number of affected objects of batch operations
+	Count   *int64 `json:"count,omitempty"`
+	Backup  string `json:"backup,omitempty"`
+	Outcome string `json:"outcome"`
+	Error   string `json:"error,omitempty"`
+}
+
+// NewEvent creates an event of operation op requested by principal
+func NewEvent(source string, principal *models.Principal, op string) *Event {
+	e := &Event{Source: source, Principal: anonymous, Operation: op}
+	if principal != nil {
+		e.Principal, e.Groups = principal.Username, principal.Groups
+	}
+	return e
+}
+
+// WithClass sets the class and, if not empty, the tenant the operation affects
+func (e *Event) WithClass(class, tenant string) *Event {
+	e.Class = class
+	if tenant != "" {
+		e.Tenants = append(e.Tenants, tenant)
+	}
+	return e
+}
+
+// WithTenants sets the tenants the operation affects
+func (e *Event) WithTenants(tenants ...string) *Event {
+	e.Tenants = append(e.Tenants, tenants...)
+	return e
+}
+
+// WithTenantStatus sets the tenants the operation affects together with
+// their requested activity status
+func (e *Event) WithTenantStatus(tenants []*models.Tenant) *Event {
+	e.TenantStatus = make(map[string]string, len(tenants))
+	for _, t := range tenants {
+		if t != nil {
+			e.Tenants = append(e.Tenants, t.Name)
+			e.TenantStatus[t.Name] = t.ActivityStatus
+		}
+	}
+	return e
+}
+
+// WithShard sets the shard the operation affects and its requested status
+func (e *Event) WithShard(shard, status string) *Event {
+	e.Shard, e.ShardStatus = shard, status
+	return e
+}
+
+// WithObjects sets the IDs of the objects the operation affects
+func (e *Event) WithObjects(ids ...strfmt.UUID) *Event {
+	e.ObjectIDs = append(e.ObjectIDs, ids...)
+	return e
+}
+
+// WithCount sets the number of objects a batch operation affects
+func (e *Event) WithCount(n int64) *Event {
+	e.Count = &n
+	return e
+}
+
+// WithBackup sets the ID of the backup or backup schedule the operation affects
+func (e *Event) WithBackup(id string) *Event {
+	e.Backup = id
+	return e
+}
+
+// WithError sets the outcome of the operation. Failed authorizations are
+// recorded as denied.
+func (e *Event) WithError(err error) *Event {
+	switch {
+	case err == nil:
+		e.Outcome = OutcomeSuccess
+	case errors.As(err, &autherrs.Forbidden{}):
+		e.Outcome, e.Error = OutcomeDenied, err.Error()
+	default:
+		e.Outcome, e.Error = OutcomeFailure, err.Error()
+	}
+	return e
+}
+
+// Logger writes audit events as JSON lines. A nil Logger discards all
+// events, so that callers don't need to check whether auditing is enabled.
+type Logger struct {
+	sync.Mutex
+	w      io.Writer
+	logger logrus.FieldLogger
+	now    func() time.Time
+}
+
+// New creates a logger writing to w. logger reports failed writes.
+func New(w io.Writer, logger logrus.FieldLogger) *Logger {
+	return &Logger{w: w, logger: logger, now: time.Now}
+}
+
+// Log writes the event e
+func (l *Logger) Log(e *Event) {
+	if l == nil {
+		return
+	}
+	if e.Outcome == "" {
+		e.Outcome = OutcomeSuccess
+	}
+	e.Time = l.now().UTC()
+	line, err := json.Marshal(e)
+	if err != nil {
+		l.logger.WithField("action", "audit_log").WithError(err).Error("could not encode event")
+		return
+	}
+	line = append(line, '\n')
+
+	l.Lock()
+	defer l.Unlock()
+	if _, err := l.w.Write(line); err != nil {
+		l.logger.WithField("action", "audit_log").WithError(err).Error("could not write event")
+	}
+}
+
+// Close closes the underlying writer if it is closable
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	defer l.Unlock()
+	if c, ok := l.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
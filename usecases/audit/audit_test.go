@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+func TestLoggerLog(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	principal := &models.Principal{Username: "jane", Groups: []string{"admins"}}
+	id := strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168241")
+
+	tests := []struct {
+		name     string
+		event    *Event
+		expected map[string]interface{}
+	}{
+		{
+			name: "successful object deletion",
+			event: NewEvent(SourceREST, principal, OpObjectDelete).
+				WithClass("Article", "tenant1").WithObjects(id).WithError(nil),
+			expected: map[string]interface{}{
+				"time":      "2024-03-01T12:00:00Z",
+				"source":    "rest",
+				"principal": "jane",
+				"groups":    []interface{}{"admins"},
+				"operation": "object.delete",
+				"class":     "Article",
+				"tenants":   []interface{}{"tenant1"},
+				"objectIds": []interface{}{id.String()},
+				"outcome":   "success",
+			},
+		},
+		{
+			name: "denied batch",
+			event: NewEvent(SourceGRPC, &models.Principal{Username: "john"}, OpBatchObjects).WithCount(0).
+				WithError(autherrs.NewForbidden(&models.Principal{Username: "john"}, "update", "batch/objects")),
+			expected: map[string]interface{}{
+				"time":      "2024-03-01T12:00:00Z",
+				"source":    "grpc",
+				"principal": "john",
+				"operation": "batch.objects",
+				"count":     float64(0),
+				"outcome":   "denied",
+				"error":     "forbidden: user 'john' has insufficient permissions to update batch/objects",
+			},
+		},
+		{
+			name: "failed tenant update",
+			event: NewEvent(SourceREST, principal, OpTenantsUpdate).WithClass("Article", "").
+				WithTenantStatus([]*models.Tenant{{Name: "t1", ActivityStatus: models.TenantActivityStatusCOLD}}).
+				WithError(errors.New("boom")),
+			expected: map[string]interface{}{
+				"time":         "2024-03-01T12:00:00Z",
+				"source":       "rest",
+				"principal":    "jane",
+				"groups":       []interface{}{"admins"},
+				"operation":    "tenants.update",
+				"class":        "Article",
+				"tenants":      []interface{}{"t1"},
+				"tenantStatus": map[string]interface{}{"t1": "COLD"},
+				"outcome":      "failure",
+				"error":        "boom",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			l := New(&buf, logger)
+			l.now = func() time.Time { return now }
+			l.Log(tt.event)
+
+			line := buf.Bytes()
+			require.Equal(t, byte('\n'), line[len(line)-1])
+			var actual map[string]interface{}
+			require.Nil(t, json.Unmarshal(line, &actual))
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestNewEventAnonymous(t *testing.T) {
+	e := NewEvent(SourceREST, nil, OpClassCreate)
+	assert.Equal(t, "anonymous", e.Principal)
+	assert.Empty(t, e.Groups)
+}
+
+func TestNilLogger(t *testing.T) {
+	var l *Logger
+	l.Log(NewEvent(SourceREST, nil, OpClassCreate))
+	assert.Nil(t, l.Close())
+}
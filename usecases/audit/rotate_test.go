@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	f, err := OpenRotatingFile(path, 10, 2)
+	require.Nil(t, err)
+
+	for _, line := range []string{"aaaaaa\n", "bbbbbb\n", "cccccc\n", "dddddd\n"} {
+		n, err := f.Write([]byte(line))
+		require.Nil(t, err)
+		assert.Equal(t, len(line), n)
+	}
+	require.Nil(t, f.Close())
+
+	read := func(path string) string {
+		t.Helper()
+		b, err := os.ReadFile(path)
+		require.Nil(t, err)
+		return string(b)
+	}
+	assert.Equal(t, "dddddd\n", read(path))
+	assert.Equal(t, "cccccc\n", read(path+".1"))
+	assert.Equal(t, "bbbbbb\n", read(path+".2"))
+	assert.NoFileExists(t, path+".3")
+
+	_, err = f.Write([]byte("eeeeee\n"))
+	assert.ErrorIs(t, err, os.ErrClosed)
+}
+
+func TestRotatingFileReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	f, err := OpenRotatingFile(path, 10, 0)
+	require.Nil(t, err)
+	_, err = f.Write([]byte("aaaaaa\n"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+
+	// the size of existing content counts towards the maximum
+	f, err = OpenRotatingFile(path, 10, 0)
+	require.Nil(t, err)
+	_, err = f.Write([]byte("bbbbbb\n"))
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+
+	b, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.Equal(t, "bbbbbb\n", string(b))
+	assert.NoFileExists(t, path+".1")
+}
@@ -20,6 +20,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -178,21 +179,27 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	// base holds the segment files of the base backup of an incremental
+	// backup per class and shard
+	base map[string]map[string]baseSegments
+	// key encrypts chunks if set
+	key *EncryptionKey
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
 	backupID string, setstatus func(st backup.Status), l logrus.FieldLogger,
 ) *uploader {
 	return &uploader{
-		sourcer, backend,
-		backupID,
-		newZipConfig(Compression{
+		sourcer:  sourcer,
+		backend:  backend,
+		backupID: backupID,
+		zipConfig: newZipConfig(Compression{
 			Level:         DefaultCompression,
 			CPUPercentage: DefaultCPUPercentage,
 			ChunkSize:     DefaultChunkSize,
 		}),
-		setstatus,
-		l,
+		setStatus: setstatus,
+		log:       l,
 	}
 }
 
@@ -201,6 +208,34 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+func (u *uploader) withEncryption(key *EncryptionKey) *uploader {
+	u.key = key
+	return u
+}
+
+// withBase makes the upload incremental: segment files which did not change
+// since the backup described by base are referenced instead of uploaded.
+// References are resolved to the backup storing the file, so restoring
+// never has to follow a chain of backups.
+func (u *uploader) withBase(base *backup.BackupDescriptor) *uploader {
+	u.base = make(map[string]map[string]baseSegments, len(base.Classes))
+	for _, c := range base.Classes {
+		shards := make(map[string]baseSegments, len(c.Shards))
+		for _, s := range c.Shards {
+			segments := make(baseSegments, len(s.Segments))
+			for _, f := range s.Segments {
+				if f.BackupID == "" {
+					f.BackupID, f.Chunk = base.ID, s.Chunk
+				}
+				segments[f.Path] = f
+			}
+			shards[s.Name] = segments
+		}
+		u.base[c.Name] = shards
+	}
+	return u
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor) (err error) {
 	u.setStatus(backup.Transferring)
@@ -356,19 +391,22 @@ func (u *uploader) compress(ctx context.Context,
 		// add tolerance to enable better optimization of the chunk size
 		maxSize = int64(u.ChunkSize + u.ChunkSize/20) // size + 5%
 	)
-	zip, reader := NewZip(u.backend.SourceDataPath(), u.Level)
+	zip, reader, err := NewZip(u.backend.SourceDataPath(), u.Level, u.Codec, u.key)
+	if err != nil {
+		return shards, err
+	}
 	producer := func() error {
 		defer zip.Close()
 		lastShardSize := int64(0)
 		for shard := range ch {
-			if _, err := zip.WriteShard(ctx, shard); err != nil {
+			if _, err := zip.WriteShard(ctx, shard, u.base[class][shard.Name]); err != nil {
 				return err
 			}
 			shard.Chunk = chunk
 			shards = append(shards, shard.Name)
 			shard.ClearTemporary()
 
-			zip.gzw.Flush() // flush new shard
+			zip.cw.Flush() // flush new shard
 			lastShardSize = zip.lastWritten() - lastShardSize
 			if zip.lastWritten()+lastShardSize > maxSize {
 				break
@@ -403,6 +441,8 @@ type fileWriter struct {
 	compressed bool
 	GoPoolSize int
 	migrator   func(classPath string) error
+	key        *EncryptionKey // decrypts encrypted chunks
+	encrypted  bool           // all chunks must be encrypted
 	logger     logrus.FieldLogger
 }
 
@@ -427,14 +467,25 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 	return fw
 }
 
+// withEncryption decrypts chunks with key. If encrypted is set, because the
+// descriptor of the backup says so, chunks which are not encrypted are
+// rejected.
+func (fw *fileWriter) withEncryption(key *EncryptionKey, encrypted bool) *fileWriter {
+	fw.key = key
+	fw.encrypted = encrypted
+	return fw
+}
+
 func (fw *fileWriter) setMigrator(m func(classPath string) error) { fw.migrator = m }
 
-// Write downloads files and put them in the destination directory
-func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor) (err error) {
+// Write downloads files and put them in the destination directory.
+// The files are restored as files of class target, which differs from
+// desc.Name if the class is restored under a new name.
+func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, target string) (err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
 		return nil
 	}
-	classTempDir := path.Join(fw.tempDir, desc.Name)
+	classTempDir := path.Join(fw.tempDir, target)
 
 	if err := fw.writeTempFiles(ctx, classTempDir, desc); err != nil {
 		return fmt.Errorf("get files: %w", err)
@@ -446,6 +497,13 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor) (
 		}
 	}
 
+	if from, to := strings.ToLower(desc.Name), strings.ToLower(target); from != to {
+		src, dst := path.Join(classTempDir, from), path.Join(classTempDir, to)
+		if err := os.Rename(src, dst); err != nil {
+			return fmt.Errorf("rename class directory %s %s: %w", src, dst, err)
+		}
+	}
+
 	return nil
 }
 
@@ -476,10 +534,32 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 	// source files are compressed
 
 	eg.SetLimit(fw.GoPoolSize)
-	for k := range desc.Chunks {
+	shards := make(map[string]struct{}, len(desc.Shards))
+	for _, shard := range desc.Shards {
+		shards[shard.Name] = struct{}{}
+	}
+	for k, names := range desc.Chunks {
+		// only a subset of the shards is restored if tenants were selected
+		dirs := make([]string, 0, len(names))
+		for _, name := range names {
+			if _, ok := shards[name]; ok {
+				dirs = append(dirs, path.Join(strings.ToLower(desc.Name), name))
+			}
+		}
+		if len(dirs) == 0 {
+			continue
+		}
+		partial := len(dirs) < len(names)
+
 		chunk := chunkKey(desc.Name, k)
 		eg.Go(func() error {
-			uz, w := NewUnzip(classTempDir)
+			uz, w := NewUnzip(classTempDir, fw.key)
+			if fw.encrypted {
+				uz.requireEncryption()
+			}
+			if partial {
+				uz.onlyDirs(dirs)
+			}
 			enterrors.GoWrapper(func() {
 				fw.backend.Read(ctx, chunk, w)
 			}, fw.logger)
@@ -487,9 +567,68 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 			return err
 		})
 	}
+
+	// segment files of incremental backups stored by other backups
+	for key, paths := range baseChunks(desc) {
+		key, paths := key, paths
+		eg.Go(func() error {
+			return fw.writeBaseChunk(ctx, classTempDir, desc.Name, key, paths)
+		})
+	}
 	return eg.Wait()
 }
 
+// baseChunk identifies a chunk of a class stored by a backup of a node
+type baseChunk struct {
+	backup.BackupChunk
+	node string
+}
+
+// baseChunks returns the segment files of a class which are stored in
+// other backups, indexed by the chunk containing them
+func baseChunks(desc *backup.ClassDescriptor) map[baseChunk][]string {
+	out := map[baseChunk][]string{}
+	for _, shard := range desc.Shards {
+		for key, paths := range shard.BaseSegments() {
+			k := baseChunk{key, shard.Node}
+			out[k] = append(out[k], paths...)
+		}
+	}
+	return out
+}
+
+// writeBaseChunk extracts the given files from a chunk of another backup
+func (fw *fileWriter) writeBaseChunk(ctx context.Context, classTempDir, class string,
+	key baseChunk, paths []string,
+) error {
+	store := objStore{b: fw.backend.b, BasePath: fmt.Sprintf("%s/%s", key.BackupID, key.node)}
+	chunk := chunkKey(class, key.Chunk)
+
+	uz, w := NewUnzip(classTempDir, fw.key)
+	if fw.encrypted {
+		uz.requireEncryption()
+	}
+	uz.only(paths)
+	var readErr error
+	done := make(chan struct{})
+	enterrors.GoWrapper(func() {
+		defer close(done)
+		_, readErr = store.Read(ctx, chunk, w)
+	}, fw.logger)
+
+	_, err := uz.ReadChunk()
+	// unblocks the reader if the chunk was not read completely
+	uz.Close()
+	<-done
+	if err != nil {
+		if readErr != nil {
+			err = readErr
+		}
+		return fmt.Errorf("read %s of base backup %q: %w", chunk, key.BackupID, err)
+	}
+	return nil
+}
+
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescriptor, classTempDir string) error {
 	for _, key := range sd.Files {
 		destPath := path.Join(classTempDir, key)
@@ -534,6 +673,8 @@ func routinePoolSize(percentage int) int {
 
 // RestoreClassDir returns a func that restores classes on the filesystem directly from the temporary class backup stored on disk.
 // This function is invoked by the Raft store when a restoration request is sent by the backup coordinator.
+// If the class directory exists already, because tenants are restored into an existing class,
+// the restored shard directories are moved into it. Existing shard directories are never overwritten.
 func RestoreClassDir(dataPath string) func(class string) error {
 	return func(class string) error {
 		classTempDir := filepath.Join(dataPath, TempDirectory, class)
@@ -551,6 +692,12 @@ func RestoreClassDir(dataPath string) func(class string) error {
 		for _, key := range files {
 			from := path.Join(classTempDir, key.Name())
 			to := path.Join(destDir, key.Name())
+			if info, err := os.Stat(to); err == nil && info.IsDir() && key.IsDir() {
+				if err := moveShardDirs(from, to); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := os.Rename(from, to); err != nil {
 				return fmt.Errorf("move %s %s: %w", from, to, err)
 			}
@@ -559,3 +706,22 @@ func RestoreClassDir(dataPath string) func(class string) error {
 		return nil
 	}
 }
+
+// moveShardDirs moves the shard directories of a restored class into the
+// existing directory of the class
+func moveShardDirs(from, to string) error {
+	shards, err := os.ReadDir(from)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", from, err)
+	}
+	for _, shard := range shards {
+		src, dst := path.Join(from, shard.Name()), path.Join(to, shard.Name())
+		if _, err := os.Stat(dst); err == nil {
+			return fmt.Errorf("move %s: %s already exists", src, dst)
+		}
+		if err := os.Rename(src, dst); err != nil {
+			return fmt.Errorf("move %s %s: %w", src, dst, err)
+		}
+	}
+	return nil
+}
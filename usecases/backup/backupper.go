@@ -30,6 +30,8 @@ type backupper struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	// key encrypts backup chunks if set
+	key *EncryptionKey
 	// shardCoordinationChan is sync and coordinate operations
 	shardSyncChan
 }
@@ -135,6 +137,13 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		ID:      req.ID,
 		Timeout: expiration,
 	}
+	var base *backup.BackupDescriptor
+	if req.IncrementalBaseBackupID != "" {
+		var err error
+		if base, err = b.baseDescriptor(req.Backend, req.IncrementalBaseBackupID); err != nil {
+			return ret, err
+		}
+	}
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir()); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -151,7 +160,8 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger).
-			withCompression(newZipConfig(req.Compression))
+			withCompression(newZipConfig(req.Compression)).
+			withEncryption(b.key)
 
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
@@ -159,6 +169,15 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseBackupID:  req.IncrementalBaseBackupID,
+			Compression:   string(CodecGzip),
+			Encryption:    b.key.descriptor(),
+		}
+		if req.Codec != "" {
+			result.Compression = string(req.Codec)
+		}
+		if base != nil {
+			provider.withBase(base)
 		}
 
 		// the coordinator might want to abort the backup
@@ -180,3 +199,36 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 	return ret, nil
 }
+
+// baseDescriptor returns the descriptor of this node's part of the base
+// backup of an incremental backup. It returns nil if the node did not take
+// part in the base backup, in which case all files are uploaded.
+func (b *backupper) baseDescriptor(backend, baseID string) (*backup.BackupDescriptor, error) {
+	store, err := nodeBackend(b.node, b.backends, backend, baseID)
+	if err != nil {
+		return nil, fmt.Errorf("base backup %q: %w", baseID, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), metaTimeout)
+	defer cancel()
+	meta, err := store.Meta(ctx, baseID, false)
+	if err != nil {
+		if _, ok := err.(backup.ErrNotFound); ok {
+			b.logger.WithField("action", "create_backup").
+				Warnf("node has no data in base backup %q, uploading all files", baseID)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("base backup %q: %w", baseID, err)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("base backup %q has status %q", baseID, meta.Status)
+	}
+	// segments stored by the base are restored as part of this backup, so
+	// they have to be encrypted with the same key
+	if b.key != nil && meta.Encryption == nil {
+		return nil, fmt.Errorf("base backup %q is not encrypted", baseID)
+	}
+	if err := b.key.validate(meta.Encryption); err != nil {
+		return nil, fmt.Errorf("base backup %q: %w", baseID, err)
+	}
+	return meta, nil
+}
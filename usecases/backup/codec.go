@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Codec is the algorithm compressing the chunks of a backup
+type Codec string
+
+const (
+	CodecGzip Codec = "gzip"
+	CodecZstd Codec = "zstd"
+	CodecNone Codec = "none"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+func (c Codec) validate() error {
+	switch c {
+	case "", CodecGzip, CodecZstd, CodecNone:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compression codec %q", c)
+	}
+}
+
+type compressor interface {
+	io.WriteCloser
+	// Flush writes pending data to the underlying writer
+	Flush() error
+}
+
+// newCompressor returns a writer compressing to w. The compression level is
+// one of DefaultCompression, BestSpeed, BestCompression.
+func newCompressor(w io.Writer, codec Codec, level int) (compressor, error) {
+	switch codec {
+	case "", CodecGzip:
+		return gzip.NewWriterLevel(w, zipLevel(level))
+	case CodecZstd:
+		// chunks are already compressed in parallel
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstdLevel(level)),
+			zstd.WithEncoderConcurrency(1))
+	case CodecNone:
+		return nopCompressor{w}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %q", codec)
+	}
+}
+
+// newDecompressor returns a reader decompressing r. The codec is detected
+// from the first bytes of r, so chunks written with any codec can be read.
+func newDecompressor(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("detect codec: %w", err)
+	}
+	switch {
+	case len(head) == 0:
+		// an archive is never empty, even without compression
+		return nil, fmt.Errorf("detect codec: %w", io.ErrUnexpectedEOF)
+	case bytes.HasPrefix(head, gzipMagic):
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("gzip.NewReader: %w", err)
+		}
+		return gzr, nil
+	case bytes.HasPrefix(head, zstdMagic):
+		zr, err := zstd.NewReader(br, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("zstd.NewReader: %w", err)
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
+
+func zstdLevel(level int) zstd.EncoderLevel {
+	switch CompressionLevel(level) {
+	case BestSpeed:
+		return zstd.SpeedFastest
+	case BestCompression:
+		return zstd.SpeedBestCompression
+	default:
+		return zstd.SpeedDefault
+	}
+}
+
+type nopCompressor struct {
+	io.Writer
+}
+
+func (nopCompressor) Flush() error { return nil }
+func (nopCompressor) Close() error { return nil }
@@ -152,6 +152,7 @@ func (c *coordinator) Backup(ctx context.Context, store coordStore, req *Request
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
 		Leader:        leader,
+		BaseBackupID:  req.IncrementalBaseBackupID,
 	}
 
 	for key := range c.Participants {
@@ -232,7 +233,7 @@ func (c *coordinator) Restore(
 		defer c.lastOp.reset()
 		ctx := context.Background()
 		c.commit(ctx, &statusReq, nodes, true)
-		c.restoreClasses(ctx, schema, req.NodeMapping)
+		c.restoreClasses(ctx, schema, req)
 		logFields := logrus.Fields{"action": OpRestore, "backup_id": desc.ID}
 		if err := store.PutMeta(ctx, GlobalRestoreFile, c.descriptor); err != nil {
 			c.log.WithFields(logFields).Errorf("coordinator: put_meta: %v", err)
@@ -256,14 +257,24 @@ func (c *coordinator) Restore(
 func (c *coordinator) restoreClasses(
 	ctx context.Context,
 	schema []backup.ClassDescriptor,
-	mapping map[string]string,
+	req *Request,
 ) {
 	if c.descriptor.Status != backup.Success {
 		return
 	}
+	existing := map[string]bool{}
+	if req.MergeTenants {
+		for _, name := range c.selector.ListClasses(ctx) {
+			existing[name] = true
+		}
+	}
 	errors := make([]string, 0, 5)
 	for _, cls := range schema {
-		if err := c.schema.RestoreClass(ctx, &cls, mapping); err != nil {
+		restore := c.schema.RestoreClass
+		if existing[cls.Name] {
+			restore = c.schema.RestoreTenants
+		}
+		if err := restore(ctx, &cls, req.NodeMapping); err != nil {
 			c.descriptor.Error = fmt.Sprintf("restore class %q: %v", cls.Name, err)
 			errors = append(errors, fmt.Sprintf("%q: %v", cls.Name, err))
 		}
@@ -349,6 +360,11 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 					Duration:    _BookingPeriod,
 					NodeMapping: nodeMapping,
 					Compression: req.Compression,
+
+					IncrementalBaseBackupID: req.IncrementalBaseBackupID,
+					ClassMapping:            req.ClassMapping,
+					Tenants:                 req.Tenants,
+					MergeTenants:            req.MergeTenants,
 				},
 			}
 		}
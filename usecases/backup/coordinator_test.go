@@ -114,6 +114,35 @@ func TestCoordinatedBackup(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 
+	t.Run("Incremental", func(t *testing.T) {
+		t.Parallel()
+		fc := newFakeCoordinator(nodeResolver)
+		ireq := *creq
+		ireq.IncrementalBaseBackupID = "0"
+		fc.selector.On("Shards", ctx, classes[0]).Return(nodes, nil)
+		fc.selector.On("Shards", ctx, classes[1]).Return(nodes, nil)
+		fc.client.On("CanCommit", any, nodes[0], &ireq).Return(cresp, nil)
+		fc.client.On("CanCommit", any, nodes[1], &ireq).Return(cresp, nil)
+		fc.client.On("Commit", any, nodes[0], sReq).Return(nil)
+		fc.client.On("Commit", any, nodes[1], sReq).Return(nil)
+		fc.client.On("Status", any, nodes[0], sReq).Return(sresp, nil)
+		fc.client.On("Status", any, nodes[1], sReq).Return(sresp, nil)
+		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
+		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+
+		coordinator := *fc.coordinator()
+		req := newReq(classes, backendName, backupID)
+		req.IncrementalBaseBackupID = "0"
+		store := coordStore{objStore{fc.backend, req.ID}}
+		err := coordinator.Backup(ctx, store, &req)
+		assert.Nil(t, err)
+		<-fc.backend.doneChan
+
+		got := fc.backend.glMeta
+		assert.Equal(t, backup.Success, got.Status)
+		assert.Equal(t, "0", got.BaseBackupID)
+	})
+
 	t.Run("SuccessOnShardsEmptyPhysical", func(t *testing.T) {
 		t.Parallel()
 		fc := newFakeCoordinator(nodeResolver)
@@ -508,6 +537,19 @@ func TestCoordinatedRestoreWithNodeMapping(t *testing.T) {
 	})
 }
 
+func TestCoordinatorRestoreClassesMergeTenants(t *testing.T) {
+	ctx := context.Background()
+	fc := newFakeCoordinator(&fakeNodeResolver{})
+	fc.selector.On("ListClasses", ctx).Return([]string{"Existing"})
+	coordinator := fc.coordinator()
+	coordinator.descriptor = &backup.DistributedBackupDescriptor{Status: backup.Success}
+
+	schema := []backup.ClassDescriptor{{Name: "Existing"}, {Name: "New"}}
+	coordinator.restoreClasses(ctx, schema, &Request{MergeTenants: true})
+	assert.Equal(t, backup.Success, coordinator.descriptor.Status)
+	assert.Equal(t, []string{"Existing"}, fc.schema.restoredTenants)
+}
+
 type fakeSelector struct {
 	mock.Mock
 }
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronNext(t *testing.T) {
+	from := time.Date(2024, 5, 10, 12, 30, 15, 0, time.UTC) // Friday
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, 5, 10, 12, 31, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2024, 5, 11, 3, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 5, 10, 12, 45, 0, 0, time.UTC)},
+		{"0-10/5 13 * * *", time.Date(2024, 5, 10, 13, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1,3", time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)},
+		// day of month or day of week
+		{"0 0 12 * 6", time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+		{"@hourly", time.Date(2024, 5, 10, 13, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2024, 5, 10, 14, 0, 15, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		c, err := parseCron(test.expr)
+		require.Nil(t, err, test.expr)
+		assert.Equal(t, test.want, c.next(from), test.expr)
+	}
+}
+
+func TestCronParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@often",
+		"@every 30s",
+		"@every soon",
+	} {
+		_, err := parseCron(expr)
+		assert.NotNil(t, err, expr)
+	}
+}
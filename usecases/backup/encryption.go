@@ -0,0 +1,284 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// Encrypted chunks start with encryptionMagic followed by the nonce prefix.
+// The plaintext is split into blocks, each of them is sealed separately
+// with AES-GCM and stored as its length followed by the ciphertext. The
+// nonce of a block is the nonce prefix followed by the block counter, and
+// the last block is flagged so truncated chunks are detected.
+var encryptionMagic = []byte("WVBKAES1")
+
+const (
+	encryptionAlgorithm = "aes-gcm"
+	encryptedBlockSize  = 64 * 1024
+	noncePrefixSize     = 8
+	lastBlockFlag       = uint32(1) << 31
+)
+
+var (
+	errNoEncryptionKey = errors.New("backup is encrypted, but no encryption key is configured")
+	errNotEncrypted    = errors.New("backup is encrypted, but chunk has no encryption header")
+)
+
+// EncryptionKey encrypts and decrypts backup chunks
+type EncryptionKey struct {
+	aead cipher.AEAD
+	id   string
+}
+
+// NewEncryptionKey creates a key from 16, 24 or 32 bytes, selecting
+// AES-128, AES-192 or AES-256
+func NewEncryptionKey(key []byte) (*EncryptionKey, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("backup encryption key: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("backup encryption key: %w", err)
+	}
+	sum := sha256.Sum256(key)
+	return &EncryptionKey{aead: aead, id: hex.EncodeToString(sum[:8])}, nil
+}
+
+// LoadEncryptionKey returns the key configured in cfg, nil if there is none
+func LoadEncryptionKey(cfg config.Backup) (*EncryptionKey, error) {
+	var encoded string
+	switch {
+	case cfg.EncryptionKey != "" && cfg.EncryptionKeyFile != "":
+		return nil, fmt.Errorf("backup encryption key and key file are mutually exclusive")
+	case cfg.EncryptionKey != "":
+		encoded = cfg.EncryptionKey
+	case cfg.EncryptionKeyFile != "":
+		content, err := os.ReadFile(cfg.EncryptionKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read backup encryption key file: %w", err)
+		}
+		encoded = string(content)
+	default:
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("backup encryption key must be base64 encoded: %w", err)
+	}
+	return NewEncryptionKey(key)
+}
+
+// descriptor describes the encryption in the backup descriptor
+func (k *EncryptionKey) descriptor() *backup.Encryption {
+	if k == nil {
+		return nil
+	}
+	return &backup.Encryption{Algorithm: encryptionAlgorithm, KeyID: k.id}
+}
+
+// validate checks that k can decrypt a backup encrypted as described by enc
+func (k *EncryptionKey) validate(enc *backup.Encryption) error {
+	switch {
+	case enc == nil:
+		return nil
+	case enc.Algorithm != encryptionAlgorithm:
+		return fmt.Errorf("unsupported backup encryption algorithm %q", enc.Algorithm)
+	case k == nil:
+		return errNoEncryptionKey
+	case k.id != enc.KeyID:
+		return fmt.Errorf("backup was encrypted with key %s, but key %s is configured", enc.KeyID, k.id)
+	default:
+		return nil
+	}
+}
+
+// newEncrypter returns a writer encrypting to w. Close must be called to
+// write the last block.
+func (k *EncryptionKey) newEncrypter(w io.Writer) (io.WriteCloser, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce[:noncePrefixSize]); err != nil {
+		return nil, fmt.Errorf("create nonce: %w", err)
+	}
+	return &encrypter{
+		w:     w,
+		aead:  k.aead,
+		nonce: nonce,
+		buf:   make([]byte, 0, encryptedBlockSize),
+		// the header is written together with the first block
+		out: append(append([]byte{}, encryptionMagic...), nonce[:noncePrefixSize]...),
+	}, nil
+}
+
+// newDecrypter returns a reader decrypting r, which must start with the
+// encryption header
+func (k *EncryptionKey) newDecrypter(r io.Reader) (io.Reader, error) {
+	header := make([]byte, len(encryptionMagic)+noncePrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("read encryption header: %w", err)
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], encryptionMagic) {
+		return nil, fmt.Errorf("invalid encryption header")
+	}
+	nonce := make([]byte, k.aead.NonceSize())
+	copy(nonce, header[len(encryptionMagic):])
+	return &decrypter{r: r, aead: k.aead, nonce: nonce}, nil
+}
+
+// decryptIfEncrypted detects whether r is encrypted and decrypts it with
+// key. Plaintext chunks are returned as they are, unless required is set
+// because the backup is known to be encrypted.
+func decryptIfEncrypted(r io.Reader, key *EncryptionKey, required bool) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(encryptionMagic))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("detect encryption: %w", err)
+	}
+	if !bytes.Equal(head, encryptionMagic) {
+		if required {
+			return nil, errNotEncrypted
+		}
+		return br, nil
+	}
+	if key == nil {
+		return nil, errNoEncryptionKey
+	}
+	return key.newDecrypter(br)
+}
+
+type encrypter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint32
+	buf     []byte // plaintext of the current block
+	out     []byte // pending output, starts with the header
+}
+
+func (e *encrypter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// a full block is only sealed once more data follows, so the last
+		// block is always written by Close
+		if len(e.buf) == encryptedBlockSize {
+			if err := e.seal(false); err != nil {
+				return n - len(p), err
+			}
+		}
+		m := copy(e.buf[len(e.buf):encryptedBlockSize], p)
+		e.buf = e.buf[:len(e.buf)+m]
+		p = p[m:]
+	}
+	return n, nil
+}
+
+func (e *encrypter) Close() error {
+	return e.seal(true)
+}
+
+func (e *encrypter) seal(last bool) error {
+	if e.counter == math.MaxUint32 {
+		return fmt.Errorf("encrypt: too many blocks")
+	}
+	binary.BigEndian.PutUint32(e.nonce[noncePrefixSize:], e.counter)
+	e.counter++
+
+	length := uint32(len(e.buf) + e.aead.Overhead())
+	if last {
+		length |= lastBlockFlag
+	}
+	var prefix [4]byte
+	binary.BigEndian.PutUint32(prefix[:], length)
+	e.out = append(e.out, prefix[:]...)
+	e.out = e.aead.Seal(e.out, e.nonce, e.buf, prefix[:])
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(e.out)
+	e.out = e.out[:0]
+	return err
+}
+
+type decrypter struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint32
+	buf     []byte // decrypted but not yet read plaintext
+	in      []byte
+	last    bool
+}
+
+func (d *decrypter) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.last {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decrypter) open() error {
+	var prefix [4]byte
+	if _, err := io.ReadFull(d.r, prefix[:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return fmt.Errorf("decrypt: read block: %w", err)
+	}
+	length := binary.BigEndian.Uint32(prefix[:])
+	d.last = length&lastBlockFlag != 0
+	size := int(length &^ lastBlockFlag)
+	if size < d.aead.Overhead() || size > encryptedBlockSize+d.aead.Overhead() {
+		return fmt.Errorf("decrypt: invalid block size %d", size)
+	}
+	if cap(d.in) < size {
+		d.in = make([]byte, size)
+	}
+	d.in = d.in[:size]
+	if _, err := io.ReadFull(d.r, d.in); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return fmt.Errorf("decrypt: read block: %w", err)
+	}
+
+	binary.BigEndian.PutUint32(d.nonce[noncePrefixSize:], d.counter)
+	d.counter++
+	plain, err := d.aead.Open(d.in[:0], d.nonce, d.in, prefix[:])
+	if err != nil {
+		return fmt.Errorf("decrypt: wrong encryption key or corrupted backup: %w", err)
+	}
+	d.buf = plain
+	return nil
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestEncryption(t *testing.T) {
+	key, err := NewEncryptionKey(bytes.Repeat([]byte{7}, 32))
+	require.Nil(t, err)
+
+	encrypt := func(t *testing.T, plain []byte) []byte {
+		var buf bytes.Buffer
+		w, err := key.newEncrypter(&buf)
+		require.Nil(t, err)
+		// odd write sizes cross block boundaries
+		for len(plain) > 0 {
+			n := min(len(plain), 10_000)
+			_, err := w.Write(plain[:n])
+			require.Nil(t, err)
+			plain = plain[n:]
+		}
+		require.Nil(t, w.Close())
+		return buf.Bytes()
+	}
+	decrypt := func(key *EncryptionKey, data []byte) ([]byte, error) {
+		r, err := decryptIfEncrypted(bytes.NewReader(data), key, false)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+
+	for _, size := range []int{0, 1, encryptedBlockSize, 3*encryptedBlockSize + 17} {
+		plain := make([]byte, size)
+		for i := range plain {
+			plain[i] = byte(i % 251)
+		}
+		data := encrypt(t, plain)
+		got, err := decrypt(key, data)
+		require.Nil(t, err, "size %d", size)
+		assert.Equal(t, plain, got, "size %d", size)
+		if size >= 64 {
+			assert.False(t, bytes.Contains(data, plain[:64]))
+		}
+	}
+
+	plain := bytes.Repeat([]byte("weaviate"), encryptedBlockSize/4)
+	data := encrypt(t, plain)
+
+	t.Run("plaintext is passed through", func(t *testing.T) {
+		got, err := decrypt(key, plain)
+		require.Nil(t, err)
+		assert.Equal(t, plain, got)
+	})
+
+	t.Run("plaintext is rejected if encryption is required", func(t *testing.T) {
+		_, err := decryptIfEncrypted(bytes.NewReader(plain), key, true)
+		assert.ErrorIs(t, err, errNotEncrypted)
+
+		r, err := decryptIfEncrypted(bytes.NewReader(data), key, true)
+		require.Nil(t, err)
+		got, err := io.ReadAll(r)
+		require.Nil(t, err)
+		assert.Equal(t, plain, got)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		// cut off the last block
+		_, err := decrypt(key, data[:len(data)/2+encryptedBlockSize/2])
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(data)
+		tampered[len(tampered)-1] ^= 1
+		_, err := decrypt(key, tampered)
+		assert.ErrorContains(t, err, "wrong encryption key or corrupted backup")
+	})
+
+	t.Run("blocks cannot be reordered", func(t *testing.T) {
+		header := len(encryptionMagic) + noncePrefixSize
+		block := 4 + encryptedBlockSize + key.aead.Overhead()
+		reordered := bytes.Clone(data)
+		copy(reordered[header:], data[header+block:header+2*block])
+		copy(reordered[header+block:], data[header:header+block])
+		_, err := decrypt(key, reordered)
+		assert.ErrorContains(t, err, "wrong encryption key or corrupted backup")
+	})
+
+	t.Run("no key", func(t *testing.T) {
+		_, err := decrypt(nil, data)
+		assert.ErrorIs(t, err, errNoEncryptionKey)
+	})
+}
+
+func TestEncryptionKeyValidate(t *testing.T) {
+	key, err := NewEncryptionKey(bytes.Repeat([]byte{1}, 16))
+	require.Nil(t, err)
+	other, err := NewEncryptionKey(bytes.Repeat([]byte{2}, 16))
+	require.Nil(t, err)
+
+	assert.Nil(t, key.validate(nil))
+	assert.Nil(t, (*EncryptionKey)(nil).validate(nil))
+	assert.Nil(t, key.validate(key.descriptor()))
+	assert.ErrorIs(t, (*EncryptionKey)(nil).validate(key.descriptor()), errNoEncryptionKey)
+	assert.ErrorContains(t, other.validate(key.descriptor()), "was encrypted with key")
+	assert.ErrorContains(t, key.validate(&backup.Encryption{Algorithm: "rot13"}), "unsupported")
+	assert.Nil(t, (*EncryptionKey)(nil).descriptor())
+}
+
+func TestLoadEncryptionKey(t *testing.T) {
+	raw := bytes.Repeat([]byte{3}, 32)
+	encoded := base64.StdEncoding.EncodeToString(raw)
+	want, err := NewEncryptionKey(raw)
+	require.Nil(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "backup.key")
+	require.Nil(t, os.WriteFile(keyFile, []byte(encoded+"\n"), 0o600))
+
+	key, err := LoadEncryptionKey(config.Backup{})
+	require.Nil(t, err)
+	assert.Nil(t, key)
+
+	key, err = LoadEncryptionKey(config.Backup{EncryptionKey: encoded})
+	require.Nil(t, err)
+	assert.Equal(t, want.id, key.id)
+
+	key, err = LoadEncryptionKey(config.Backup{EncryptionKeyFile: keyFile})
+	require.Nil(t, err)
+	assert.Equal(t, want.id, key.id)
+
+	_, err = LoadEncryptionKey(config.Backup{EncryptionKey: encoded, EncryptionKeyFile: keyFile})
+	assert.ErrorContains(t, err, "mutually exclusive")
+
+	_, err = LoadEncryptionKey(config.Backup{EncryptionKey: "not base64!"})
+	assert.ErrorContains(t, err, "base64")
+
+	_, err = LoadEncryptionKey(config.Backup{EncryptionKey: base64.StdEncoding.EncodeToString([]byte("short"))})
+	assert.ErrorContains(t, err, "invalid key size")
+
+	_, err = LoadEncryptionKey(config.Backup{EncryptionKeyFile: filepath.Join(t.TempDir(), "missing")})
+	assert.NotNil(t, err)
+}
@@ -148,6 +148,13 @@ func (fb *fakeBackend) SourceDataPath() string {
 	return args.String(0)
 }
 
+func (fb *fakeBackend) DeleteBackup(ctx context.Context, backupID string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) IsExternal() bool {
 	return true
 }
@@ -46,6 +46,7 @@ type authorizer interface {
 
 type schemaManger interface {
 	RestoreClass(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error
+	RestoreTenants(ctx context.Context, d *backup.ClassDescriptor, nodeMapping map[string]string) error
 	NodeName() string
 }
 
@@ -101,11 +102,22 @@ func NewHandler(
 	return m
 }
 
+// WithEncryption encrypts the chunks of new backups with key and decrypts
+// encrypted backups on restore. Backups are not encrypted if key is nil.
+func (m *Handler) WithEncryption(key *EncryptionKey) *Handler {
+	m.backupper.key = key
+	m.restorer.key = key
+	return m
+}
+
 // Compression is the compression configuration.
 type Compression struct {
 	// Level is one of DefaultCompression, BestSpeed, BestCompression
 	Level CompressionLevel
 
+	// Codec is one of CodecGzip, CodecZstd, CodecNone, default: CodecGzip
+	Codec Codec
+
 	// ChunkSize represents the desired size for chunks between 1 - 512  MB
 	// However, during compression, the chunk size might
 	// slightly deviate from this value, being either slightly
@@ -116,6 +128,48 @@ type Compression struct {
 	CPUPercentage int
 }
 
+// CompressionFromConfig transforms a backup config of the API into a
+// compression configuration. Missing values are set to their defaults.
+func CompressionFromConfig(cfg *models.BackupConfig) Compression {
+	if cfg != nil {
+		if cfg.CPUPercentage == 0 {
+			cfg.CPUPercentage = DefaultCPUPercentage
+		}
+
+		if cfg.ChunkSize == 0 {
+			cfg.ChunkSize = DefaultChunkSize
+		}
+
+		if cfg.CompressionLevel == "" {
+			cfg.CompressionLevel = models.BackupConfigCompressionLevelDefaultCompression
+		}
+
+		return Compression{
+			CPUPercentage: int(cfg.CPUPercentage),
+			ChunkSize:     int(cfg.ChunkSize),
+			Level:         parseCompressionLevel(cfg.CompressionLevel),
+			Codec:         Codec(cfg.Codec),
+		}
+	}
+
+	return Compression{
+		Level:         DefaultCompression,
+		CPUPercentage: DefaultCPUPercentage,
+		ChunkSize:     DefaultChunkSize,
+	}
+}
+
+func parseCompressionLevel(l string) CompressionLevel {
+	switch {
+	case l == models.BackupConfigCompressionLevelBestSpeed:
+		return BestSpeed
+	case l == models.BackupConfigCompressionLevelBestCompression:
+		return BestCompression
+	default:
+		return DefaultCompression
+	}
+}
+
 // BackupRequest a transition request from API to Backend.
 type BackupRequest struct {
 	// Compression is the compression configuration.
@@ -136,6 +190,22 @@ type BackupRequest struct {
 	// NodeMapping is a map of node name replacement where key is the old name and value is the new name
 	// No effect if the map is empty
 	NodeMapping map[string]string
+
+	// IncrementalBaseBackupID makes the backup incremental: segment files
+	// which did not change since this backup are referenced instead of stored
+	IncrementalBaseBackupID string
+
+	// ClassMapping restores classes under new names, where key is the name
+	// of a class in the backup and value is the name to restore it as
+	ClassMapping map[string]string
+
+	// Tenants restricts the restored tenants of multi-tenant classes, where
+	// key is the name of a class in the backup
+	Tenants map[string][]string
+
+	// MergeTenants restores the tenants of a multi-tenant class into an
+	// existing class instead of failing because the class exists
+	MergeTenants bool
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
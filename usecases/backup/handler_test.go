@@ -101,6 +101,7 @@ func (m *Handler) validateRestoreRequest(ctx context.Context, store nodeStore, r
 type fakeSchemaManger struct {
 	errRestoreClass error
 	nodeName        string
+	restoredTenants []string
 }
 
 func (f *fakeSchemaManger) RestoreClass(context.Context, *backup.ClassDescriptor, map[string]string,
@@ -108,6 +109,12 @@ func (f *fakeSchemaManger) RestoreClass(context.Context, *backup.ClassDescriptor
 	return f.errRestoreClass
 }
 
+func (f *fakeSchemaManger) RestoreTenants(_ context.Context, d *backup.ClassDescriptor, _ map[string]string,
+) error {
+	f.restoredTenants = append(f.restoredTenants, d.Name)
+	return f.errRestoreClass
+}
+
 func (f *fakeSchemaManger) NodeName() string {
 	return f.nodeName
 }
@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// validateClassMapping checks the class names and tenants of a restore
+// request against the classes being restored
+func validateClassMapping(classes []string, mapping map[string]string, tenants map[string][]string) error {
+	restored := make(map[string]struct{}, len(classes))
+	for _, cls := range classes {
+		restored[cls] = struct{}{}
+	}
+	for from, to := range mapping {
+		if _, ok := restored[from]; !ok {
+			return fmt.Errorf("class mapping: class %s is not restored from the backup", from)
+		}
+		if _, err := schema.ValidateClassName(to); err != nil {
+			return fmt.Errorf("class mapping: %w", err)
+		}
+	}
+	for cls, names := range tenants {
+		if _, ok := restored[cls]; !ok {
+			return fmt.Errorf("tenants: class %s is not restored from the backup", cls)
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("tenants: empty tenant list for class %s", cls)
+		}
+		if dup := findDuplicate(names); dup != "" {
+			return fmt.Errorf("tenants: class %s contains duplicate tenant: %s", cls, dup)
+		}
+	}
+
+	// restored classes must not end up with the same name
+	targets := make(map[string]string, len(classes))
+	for _, cls := range classes {
+		to := cls
+		if name, ok := mapping[cls]; ok {
+			to = name
+		}
+		if other, ok := targets[to]; ok {
+			return fmt.Errorf("class mapping: classes %s and %s are both restored as %s", other, cls, to)
+		}
+		targets[to] = cls
+	}
+	return nil
+}
+
+// mapClasses renames the classes of a backup as given by mapping and
+// removes tenants which are not selected for restoration. Descriptors of
+// classes which do not appear in mapping or tenants are returned unchanged.
+func mapClasses(descs []backup.ClassDescriptor,
+	mapping map[string]string, tenants map[string][]string,
+) ([]backup.ClassDescriptor, error) {
+	out := make([]backup.ClassDescriptor, len(descs))
+	for i, d := range descs {
+		target, renamed := mapping[d.Name]
+		selected, filtered := tenants[d.Name]
+		if !renamed && !filtered {
+			out[i] = d
+			continue
+		}
+		if !renamed {
+			target = d.Name
+		}
+		mapped, err := mapClass(d, target, selected, filtered)
+		if err != nil {
+			return nil, fmt.Errorf("class %s: %w", d.Name, err)
+		}
+		out[i] = mapped
+	}
+	return out, nil
+}
+
+func mapClass(d backup.ClassDescriptor, target string, tenants []string, filtered bool) (backup.ClassDescriptor, error) {
+	class := models.Class{}
+	if err := json.Unmarshal(d.Schema, &class); err != nil {
+		return d, fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	var ss sharding.State
+	if d.ShardingState != nil {
+		if err := json.Unmarshal(d.ShardingState, &ss); err != nil {
+			return d, fmt.Errorf("unmarshal sharding state: %w", err)
+		}
+	}
+
+	class.Class = target
+	ss.IndexID = target
+	if filtered {
+		if !schema.MultiTenancyEnabled(&class) {
+			return d, fmt.Errorf("tenants can only be selected for multi-tenant classes")
+		}
+		physical := make(map[string]sharding.Physical, len(tenants))
+		for _, name := range tenants {
+			p, ok := ss.Physical[name]
+			if !ok {
+				return d, fmt.Errorf("tenant %s does not exist in the backup, but does have %v",
+					name, tenantNames(&ss))
+			}
+			physical[name] = p
+		}
+		ss.Physical = physical
+	}
+
+	var err error
+	if d.Schema, err = json.Marshal(class); err != nil {
+		return d, fmt.Errorf("marshal class schema: %w", err)
+	}
+	if d.ShardingState != nil {
+		if d.ShardingState, err = json.Marshal(ss); err != nil {
+			return d, fmt.Errorf("marshal sharding state: %w", err)
+		}
+	}
+	d.Name = target
+	return d, nil
+}
+
+func tenantNames(ss *sharding.State) []string {
+	names := make([]string, 0, len(ss.Physical))
+	for name := range ss.Physical {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// multiTenant reports whether the class of d has multi-tenancy enabled
+func multiTenant(d *backup.ClassDescriptor) (bool, error) {
+	class := models.Class{}
+	if err := json.Unmarshal(d.Schema, &class); err != nil {
+		return false, fmt.Errorf("unmarshal class schema: %w", err)
+	}
+	return schema.MultiTenancyEnabled(&class), nil
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestValidateClassMapping(t *testing.T) {
+	classes := []string{"A", "B"}
+	tests := []struct {
+		name    string
+		mapping map[string]string
+		tenants map[string][]string
+		err     string
+	}{
+		{name: "empty"},
+		{name: "rename", mapping: map[string]string{"A": "A_restored"}},
+		{name: "swap", mapping: map[string]string{"A": "B", "B": "A"}},
+		{name: "tenants", tenants: map[string][]string{"A": {"t1", "t2"}}},
+		{name: "unknown class", mapping: map[string]string{"C": "D"}, err: "not restored"},
+		{name: "invalid name", mapping: map[string]string{"A": "a-b"}, err: "class mapping"},
+		{name: "same target", mapping: map[string]string{"A": "C", "B": "C"}, err: "both restored as C"},
+		{name: "target is restored", mapping: map[string]string{"A": "B"}, err: "both restored as B"},
+		{name: "tenants of unknown class", tenants: map[string][]string{"C": {"t1"}}, err: "not restored"},
+		{name: "no tenants", tenants: map[string][]string{"A": {}}, err: "empty tenant list"},
+		{name: "duplicate tenant", tenants: map[string][]string{"A": {"t1", "t1"}}, err: "duplicate"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateClassMapping(classes, test.mapping, test.tenants)
+			if test.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestMapClasses(t *testing.T) {
+	descriptor := func(t *testing.T, name string, mt bool) backup.ClassDescriptor {
+		class := models.Class{Class: name}
+		ss := sharding.State{IndexID: name}
+		if mt {
+			class.MultiTenancyConfig = &models.MultiTenancyConfig{Enabled: true}
+			ss.PartitioningEnabled = true
+			ss.Physical = map[string]sharding.Physical{
+				"t1": {Name: "t1", BelongsToNodes: []string{"N1"}},
+				"t2": {Name: "t2", BelongsToNodes: []string{"N1"}},
+			}
+		}
+		d := backup.ClassDescriptor{Name: name}
+		var err error
+		d.Schema, err = json.Marshal(class)
+		require.Nil(t, err)
+		d.ShardingState, err = json.Marshal(ss)
+		require.Nil(t, err)
+		return d
+	}
+	unmarshal := func(t *testing.T, d backup.ClassDescriptor) (models.Class, sharding.State) {
+		var class models.Class
+		var ss sharding.State
+		require.Nil(t, json.Unmarshal(d.Schema, &class))
+		require.Nil(t, json.Unmarshal(d.ShardingState, &ss))
+		return class, ss
+	}
+
+	t.Run("rename and select tenants", func(t *testing.T) {
+		descs := []backup.ClassDescriptor{descriptor(t, "A", true), descriptor(t, "B", false)}
+		got, err := mapClasses(descs,
+			map[string]string{"A": "A_restored"}, map[string][]string{"A": {"t2"}})
+		require.Nil(t, err)
+		require.Len(t, got, 2)
+
+		assert.Equal(t, "A_restored", got[0].Name)
+		class, ss := unmarshal(t, got[0])
+		assert.Equal(t, "A_restored", class.Class)
+		assert.Equal(t, "A_restored", ss.IndexID)
+		assert.Equal(t, []string{"t2"}, tenantNames(&ss))
+		assert.Equal(t, []string{"N1"}, ss.Physical["t2"].BelongsToNodes)
+
+		assert.Equal(t, descs[1], got[1])
+	})
+
+	t.Run("select tenants without renaming", func(t *testing.T) {
+		got, err := mapClasses([]backup.ClassDescriptor{descriptor(t, "A", true)},
+			nil, map[string][]string{"A": {"t1"}})
+		require.Nil(t, err)
+		class, ss := unmarshal(t, got[0])
+		assert.Equal(t, "A", class.Class)
+		assert.Equal(t, []string{"t1"}, tenantNames(&ss))
+	})
+
+	t.Run("unknown tenant", func(t *testing.T) {
+		_, err := mapClasses([]backup.ClassDescriptor{descriptor(t, "A", true)},
+			nil, map[string][]string{"A": {"t3"}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "tenant t3 does not exist")
+	})
+
+	t.Run("tenants of single-tenant class", func(t *testing.T) {
+		_, err := mapClasses([]backup.ClassDescriptor{descriptor(t, "B", false)},
+			nil, map[string][]string{"B": {"t1"}})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "multi-tenant")
+	})
+}
+
+func TestSelectShards(t *testing.T) {
+	shards := []*backup.ShardDescriptor{{Name: "t1"}, {Name: "t2"}, {Name: "t3"}}
+	got := selectShards(shards, []string{"t3", "t1", "t4"})
+	assert.Equal(t, []*backup.ShardDescriptor{{Name: "t1"}, {Name: "t3"}}, got)
+}
+
+func TestRestoreClassDirMerge(t *testing.T) {
+	write := func(t *testing.T, path string) {
+		require.Nil(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.Nil(t, os.WriteFile(path, []byte(path), 0o644))
+	}
+	dataPath := t.TempDir()
+	tempDir := filepath.Join(dataPath, TempDirectory, "Class")
+	write(t, filepath.Join(dataPath, "class", "t1", "file"))
+	write(t, filepath.Join(tempDir, "class", "t2", "file"))
+
+	require.Nil(t, RestoreClassDir(dataPath)("Class"))
+	assert.FileExists(t, filepath.Join(dataPath, "class", "t1", "file"))
+	assert.FileExists(t, filepath.Join(dataPath, "class", "t2", "file"))
+	assert.NoDirExists(t, tempDir)
+
+	// existing shards are not overwritten
+	write(t, filepath.Join(tempDir, "class", "t1", "file"))
+	err := RestoreClassDir(dataPath)("Class")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+	data, err := os.ReadFile(filepath.Join(dataPath, "class", "t1", "file"))
+	require.Nil(t, err)
+	assert.Equal(t, filepath.Join(dataPath, "class", "t1", "file"), string(data))
+}
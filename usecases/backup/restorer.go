@@ -37,6 +37,8 @@ type restorer struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	// key decrypts encrypted backup chunks
+	key *EncryptionKey
 	shardSyncChan
 
 	// TODO: keeping status in memory after restore has been done
@@ -109,7 +111,7 @@ func (r *restorer) restore(
 			return
 		}
 
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store)
+		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, req.ClassMapping)
 		logFields := logrus.Fields{"action": "restore", "backup_id": req.ID}
 		if err != nil {
 			r.logger.WithFields(logFields).Error(err)
@@ -124,14 +126,20 @@ func (r *restorer) restore(
 
 // restoreAll restores classes in temporary directories on the filesystem.
 // The final backup restoration is orchestrated by the raft store.
+// Classes are restored under the names given by classMapping if present.
 func (r *restorer) restoreAll(ctx context.Context,
 	desc *backup.BackupDescriptor, cpuPercentage int,
-	store nodeStore,
+	store nodeStore, classMapping map[string]string,
 ) (err error) {
 	compressed := desc.Version > version1
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, cpuPercentage, store); err != nil {
+		target := cdesc.Name
+		if name, ok := classMapping[cdesc.Name]; ok {
+			target = name
+		}
+		if err := r.restoreOne(ctx, &cdesc, target, desc.ServerVersion, compressed,
+			desc.Encryption != nil, cpuPercentage, store); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -150,8 +158,8 @@ func getType(myvar interface{}) string {
 }
 
 func (r *restorer) restoreOne(ctx context.Context,
-	desc *backup.ClassDescriptor, serverVersion string,
-	compressed bool, cpuPercentage int, store nodeStore,
+	desc *backup.ClassDescriptor, target, serverVersion string,
+	compressed, encrypted bool, cpuPercentage int, store nodeStore,
 ) (err error) {
 	classLabel := desc.Name
 	if monitoring.GetMetrics().Group {
@@ -164,7 +172,8 @@ func (r *restorer) restoreOne(ctx context.Context,
 	}
 
 	fw := newFileWriter(r.sourcer, store, compressed, r.logger).
-		WithPoolPercentage(cpuPercentage)
+		WithPoolPercentage(cpuPercentage).
+		withEncryption(r.key, encrypted)
 
 	// Pre-v1.23 versions store files in a flat format
 	if serverVersion < "1.23" {
@@ -175,7 +184,7 @@ func (r *restorer) restoreOne(ctx context.Context,
 		fw.setMigrator(f)
 	}
 
-	if err := fw.Write(ctx, desc); err != nil {
+	if err := fw.Write(ctx, desc, target); err != nil {
 		return fmt.Errorf("write files: %w", err)
 	}
 
@@ -222,6 +231,9 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 	if v := meta.Version; v > Version {
 		return nil, nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if err := r.key.validate(meta.Encryption); err != nil {
+		return nil, nil, err
+	}
 	cs := meta.List()
 	if len(req.Classes) > 0 {
 		if first := meta.AllExist(req.Classes); first != "" {
@@ -230,9 +242,29 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 		}
 		meta.Include(req.Classes)
 	}
+	for i := range meta.Classes {
+		if tenants, ok := req.Tenants[meta.Classes[i].Name]; ok {
+			meta.Classes[i].Shards = selectShards(meta.Classes[i].Shards, tenants)
+		}
+	}
 	return meta, cs, nil
 }
 
+// selectShards returns the shards of the given tenants
+func selectShards(shards []*backup.ShardDescriptor, tenants []string) []*backup.ShardDescriptor {
+	selected := make(map[string]struct{}, len(tenants))
+	for _, t := range tenants {
+		selected[t] = struct{}{}
+	}
+	out := make([]*backup.ShardDescriptor, 0, len(tenants))
+	for _, shard := range shards {
+		if _, ok := selected[shard.Name]; ok {
+			out = append(out, shard)
+		}
+	}
+	return out
+}
+
 // oneClassSchema allows for creating schema with one class
 // This is required when migrating to hierarchical file structure from pre-v1.23
 type oneClassSchema struct {
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// applyRetention splits the backups of a schedule into the ones to keep and
+// the ones to delete according to the retention policy r.
+//
+// Backups which have not finished yet are always kept and failed backups are
+// always deleted. A successful backup is kept if it is one of the newest
+// KeepLast successful backups or if it is the newest successful backup of a
+// day (UTC) within the last KeepDailyDays days. Without any rule all
+// successful backups are kept. The backups to keep are sorted by start time.
+func applyRetention(backups []api.ScheduledBackup, r *models.BackupRetention, now time.Time,
+) (keep, drop []api.ScheduledBackup) {
+	sorted := make([]api.ScheduledBackup, len(backups))
+	copy(sorted, backups)
+	// newest first
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartedAt.After(sorted[j].StartedAt)
+	})
+
+	keepAll := r == nil || (r.KeepLast == 0 && r.KeepDailyDays == 0)
+	var (
+		nSuccess int64
+		days     = make(map[string]struct{})
+		today    = now.UTC().Truncate(24 * time.Hour)
+	)
+	for _, b := range sorted {
+		switch backup.Status(b.Status) {
+		case backup.Failed:
+			drop = append(drop, b)
+			continue
+		case backup.Success:
+		default:
+			keep = append(keep, b)
+			continue
+		}
+		if keepAll {
+			keep = append(keep, b)
+			continue
+		}
+
+		nSuccess++
+		retained := nSuccess <= r.KeepLast
+		if r.KeepDailyDays > 0 {
+			day := b.StartedAt.UTC().Truncate(24 * time.Hour)
+			key := day.Format(time.DateOnly)
+			if _, seen := days[key]; !seen && today.Sub(day) < time.Duration(r.KeepDailyDays)*24*time.Hour {
+				days[key] = struct{}{}
+				retained = true
+			}
+		}
+		if retained {
+			keep = append(keep, b)
+		} else {
+			drop = append(drop, b)
+		}
+	}
+
+	sort.SliceStable(keep, func(i, j int) bool {
+		return keep[i].StartedAt.Before(keep[j].StartedAt)
+	})
+	return keep, drop
+}
+
+// retainBases moves the successful backups of drop which are referenced by
+// a backup of keep over to keep, as incremental backups cannot be restored
+// without the files stored by their bases. bases returns the backups
+// referenced by a successful backup, see backup.BackupDescriptor.BaseBackups.
+// The bases of unfinished backups are not known yet, so no successful backup
+// is dropped as long as one of them is kept.
+func retainBases(keep, drop []api.ScheduledBackup, bases func(id string) ([]string, error),
+) ([]api.ScheduledBackup, []api.ScheduledBackup, error) {
+	referenced := make(map[string]struct{})
+	pending := append([]api.ScheduledBackup(nil), keep...)
+	for len(pending) > 0 {
+		b := pending[0]
+		pending = pending[1:]
+		switch backup.Status(b.Status) {
+		case backup.Success:
+		case backup.Failed:
+			continue
+		default:
+			var rest []api.ScheduledBackup
+			for _, d := range drop {
+				if backup.Status(d.Status) == backup.Success {
+					keep = append(keep, d)
+				} else {
+					rest = append(rest, d)
+				}
+			}
+			return keep, rest, nil
+		}
+
+		ids, err := bases(b.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("base backups of %q: %w", b.ID, err)
+		}
+		for _, id := range ids {
+			referenced[id] = struct{}{}
+		}
+		// bases may be referenced by backups kept only because of this one
+		var rest []api.ScheduledBackup
+		for _, d := range drop {
+			if _, ok := referenced[d.ID]; ok {
+				keep = append(keep, d)
+				pending = append(pending, d)
+			} else {
+				rest = append(rest, d)
+			}
+		}
+		drop = rest
+	}
+	return keep, drop, nil
+}
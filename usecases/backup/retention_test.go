@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestApplyRetention(t *testing.T) {
+	var (
+		now     = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+		success = string(backup.Success)
+		backups = []api.ScheduledBackup{
+			{ID: "d5", StartedAt: now.Add(-5 * 24 * time.Hour), Status: success},
+			{ID: "d2-a", StartedAt: now.Add(-2*24*time.Hour - time.Hour), Status: success},
+			{ID: "d2-b", StartedAt: now.Add(-2 * 24 * time.Hour), Status: success},
+			{ID: "d1", StartedAt: now.Add(-24 * time.Hour), Status: string(backup.Failed)},
+			{ID: "d0-a", StartedAt: now.Add(-2 * time.Hour), Status: success},
+			{ID: "d0-b", StartedAt: now.Add(-time.Hour), Status: string(backup.Transferring)},
+		}
+	)
+	ids := func(xs []api.ScheduledBackup) []string {
+		res := make([]string, len(xs))
+		for i, x := range xs {
+			res[i] = x.ID
+		}
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		retention *models.BackupRetention
+		keep      []string
+		drop      []string
+	}{
+		{
+			name: "NoRetention",
+			keep: []string{"d5", "d2-a", "d2-b", "d0-a", "d0-b"},
+			drop: []string{"d1"},
+		},
+		{
+			name:      "KeepLast",
+			retention: &models.BackupRetention{KeepLast: 2},
+			keep:      []string{"d2-b", "d0-a", "d0-b"},
+			drop:      []string{"d1", "d2-a", "d5"},
+		},
+		{
+			name:      "KeepDaily",
+			retention: &models.BackupRetention{KeepDailyDays: 3},
+			keep:      []string{"d2-b", "d0-a", "d0-b"},
+			drop:      []string{"d1", "d2-a", "d5"},
+		},
+		{
+			name:      "KeepLastAndDaily",
+			retention: &models.BackupRetention{KeepLast: 4, KeepDailyDays: 1},
+			keep:      []string{"d5", "d2-a", "d2-b", "d0-a", "d0-b"},
+			drop:      []string{"d1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keep, drop := applyRetention(backups, test.retention, now)
+			assert.Equal(t, test.keep, ids(keep))
+			assert.Equal(t, test.drop, ids(drop))
+		})
+	}
+}
+
+func TestRetainBases(t *testing.T) {
+	var (
+		now     = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+		success = string(backup.Success)
+		full    = api.ScheduledBackup{ID: "full", StartedAt: now.Add(-72 * time.Hour), Status: success}
+		inc1    = api.ScheduledBackup{ID: "inc1", StartedAt: now.Add(-48 * time.Hour), Status: success}
+		failed  = api.ScheduledBackup{ID: "failed", StartedAt: now.Add(-36 * time.Hour), Status: string(backup.Failed)}
+		inc2    = api.ScheduledBackup{ID: "inc2", StartedAt: now.Add(-24 * time.Hour), Status: success}
+		running = api.ScheduledBackup{ID: "running", StartedAt: now, Status: string(backup.Transferring)}
+		// inc2 references files stored by inc1 only, which is based on full
+		bases = map[string][]string{"full": nil, "inc1": {"full"}, "inc2": {"inc1"}}
+	)
+	ids := func(xs []api.ScheduledBackup) []string {
+		res := make([]string, len(xs))
+		for i, x := range xs {
+			res[i] = x.ID
+		}
+		return res
+	}
+	lookup := func(id string) ([]string, error) {
+		ids, ok := bases[id]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return ids, nil
+	}
+
+	t.Run("full backup followed by an incremental", func(t *testing.T) {
+		backups := []api.ScheduledBackup{full, inc1}
+		keep, drop := applyRetention(backups, &models.BackupRetention{KeepLast: 1}, now)
+		require.Equal(t, []string{"inc1"}, ids(keep))
+		require.Equal(t, []string{"full"}, ids(drop))
+
+		keep, drop, err := retainBases(keep, drop, lookup)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"inc1", "full"}, ids(keep))
+		assert.Empty(t, drop)
+	})
+
+	t.Run("chain of incrementals", func(t *testing.T) {
+		keep, drop, err := retainBases([]api.ScheduledBackup{inc2},
+			[]api.ScheduledBackup{failed, full, inc1}, lookup)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"inc2", "inc1", "full"}, ids(keep))
+		assert.Equal(t, []string{"failed"}, ids(drop))
+	})
+
+	t.Run("unreferenced backups are dropped", func(t *testing.T) {
+		keep, drop, err := retainBases([]api.ScheduledBackup{inc1},
+			[]api.ScheduledBackup{full, inc2}, lookup)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"inc1", "full"}, ids(keep))
+		assert.Equal(t, []string{"inc2"}, ids(drop))
+	})
+
+	t.Run("unfinished backup", func(t *testing.T) {
+		keep, drop, err := retainBases([]api.ScheduledBackup{running},
+			[]api.ScheduledBackup{failed, full}, lookup)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"running", "full"}, ids(keep))
+		assert.Equal(t, []string{"failed"}, ids(drop))
+	})
+
+	t.Run("unknown bases", func(t *testing.T) {
+		_, _, err := retainBases([]api.ScheduledBackup{{ID: "other", Status: success}},
+			[]api.ScheduledBackup{full}, lookup)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,412 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// staleScheduledBackup is the time after which a scheduled backup whose
+// status cannot be determined anymore is considered as failed
+const staleScheduledBackup = 24 * time.Hour
+
+// scheduleStore persists backup schedules in the cluster
+type scheduleStore interface {
+	PutBackupSchedule(x *models.BackupSchedule) (uint64, error)
+	DeleteBackupSchedule(id string) (uint64, error)
+	UpdateBackupScheduleStatus(req *api.UpdateBackupScheduleStatusRequest) (uint64, error)
+	BackupSchedules() []api.BackupSchedule
+	IsLeader() bool
+}
+
+// nextRun is the next planned run of a schedule
+type nextRun struct {
+	cron string
+	at   time.Time
+}
+
+// ScheduleManager manages backup schedules. Schedules are stored in the
+// cluster store and executed by the current leader, which also applies
+// their retention policies.
+type ScheduleManager struct {
+	logger     logrus.FieldLogger
+	authorizer authorizer
+	scheduler  *Scheduler
+	store      scheduleStore
+
+	// next and bases are only accessed by the runner
+	next map[string]nextRun
+	// bases caches the base backups of finished backups by their ID
+	bases map[string][]string
+	now   func() time.Time
+}
+
+// NewScheduleManager creates a schedule manager starting backups through scheduler
+func NewScheduleManager(authorizer authorizer, scheduler *Scheduler,
+	store scheduleStore, logger logrus.FieldLogger,
+) *ScheduleManager {
+	return &ScheduleManager{
+		logger:     logger,
+		authorizer: authorizer,
+		scheduler:  scheduler,
+		store:      store,
+		next:       make(map[string]nextRun),
+		bases:      make(map[string][]string),
+		now:        time.Now,
+	}
+}
+
+// PutSchedule creates or replaces the backup schedule x
+func (m *ScheduleManager) PutSchedule(ctx context.Context, pr *models.Principal, x *models.BackupSchedule) error {
+	path := fmt.Sprintf("backups/schedules/%s", x.ID)
+	if err := m.authorizer.Authorize(pr, "update", path); err != nil {
+		return err
+	}
+	if err := m.validateSchedule(x); err != nil {
+		return backup.NewErrUnprocessable(err)
+	}
+	x.Status = nil
+	_, err := m.store.PutBackupSchedule(x)
+	return err
+}
+
+// DeleteSchedule deletes the backup schedule with the given id. Backups
+// already created by the schedule are kept.
+func (m *ScheduleManager) DeleteSchedule(ctx context.Context, pr *models.Principal, id string) error {
+	path := fmt.Sprintf("backups/schedules/%s", id)
+	if err := m.authorizer.Authorize(pr, "delete", path); err != nil {
+		return err
+	}
+	if m.schedule(id) == nil {
+		return backup.NewErrNotFound(fmt.Errorf("backup schedule %q does not exist", id))
+	}
+	_, err := m.store.DeleteBackupSchedule(id)
+	return err
+}
+
+// Schedules returns all backup schedules together with their status
+func (m *ScheduleManager) Schedules(ctx context.Context, pr *models.Principal) ([]*models.BackupSchedule, error) {
+	if err := m.authorizer.Authorize(pr, "list", "backups/schedules"); err != nil {
+		return nil, err
+	}
+	xs := m.store.BackupSchedules()
+	res := make([]*models.BackupSchedule, len(xs))
+	for i, x := range xs {
+		s := *x.Schedule
+		s.Status = scheduleStatus(&xs[i])
+		res[i] = &s
+	}
+	return res, nil
+}
+
+// Statuses returns the status of all backup schedules
+func (m *ScheduleManager) Statuses() []*models.BackupScheduleStatus {
+	xs := m.store.BackupSchedules()
+	res := make([]*models.BackupScheduleStatus, len(xs))
+	for i := range xs {
+		res[i] = scheduleStatus(&xs[i])
+	}
+	return res
+}
+
+func scheduleStatus(x *api.BackupSchedule) *models.BackupScheduleStatus {
+	st := x.Status
+	st.ID = x.Schedule.ID
+	st.Backups = make([]string, len(x.Backups))
+	for i, b := range x.Backups {
+		st.Backups[i] = b.ID
+	}
+	return &st
+}
+
+func (m *ScheduleManager) schedule(id string) *api.BackupSchedule {
+	for _, x := range m.store.BackupSchedules() {
+		if x.Schedule.ID == id {
+			return &x
+		}
+	}
+	return nil
+}
+
+func (m *ScheduleManager) validateSchedule(x *models.BackupSchedule) error {
+	if err := validateID(x.ID); err != nil {
+		return err
+	}
+	if x.Backend == "" {
+		return fmt.Errorf("backend must be set")
+	}
+	store, err := m.scheduler.backends.BackupBackend(x.Backend)
+	if err != nil {
+		return fmt.Errorf("no backup backend %q: %w, did you enable the right module?", x.Backend, err)
+	}
+	if !store.IsExternal() && m.scheduler.backupper.nodeResolver.NodeCount() > 1 {
+		return errLocalBackendDBRO
+	}
+	if _, err := parseCron(x.Cron); err != nil {
+		return err
+	}
+	if len(x.Include) > 0 && len(x.Exclude) > 0 {
+		return errIncludeExclude
+	}
+	if dup := findDuplicate(x.Include); dup != "" {
+		return fmt.Errorf("class list 'include' contains duplicate: %s", dup)
+	}
+	if x.Config != nil {
+		if err := Codec(x.Config.Codec).validate(); err != nil {
+			return err
+		}
+	}
+	if r := x.Retention; r != nil && (r.KeepLast < 0 || r.KeepDailyDays < 0) {
+		return fmt.Errorf("retention values must not be negative")
+	}
+	return nil
+}
+
+// Run executes due backup schedules every interval until ctx is done
+func (m *ScheduleManager) Run(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			m.tick(ctx)
+		}
+	}
+}
+
+func (m *ScheduleManager) tick(ctx context.Context) {
+	if !m.store.IsLeader() {
+		// runs are planned again from the stored status once this node
+		// becomes the leader
+		m.next = make(map[string]nextRun)
+		return
+	}
+	now := m.now()
+	seen := make(map[string]struct{})
+	for _, x := range m.store.BackupSchedules() {
+		seen[x.Schedule.ID] = struct{}{}
+		m.run(ctx, x, now)
+	}
+	for id := range m.next {
+		if _, ok := seen[id]; !ok {
+			delete(m.next, id)
+			metrics := monitoring.GetMetrics()
+			metrics.BackupScheduleLastRun.DeleteLabelValues(id)
+			metrics.BackupScheduleLastSuccess.DeleteLabelValues(id)
+		}
+	}
+}
+
+// run refreshes the status of the backups created by schedule x, starts a
+// new backup if the schedule is due and prunes backups which are not
+// retained anymore.
+func (m *ScheduleManager) run(ctx context.Context, x api.BackupSchedule, now time.Time) {
+	var (
+		sched   = x.Schedule
+		st      = x.Status
+		backups = append([]api.ScheduledBackup(nil), x.Backups...)
+		logger  = m.logger.WithField("action", "backup_schedule").WithField("schedule_id", sched.ID)
+		metrics = monitoring.GetMetrics()
+		changed bool
+	)
+
+	for i := range backups {
+		b := &backups[i]
+		if isFinished(b.Status) {
+			continue
+		}
+		var phase, errMsg string
+		if status, err := m.scheduler.backupStatus(ctx, sched.Backend, b.ID); err != nil {
+			if now.Sub(b.StartedAt) < staleScheduledBackup {
+				continue
+			}
+			phase, errMsg = string(backup.Failed), err.Error()
+		} else {
+			phase, errMsg = string(status.Status), status.Err
+		}
+		if phase == b.Status {
+			continue
+		}
+		b.Status, changed = phase, true
+		if b.ID == st.LastBackupID {
+			st.LastStatus, st.LastError = phase, errMsg
+		}
+		if isFinished(phase) {
+			metrics.BackupScheduleRuns.WithLabelValues(sched.ID, phase).Inc()
+			metrics.BackupScheduleLastSuccess.WithLabelValues(sched.ID).Set(boolToFloat(phase == string(backup.Success)))
+		}
+	}
+
+	cron, err := parseCron(sched.Cron)
+	if err != nil {
+		logger.WithError(err).Error("invalid cron expression")
+		return
+	}
+	next, ok := m.next[sched.ID]
+	if !ok || next.cron != sched.Cron {
+		from := now
+		if last := time.Time(st.LastRunAt); !ok && !last.IsZero() {
+			from = last
+		}
+		next = nextRun{cron: sched.Cron, at: cron.next(from)}
+	}
+
+	if !next.at.IsZero() && !now.Before(next.at) {
+		id := fmt.Sprintf("%s-%s", sched.ID, now.UTC().Format("20060102150405"))
+		st.LastRunAt, st.LastBackupID = strfmt.DateTime(now), id
+		metrics.BackupScheduleLastRun.WithLabelValues(sched.ID).Set(float64(now.Unix()))
+		if resp, err := m.scheduler.backup(ctx, m.backupRequest(sched, id, backups)); err != nil {
+			logger.WithField("backup_id", id).WithError(err).Error("start scheduled backup")
+			st.LastStatus, st.LastError = string(backup.Failed), err.Error()
+			metrics.BackupScheduleRuns.WithLabelValues(sched.ID, st.LastStatus).Inc()
+			metrics.BackupScheduleLastSuccess.WithLabelValues(sched.ID).Set(0)
+		} else {
+			st.LastStatus, st.LastError = *resp.Status, ""
+			backups = append(backups, api.ScheduledBackup{ID: id, StartedAt: now, Status: st.LastStatus})
+		}
+		next.at = cron.next(now)
+		changed = true
+	}
+	m.next[sched.ID] = next
+	if nextAt := strfmt.DateTime(next.at); !time.Time(st.NextRunAt).Equal(next.at) {
+		st.NextRunAt, changed = nextAt, true
+	}
+
+	keep, drop := applyRetention(backups, sched.Retention, now)
+	keep, drop, err = retainBases(keep, drop, func(id string) ([]string, error) {
+		return m.baseBackups(ctx, sched.Backend, id)
+	})
+	if err != nil {
+		// nothing is pruned until it is known which backups are still needed
+		logger.WithError(err).Error("determine base backups")
+		keep, drop = backups, nil
+	}
+	for _, b := range drop {
+		if err := m.deleteBackup(ctx, sched.Backend, b.ID); err != nil {
+			// retry on the next run
+			logger.WithField("backup_id", b.ID).WithError(err).Error("delete backup")
+			keep = append(keep, b)
+			continue
+		}
+		delete(m.bases, b.ID)
+		metrics.BackupSchedulePrunedBackups.WithLabelValues(sched.ID).Inc()
+		changed = true
+	}
+	sort.SliceStable(keep, func(i, j int) bool {
+		return keep[i].StartedAt.Before(keep[j].StartedAt)
+	})
+
+	if !changed {
+		return
+	}
+	req := &api.UpdateBackupScheduleStatusRequest{ID: sched.ID, Status: st, Backups: keep}
+	if _, err := m.store.UpdateBackupScheduleStatus(req); err != nil {
+		logger.WithError(err).Error("update backup schedule status")
+	}
+}
+
+// backupRequest returns the request for backup id of schedule sched. If the
+// schedule is incremental, the backup is based on the newest successful one
+// of backups.
+func (m *ScheduleManager) backupRequest(sched *models.BackupSchedule, id string,
+	backups []api.ScheduledBackup,
+) *BackupRequest {
+	var cfg *models.BackupConfig
+	if sched.Config != nil {
+		c := *sched.Config
+		cfg = &c
+	}
+	req := &BackupRequest{
+		ID:          id,
+		Backend:     sched.Backend,
+		Include:     sched.Include,
+		Exclude:     sched.Exclude,
+		Compression: CompressionFromConfig(cfg),
+	}
+	if sched.Incremental {
+		var newest time.Time
+		for _, b := range backups {
+			if b.Status == string(backup.Success) && b.StartedAt.After(newest) {
+				req.IncrementalBaseBackupID, newest = b.ID, b.StartedAt
+			}
+		}
+	}
+	return req
+}
+
+// baseBackups returns the backups storing files referenced by the finished
+// backup id, as reported by the descriptors of all its nodes
+func (m *ScheduleManager) baseBackups(ctx context.Context, backend, id string) ([]string, error) {
+	if ids, ok := m.bases[id]; ok {
+		return ids, nil
+	}
+	store, err := coordBackend(m.scheduler.backends, backend, id)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]struct{})
+	for node := range meta.Nodes {
+		ns, err := nodeBackend(node, m.scheduler.backends, backend, id)
+		if err != nil {
+			return nil, err
+		}
+		desc, err := ns.Meta(ctx, id, false)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %w", node, err)
+		}
+		for _, base := range desc.BaseBackups() {
+			set[base] = struct{}{}
+		}
+	}
+	ids := make([]string, 0, len(set))
+	for base := range set {
+		ids = append(ids, base)
+	}
+	sort.Strings(ids)
+	m.bases[id] = ids
+	return ids, nil
+}
+
+func (m *ScheduleManager) deleteBackup(ctx context.Context, backend, id string) error {
+	b, err := m.scheduler.backends.BackupBackend(backend)
+	if err != nil {
+		return err
+	}
+	return b.DeleteBackup(ctx, id)
+}
+
+func isFinished(status string) bool {
+	return status == string(backup.Success) || status == string(backup.Failed)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
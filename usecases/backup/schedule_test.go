@@ -0,0 +1,301 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestScheduleManagerValidation(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		valid = func() *models.BackupSchedule {
+			return &models.BackupSchedule{ID: "daily", Backend: "s3", Cron: "0 3 * * *"}
+		}
+	)
+	tests := []struct {
+		name   string
+		modify func(x *models.BackupSchedule)
+	}{
+		{"InvalidID", func(x *models.BackupSchedule) { x.ID = "A*:" }},
+		{"MissingBackend", func(x *models.BackupSchedule) { x.Backend = "" }},
+		{"InvalidCron", func(x *models.BackupSchedule) { x.Cron = "0 3 * *" }},
+		{"IncludeExclude", func(x *models.BackupSchedule) {
+			x.Include, x.Exclude = []string{"C1"}, []string{"C2"}
+		}},
+		{"IncludeHasDuplicate", func(x *models.BackupSchedule) { x.Include = []string{"C1", "C1"} }},
+		{"InvalidCodec", func(x *models.BackupSchedule) { x.Config = &models.BackupConfig{Codec: "lz4"} }},
+		{"NegativeRetention", func(x *models.BackupSchedule) {
+			x.Retention = &models.BackupRetention{KeepLast: -1}
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, store, _ := newFakeScheduleManager(nil)
+			x := valid()
+			test.modify(x)
+			err := m.PutSchedule(ctx, nil, x)
+			assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+			assert.Empty(t, store.BackupSchedules())
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		m, store, _ := newFakeScheduleManager(nil)
+		x := valid()
+		x.Status = &models.BackupScheduleStatus{LastStatus: "ignored"}
+		require.Nil(t, m.PutSchedule(ctx, nil, x))
+		schedules, err := m.Schedules(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, schedules, 1)
+		assert.Equal(t, "daily", schedules[0].ID)
+		assert.Equal(t, &models.BackupScheduleStatus{ID: "daily", Backups: []string{}}, schedules[0].Status)
+		assert.Len(t, store.BackupSchedules(), 1)
+	})
+
+	t.Run("DeleteNotFound", func(t *testing.T) {
+		m, _, _ := newFakeScheduleManager(nil)
+		err := m.DeleteSchedule(ctx, nil, "daily")
+		assert.ErrorAs(t, err, &backup.ErrNotFound{})
+	})
+}
+
+func TestScheduleManagerRun(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		any     = mock.Anything
+		now     = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+		success = string(backup.Success)
+	)
+
+	t.Run("NotLeader", func(t *testing.T) {
+		m, store, _ := newFakeScheduleManager(nil)
+		store.follower = true
+		store.put(&models.BackupSchedule{ID: "daily", Backend: "s3", Cron: "* * * * *"})
+		m.tick(ctx)
+		assert.Equal(t, 0, store.updates)
+	})
+
+	t.Run("BackupFails", func(t *testing.T) {
+		m, store, fs := newFakeScheduleManager(nil)
+		fs.selector.On("Backupable", any, []string{"C1"}).Return(errors.New("not ready"))
+		store.put(&models.BackupSchedule{
+			ID: "daily", Backend: "s3", Cron: "0 3 * * *", Include: []string{"C1"},
+		})
+		// last run was yesterday, hence the run of today is due
+		store.schedules["daily"].Status.LastRunAt = strfmt.DateTime(now.Add(-33 * time.Hour))
+
+		m.tick(ctx)
+		st := store.schedules["daily"].Status
+		assert.Equal(t, "daily-20240510120000", st.LastBackupID)
+		assert.Equal(t, string(backup.Failed), st.LastStatus)
+		assert.Contains(t, st.LastError, "not ready")
+		assert.Equal(t, now, time.Time(st.LastRunAt))
+		assert.Equal(t, time.Date(2024, 5, 11, 3, 0, 0, 0, time.UTC), time.Time(st.NextRunAt))
+		assert.Empty(t, store.schedules["daily"].Backups)
+
+		// nothing is due anymore
+		updates := store.updates
+		m.tick(ctx)
+		assert.Equal(t, updates, store.updates)
+	})
+
+	t.Run("Retention", func(t *testing.T) {
+		m, store, fs := newFakeScheduleManager(nil)
+		store.put(&models.BackupSchedule{
+			ID: "daily", Backend: "s3", Cron: "0 3 * * *",
+			Retention: &models.BackupRetention{KeepLast: 2},
+		})
+		store.schedules["daily"].Status.LastRunAt = strfmt.DateTime(now.Add(-9 * time.Hour))
+		store.schedules["daily"].Backups = []api.ScheduledBackup{
+			{ID: "daily-1", StartedAt: now.Add(-81 * time.Hour), Status: success},
+			{ID: "daily-2", StartedAt: now.Add(-57 * time.Hour), Status: success},
+			{ID: "daily-3", StartedAt: now.Add(-33 * time.Hour), Status: success},
+			{ID: "daily-4", StartedAt: now.Add(-9 * time.Hour), Status: success},
+		}
+		for _, id := range []string{"daily-3", "daily-4"} {
+			mockDescriptors(t, fs.backend, id, nil)
+		}
+		fs.backend.On("DeleteBackup", any, "daily-1").Return(nil)
+		fs.backend.On("DeleteBackup", any, "daily-2").Return(errors.New("unavailable")).Once()
+
+		m.tick(ctx)
+		fs.backend.AssertNumberOfCalls(t, "DeleteBackup", 2)
+		statuses := m.Statuses()
+		require.Len(t, statuses, 1)
+		// deleting daily-2 failed, it is deleted on the next run
+		assert.Equal(t, []string{"daily-2", "daily-3", "daily-4"}, statuses[0].Backups)
+
+		fs.backend.On("DeleteBackup", any, "daily-2").Return(nil)
+		m.tick(ctx)
+		assert.Equal(t, []string{"daily-3", "daily-4"}, m.Statuses()[0].Backups)
+	})
+
+	t.Run("RetentionKeepsBaseBackups", func(t *testing.T) {
+		m, store, fs := newFakeScheduleManager(nil)
+		store.put(&models.BackupSchedule{
+			ID: "daily", Backend: "s3", Cron: "0 3 * * *", Incremental: true,
+			Retention: &models.BackupRetention{KeepLast: 1},
+		})
+		store.schedules["daily"].Status.LastRunAt = strfmt.DateTime(now.Add(-9 * time.Hour))
+		store.schedules["daily"].Backups = []api.ScheduledBackup{
+			{ID: "daily-1", StartedAt: now.Add(-33 * time.Hour), Status: success},
+			{ID: "daily-2", StartedAt: now.Add(-9 * time.Hour), Status: success},
+		}
+		// daily-1 is a full backup, daily-2 an incremental one based on it
+		mockDescriptors(t, fs.backend, "daily-1", nil)
+		mockDescriptors(t, fs.backend, "daily-2", []backup.SegmentFile{
+			{Path: "c1/s1/lsm/objects/segment-1.db"},
+			{Path: "c1/s1/lsm/objects/segment-0.db", BackupID: "daily-1"},
+		})
+
+		m.tick(ctx)
+		fs.backend.AssertNotCalled(t, "DeleteBackup", any, "daily-1")
+		assert.Equal(t, []string{"daily-1", "daily-2"}, m.Statuses()[0].Backups)
+
+		// daily-1 is pruned once daily-2 is not retained anymore
+		store.schedules["daily"].Backups = append(store.schedules["daily"].Backups,
+			api.ScheduledBackup{ID: "daily-3", StartedAt: now.Add(-time.Hour), Status: success})
+		mockDescriptors(t, fs.backend, "daily-3", nil)
+		fs.backend.On("DeleteBackup", any, "daily-1").Return(nil)
+		fs.backend.On("DeleteBackup", any, "daily-2").Return(nil)
+		m.tick(ctx)
+		assert.Equal(t, []string{"daily-3"}, m.Statuses()[0].Backups)
+	})
+
+	t.Run("IncrementalSchedule", func(t *testing.T) {
+		m, _, _ := newFakeScheduleManager(nil)
+		sched := &models.BackupSchedule{ID: "daily", Backend: "s3", Incremental: true}
+		backups := []api.ScheduledBackup{
+			{ID: "daily-1", StartedAt: now.Add(-57 * time.Hour), Status: success},
+			{ID: "daily-2", StartedAt: now.Add(-33 * time.Hour), Status: success},
+			{ID: "daily-3", StartedAt: now.Add(-9 * time.Hour), Status: string(backup.Failed)},
+		}
+		assert.Equal(t, "daily-2", m.backupRequest(sched, "daily-4", backups).IncrementalBaseBackupID)
+
+		sched.Incremental = false
+		assert.Empty(t, m.backupRequest(sched, "daily-4", backups).IncrementalBaseBackupID)
+	})
+
+	t.Run("StaleBackup", func(t *testing.T) {
+		m, store, fs := newFakeScheduleManager(nil)
+		store.put(&models.BackupSchedule{ID: "daily", Backend: "s3", Cron: "0 3 * * *"})
+		store.schedules["daily"].Status.LastRunAt = strfmt.DateTime(now.Add(-33 * time.Hour))
+		store.schedules["daily"].Status.LastBackupID = "daily-1"
+		store.schedules["daily"].Backups = []api.ScheduledBackup{
+			{ID: "daily-1", StartedAt: now.Add(-33 * time.Hour), Status: string(backup.Started)},
+		}
+		fs.backend.On("GetObject", any, "daily-1", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", any, "daily-1", BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("DeleteBackup", any, "daily-1").Return(nil)
+		fs.selector.On("Backupable", any, any).Return(errors.New("not ready"))
+		fs.selector.On("ListClasses", any).Return([]string{"C1"})
+
+		m.tick(ctx)
+		fs.backend.AssertCalled(t, "DeleteBackup", any, "daily-1")
+		assert.Empty(t, store.schedules["daily"].Backups)
+	})
+}
+
+// mockDescriptors serves the descriptors of a backup of node1, which
+// references the given segment files
+func mockDescriptors(t *testing.T, backend *fakeBackend, id string, segments []backup.SegmentFile) {
+	global, err := json.Marshal(backup.DistributedBackupDescriptor{
+		ID:     id,
+		Status: backup.Success,
+		Nodes:  map[string]*backup.NodeDescriptor{"node1": {}},
+	})
+	require.Nil(t, err)
+	node, err := json.Marshal(backup.BackupDescriptor{
+		ID:     id,
+		Status: string(backup.Success),
+		Classes: []backup.ClassDescriptor{{
+			Name:   "C1",
+			Shards: []*backup.ShardDescriptor{{Name: "s1", Node: "node1", Segments: segments}},
+		}},
+	})
+	require.Nil(t, err)
+	backend.On("GetObject", mock.Anything, id, GlobalBackupFile).Return(global, nil)
+	backend.On("GetObject", mock.Anything, id+"/node1", BackupFile).Return(node, nil)
+}
+
+type fakeScheduleStore struct {
+	sync.Mutex
+	schedules map[string]*api.BackupSchedule
+	follower  bool
+	updates   int
+}
+
+func (f *fakeScheduleStore) put(x *models.BackupSchedule) {
+	f.Lock()
+	defer f.Unlock()
+	f.schedules[x.ID] = &api.BackupSchedule{Schedule: x}
+}
+
+func (f *fakeScheduleStore) PutBackupSchedule(x *models.BackupSchedule) (uint64, error) {
+	f.put(x)
+	return 0, nil
+}
+
+func (f *fakeScheduleStore) DeleteBackupSchedule(id string) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.schedules, id)
+	return 0, nil
+}
+
+func (f *fakeScheduleStore) UpdateBackupScheduleStatus(req *api.UpdateBackupScheduleStatusRequest) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	x, ok := f.schedules[req.ID]
+	if !ok {
+		return 0, errors.New("not found")
+	}
+	x.Status, x.Backups = req.Status, req.Backups
+	f.updates++
+	return 0, nil
+}
+
+func (f *fakeScheduleStore) BackupSchedules() []api.BackupSchedule {
+	f.Lock()
+	defer f.Unlock()
+	res := make([]api.BackupSchedule, 0, len(f.schedules))
+	for _, x := range f.schedules {
+		res = append(res, *x)
+	}
+	return res
+}
+
+func (f *fakeScheduleStore) IsLeader() bool {
+	return !f.follower
+}
+
+func newFakeScheduleManager(resolver nodeResolver) (*ScheduleManager, *fakeScheduleStore, *fakeScheduler) {
+	fs := newFakeScheduler(resolver)
+	store := &fakeScheduleStore{schedules: make(map[string]*api.BackupSchedule)}
+	m := NewScheduleManager(fs.auth, fs.scheduler(), store, fs.log)
+	m.now = func() time.Time { return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC) }
+	return m, store, fs
+}
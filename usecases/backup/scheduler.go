@@ -79,6 +79,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 	if err := s.authorizer.Authorize(pr, "add", path); err != nil {
 		return nil, err
 	}
+	return s.backup(ctx, req)
+}
+
+// backup starts a backup without authorizing it. It is used by
+// [Scheduler.Backup] and by backup schedules.
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -94,11 +100,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:      OpCreate,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Classes:     classes,
-		Compression: req.Compression,
+		Method:                  OpCreate,
+		ID:                      req.ID,
+		Backend:                 req.Backend,
+		Classes:                 classes,
+		Compression:             req.Compression,
+		IncrementalBaseBackupID: req.IncrementalBaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -143,19 +150,36 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	if err != nil {
 		return nil, backup.NewErrUnprocessable(err)
 	}
+	if schema, err = mapClasses(schema, req.ClassMapping, req.Tenants); err != nil {
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	if req.MergeTenants {
+		if err := s.validateMergeTenants(ctx, schema, meta.Classes(), req.ClassMapping); err != nil {
+			return nil, backup.NewErrUnprocessable(err)
+		}
+	}
+	classes := meta.Classes()
+	for i, cls := range classes {
+		if name, ok := req.ClassMapping[cls]; ok {
+			classes[i] = name
+		}
+	}
 	status := string(backup.Started)
 	data := &models.BackupRestoreResponse{
 		Backend: req.Backend,
 		ID:      req.ID,
 		Path:    store.HomeDir(),
-		Classes: meta.Classes(),
+		Classes: classes,
 	}
 
 	rReq := Request{
-		Method:      OpRestore,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Compression: req.Compression,
+		Method:       OpRestore,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Compression:  req.Compression,
+		ClassMapping: req.ClassMapping,
+		Tenants:      req.Tenants,
+		MergeTenants: req.MergeTenants,
 	}
 	err = s.restorer.Restore(ctx, store, &rReq, meta, schema)
 	if err != nil {
@@ -178,6 +202,10 @@ func (s *Scheduler) BackupStatus(ctx context.Context, principal *models.Principa
 	if err := s.authorizer.Authorize(principal, "get", path); err != nil {
 		return nil, err
 	}
+	return s.backupStatus(ctx, backend, backupID)
+}
+
+func (s *Scheduler) backupStatus(ctx context.Context, backend, backupID string) (*Status, error) {
 	store, err := coordBackend(s.backends, backend, backupID)
 	if err != nil {
 		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
@@ -236,6 +264,9 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if dup := findDuplicate(req.Include); dup != "" {
 		return nil, fmt.Errorf("class list 'include' contains duplicate: %s", dup)
 	}
+	if err := req.Codec.validate(); err != nil {
+		return nil, err
+	}
 	classes := req.Include
 	if len(classes) == 0 {
 		classes = s.backupper.selector.ListClasses(ctx)
@@ -260,9 +291,35 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.IncrementalBaseBackupID != "" {
+		if err := s.validateBaseBackup(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// validateBaseBackup makes sure that the base of an incremental backup has
+// been completed successfully
+func (s *Scheduler) validateBaseBackup(ctx context.Context, req *BackupRequest) error {
+	baseID := req.IncrementalBaseBackupID
+	if baseID == req.ID {
+		return fmt.Errorf("backup %q cannot be based on itself", req.ID)
+	}
+	store, err := coordBackend(s.backends, req.Backend, baseID)
+	if err != nil {
+		return err
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		return fmt.Errorf("base backup %q: %w", baseID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("base backup %q has status %q, expected %q", baseID, meta.Status, backup.Success)
+	}
+	return nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.b.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
@@ -307,6 +364,9 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if meta.RemoveEmpty().Count() == 0 {
 		return nil, fmt.Errorf("nothing left to restore: please choose from : %v", cs)
 	}
+	if err := validateClassMapping(meta.Classes(), req.ClassMapping, req.Tenants); err != nil {
+		return nil, err
+	}
 	if len(req.NodeMapping) > 0 {
 		meta.NodeMapping = req.NodeMapping
 		meta.ApplyNodeMapping()
@@ -314,6 +374,41 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	return meta, nil
 }
 
+// validateMergeTenants makes sure that only multi-tenant classes are
+// restored into existing classes
+func (s *Scheduler) validateMergeTenants(ctx context.Context,
+	schema []backup.ClassDescriptor, classes []string, mapping map[string]string,
+) error {
+	restored := make(map[string]struct{}, len(classes))
+	for _, cls := range classes {
+		if name, ok := mapping[cls]; ok {
+			cls = name
+		}
+		restored[cls] = struct{}{}
+	}
+	existing := make(map[string]struct{})
+	for _, cls := range s.restorer.selector.ListClasses(ctx) {
+		existing[cls] = struct{}{}
+	}
+	for i := range schema {
+		d := &schema[i]
+		if _, ok := restored[d.Name]; !ok {
+			continue
+		}
+		if _, ok := existing[d.Name]; !ok {
+			continue
+		}
+		mt, err := multiTenant(d)
+		if err != nil {
+			return fmt.Errorf("class %s: %w", d.Name, err)
+		}
+		if !mt {
+			return fmt.Errorf("class %s exists already and tenants can only be merged into multi-tenant classes", d.Name)
+		}
+	}
+	return nil
+}
+
 // fetchSchema retrieves and returns the latest schema for all classes
 // In pre-raft scenarios where schema may diverge, some guesswork is necessary
 func (s *Scheduler) fetchSchema(
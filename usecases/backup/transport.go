@@ -48,6 +48,19 @@ type Request struct {
 
 	// Compression is the compression configuration.
 	Compression
+
+	// IncrementalBaseBackupID is the backup an incremental backup is based on
+	IncrementalBaseBackupID string
+
+	// ClassMapping specifies new names of restored classes
+	ClassMapping map[string]string
+
+	// Tenants specifies the tenants to be restored per class, all tenants
+	// are restored if a class is missing
+	Tenants map[string][]string
+
+	// MergeTenants restores tenants into existing classes
+	MergeTenants bool
 }
 
 type CanCommitResponse struct {
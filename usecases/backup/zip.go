@@ -21,6 +21,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -39,40 +40,60 @@ const (
 type zip struct {
 	sourcePath string
 	w          *tar.Writer
-	gzw        *gzip.Writer
+	cw         compressor
+	ew         io.WriteCloser // encrypter, nil if chunks are not encrypted
 	pipeWriter *io.PipeWriter
 	counter    func() int64
 }
 
-func NewZip(sourcePath string, level int) (zip, io.ReadCloser) {
+// NewZip creates an archive of files in sourcePath compressed with codec.
+// The archive is encrypted if key is not nil.
+func NewZip(sourcePath string, level int, codec Codec, key *EncryptionKey) (zip, io.ReadCloser, error) {
 	pr, pw := io.Pipe()
-	gzw, _ := gzip.NewWriterLevel(pw, zipLevel(level))
 	reader := &readCloser{src: pr, n: 0}
-
-	return zip{
+	z := zip{
 		sourcePath: sourcePath,
-		gzw:        gzw,
-		w:          tar.NewWriter(gzw),
 		pipeWriter: pw,
 		counter:    reader.counter(),
-	}, reader
+	}
+
+	var w io.Writer = pw
+	if key != nil {
+		ew, err := key.newEncrypter(pw)
+		if err != nil {
+			return zip{}, nil, err
+		}
+		z.ew, w = ew, ew
+	}
+	cw, err := newCompressor(w, codec, level)
+	if err != nil {
+		return zip{}, nil, err
+	}
+	z.cw = cw
+	z.w = tar.NewWriter(cw)
+	return z, reader, nil
 }
 
 func (z *zip) Close() error {
-	var err1, err2, err3 error
+	var err1, err2, err3, err4 error
 	err1 = z.w.Close()
-	err2 = z.gzw.Close()
+	err2 = z.cw.Close()
+	if z.ew != nil {
+		err3 = z.ew.Close()
+	}
 	if err := z.pipeWriter.Close(); err != nil && err != io.ErrClosedPipe {
-		err3 = err
+		err4 = err
 	}
-	if err1 != nil || err2 != nil || err3 != nil {
-		return fmt.Errorf("tar: %w, gzip: %w, pw: %w", err1, err2, err3)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return fmt.Errorf("tar: %w, compress: %w, encrypt: %w, pw: %w", err1, err2, err3, err4)
 	}
 	return nil
 }
 
-// WriteShard writes shard internal files including in memory files stored in sd
-func (z *zip) WriteShard(ctx context.Context, sd *backup.ShardDescriptor) (written int64, err error) {
+// WriteShard writes shard internal files including in memory files stored in sd.
+// Segment files which did not change compared to base are not written, they
+// are referenced in sd.Segments instead.
+func (z *zip) WriteShard(ctx context.Context, sd *backup.ShardDescriptor, base baseSegments) (written int64, err error) {
 	var n int64 // temporary written bytes
 	for _, x := range [3]struct {
 		relPath string
@@ -94,12 +115,55 @@ func (z *zip) WriteShard(ctx context.Context, sd *backup.ShardDescriptor) (writt
 
 	}
 
+	if err := z.diffSegments(sd, base); err != nil {
+		return written, err
+	}
 	n, err = z.WriteRegulars(ctx, sd.Files)
 	written += n
 
 	return
 }
 
+// diffSegments records all segment files of sd in sd.Segments and removes
+// those which are unchanged compared to base from sd.Files
+func (z *zip) diffSegments(sd *backup.ShardDescriptor, base baseSegments) error {
+	files := make([]string, 0, len(sd.Files))
+	for _, relPath := range sd.Files {
+		if !isSegmentFile(relPath) {
+			files = append(files, relPath)
+			continue
+		}
+		info, err := os.Stat(filepath.Join(z.sourcePath, relPath))
+		if err != nil {
+			return fmt.Errorf("stat: %w", err)
+		}
+		seg := backup.SegmentFile{
+			Path:    relPath,
+			Size:    info.Size(),
+			ModTime: info.ModTime().UnixNano(),
+		}
+		if prev, ok := base[relPath]; ok && seg.Unchanged(&prev) {
+			seg.BackupID, seg.Chunk = prev.BackupID, prev.Chunk
+		} else {
+			files = append(files, relPath)
+		}
+		sd.Segments = append(sd.Segments, seg)
+	}
+	sd.Files = files
+	return nil
+}
+
+// isSegmentFile reports whether relPath is an immutable LSM segment file
+// (including its bloom filters and count net additions)
+func isSegmentFile(relPath string) bool {
+	return strings.HasPrefix(filepath.Base(relPath), "segment-") &&
+		filepath.Ext(relPath) != ".tmp"
+}
+
+// baseSegments are the segment files of a shard stored in earlier backups,
+// indexed by their path
+type baseSegments map[string]backup.SegmentFile
+
 func (z *zip) WriteRegulars(ctx context.Context, relPaths []string) (written int64, err error) {
 	for _, relPath := range relPaths {
 		if filepath.Base(relPath) == ".DS_Store" {
@@ -162,29 +226,83 @@ func (z *zip) lastWritten() int64 {
 
 type unzip struct {
 	destPath   string
-	gzr        *gzip.Reader
+	key        *EncryptionKey
+	dr         io.ReadCloser // decompressor
 	r          *tar.Reader
 	pipeReader *io.PipeReader
+	// include limits the extracted files to the given paths if set
+	include map[string]struct{}
+	// dirs limits the extracted files to the given directories if set
+	dirs []string
+	// encrypted rejects archives which are not encrypted
+	encrypted bool
 }
 
-func NewUnzip(dst string) (unzip, io.WriteCloser) {
+// NewUnzip extracts archives to dst. Their codec and whether they are
+// encrypted is detected, key is required to read encrypted archives.
+func NewUnzip(dst string, key *EncryptionKey) (unzip, io.WriteCloser) {
 	pr, pw := io.Pipe()
 	return unzip{
 		destPath:   dst,
+		key:        key,
 		pipeReader: pr,
 	}, pw
 }
 
+// requireEncryption rejects archives which are not encrypted, as is the
+// case for all chunks of an encrypted backup
+func (u *unzip) requireEncryption() {
+	u.encrypted = true
+}
+
+// only restricts the files extracted by ReadChunk to relPaths
+func (u *unzip) only(relPaths []string) {
+	u.include = make(map[string]struct{}, len(relPaths))
+	for _, p := range relPaths {
+		u.include[p] = struct{}{}
+	}
+}
+
+// onlyDirs restricts the files extracted by ReadChunk to the given directories
+func (u *unzip) onlyDirs(relDirs []string) {
+	u.dirs = make([]string, len(relDirs))
+	for i, d := range relDirs {
+		u.dirs[i] = strings.TrimSuffix(d, "/") + "/"
+	}
+}
+
+// selected reports whether the file with the given name is to be extracted
+func (u *unzip) selected(name string) bool {
+	if u.include != nil {
+		if _, ok := u.include[name]; !ok {
+			return false
+		}
+	}
+	if u.dirs != nil {
+		for _, d := range u.dirs {
+			if strings.HasPrefix(name, d) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
 func (u *unzip) init() error {
-	if u.gzr != nil {
+	if u.dr != nil {
 		return nil
 	}
-	gz, err := gzip.NewReader(u.pipeReader)
+	r, err := decryptIfEncrypted(u.pipeReader, u.key, u.encrypted)
+	if err != nil {
+		return err
+	}
+	dr, err := newDecompressor(r)
 	if err != nil {
-		return fmt.Errorf("gzip.NewReader: %w", err)
+		return err
 	}
-	u.gzr = gz
-	u.r = tar.NewReader(gz)
+	u.dr = dr
+	u.r = tar.NewReader(dr)
 	return nil
 }
 
@@ -193,11 +311,11 @@ func (u *unzip) Close() (err error) {
 	if err := u.pipeReader.Close(); err != nil && err != io.ErrClosedPipe {
 		err1 = err
 	}
-	if u.gzr != nil {
-		err2 = u.gzr.Close()
+	if u.dr != nil {
+		err2 = u.dr.Close()
 	}
 	if err1 != nil || err2 != nil {
-		return fmt.Errorf("close pr: %w, gunzip: %w", err1, err2)
+		return fmt.Errorf("close pr: %w, decompress: %w", err1, err2)
 	}
 
 	return nil
@@ -212,6 +330,11 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 		header, err := u.r.Next()
 		if err != nil {
 			if err == io.EOF { // end of the loop
+				// consume the rest of the chunk, which includes the
+				// last encrypted block
+				if _, err := io.Copy(io.Discard, u.dr); err != nil {
+					return written, fmt.Errorf("read chunk end: %w", err)
+				}
 				return written, nil
 			}
 			return written, fmt.Errorf("fetch next: %w", err)
@@ -228,6 +351,9 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 				return written, fmt.Errorf("crateDir %s: %w", target, err)
 			}
 		case tar.TypeReg:
+			if !u.selected(header.Name) {
+				continue
+			}
 			if pp := filepath.Dir(target); pp != parentPath {
 				parentPath = pp
 				if err := os.MkdirAll(parentPath, 0o755); err != nil {
@@ -304,6 +430,7 @@ func zipLevel(level int) int {
 
 type zipConfig struct {
 	Level      int
+	Codec      Codec
 	GoPoolSize int
 	ChunkSize  int
 }
@@ -322,6 +449,7 @@ func newZipConfig(c Compression) zipConfig {
 
 	return zipConfig{
 		Level:      int(c.Level),
+		Codec:      c.Codec,
 		GoPoolSize: routinePoolSize(c.CPUPercentage),
 		ChunkSize:  c.ChunkSize,
 	}
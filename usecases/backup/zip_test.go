@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -41,10 +42,13 @@ func TestZip(t *testing.T) {
 
 	// compression writer
 	compressBuf := bytes.NewBuffer(make([]byte, 0, 1000_000))
-	z, rc := NewZip(pathNode, 0)
+	z, rc, err := NewZip(pathNode, 0, CodecGzip, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
 	var zInputLen int64
 	go func() {
-		zInputLen, err = z.WriteShard(ctx, &sd)
+		zInputLen, err = z.WriteShard(ctx, &sd, nil)
 		if err != nil {
 			t.Errorf("compress: %v", err)
 		}
@@ -65,7 +69,7 @@ func TestZip(t *testing.T) {
 	fmt.Printf("compression input_size=%d output_size=%d factor=%v\n", zInputLen, zOutputLen, f)
 	os.RemoveAll(pathDest)
 	// decompression
-	uz, wc := NewUnzip(pathDest)
+	uz, wc := NewUnzip(pathDest, nil)
 
 	// decompression reader
 	var uzInputLen int64
@@ -103,6 +107,135 @@ func TestZip(t *testing.T) {
 	}
 }
 
+func TestZipIncremental(t *testing.T) {
+	pathNode := "test_data/node1"
+	full, err := getShard(pathNode, "cT9eTErXgmTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nFiles := len(full.Files)
+	fullBuf := zipShard(t, pathNode, &full, nil, CodecGzip, nil)
+	if len(full.Segments) == 0 {
+		t.Fatal("no segment files recorded")
+	}
+	if len(full.Files) != nFiles {
+		t.Errorf("full backup files got=%d want=%d", len(full.Files), nFiles)
+	}
+	base := baseSegments{}
+	for _, f := range full.Segments {
+		if f.BackupID != "" {
+			t.Errorf("full backup references %s in backup %q", f.Path, f.BackupID)
+		}
+		f.BackupID, f.Chunk = "base", 3
+		base[f.Path] = f
+	}
+
+	// a changed segment is stored again
+	changed := full.Segments[0].Path
+	f := base[changed]
+	f.Size++
+	base[changed] = f
+
+	incr, err := getShard(pathNode, "cT9eTErXgmTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	zipShard(t, pathNode, &incr, base, CodecGzip, nil)
+	if got, want := len(incr.Files), nFiles-len(full.Segments)+1; got != want {
+		t.Errorf("incremental backup files got=%d want=%d", got, want)
+	}
+	for _, f := range incr.Segments {
+		if f.Path == changed {
+			if f.BackupID != "" {
+				t.Errorf("changed segment %s references backup %q", f.Path, f.BackupID)
+			}
+		} else if f.BackupID != "base" || f.Chunk != 3 {
+			t.Errorf("unchanged segment %s references backup %q chunk %d", f.Path, f.BackupID, f.Chunk)
+		}
+	}
+
+	// restoring a referenced segment extracts only that file
+	dest := t.TempDir()
+	if err := unzipChunk(t, fullBuf, dest, nil, []string{changed}); err != nil {
+		t.Fatalf("unzip: %v", err)
+	}
+	var extracted []string
+	err = filepath.Walk(dest, func(path string, fi os.FileInfo, err error) error {
+		if err == nil && fi.Mode().IsRegular() {
+			rel, _ := filepath.Rel(dest, path)
+			extracted = append(extracted, rel)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extracted) != 1 || extracted[0] != changed {
+		t.Errorf("extracted files got=%v want=[%s]", extracted, changed)
+	}
+}
+
+func TestZipCodecs(t *testing.T) {
+	pathNode := "test_data/node1"
+	key, err := NewEncryptionKey(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := NewEncryptionKey(bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, codec := range []Codec{CodecGzip, CodecZstd, CodecNone} {
+		for _, key := range []*EncryptionKey{nil, key} {
+			name := fmt.Sprintf("%s encrypted=%v", codec, key != nil)
+			t.Run(name, func(t *testing.T) {
+				sd, err := getShard(pathNode, "cT9eTErXgmTX")
+				if err != nil {
+					t.Fatal(err)
+				}
+				buf := zipShard(t, pathNode, &sd, nil, codec, key)
+				encrypted := bytes.HasPrefix(buf.Bytes(), encryptionMagic)
+				if encrypted != (key != nil) {
+					t.Errorf("archive encrypted got=%v want=%v", encrypted, key != nil)
+				}
+				raw := buf.Bytes()
+
+				// restore detects codec and encryption
+				dest := t.TempDir()
+				if err := unzipChunk(t, bytes.NewBuffer(raw), dest, key, nil); err != nil {
+					t.Fatalf("unzip: %v", err)
+				}
+				for _, relPath := range sd.Files {
+					want, err := os.ReadFile(filepath.Join(pathNode, relPath))
+					if err != nil {
+						t.Fatal(err)
+					}
+					got, err := os.ReadFile(filepath.Join(dest, relPath))
+					if err != nil {
+						t.Fatalf("restored file: %v", err)
+					}
+					if !bytes.Equal(got, want) {
+						t.Errorf("restored file %s differs", relPath)
+					}
+				}
+				if key == nil {
+					return
+				}
+
+				err = unzipChunk(t, bytes.NewBuffer(raw), t.TempDir(), nil, nil)
+				if !errors.Is(err, errNoEncryptionKey) {
+					t.Errorf("unzip without key got=%v want=%v", err, errNoEncryptionKey)
+				}
+				err = unzipChunk(t, bytes.NewBuffer(raw), t.TempDir(), otherKey, nil)
+				if err == nil || !strings.Contains(err.Error(), "wrong encryption key") {
+					t.Errorf("unzip with wrong key got=%v", err)
+				}
+			})
+		}
+	}
+}
+
 func TestZipLevel(t *testing.T) {
 	tests := []struct {
 		in  int
@@ -158,6 +291,47 @@ func TestZipConfig(t *testing.T) {
 	}
 }
 
+// zipShard writes the shard and returns the archive
+func zipShard(t *testing.T, sourcePath string, sd *backup.ShardDescriptor,
+	base baseSegments, codec Codec, key *EncryptionKey,
+) *bytes.Buffer {
+	buf := bytes.NewBuffer(make([]byte, 0, 1000_000))
+	z, rc, err := NewZip(sourcePath, 0, codec, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if _, err := z.WriteShard(context.Background(), sd, base); err != nil {
+			t.Errorf("compress: %v", err)
+		}
+		z.Close()
+	}()
+	if _, err := io.Copy(buf, rc); err != nil {
+		t.Fatal("copy to buffer", err)
+	}
+	if err := rc.Close(); err != nil {
+		t.Errorf("compress:close %v", err)
+	}
+	return buf
+}
+
+// unzipChunk extracts the archive to dest
+func unzipChunk(t *testing.T, buf *bytes.Buffer, dest string, key *EncryptionKey, only []string) error {
+	uz, wc := NewUnzip(dest, key)
+	if only != nil {
+		uz.only(only)
+	}
+	go func() {
+		io.Copy(wc, buf)
+		wc.Close()
+	}()
+	_, err := uz.ReadChunk()
+	if err := uz.Close(); err != nil {
+		t.Errorf("close reader: %v", err)
+	}
+	return err
+}
+
 func getShard(src, shardName string) (sd backup.ShardDescriptor, err error) {
 	sd.Name = shardName
 	err = filepath.Walk(src, func(fPath string, fi os.FileInfo, err error) error {
@@ -190,3 +364,24 @@ func getShard(src, shardName string) (sd backup.ShardDescriptor, err error) {
 
 	return sd, err
 }
+
+func TestUnzipSelected(t *testing.T) {
+	var uz unzip
+	check := func(name string, want bool) {
+		t.Helper()
+		if got := uz.selected(name); got != want {
+			t.Errorf("selected(%q) got=%v want=%v", name, got, want)
+		}
+	}
+	check("class/t1/file", true)
+
+	uz.onlyDirs([]string{"class/t1", "class/t3/"})
+	check("class/t1/file", true)
+	check("class/t3/lsm/segment.db", true)
+	check("class/t2/file", false)
+	check("class/t10/file", false)
+
+	uz.only([]string{"class/t1/file", "class/t2/file"})
+	check("class/t1/file", true)
+	check("class/t2/file", false)
+}
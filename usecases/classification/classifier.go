@@ -103,7 +103,7 @@ type VectorRepo interface {
 	GetUnclassified(ctx context.Context, class string,
 		properties []string, filter *libfilters.LocalFilter) ([]search.Result, error)
 	AggregateNeighbors(ctx context.Context, vector []float32,
-		class string, properties []string, k int,
+		class string, properties []string, k int, weighted bool,
 		filter *libfilters.LocalFilter) ([]NeighborRef, error)
 	VectorSearch(ctx context.Context, params dto.GetParams) ([]search.Result, error)
 	ZeroShotSearch(ctx context.Context, vector []float32,
@@ -130,6 +130,10 @@ type NeighborRef struct {
 	WinningCount int
 	LosingCount  int
 
+	// Confidence is the share of the (optionally distance-weighted) votes
+	// which went to the winning group, a number between 0 and 1
+	Confidence float32
+
 	Distances NeighborRefDistances
 }
 
@@ -207,7 +211,7 @@ func (c *Classifier) extractFilters(params models.Classification) (Filters, erro
 }
 
 func (c *Classifier) validateFilters(params *models.Classification, filters *classificationFilters) (err error) {
-	if params.Type == TypeKNN {
+	if params.Type == TypeKNN || params.Type == TypeCentroid {
 		if err = c.validateFilter(filters.Source()); err != nil {
 			return fmt.Errorf("invalid sourceWhere: %s", err)
 		}
@@ -267,6 +271,13 @@ func (c *Classifier) parseAndSetDefaults(params *models.Classification) error {
 		return nil
 	}
 
+	if params.Type == TypeCentroid {
+		if err := c.parseCentroidSettings(params); err != nil {
+			return errors.Wrapf(err, "parse centroid specific settings")
+		}
+		return nil
+	}
+
 	if c.modulesProvider != nil {
 		if err := c.modulesProvider.ParseClassifierSettings(params.Type, params); err != nil {
 			return errors.Wrapf(err, "parse %s specific settings", params.Type)
@@ -297,14 +308,57 @@ func (c *Classifier) parseKNNSettings(params *models.Classification) error {
 	}
 	settings.K = v
 
+	weighted, err := extractBoolFromMap(asMap, "weightedVoting")
+	if err != nil {
+		return err
+	}
+	settings.WeightedVoting = weighted
+
+	threshold, err := extractConfidenceThreshold(asMap)
+	if err != nil {
+		return err
+	}
+	settings.ConfidenceThreshold = threshold
+
 	settings.SetDefaults()
 	params.Settings = settings
 
 	return nil
 }
 
+func (c *Classifier) parseCentroidSettings(params *models.Classification) error {
+	raw := params.Settings
+	settings := &ParamsCentroid{}
+	if raw == nil {
+		params.Settings = settings
+		return nil
+	}
+
+	asMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("settings must be an object got %T", raw)
+	}
+
+	threshold, err := extractConfidenceThreshold(asMap)
+	if err != nil {
+		return err
+	}
+	settings.ConfidenceThreshold = threshold
+	params.Settings = settings
+
+	return nil
+}
+
 type ParamsKNN struct {
 	K *int32 `json:"k"`
+
+	// WeightedVoting weighs the vote of each neighbor by the inverse of its
+	// distance, instead of giving every neighbor an equal vote
+	WeightedVoting *bool `json:"weightedVoting"`
+
+	// ConfidenceThreshold is the minimum share of votes the winning group
+	// needs, otherwise the property is left unclassified
+	ConfidenceThreshold *float64 `json:"confidenceThreshold"`
 }
 
 func (params *ParamsKNN) SetDefaults() {
@@ -312,6 +366,17 @@ func (params *ParamsKNN) SetDefaults() {
 		defaultK := int32(3)
 		params.K = &defaultK
 	}
+
+	if params.WeightedVoting == nil {
+		weighted := false
+		params.WeightedVoting = &weighted
+	}
+}
+
+type ParamsCentroid struct {
+	// ConfidenceThreshold is the minimum confidence the nearest centroid needs,
+	// otherwise the property is left unclassified
+	ConfidenceThreshold *float64 `json:"confidenceThreshold"`
 }
 
 func extractNumberFromMap(in map[string]interface{}, field string) (*int32, error) {
@@ -334,3 +399,52 @@ func extractNumberFromMap(in map[string]interface{}, field string) (*int32, erro
 
 	return nil, nil
 }
+
+func extractFloatFromMap(in map[string]interface{}, field string) (*float64, error) {
+	unparsed, present := in[field]
+	if present {
+		parsed, ok := unparsed.(json.Number)
+		if !ok {
+			return nil, errors.Errorf("settings.%s must be number, got %T",
+				field, unparsed)
+		}
+
+		asFloat64, err := parsed.Float64()
+		if err != nil {
+			return nil, errors.Wrapf(err, "settings.%s", field)
+		}
+
+		return &asFloat64, nil
+	}
+
+	return nil, nil
+}
+
+func extractBoolFromMap(in map[string]interface{}, field string) (*bool, error) {
+	unparsed, present := in[field]
+	if present {
+		parsed, ok := unparsed.(bool)
+		if !ok {
+			return nil, errors.Errorf("settings.%s must be boolean, got %T",
+				field, unparsed)
+		}
+
+		return &parsed, nil
+	}
+
+	return nil, nil
+}
+
+func extractConfidenceThreshold(in map[string]interface{}) (*float64, error) {
+	threshold, err := extractFloatFromMap(in, "confidenceThreshold")
+	if err != nil {
+		return nil, err
+	}
+
+	if threshold != nil && (*threshold < 0 || *threshold > 1) {
+		return nil, errors.Errorf("settings.confidenceThreshold must be between 0 and 1, got %v",
+			*threshold)
+	}
+
+	return threshold, nil
+}
@@ -28,7 +28,8 @@ import (
 
 // the contents of this file deal with anything about a classification run
 // which is generic, whereas the individual classify_item fns can be found in
-// the respective files such as classifier_run_knn.go
+// the respective files such as classifier_run_knn.go or
+// classifier_run_centroid.go
 
 func (c *Classifier) run(params models.Classification,
 	filters Filters,
@@ -99,6 +100,10 @@ func (c *Classifier) prepareRun(params models.Classification, filters Filters,
 		return c.classifyItemUsingZeroShot, nil
 	}
 
+	if params.Type == TypeCentroid {
+		return c.prepareCentroids(params, filters)
+	}
+
 	if c.modulesProvider != nil {
 		classifyItemFn, err := c.modulesProvider.GetClassificationFn(params.Class, params.Type,
 			c.getClassifyParams(params, filters, unclassifiedItems))
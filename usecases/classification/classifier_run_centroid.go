@@ -0,0 +1,356 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package classification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	libfilters "github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// centroid is the mean vector of all training objects which reference the
+// same beacon
+type centroid struct {
+	beacon strfmt.URI
+	vector []float32
+	count  int
+}
+
+// centroidClassifier classifies items by the nearest label centroid. The
+// centroids are calculated once per run from the already labelled objects
+// and shared by all workers, they are never modified after preparation.
+type centroidClassifier struct {
+	distancer distancer
+	// centroids per classify property
+	centroids map[string][]centroid
+	// overall number of training objects per classify property
+	counts map[string]int
+}
+
+// centroidTrainingPageSize is the number of training objects loaded per page
+var centroidTrainingPageSize = 10000
+
+func (c *Classifier) prepareCentroids(params models.Classification,
+	filters Filters,
+) (ClassifyItemFn, error) {
+	res, err := c.centroidTrainingData(params, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "centroid: search training data")
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("centroid: no labelled training data found for class '%s'",
+			params.Class)
+	}
+
+	cc, err := newCentroidClassifier(res, params.ClassifyProperties, c.distancer)
+	if err != nil {
+		return nil, errors.Wrap(err, "centroid")
+	}
+
+	return c.classifyItemUsingCentroids(cc), nil
+}
+
+// centroidTrainingData loads all labelled training objects. The objects are
+// paged through in the order of their ids, every page continues after the
+// last id of the previous page.
+func (c *Classifier) centroidTrainingData(params models.Classification,
+	filters Filters,
+) (search.Results, error) {
+	var (
+		res   search.Results
+		after strfmt.UUID
+	)
+	for {
+		page, err := c.centroidTrainingPage(params, filters, after)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, page...)
+		if len(page) < centroidTrainingPageSize {
+			return res, nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+func (c *Classifier) centroidTrainingPage(params models.Classification,
+	filters Filters, after strfmt.UUID,
+) (search.Results, error) {
+	ctx, cancel := contextWithTimeout(30 * time.Second)
+	defer cancel()
+
+	filter := labelledFilter(filters.TrainingSet(), params.Class,
+		params.ClassifyProperties)
+	if after != "" {
+		filter = &libfilters.LocalFilter{
+			Root: &libfilters.Clause{
+				Operator: libfilters.OperatorAnd,
+				Operands: []libfilters.Clause{*filter.Root, afterIDClause(params.Class, after)},
+			},
+		}
+	}
+
+	return c.vectorRepo.VectorSearch(ctx, dto.GetParams{
+		ClassName: params.Class,
+		Filters:   filter,
+		Sort: []libfilters.Sort{{
+			Path:  []string{libfilters.InternalPropID},
+			Order: "asc",
+		}},
+		Pagination: &libfilters.Pagination{
+			Limit: centroidTrainingPageSize,
+		},
+		AdditionalProperties: additional.Properties{
+			Vector: true,
+		},
+	})
+}
+
+func afterIDClause(className string, after strfmt.UUID) libfilters.Clause {
+	return libfilters.Clause{
+		Operator: libfilters.OperatorGreaterThan,
+		Value: &libfilters.Value{
+			Type:  schema.DataTypeText,
+			Value: after.String(),
+		},
+		On: &libfilters.Path{
+			Class:    schema.ClassName(className),
+			Property: libfilters.InternalPropID,
+		},
+	}
+}
+
+func newCentroidClassifier(training search.Results, properties []string,
+	distancer distancer,
+) (*centroidClassifier, error) {
+	cc := &centroidClassifier{
+		distancer: distancer,
+		centroids: map[string][]centroid{},
+		counts:    map[string]int{},
+	}
+
+	for _, prop := range properties {
+		// keep the order in which the beacons were encountered, so that the
+		// results are deterministic
+		positions := map[strfmt.URI]int{}
+		var centroids []centroid
+
+		for i, elem := range training {
+			beacon, err := singleLabel(elem, i, prop)
+			if err != nil {
+				return nil, err
+			}
+
+			pos, ok := positions[beacon]
+			if !ok {
+				pos = len(centroids)
+				positions[beacon] = pos
+				centroids = append(centroids, centroid{
+					beacon: beacon,
+					vector: make([]float32, len(elem.Vector)),
+				})
+			}
+
+			if len(elem.Vector) != len(centroids[pos].vector) {
+				return nil, fmt.Errorf("training object %s has vector of length %d, expected %d",
+					elem.ID, len(elem.Vector), len(centroids[pos].vector))
+			}
+
+			for j, v := range elem.Vector {
+				centroids[pos].vector[j] += v
+			}
+			centroids[pos].count++
+		}
+
+		for i := range centroids {
+			for j := range centroids[i].vector {
+				centroids[i].vector[j] /= float32(centroids[i].count)
+			}
+		}
+
+		cc.centroids[prop] = centroids
+		cc.counts[prop] = len(training)
+	}
+
+	return cc, nil
+}
+
+func singleLabel(elem search.Result, i int, prop string) (strfmt.URI, error) {
+	schemaMap, ok := elem.Schema.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("expected element[%d].Schema to be map, got: %T", i, elem.Schema)
+	}
+
+	refs, ok := schemaMap[prop].(models.MultipleRef)
+	if !ok {
+		return "", fmt.Errorf("expected element[%d].Schema.%s to be models.MultipleRef, got: %T",
+			i, prop, schemaMap[prop])
+	}
+
+	if len(refs) != 1 {
+		return "", fmt.Errorf("a centroid training data object needs to have exactly one label: "+
+			"expected element[%d].Schema.%s to have exactly one reference, got: %d",
+			i, prop, len(refs))
+	}
+
+	return refs[0].Beacon, nil
+}
+
+// nearest finds the centroid closest to the vector. The distances to all
+// other centroids make up the losing group, the confidence is the winning
+// share of the distance-weighted votes of all centroids.
+func (cc *centroidClassifier) nearest(vector []float32, prop string) (*NeighborRef, error) {
+	centroids := cc.centroids[prop]
+	if len(centroids) == 0 {
+		return nil, fmt.Errorf("no centroids for property %q", prop)
+	}
+
+	winner := -1
+	var winningDistance float32
+	distances := make([]float32, len(centroids))
+	for i, centroid := range centroids {
+		dist, err := cc.distancer(vector, centroid.vector)
+		if err != nil {
+			return nil, errors.Wrap(err, "calculate distance to centroid")
+		}
+
+		distances[i] = dist
+		if winner == -1 || dist < winningDistance {
+			winner = i
+			winningDistance = dist
+		}
+	}
+
+	ref := &NeighborRef{
+		Property:     prop,
+		Beacon:       centroids[winner].beacon,
+		OverallCount: cc.counts[prop],
+		WinningCount: centroids[winner].count,
+		LosingCount:  cc.counts[prop] - centroids[winner].count,
+		Distances: NeighborRefDistances{
+			ClosestOverallDistance: winningDistance,
+			ClosestWinningDistance: winningDistance,
+			MeanWinningDistance:    winningDistance,
+		},
+	}
+
+	var totalVotes float32
+	var losingSum float32
+	var closestLosing *float32
+	for i, dist := range distances {
+		totalVotes += DistanceWeight(dist)
+		if i == winner {
+			continue
+		}
+
+		losingSum += dist
+		if closestLosing == nil || dist < *closestLosing {
+			closestLosing = &distances[i]
+		}
+	}
+	ref.Confidence = DistanceWeight(winningDistance) / totalVotes
+
+	if closestLosing != nil {
+		meanLosing := losingSum / float32(len(distances)-1)
+		ref.Distances.MeanLosingDistance = &meanLosing
+		ref.Distances.ClosestLosingDistance = closestLosing
+	}
+
+	return ref, nil
+}
+
+func (c *Classifier) classifyItemUsingCentroids(cc *centroidClassifier) ClassifyItemFn {
+	return func(item search.Result, itemIndex int,
+		params models.Classification, filters Filters, writer Writer,
+	) error {
+		// this type assertion is safe to make, since we have passed the parsing stage
+		settings := params.Settings.(*ParamsCentroid)
+
+		var classified []string
+		for _, prop := range params.ClassifyProperties {
+			ref, err := cc.nearest(item.Vector, prop)
+			if err != nil {
+				return fmt.Errorf("classify %s/%s: %v", item.ClassName, item.ID, err)
+			}
+
+			if belowConfidenceThreshold(settings.ConfidenceThreshold, ref.Confidence) {
+				continue
+			}
+
+			item.Schema.(map[string]interface{})[prop] = models.MultipleRef{
+				&models.SingleRef{
+					Beacon:         ref.Beacon,
+					Classification: ref.Meta(),
+				},
+			}
+			classified = append(classified, prop)
+		}
+
+		c.extendItemWithObjectMeta(&item, params, classified)
+		err := writer.Store(item)
+		if err != nil {
+			return fmt.Errorf("store %s/%s: %v", item.ClassName, item.ID, err)
+		}
+
+		return nil
+	}
+}
+
+// labelledFilter restricts the user-specified training set filter to objects
+// which already have a reference set for all classify properties
+func labelledFilter(userFilter *libfilters.LocalFilter, className string,
+	properties []string,
+) *libfilters.LocalFilter {
+	countFilters := make([]libfilters.Clause, len(properties))
+	for i, prop := range properties {
+		countFilters[i] = libfilters.Clause{
+			Operator: libfilters.OperatorGreaterThan,
+			Value: &libfilters.Value{
+				Type:  schema.DataTypeInt,
+				Value: 0,
+			},
+			On: &libfilters.Path{
+				Class:    schema.ClassName(className),
+				Property: schema.PropertyName(prop),
+			},
+		}
+	}
+
+	countRootClause := libfilters.Clause{
+		Operator: libfilters.OperatorAnd,
+		Operands: countFilters,
+	}
+	if len(countFilters) == 1 {
+		countRootClause = countFilters[0]
+	}
+
+	if userFilter == nil {
+		return &libfilters.LocalFilter{Root: &countRootClause}
+	}
+
+	return &libfilters.LocalFilter{
+		Root: &libfilters.Clause{
+			Operator: libfilters.OperatorAnd,
+			Operands: []libfilters.Clause{*userFilter.Root, countRootClause},
+		},
+	}
+}
@@ -31,7 +31,8 @@ func (c *Classifier) classifyItemUsingKNN(item search.Result, itemIndex int,
 	// K is guaranteed to be set by now, no danger in dereferencing the pointer
 	res, err := c.vectorRepo.AggregateNeighbors(ctx, item.Vector,
 		item.ClassName,
-		params.ClassifyProperties, int(*settings.K), filters.TrainingSet())
+		params.ClassifyProperties, int(*settings.K), *settings.WeightedVoting,
+		filters.TrainingSet())
 	if err != nil {
 		return fmt.Errorf("classify %s/%s: %v", item.ClassName, item.ID, err)
 	}
@@ -39,6 +40,12 @@ func (c *Classifier) classifyItemUsingKNN(item search.Result, itemIndex int,
 	var classified []string
 
 	for _, agg := range res {
+		if belowConfidenceThreshold(settings.ConfidenceThreshold, agg.Confidence) {
+			// leave the property unclassified, so it can be picked up again
+			// by a later run once there is more training data
+			continue
+		}
+
 		meta := agg.Meta()
 		item.Schema.(map[string]interface{})[agg.Property] = models.MultipleRef{
 			&models.SingleRef{
@@ -60,3 +67,7 @@ func (c *Classifier) classifyItemUsingKNN(item search.Result, itemIndex int,
 
 	return nil
 }
+
+func belowConfidenceThreshold(threshold *float64, confidence float32) bool {
+	return threshold != nil && float64(confidence) < *threshold
+}
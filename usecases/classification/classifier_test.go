@@ -206,6 +206,127 @@ func Test_Classifier_KNN(t *testing.T) {
 	})
 }
 
+func Test_Classifier_Centroid(t *testing.T) {
+	t.Run("with valid data", func(t *testing.T) {
+		sg := &fakeSchemaGetter{testSchema()}
+		repo := newFakeClassificationRepo()
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+		classifier := New(sg, repo, vectorRepo, authorizer, newNullLogger(), nil)
+
+		params := models.Classification{
+			Class:              "Article",
+			Type:               TypeCentroid,
+			BasedOnProperties:  []string{"description"},
+			ClassifyProperties: []string{"exactCategory", "mainCategory"},
+		}
+
+		class, err := classifier.Schedule(context.Background(), nil, params)
+		require.Nil(t, err, "should not error")
+		require.NotNil(t, class)
+
+		waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+		t.Run("status is now completed", func(t *testing.T) {
+			class, err := classifier.Get(context.Background(), nil, class.ID)
+			require.Nil(t, err)
+			require.NotNil(t, class)
+			assert.Equal(t, models.ClassificationStatusCompleted, class.Status)
+			assert.Equal(t, int64(6), class.Meta.CountSucceeded)
+		})
+
+		t.Run("the classifier updated the actions with the classified references", func(t *testing.T) {
+			checkRef(t, vectorRepo, "06a1e824-889c-4649-97f9-1ed3fa401d8e", "exactCategory", idCategoryFoodAndDrink)
+			checkRef(t, vectorRepo, "6402e649-b1e0-40ea-b192-a64eab0d5e56", "mainCategory", idMainCategoryFoodAndDrink)
+			checkRef(t, vectorRepo, "75ba35af-6a08-40ae-b442-3bec69b355f9", "exactCategory", idCategoryPolitics)
+			checkRef(t, vectorRepo, "f850439a-d3cd-4f17-8fbf-5a64405645cd", "mainCategory", idMainCategoryPoliticsAndSociety)
+			checkRef(t, vectorRepo, "a2bbcbdc-76e1-477d-9e72-a6d2cfb50109", "exactCategory", idCategorySociety)
+			checkRef(t, vectorRepo, "069410c3-4b9e-4f68-8034-32a066cb7997", "mainCategory", idMainCategoryPoliticsAndSociety)
+		})
+	})
+
+	t.Run("with training data spanning multiple pages", func(t *testing.T) {
+		pageSize := centroidTrainingPageSize
+		centroidTrainingPageSize = 1
+		defer func() { centroidTrainingPageSize = pageSize }()
+
+		sg := &fakeSchemaGetter{testSchema()}
+		repo := newFakeClassificationRepo()
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+		classifier := New(sg, repo, vectorRepo, authorizer, newNullLogger(), nil)
+
+		params := models.Classification{
+			Class:              "Article",
+			Type:               TypeCentroid,
+			BasedOnProperties:  []string{"description"},
+			ClassifyProperties: []string{"exactCategory", "mainCategory"},
+		}
+
+		class, err := classifier.Schedule(context.Background(), nil, params)
+		require.Nil(t, err, "should not error")
+		require.NotNil(t, class)
+
+		waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+		// every label is only contained in one of the pages
+		checkRef(t, vectorRepo, "06a1e824-889c-4649-97f9-1ed3fa401d8e", "exactCategory", idCategoryFoodAndDrink)
+		checkRef(t, vectorRepo, "75ba35af-6a08-40ae-b442-3bec69b355f9", "exactCategory", idCategoryPolitics)
+		checkRef(t, vectorRepo, "a2bbcbdc-76e1-477d-9e72-a6d2cfb50109", "exactCategory", idCategorySociety)
+	})
+
+	t.Run("with a confidence threshold", func(t *testing.T) {
+		sg := &fakeSchemaGetter{testSchema()}
+		repo := newFakeClassificationRepo()
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := newFakeVectorRepoKNN(testDataToBeClassified(), testDataAlreadyClassified())
+		classifier := New(sg, repo, vectorRepo, authorizer, newNullLogger(), nil)
+
+		params := models.Classification{
+			Class:              "Article",
+			Type:               TypeCentroid,
+			BasedOnProperties:  []string{"description"},
+			ClassifyProperties: []string{"exactCategory", "mainCategory"},
+			Settings: map[string]interface{}{
+				"confidenceThreshold": json.Number("0.9"),
+			},
+		}
+
+		class, err := classifier.Schedule(context.Background(), nil, params)
+		require.Nil(t, err, "should not error")
+		require.NotNil(t, class)
+
+		waitForStatusToNoLongerBeRunning(t, classifier, class.ID)
+
+		// the exact categories match the training data exactly, whereas the
+		// politics and society main category centroid lies in between the
+		// two, so the confidence is too low for those
+		idArticlePolitics := "75ba35af-6a08-40ae-b442-3bec69b355f9"
+		checkRef(t, vectorRepo, idArticlePolitics, "exactCategory", idCategoryPolitics)
+
+		object, ok := vectorRepo.get(strfmt.UUID(idArticlePolitics))
+		require.True(t, ok)
+		assert.NotContains(t, object.Properties, "mainCategory")
+	})
+
+	t.Run("with an invalid confidence threshold", func(t *testing.T) {
+		sg := &fakeSchemaGetter{testSchema()}
+		params := models.Classification{
+			Class:              "Article",
+			Type:               TypeCentroid,
+			BasedOnProperties:  []string{"description"},
+			ClassifyProperties: []string{"exactCategory", "mainCategory"},
+			Settings: map[string]interface{}{
+				"confidenceThreshold": json.Number("1.5"),
+			},
+		}
+
+		_, err := New(sg, newFakeClassificationRepo(), nil, &fakeAuthorizer{}, newNullLogger(), nil).
+			Schedule(context.Background(), nil, params)
+		assert.ErrorContains(t, err, "confidenceThreshold must be between 0 and 1")
+	})
+}
+
 func Test_Classifier_Custom_Classifier(t *testing.T) {
 	var id strfmt.UUID
 	// so we can reuse it for follow up requests, such as checking the status
@@ -138,7 +138,7 @@ func (f *fakeVectorRepoKNN) GetUnclassified(ctx context.Context,
 }
 
 func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []float32,
-	class string, properties []string, k int,
+	class string, properties []string, k int, weighted bool,
 	filter *libfilters.LocalFilter,
 ) ([]NeighborRef, error) {
 	f.Lock()
@@ -183,6 +183,7 @@ func (f *fakeVectorRepoKNN) AggregateNeighbors(ctx context.Context, vector []flo
 			WinningCount: 1,
 			OverallCount: 1,
 			LosingCount:  1,
+			Confidence:   1,
 			Property:     propName,
 		})
 	}
@@ -202,9 +203,37 @@ func (f *fakeVectorRepoKNN) VectorSearch(ctx context.Context,
 ) ([]search.Result, error) {
 	f.Lock()
 	defer f.Unlock()
+	if params.SearchVector == nil {
+		// the centroid classifier pages through the already classified items
+		// in the order of their ids
+		after := afterIDFromFilter(params.Filters.Root)
+		results := make(search.Results, 0, len(f.classified))
+		for _, res := range f.classified {
+			if res.ID > after {
+				results = append(results, res)
+			}
+		}
+		sort.Slice(results, func(i, j int) bool { return results[i].ID < results[j].ID })
+		if params.Pagination != nil && len(results) > params.Pagination.Limit {
+			results = results[:params.Pagination.Limit]
+		}
+		return results, nil
+	}
 	return nil, fmt.Errorf("vector class search not implemented in fake")
 }
 
+func afterIDFromFilter(clause *libfilters.Clause) strfmt.UUID {
+	if clause.On != nil && clause.On.Property == libfilters.InternalPropID {
+		return strfmt.UUID(clause.Value.Value.(string))
+	}
+	for i := range clause.Operands {
+		if after := afterIDFromFilter(&clause.Operands[i]); after != "" {
+			return after
+		}
+	}
+	return ""
+}
+
 func (f *fakeVectorRepoKNN) BatchPutObjects(ctx context.Context, objects objects.BatchObjects, repl *additional.ReplicationProperties) (objects.BatchObjects, error) {
 	f.Lock()
 	defer f.Unlock()
@@ -265,7 +294,7 @@ func (f *fakeVectorRepoContextual) GetUnclassified(ctx context.Context,
 }
 
 func (f *fakeVectorRepoContextual) AggregateNeighbors(ctx context.Context, vector []float32,
-	class string, properties []string, k int,
+	class string, properties []string, k int, weighted bool,
 	filter *libfilters.LocalFilter,
 ) ([]NeighborRef, error) {
 	panic("not implemented")
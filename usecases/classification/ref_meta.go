@@ -36,6 +36,7 @@ func (r NeighborRef) Meta() *models.ReferenceMetaClassification {
 		WinningDistance:        float64(r.Distances.MeanWinningDistance), // deprecated, remove in 0.23.0
 		MeanWinningDistance:    float64(r.Distances.MeanWinningDistance),
 		ClosestWinningDistance: float64(r.Distances.ClosestWinningDistance),
+		Confidence:             float64(r.Confidence),
 	}
 
 	if r.Distances.MeanLosingDistance != nil {
@@ -50,6 +51,18 @@ func (r NeighborRef) Meta() *models.ReferenceMetaClassification {
 	return out
 }
 
+// DistanceWeight is the weight of a vote cast from the given distance when
+// using distance-weighted voting. Closer neighbors get a higher weight, a
+// small epsilon prevents a division by zero for exact matches. Distances can
+// be negative for the dot product, those are clamped to zero, so that they
+// get the highest weight instead of a negative one.
+func DistanceWeight(distance float32) float32 {
+	if distance < 0 {
+		distance = 0
+	}
+	return 1 / (distance + 1e-6)
+}
+
 func ptFloat64(in float64) *float64 {
 	return &in
 }
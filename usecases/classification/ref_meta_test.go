@@ -56,6 +56,7 @@ func Test_RefMeta(t *testing.T) {
 			WinningCount: 3,
 			OverallCount: 5,
 			LosingCount:  2,
+			Confidence:   0.6,
 			Distances: NeighborRefDistances{
 				ClosestWinningDistance: 0.1,
 				ClosestOverallDistance: 0.1,
@@ -76,6 +77,7 @@ func Test_RefMeta(t *testing.T) {
 			OverallCount:           5,
 			WinningCount:           3,
 			LosingCount:            2,
+			Confidence:             0.6,
 		}
 
 		actual := source.Meta()
@@ -86,6 +88,7 @@ func Test_RefMeta(t *testing.T) {
 		assert.InDelta(t, *expected.ClosestLosingDistance, *actual.ClosestLosingDistance, 0.001)
 		assert.InDelta(t, *expected.MeanLosingDistance, *actual.MeanLosingDistance, 0.001)
 		assert.InDelta(t, *expected.LosingDistance, *actual.LosingDistance, 0.001)
+		assert.InDelta(t, expected.Confidence, actual.Confidence, 0.001)
 		assert.Equal(t, expected.OverallCount, actual.OverallCount)
 		assert.Equal(t, expected.OverallCount, actual.OverallCount)
 		assert.Equal(t, expected.WinningCount, actual.WinningCount)
@@ -93,6 +96,12 @@ func Test_RefMeta(t *testing.T) {
 	})
 }
 
+func Test_DistanceWeight(t *testing.T) {
+	assert.Greater(t, DistanceWeight(0.1), DistanceWeight(0.2))
+	assert.Equal(t, DistanceWeight(0), DistanceWeight(-0.5))
+	assert.Greater(t, DistanceWeight(-3), float32(0))
+}
+
 func ptFloat32(in float32) *float32 {
 	return &in
 }
@@ -23,6 +23,7 @@ const (
 	TypeKNN        = "knn"
 	TypeContextual = "text2vec-contextionary-contextual"
 	TypeZeroShot   = "zeroshot"
+	TypeCentroid   = "centroid"
 )
 
 type Validator struct {
@@ -64,6 +65,7 @@ func (v *Validator) validate() {
 
 	v.contextualTypeFeasibility()
 	v.knnTypeFeasibility()
+	v.centroidTypeFeasibility()
 	v.basedOnProperties(class)
 	v.classifyProperties(class)
 }
@@ -88,6 +90,16 @@ func (v *Validator) knnTypeFeasibility() {
 	}
 }
 
+func (v *Validator) centroidTypeFeasibility() {
+	if v.subject.Type != TypeCentroid {
+		return
+	}
+
+	if v.subject.Filters != nil && v.subject.Filters.TargetWhere != nil {
+		v.errors.Addf("type is 'centroid', but 'targetWhere' filter is set, for 'centroid' you cannot limit target data directly, instead limit training data through setting 'trainingSetWhere'")
+	}
+}
+
 func (v *Validator) basedOnProperties(class *models.Class) {
 	if v.subject.BasedOnProperties == nil || len(v.subject.BasedOnProperties) == 0 {
 		v.errors.Addf("basedOnProperties must have at least one property")
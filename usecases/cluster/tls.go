@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TLSConfig configures TLS for the communication between nodes
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	CertFile string `json:"certFile" yaml:"certFile"`
+	KeyFile  string `json:"keyFile" yaml:"keyFile"`
+	// CAFile contains the certificates used to verify peers. The system
+	// certificates are used if it is empty.
+	CAFile string `json:"caFile" yaml:"caFile"`
+	// VerifyClients enables mutual TLS: nodes only accept requests of
+	// clients presenting a certificate signed by the CA
+	VerifyClients bool `json:"verifyClients" yaml:"verifyClients"`
+	// ServerName is the name expected in the certificates of other nodes.
+	// By default their host name or IP address is expected.
+	ServerName         string `json:"serverName" yaml:"serverName"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify" yaml:"insecureSkipVerify"`
+}
+
+func (c TLSConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.CertFile == "" || c.KeyFile == "" {
+		return errors.New("cluster tls requires a certificate and a key file")
+	}
+	if c.VerifyClients && c.CAFile == "" {
+		return errors.New("verifying cluster tls clients requires a CA file")
+	}
+	return nil
+}
+
+// TLS provides the TLS configurations of servers and clients for the
+// communication between nodes. The certificates are reloaded when their
+// files change, hence they can be rotated without restarting the node.
+type TLS struct {
+	config TLSConfig
+
+	sync.RWMutex
+	cert    *tls.Certificate
+	roots   *x509.CertPool
+	version string // identifies the loaded files
+}
+
+// NewTLS loads the certificates configured by cfg
+func NewTLS(cfg TLSConfig) (*TLS, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	t := &TLS{config: cfg}
+	if _, err := t.Reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Reload loads the certificates again if any of their files changed and
+// reports whether they were reloaded. The current certificates are kept on
+// error.
+func (t *TLS) Reload() (bool, error) {
+	version, err := t.filesVersion()
+	if err != nil {
+		return false, err
+	}
+	t.RLock()
+	unchanged := version == t.version
+	t.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(t.config.CertFile, t.config.KeyFile)
+	if err != nil {
+		return false, fmt.Errorf("load cluster tls key pair: %w", err)
+	}
+	var roots *x509.CertPool
+	if t.config.CAFile == "" {
+		if roots, err = x509.SystemCertPool(); err != nil {
+			return false, fmt.Errorf("load system certificates: %w", err)
+		}
+	} else {
+		pem, err := os.ReadFile(t.config.CAFile)
+		if err != nil {
+			return false, fmt.Errorf("read cluster tls ca file: %w", err)
+		}
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return false, fmt.Errorf("cluster tls ca file %q contains no certificate", t.config.CAFile)
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	t.cert, t.roots, t.version = &cert, roots, version
+	return true, nil
+}
+
+// filesVersion identifies the current content of the certificate files by
+// their size and modification time
+func (t *TLS) filesVersion() (string, error) {
+	var b strings.Builder
+	for _, path := range []string{t.config.CertFile, t.config.KeyFile, t.config.CAFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", fmt.Errorf("cluster tls: %w", err)
+		}
+		fmt.Fprintf(&b, "%s:%d:%d;", path, info.Size(), info.ModTime().UnixNano())
+	}
+	return b.String(), nil
+}
+
+// Watch reloads changed certificates every interval until ctx is done
+func (t *TLS) Watch(ctx context.Context, interval time.Duration, logger logrus.FieldLogger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := t.Reload()
+			if err != nil {
+				logger.WithField("action", "cluster_tls_reload").WithError(err).
+					Error("could not reload certificates, keep using the current ones")
+			} else if reloaded {
+				logger.WithField("action", "cluster_tls_reload").Info("reloaded certificates")
+			}
+		}
+	}
+}
+
+// ServerConfig returns the TLS configuration of servers accepting requests
+// from other nodes
+func (t *TLS) ServerConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return t.certificate(), nil
+		},
+	}
+	if t.config.VerifyClients {
+		// the chain is verified by VerifyConnection to pick up reloaded CAs
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			return t.verifyClient(cs.PeerCertificates)
+		}
+	}
+	return cfg
+}
+
+// ClientConfig returns the TLS configuration of clients sending requests to
+// other nodes. It contains the certificates loaded at the time of the call,
+// hence it should be obtained for every new connection.
+func (t *TLS) ClientConfig() *tls.Config {
+	t.RLock()
+	defer t.RUnlock()
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         t.config.ServerName,
+		Certificates:       []tls.Certificate{*t.cert},
+		RootCAs:            t.roots,
+		InsecureSkipVerify: t.config.InsecureSkipVerify,
+	}
+}
+
+func (t *TLS) certificate() *tls.Certificate {
+	t.RLock()
+	defer t.RUnlock()
+	return t.cert
+}
+
+func (t *TLS) verifyClient(certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return errors.New("client presented no certificate")
+	}
+	t.RLock()
+	roots := t.roots
+	t.RUnlock()
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(opts)
+	return err
+}
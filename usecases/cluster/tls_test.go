@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSConfigValidate(t *testing.T) {
+	assert.Nil(t, TLSConfig{}.Validate())
+	assert.NotNil(t, TLSConfig{Enabled: true, CertFile: "cert.pem"}.Validate())
+	assert.NotNil(t, TLSConfig{Enabled: true, CertFile: "c", KeyFile: "k", VerifyClients: true}.Validate())
+	assert.Nil(t, TLSConfig{Enabled: true, CertFile: "c", KeyFile: "k", CAFile: "ca", VerifyClients: true}.Validate())
+}
+
+func TestTLSMutualAuthentication(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	cfg := TLSConfig{
+		Enabled:       true,
+		CertFile:      filepath.Join(dir, "node.pem"),
+		KeyFile:       filepath.Join(dir, "node-key.pem"),
+		CAFile:        filepath.Join(dir, "ca.pem"),
+		VerifyClients: true,
+	}
+	ca.writeCA(t, cfg.CAFile)
+	ca.writeCert(t, cfg.CertFile, cfg.KeyFile, "127.0.0.1")
+
+	tlsProvider, err := NewTLS(cfg)
+	require.Nil(t, err)
+
+	addr := serveTLS(t, tlsProvider.ServerConfig())
+
+	t.Run("Success", func(t *testing.T) {
+		assert.Nil(t, handshake(addr, tlsProvider.ClientConfig()))
+	})
+
+	t.Run("WrongServerName", func(t *testing.T) {
+		cfg := tlsProvider.ClientConfig()
+		cfg.ServerName = "10.0.0.1"
+		assert.NotNil(t, handshake(addr, cfg))
+	})
+
+	t.Run("NoClientCertificate", func(t *testing.T) {
+		cfg := tlsProvider.ClientConfig()
+		cfg.Certificates = nil
+		assert.NotNil(t, handshake(addr, cfg))
+	})
+
+	t.Run("UnknownClientCertificate", func(t *testing.T) {
+		other := newTestCA(t)
+		cfg := tlsProvider.ClientConfig()
+		cfg.Certificates = []tls.Certificate{other.cert(t, "127.0.0.1")}
+		assert.NotNil(t, handshake(addr, cfg))
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		reloaded, err := tlsProvider.Reload()
+		require.Nil(t, err)
+		assert.False(t, reloaded)
+
+		// rotate the CA and the certificate of the node
+		rotated := newTestCA(t)
+		rotated.writeCA(t, cfg.CAFile)
+		rotated.writeCert(t, cfg.CertFile, cfg.KeyFile, "127.0.0.1")
+		later := time.Now().Add(time.Minute)
+		for _, path := range []string{cfg.CAFile, cfg.CertFile, cfg.KeyFile} {
+			require.Nil(t, os.Chtimes(path, later, later))
+		}
+		oldClient := tlsProvider.ClientConfig()
+
+		reloaded, err = tlsProvider.Reload()
+		require.Nil(t, err)
+		assert.True(t, reloaded)
+		assert.Nil(t, handshake(addr, tlsProvider.ClientConfig()))
+		assert.NotNil(t, handshake(addr, oldClient))
+	})
+
+	t.Run("ReloadKeepsCertificatesOnError", func(t *testing.T) {
+		require.Nil(t, os.WriteFile(cfg.CertFile, []byte("invalid"), 0o600))
+		_, err := tlsProvider.Reload()
+		assert.NotNil(t, err)
+		assert.Nil(t, handshake(addr, tlsProvider.ClientConfig()))
+	})
+}
+
+func serveTLS(t *testing.T, cfg *tls.Config) string {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	require.Nil(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if err := conn.(*tls.Conn).Handshake(); err == nil {
+					conn.Write([]byte{1})
+				}
+			}()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// handshake connects to addr and waits for the server to accept the connection
+func handshake(addr string, cfg *tls.Config) error {
+	if cfg.ServerName == "" {
+		cfg.ServerName, _, _ = net.SplitHostPort(addr)
+	}
+	conn, err := tls.Dial("tcp", addr, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	return err
+}
+
+type testCA struct {
+	key  *ecdsa.PrivateKey
+	tmpl *x509.Certificate
+	der  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.Nil(t, err)
+	return &testCA{key: key, tmpl: tmpl, der: der}
+}
+
+func (ca *testCA) writeCA(t *testing.T, path string) {
+	require.Nil(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.der}), 0o600))
+}
+
+func (ca *testCA) certPEM(t *testing.T, ip string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP(ip)},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.tmpl, &key.PublicKey, ca.key)
+	require.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func (ca *testCA) writeCert(t *testing.T, certPath, keyPath, ip string) {
+	cert, key := ca.certPEM(t, ip)
+	require.Nil(t, os.WriteFile(certPath, cert, 0o600))
+	require.Nil(t, os.WriteFile(keyPath, key, 0o600))
+}
+
+func (ca *testCA) cert(t *testing.T, ip string) tls.Certificate {
+	cert, key := ca.certPEM(t, ip)
+	pair, err := tls.X509KeyPair(cert, key)
+	require.Nil(t, err)
+	return pair
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"gopkg.in/yaml.v2"
 )
 
@@ -101,6 +102,7 @@ type Config struct {
 	Cluster                             cluster.Config           `json:"cluster" yaml:"cluster"`
 	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
 	Monitoring                          monitoring.Config        `json:"monitoring" yaml:"monitoring"`
+	Tracing                             tracing.Config           `json:"tracing" yaml:"tracing"`
 	GRPC                                GRPC                     `json:"grpc" yaml:"grpc"`
 	Profiling                           Profiling                `json:"profiling" yaml:"profiling"`
 	ResourceUsage                       ResourceUsage            `json:"resource_usage" yaml:"resource_usage"`
@@ -116,6 +118,13 @@ type Config struct {
 	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
 	CORS                                CORS                     `json:"cors" yaml:"cors"`
 	DisableTelemetry                    bool                     `json:"disable_telemetry" yaml:"disable_telemetry"`
+	ChangeLog                           ChangeLog                `json:"change_log" yaml:"change_log"`
+	Backup                              Backup                   `json:"backup" yaml:"backup"`
+	AuditLog                            AuditLog                 `json:"audit_log" yaml:"audit_log"`
+	Limits                              Limits                   `json:"limits" yaml:"limits"`
+	SlowQueryLog                        SlowQueryLog             `json:"slow_query_log" yaml:"slow_query_log"`
+	QueryCache                          QueryCache               `json:"query_cache" yaml:"query_cache"`
+	BulkLoad                            BulkLoad                 `json:"bulk_load" yaml:"bulk_load"`
 
 	// Raft Specific configuration
 	// TODO-RAFT: Do we want to be able to specify these with config file as well ?
@@ -200,6 +209,65 @@ type GRPC struct {
 	KeyFile  string `json:"keyFile" yaml:"keyFile"`
 }
 
+// ChangeLog configures the per-shard log of object mutations which is
+// exposed as a change data capture stream
+type ChangeLog struct {
+	Enabled           bool `json:"enabled" yaml:"enabled"`
+	MaxEventsPerShard int  `json:"maxEventsPerShard" yaml:"maxEventsPerShard"`
+}
+
+// Backup configures the encryption of backup chunks. The key is base64
+// encoded and 16, 24 or 32 bytes long, selecting AES-128, AES-192 or
+// AES-256. At most one of EncryptionKey and EncryptionKeyFile may be set.
+type Backup struct {
+	EncryptionKey     string `json:"encryptionKey" yaml:"encryptionKey"`
+	EncryptionKeyFile string `json:"encryptionKeyFile" yaml:"encryptionKeyFile"`
+	// ScheduleInterval is how often backup schedules are checked for due runs
+	ScheduleInterval time.Duration `json:"scheduleInterval" yaml:"scheduleInterval"`
+}
+
+// AuditLog configures the log of schema, data and admin operations. Output
+// is either "stdout" or the path of a file which is rotated once it exceeds
+// MaxSizeMB, keeping MaxBackups rotated files.
+type AuditLog struct {
+	Enabled    bool   `json:"enabled" yaml:"enabled"`
+	Output     string `json:"output" yaml:"output"`
+	MaxSizeMB  int    `json:"maxSizeMB" yaml:"maxSizeMB"`
+	MaxBackups int    `json:"maxBackups" yaml:"maxBackups"`
+}
+
+// Limits configures per-principal rate limits and storage quotas. They are
+// read from the YAML file at ConfigPath, which is reloaded when it changes.
+// The usage of classes with quotas is refreshed every
+// QuotaUsageRefreshInterval.
+type Limits struct {
+	ConfigPath                string        `json:"configPath" yaml:"configPath"`
+	QuotaUsageRefreshInterval time.Duration `json:"quotaUsageRefreshInterval" yaml:"quotaUsageRefreshInterval"`
+}
+
+// SlowQueryLog configures the log of queries which take at least Threshold
+// to complete.
+type SlowQueryLog struct {
+	Enabled   bool          `json:"enabled" yaml:"enabled"`
+	Threshold time.Duration `json:"threshold" yaml:"threshold"`
+}
+
+// QueryCache configures the node-level cache of query vectors produced by
+// modules and of shard search results. MaxVectors and MaxResults limit the
+// number of cached entries.
+type QueryCache struct {
+	Enabled    bool `json:"enabled" yaml:"enabled"`
+	MaxVectors int  `json:"maxVectors" yaml:"maxVectors"`
+	MaxResults int  `json:"maxResults" yaml:"maxResults"`
+}
+
+// BulkLoad configures the directory that files are bulk loaded from. Bulk
+// loads are rejected for paths outside of ImportPath, and altogether if it
+// is not set.
+type BulkLoad struct {
+	ImportPath string `json:"importPath" yaml:"importPath"`
+}
+
 type Profiling struct {
 	BlockProfileRate     int `json:"blockProfileRate" yaml:"blockProfileRate"`
 	MutexProfileFraction int `json:"mutexProfileFraction" yaml:"mutexProfileFraction"`
@@ -212,6 +280,11 @@ type Persistence struct {
 	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
 	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
 	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	LSMCompactionStrategy             string `json:"lsmCompactionStrategy" yaml:"lsmCompactionStrategy"`
+	LSMCompactionMaxBytesPerSecond    int    `json:"lsmCompactionMaxBytesPerSecond" yaml:"lsmCompactionMaxBytesPerSecond"`
+	LSMSegmentCompression             string `json:"lsmSegmentCompression" yaml:"lsmSegmentCompression"`
+	HNSWDisableSnapshots              bool   `json:"hnswDisableSnapshots" yaml:"hnswDisableSnapshots"`
+	HNSWSnapshotMinDeltaCommitlogs    int    `json:"hnswSnapshotMinDeltaCommitlogs" yaml:"hnswSnapshotMinDeltaCommitlogs"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -54,6 +54,29 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if configbase.Enabled(os.Getenv("TRACING_ENABLED")) {
+		config.Tracing.Enabled = true
+	}
+	if v := os.Getenv("TRACING_OTLP_ENDPOINT"); v != "" {
+		config.Tracing.Endpoint = v
+	} else if config.Tracing.Endpoint == "" {
+		config.Tracing.Endpoint = DefaultTracingOTLPEndpoint
+	}
+	if configbase.Enabled(os.Getenv("TRACING_OTLP_INSECURE")) {
+		config.Tracing.Insecure = true
+	}
+	if v := os.Getenv("TRACING_SAMPLE_RATIO"); v != "" {
+		asFloat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("parse TRACING_SAMPLE_RATIO as float: %w", err)
+		} else if asFloat < 0 || asFloat > 1 {
+			return fmt.Errorf("TRACING_SAMPLE_RATIO must be between 0 and 1")
+		}
+		config.Tracing.SampleRatio = asFloat
+	} else {
+		config.Tracing.SampleRatio = DefaultTracingSampleRatio
+	}
+
 	if configbase.Enabled(os.Getenv("TRACK_VECTOR_DIMENSIONS")) {
 		config.TrackVectorDimensions = true
 	}
@@ -195,6 +218,14 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if err := config.parseLSMCompactionConfig(); err != nil {
+		return err
+	}
+
+	if err := config.parseHNSWSnapshotConfig(); err != nil {
+		return err
+	}
+
 	if err := config.parseCORSConfig(); err != nil {
 		return err
 	}
@@ -365,6 +396,105 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if configbase.Enabled(os.Getenv("CHANGE_LOG_ENABLED")) {
+		config.ChangeLog.Enabled = true
+	}
+	if err := parsePositiveInt(
+		"CHANGE_LOG_MAX_EVENTS_PER_SHARD",
+		func(val int) { config.ChangeLog.MaxEventsPerShard = val },
+		DefaultChangeLogMaxEventsPerShard,
+	); err != nil {
+		return err
+	}
+
+	config.Backup.EncryptionKey = os.Getenv("BACKUP_ENCRYPTION_KEY")
+	config.Backup.EncryptionKeyFile = os.Getenv("BACKUP_ENCRYPTION_KEY_FILE")
+	if v := os.Getenv("BACKUP_SCHEDULE_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse BACKUP_SCHEDULE_INTERVAL as time.Duration: %w", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("BACKUP_SCHEDULE_INTERVAL must be positive")
+		}
+		config.Backup.ScheduleInterval = interval
+	} else {
+		config.Backup.ScheduleInterval = DefaultBackupScheduleInterval
+	}
+
+	if configbase.Enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		config.AuditLog.Enabled = true
+	}
+	if v := os.Getenv("AUDIT_LOG_OUTPUT"); v != "" {
+		config.AuditLog.Output = v
+	} else if config.AuditLog.Output == "" {
+		config.AuditLog.Output = DefaultAuditLogOutput
+	}
+	if err := parsePositiveInt(
+		"AUDIT_LOG_MAX_SIZE_MB",
+		func(val int) { config.AuditLog.MaxSizeMB = val },
+		DefaultAuditLogMaxSizeMB,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"AUDIT_LOG_MAX_BACKUPS",
+		func(val int) { config.AuditLog.MaxBackups = val },
+		DefaultAuditLogMaxBackups,
+	); err != nil {
+		return err
+	}
+
+	config.Limits.ConfigPath = os.Getenv("LIMITS_CONFIG_PATH")
+	if v := os.Getenv("QUOTA_USAGE_REFRESH_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse QUOTA_USAGE_REFRESH_INTERVAL as time.Duration: %w", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("QUOTA_USAGE_REFRESH_INTERVAL must be positive")
+		}
+		config.Limits.QuotaUsageRefreshInterval = interval
+	} else {
+		config.Limits.QuotaUsageRefreshInterval = DefaultQuotaUsageRefreshInterval
+	}
+
+	if configbase.Enabled(os.Getenv("QUERY_SLOW_LOG_ENABLED")) {
+		config.SlowQueryLog.Enabled = true
+	}
+	if v := os.Getenv("QUERY_SLOW_LOG_THRESHOLD"); v != "" {
+		threshold, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_SLOW_LOG_THRESHOLD as time.Duration: %w", err)
+		}
+		if threshold < 0 {
+			return fmt.Errorf("QUERY_SLOW_LOG_THRESHOLD must not be negative")
+		}
+		config.SlowQueryLog.Threshold = threshold
+	} else {
+		config.SlowQueryLog.Threshold = DefaultSlowQueryLogThreshold
+	}
+
+	if configbase.Enabled(os.Getenv("QUERY_CACHE_ENABLED")) {
+		config.QueryCache.Enabled = true
+	}
+	if err := parsePositiveInt(
+		"QUERY_CACHE_MAX_VECTORS",
+		func(val int) { config.QueryCache.MaxVectors = val },
+		DefaultQueryCacheMaxVectors,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"QUERY_CACHE_MAX_RESULTS",
+		func(val int) { config.QueryCache.MaxResults = val },
+		DefaultQueryCacheMaxResults,
+	); err != nil {
+		return err
+	}
+
+	config.BulkLoad.ImportPath = os.Getenv("BULK_LOAD_IMPORT_PATH")
+
 	config.DisableTelemetry = false
 	if configbase.Enabled(os.Getenv("DISABLE_TELEMETRY")) {
 		config.DisableTelemetry = true
@@ -533,6 +663,36 @@ func (c *Config) parseMemtableConfig() error {
 	return nil
 }
 
+func (c *Config) parseLSMCompactionConfig() error {
+	if v := os.Getenv("PERSISTENCE_LSM_COMPACTION_STRATEGY"); v != "" {
+		c.Persistence.LSMCompactionStrategy = v
+	}
+
+	// one of "none", "snappy" or "zstd", applies to newly written segments
+	if v := os.Getenv("PERSISTENCE_LSM_SEGMENT_COMPRESSION"); v != "" {
+		c.Persistence.LSMSegmentCompression = v
+	}
+
+	// unset means compactions are not throttled
+	return parsePositiveInt(
+		"PERSISTENCE_LSM_COMPACTION_MAX_BYTES_PER_SECOND",
+		func(val int) { c.Persistence.LSMCompactionMaxBytesPerSecond = val },
+		c.Persistence.LSMCompactionMaxBytesPerSecond,
+	)
+}
+
+func (c *Config) parseHNSWSnapshotConfig() error {
+	if configbase.Enabled(os.Getenv("PERSISTENCE_HNSW_DISABLE_SNAPSHOTS")) {
+		c.Persistence.HNSWDisableSnapshots = true
+	}
+
+	return parsePositiveInt(
+		"PERSISTENCE_HNSW_SNAPSHOT_MIN_DELTA_COMMITLOGS",
+		func(val int) { c.Persistence.HNSWSnapshotMinDeltaCommitlogs = val },
+		DefaultPersistenceHNSWSnapshotMinDelta,
+	)
+}
+
 func parsePositiveInt(varName string, cb func(val int), defaultValue int) error {
 	if v := os.Getenv(varName); v != "" {
 		asInt, err := strconv.Atoi(v)
@@ -560,9 +720,21 @@ const (
 	DefaultPersistenceMemtablesMaxSize         = 200
 	DefaultPersistenceMemtablesMinDuration     = 15
 	DefaultPersistenceMemtablesMaxDuration     = 45
+	DefaultPersistenceHNSWSnapshotMinDelta     = 5
 	DefaultMaxConcurrentGetRequests            = 0
 	DefaultGRPCPort                            = 50051
 	DefaultMinimumReplicationFactor            = 1
+	DefaultChangeLogMaxEventsPerShard          = 10000
+	DefaultBackupScheduleInterval              = time.Minute
+	DefaultAuditLogOutput                      = "stdout"
+	DefaultAuditLogMaxSizeMB                   = 100
+	DefaultAuditLogMaxBackups                  = 5
+	DefaultQuotaUsageRefreshInterval           = 10 * time.Second
+	DefaultTracingOTLPEndpoint                 = "localhost:4318"
+	DefaultTracingSampleRatio                  = 1.0
+	DefaultSlowQueryLogThreshold               = 2 * time.Second
+	DefaultQueryCacheMaxVectors                = 10000
+	DefaultQueryCacheMaxResults                = 1000
 )
 
 const VectorizerModuleNone = "none"
@@ -697,5 +869,18 @@ func parseClusterConfig() (cluster.Config, error) {
 		},
 	}
 
+	cfg.TLS = cluster.TLSConfig{
+		Enabled:            configbase.Enabled(os.Getenv("CLUSTER_TLS_ENABLED")),
+		CertFile:           os.Getenv("CLUSTER_TLS_CERT_FILE"),
+		KeyFile:            os.Getenv("CLUSTER_TLS_KEY_FILE"),
+		CAFile:             os.Getenv("CLUSTER_TLS_CA_FILE"),
+		VerifyClients:      configbase.Enabled(os.Getenv("CLUSTER_TLS_VERIFY_CLIENTS")),
+		ServerName:         os.Getenv("CLUSTER_TLS_SERVER_NAME"),
+		InsecureSkipVerify: configbase.Enabled(os.Getenv("CLUSTER_TLS_INSECURE_SKIP_VERIFY")),
+	}
+	if err := cfg.TLS.Validate(); err != nil {
+		return cfg, err
+	}
+
 	return cfg, nil
 }
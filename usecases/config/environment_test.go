@@ -15,10 +15,12 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 const DefaultGoroutineFactor = 1.5
@@ -194,6 +196,93 @@ func TestEnvironmentMemtable_MaxSize(t *testing.T) {
 	}
 }
 
+func TestEnvironmentLSMCompaction(t *testing.T) {
+	factors := []struct {
+		name             string
+		strategy         []string
+		maxBytes         []string
+		expectedStrategy string
+		expectedMaxBytes int
+		expectedErr      bool
+	}{
+		{"not given", []string{}, []string{}, "", 0, false},
+		{"valid", []string{"sizetiered"}, []string{"52428800"}, "sizetiered", 52428800, false},
+		{"zero limit", []string{}, []string{"0"}, "", 0, true},
+		{"not parsable", []string{}, []string{"fast"}, "", 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.strategy) == 1 {
+				t.Setenv("PERSISTENCE_LSM_COMPACTION_STRATEGY", tt.strategy[0])
+			}
+			if len(tt.maxBytes) == 1 {
+				t.Setenv("PERSISTENCE_LSM_COMPACTION_MAX_BYTES_PER_SECOND", tt.maxBytes[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedStrategy, conf.Persistence.LSMCompactionStrategy)
+				require.Equal(t, tt.expectedMaxBytes, conf.Persistence.LSMCompactionMaxBytesPerSecond)
+			}
+		})
+	}
+}
+
+func TestEnvironmentLSMSegmentCompression(t *testing.T) {
+	t.Run("not given", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "", conf.Persistence.LSMSegmentCompression)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("PERSISTENCE_LSM_SEGMENT_COMPRESSION", "zstd")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		require.Equal(t, "zstd", conf.Persistence.LSMSegmentCompression)
+	})
+}
+
+func TestEnvironmentHNSWSnapshots(t *testing.T) {
+	factors := []struct {
+		name             string
+		disabled         []string
+		minDelta         []string
+		expectedDisabled bool
+		expectedMinDelta int
+		expectedErr      bool
+	}{
+		{"not given", []string{}, []string{}, false, DefaultPersistenceHNSWSnapshotMinDelta, false},
+		{"disabled", []string{"true"}, []string{}, true, DefaultPersistenceHNSWSnapshotMinDelta, false},
+		{"valid min delta", []string{}, []string{"2"}, false, 2, false},
+		{"zero min delta", []string{}, []string{"0"}, false, 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.disabled) == 1 {
+				t.Setenv("PERSISTENCE_HNSW_DISABLE_SNAPSHOTS", tt.disabled[0])
+			}
+			if len(tt.minDelta) == 1 {
+				t.Setenv("PERSISTENCE_HNSW_SNAPSHOT_MIN_DELTA_COMMITLOGS", tt.minDelta[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedDisabled, conf.Persistence.HNSWDisableSnapshots)
+				require.Equal(t, tt.expectedMinDelta, conf.Persistence.HNSWSnapshotMinDeltaCommitlogs)
+			}
+		})
+	}
+}
+
 func TestEnvironmentMemtable_MinDuration(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -629,6 +718,150 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 	}
 }
 
+func TestEnvironmentAuditLog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AuditLog{
+			Output:     DefaultAuditLogOutput,
+			MaxSizeMB:  DefaultAuditLogMaxSizeMB,
+			MaxBackups: DefaultAuditLogMaxBackups,
+		}, conf.AuditLog)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_ENABLED", "true")
+		t.Setenv("AUDIT_LOG_OUTPUT", "/var/log/weaviate/audit.log")
+		t.Setenv("AUDIT_LOG_MAX_SIZE_MB", "10")
+		t.Setenv("AUDIT_LOG_MAX_BACKUPS", "3")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AuditLog{
+			Enabled:    true,
+			Output:     "/var/log/weaviate/audit.log",
+			MaxSizeMB:  10,
+			MaxBackups: 3,
+		}, conf.AuditLog)
+	})
+
+	t.Run("invalid max size", func(t *testing.T) {
+		t.Setenv("AUDIT_LOG_MAX_SIZE_MB", "0")
+		require.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentBulkLoad(t *testing.T) {
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, "", conf.BulkLoad.ImportPath)
+
+	t.Setenv("BULK_LOAD_IMPORT_PATH", "/var/lib/weaviate-import")
+	conf = Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, "/var/lib/weaviate-import", conf.BulkLoad.ImportPath)
+}
+
+func TestEnvironmentLimits(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Limits{QuotaUsageRefreshInterval: DefaultQuotaUsageRefreshInterval}, conf.Limits)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("LIMITS_CONFIG_PATH", "/etc/weaviate/limits.yaml")
+		t.Setenv("QUOTA_USAGE_REFRESH_INTERVAL", "1m")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Limits{
+			ConfigPath:                "/etc/weaviate/limits.yaml",
+			QuotaUsageRefreshInterval: time.Minute,
+		}, conf.Limits)
+	})
+
+	t.Run("invalid refresh interval", func(t *testing.T) {
+		t.Setenv("QUOTA_USAGE_REFRESH_INTERVAL", "0s")
+		require.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentTracing(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, tracing.Config{
+			Endpoint:    DefaultTracingOTLPEndpoint,
+			SampleRatio: DefaultTracingSampleRatio,
+		}, conf.Tracing)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("TRACING_ENABLED", "true")
+		t.Setenv("TRACING_OTLP_ENDPOINT", "collector:4318")
+		t.Setenv("TRACING_OTLP_INSECURE", "true")
+		t.Setenv("TRACING_SAMPLE_RATIO", "0.25")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, tracing.Config{
+			Enabled:     true,
+			Endpoint:    "collector:4318",
+			Insecure:    true,
+			SampleRatio: 0.25,
+		}, conf.Tracing)
+	})
+
+	t.Run("invalid sample ratio", func(t *testing.T) {
+		t.Setenv("TRACING_SAMPLE_RATIO", "2")
+		require.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentSlowQueryLog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, SlowQueryLog{Threshold: DefaultSlowQueryLogThreshold}, conf.SlowQueryLog)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("QUERY_SLOW_LOG_ENABLED", "true")
+		t.Setenv("QUERY_SLOW_LOG_THRESHOLD", "500ms")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, SlowQueryLog{Enabled: true, Threshold: 500 * time.Millisecond}, conf.SlowQueryLog)
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		t.Setenv("QUERY_SLOW_LOG_THRESHOLD", "slow")
+		require.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
+func TestEnvironmentQueryCache(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, QueryCache{
+			MaxVectors: DefaultQueryCacheMaxVectors,
+			MaxResults: DefaultQueryCacheMaxResults,
+		}, conf.QueryCache)
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("QUERY_CACHE_ENABLED", "true")
+		t.Setenv("QUERY_CACHE_MAX_VECTORS", "50")
+		t.Setenv("QUERY_CACHE_MAX_RESULTS", "20")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, QueryCache{Enabled: true, MaxVectors: 50, MaxResults: 20}, conf.QueryCache)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		t.Setenv("QUERY_CACHE_MAX_RESULTS", "0")
+		require.NotNil(t, FromEnv(&Config{}))
+	})
+}
+
 func TestEnvironmentQueryDefaults_Limit(t *testing.T) {
 	factors := []struct {
 		name     string
@@ -80,18 +80,22 @@ func (f *FeatureProjector) Reduce(in []search.Result, params *Params) ([]search.
 	if err != nil {
 		return nil, err
 	}
-	t := tsne.NewTSNE(*params.Dimensions, float64(*params.Perplexity),
-		float64(*params.LearningRate), *params.Iterations, false)
-	t.EmbedData(matrix, nil)
-	rows, cols := t.Y.Dims()
+
+	projected, err := f.project(matrix, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, cols := projected.Dims()
 	if rows != len(in) {
-		return nil, fmt.Errorf("incorrect matrix dimensions after t-SNE len %d != %d", len(in), rows)
+		return nil, fmt.Errorf("incorrect matrix dimensions after %s len %d != %d",
+			*params.Algorithm, len(in), rows)
 	}
 
 	for i := 0; i < rows; i++ {
 		vector := make([]float32, cols)
 		for j := range vector {
-			vector[j] = float32(t.Y.At(i, j))
+			vector[j] = float32(projected.At(i, j))
 		}
 		up := in[i].AdditionalProperties
 		if up == nil {
@@ -108,6 +112,20 @@ func (f *FeatureProjector) Reduce(in []search.Result, params *Params) ([]search.
 	return in, nil
 }
 
+func (f *FeatureProjector) project(matrix *mat.Dense, params *Params) (mat.Matrix, error) {
+	switch *params.Algorithm {
+	case AlgorithmPCA:
+		return pca(matrix, *params.Dimensions)
+	case AlgorithmUMAP:
+		return newUMAP(params).embed(matrix)
+	default:
+		t := tsne.NewTSNE(*params.Dimensions, float64(*params.Perplexity),
+			float64(*params.LearningRate), *params.Iterations, false)
+		t.EmbedData(matrix, nil)
+		return t.Y, nil
+	}
+}
+
 func (f *FeatureProjector) vectorsToMatrix(in []search.Result, dims int, params *Params) (*mat.Dense, error) {
 	items := len(in)
 
@@ -40,6 +40,18 @@ func (f *FeatureProjector) AdditionalFeatureProjectionField(classname string) *g
 				Type:         graphql.Int,
 				DefaultValue: nil,
 			},
+			"neighbors": &graphql.ArgumentConfig{
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
+			"minDistance": &graphql.ArgumentConfig{
+				Type:         graphql.Float,
+				DefaultValue: nil,
+			},
+			"seed": &graphql.ArgumentConfig{
+				Type:         graphql.Int,
+				DefaultValue: nil,
+			},
 		},
 		Type: graphql.NewObject(graphql.ObjectConfig{
 			Name: fmt.Sprintf("%sAdditionalFeatureProjection", classname),
@@ -13,13 +13,27 @@ package projector
 
 import "github.com/weaviate/weaviate/entities/errorcompounder"
 
+const (
+	AlgorithmTSNE = "tsne"
+	AlgorithmPCA  = "pca"
+	AlgorithmUMAP = "umap"
+)
+
+// MaxUMAPItems is the maximum number of items which can be projected using
+// umap. The nearest neighbor graph is built using an exact search, which is
+// quadratic in the number of items.
+const MaxUMAPItems = 5000
+
 type Params struct {
 	Enabled          bool
-	Algorithm        *string // optional parameter
-	Dimensions       *int    // optional parameter
-	Perplexity       *int    // optional parameter
-	Iterations       *int    // optional parameter
-	LearningRate     *int    // optional parameter
+	Algorithm        *string  // optional parameter
+	Dimensions       *int     // optional parameter
+	Perplexity       *int     // optional parameter, tsne only
+	Iterations       *int     // optional parameter, tsne and umap
+	LearningRate     *int     // optional parameter, tsne only
+	Neighbors        *int     // optional parameter, umap only
+	MinDistance      *float64 // optional parameter, umap only
+	Seed             *int     // optional parameter, umap only
 	IncludeNeighbors bool
 }
 
@@ -30,29 +44,71 @@ func (p *Params) SetDefaultsAndValidate(inputSize, dims int) error {
 
 func (p *Params) setDefaults(inputSize, dims int) {
 	perplexity := p.min(inputSize-1, 5)
-	p.Algorithm = p.optionalString(p.Algorithm, "tsne")
+	p.Algorithm = p.optionalString(p.Algorithm, AlgorithmTSNE)
 	p.Dimensions = p.optionalInt(p.Dimensions, 2)
 	p.Perplexity = p.optionalInt(p.Perplexity, perplexity)
-	p.Iterations = p.optionalInt(p.Iterations, 100)
 	p.LearningRate = p.optionalInt(p.LearningRate, 25)
+	p.Neighbors = p.optionalInt(p.Neighbors, p.min(inputSize-1, 15))
+	p.MinDistance = p.optionalFloat(p.MinDistance, 0.1)
+	p.Seed = p.optionalInt(p.Seed, 0)
+
+	// umap needs more epochs than t-SNE to converge
+	iterations := 100
+	if *p.Algorithm == AlgorithmUMAP {
+		iterations = 200
+	}
+	p.Iterations = p.optionalInt(p.Iterations, iterations)
 }
 
 func (p *Params) validate(inputSize, dims int) error {
 	ec := &errorcompounder.ErrorCompounder{}
-	if *p.Algorithm != "tsne" {
-		ec.Addf("algorithm %s is not supported: must be one of: tsne", *p.Algorithm)
-	}
-
-	if *p.Perplexity >= inputSize {
-		ec.Addf("perplexity must be smaller than amount of items: %d >= %d", *p.Perplexity, inputSize)
-	}
-
-	if *p.Iterations < 1 {
-		ec.Addf("iterations must be at least 1, got: %d", *p.Iterations)
-	}
-
-	if *p.LearningRate < 1 {
-		ec.Addf("learningRate must be at least 1, got: %d", *p.LearningRate)
+	switch *p.Algorithm {
+	case AlgorithmPCA:
+		if *p.Dimensions > inputSize {
+			ec.Addf("dimensions must not be larger than amount of items: %d > %d", *p.Dimensions, inputSize)
+		}
+	case AlgorithmUMAP:
+		// the layout is initialized using pca
+		if *p.Dimensions > inputSize {
+			ec.Addf("dimensions must not be larger than amount of items: %d > %d", *p.Dimensions, inputSize)
+		}
+
+		if inputSize > MaxUMAPItems {
+			ec.Addf("umap supports at most %d items, got: %d", MaxUMAPItems, inputSize)
+		}
+
+		if *p.Neighbors < 1 {
+			ec.Addf("neighbors must be at least 1, got: %d", *p.Neighbors)
+		}
+
+		if *p.Neighbors >= inputSize {
+			ec.Addf("neighbors must be smaller than amount of items: %d >= %d", *p.Neighbors, inputSize)
+		}
+
+		if *p.MinDistance < 0 || *p.MinDistance > 1 {
+			ec.Addf("minDistance must be between 0 and 1, got: %v", *p.MinDistance)
+		}
+
+		if *p.Iterations < 1 {
+			ec.Addf("iterations must be at least 1, got: %d", *p.Iterations)
+		}
+	default:
+		if *p.Algorithm != AlgorithmTSNE {
+			ec.Addf("algorithm %s is not supported: must be one of: %s, %s, %s", *p.Algorithm,
+				AlgorithmTSNE, AlgorithmPCA, AlgorithmUMAP)
+		}
+
+		if *p.Perplexity >= inputSize {
+			ec.Addf("perplexity must be smaller than amount of items: %d >= %d", *p.Perplexity, inputSize)
+		}
+
+		if *p.Iterations < 1 {
+			ec.Addf("iterations must be at least 1, got: %d", *p.Iterations)
+		}
+
+		if *p.LearningRate < 1 {
+			ec.Addf("learningRate must be at least 1, got: %d", *p.LearningRate)
+		}
 	}
 
 	if *p.Dimensions < 1 {
@@ -88,3 +144,11 @@ func (p Params) optionalInt(in *int, defaultValue int) *int {
 
 	return in
 }
+
+func (p Params) optionalFloat(in *float64, defaultValue float64) *float64 {
+	if in == nil {
+		return &defaultValue
+	}
+
+	return in
+}
@@ -36,6 +36,15 @@ func parseFeatureProjectionArguments(args []*ast.Argument) *Params {
 			out.Perplexity = ptInt(asInt)
 		case "algorithm":
 			out.Algorithm = ptString(arg.Value.GetValue().(string))
+		case "neighbors":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.Neighbors = ptInt(asInt)
+		case "minDistance":
+			asFloat, _ := strconv.ParseFloat(arg.Value.GetValue().(string), 64)
+			out.MinDistance = ptFloat(asFloat)
+		case "seed":
+			asInt, _ := strconv.Atoi(arg.Value.GetValue().(string))
+			out.Seed = ptInt(asInt)
 
 		default:
 			// ignore what we don't recognize
@@ -52,3 +61,7 @@ func ptString(in string) *string {
 func ptInt(in int) *int {
 	return &in
 }
+
+func ptFloat(in float64) *float64 {
+	return &in
+}
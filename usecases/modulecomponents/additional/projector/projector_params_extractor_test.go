@@ -116,6 +116,24 @@ func Test_parseFeatureProjectionArguments(t *testing.T) {
 				Perplexity: ptInt(10),
 			},
 		},
+		{
+			name: "Should create with umap params",
+			args: args{
+				args: []*ast.Argument{
+					createArg("algorithm", "umap"),
+					createArg("neighbors", "10"),
+					createArg("minDistance", "0.25"),
+					createArg("seed", "42"),
+				},
+			},
+			want: &Params{
+				Enabled:     true,
+				Algorithm:   ptString("umap"),
+				Neighbors:   ptInt(10),
+				MinDistance: ptFloat(0.25),
+				Seed:        ptInt(42),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
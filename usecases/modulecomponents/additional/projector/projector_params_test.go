@@ -77,13 +77,79 @@ func TestParams_validate(t *testing.T) {
 			},
 			wantErr: true,
 			errContains: []string{
-				"algorithm unknown is not supported: must be one of: tsne",
+				"algorithm unknown is not supported: must be one of: tsne, pca, umap",
 				"perplexity must be smaller than amount of items: 5 >= 4",
 				"iterations must be at least 1, got: 0",
 				"learningRate must be at least 1, got: 0",
 				"dimensions must be smaller than source dimensions: 5 >= 2",
 			},
 		},
+		{
+			name:  "Should validate properly with default umap Params",
+			param: generateParamWithDefaultValuesForAlgorithm("umap", 100, 50),
+			args: args{
+				inputSize: 100,
+				dims:      50,
+			},
+			wantErr: false,
+		},
+		{
+			name:  "Should validate properly with default pca Params",
+			param: generateParamWithDefaultValuesForAlgorithm("pca", 2, 50),
+			args: args{
+				inputSize: 2,
+				dims:      50,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should not validate - with wrong umap values",
+			param: &Params{
+				Algorithm:   ptString("umap"),
+				Dimensions:  ptInt(5),
+				Iterations:  ptInt(0),
+				Neighbors:   ptInt(4),
+				MinDistance: ptFloat(1.5),
+			},
+			args: args{
+				inputSize: 4,
+				dims:      10,
+			},
+			wantErr: true,
+			errContains: []string{
+				"dimensions must not be larger than amount of items: 5 > 4",
+				"neighbors must be smaller than amount of items: 4 >= 4",
+				"minDistance must be between 0 and 1, got: 1.5",
+				"iterations must be at least 1, got: 0",
+			},
+		},
+		{
+			name:  "Should not validate - with too many items for umap",
+			param: generateParamWithDefaultValuesForAlgorithm("umap", MaxUMAPItems+1, 50),
+			args: args{
+				inputSize: MaxUMAPItems + 1,
+				dims:      50,
+			},
+			wantErr: true,
+			errContains: []string{
+				"umap supports at most 5000 items, got: 5001",
+			},
+		},
+		{
+			name: "Should not validate - with more pca components than items",
+			param: &Params{
+				Algorithm:  ptString("pca"),
+				Dimensions: ptInt(3),
+			},
+			args: args{
+				inputSize: 2,
+				dims:      10,
+			},
+			wantErr: true,
+			errContains: []string{
+				"dimensions must not be larger than amount of items: 3 > 2",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -107,6 +173,12 @@ func generateParamWithDefaultValues(inputSize, dims int) *Params {
 	return p
 }
 
+func generateParamWithDefaultValuesForAlgorithm(algorithm string, inputSize, dims int) *Params {
+	p := &Params{Algorithm: &algorithm}
+	p.setDefaults(inputSize, dims)
+	return p
+}
+
 func generateParamWithValues(
 	enabled bool,
 	algorithm string,
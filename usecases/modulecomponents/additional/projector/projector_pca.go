@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package projector
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// pca projects the rows of data onto their first principal components. The
+// principal components are obtained from a thin SVD of the centered data.
+// The sign of each component is fixed, so that the result is deterministic.
+func pca(data *mat.Dense, components int) (*mat.Dense, error) {
+	rows, cols := data.Dims()
+
+	centered := mat.DenseCopyOf(data)
+	for j := 0; j < cols; j++ {
+		var mean float64
+		for i := 0; i < rows; i++ {
+			mean += centered.At(i, j)
+		}
+		mean /= float64(rows)
+
+		for i := 0; i < rows; i++ {
+			centered.Set(i, j, centered.At(i, j)-mean)
+		}
+	}
+
+	var svd mat.SVD
+	if ok := svd.Factorize(centered, mat.SVDThin); !ok {
+		return nil, fmt.Errorf("pca: singular value decomposition failed")
+	}
+
+	var v mat.Dense
+	svd.VTo(&v)
+	_, available := v.Dims()
+	if components > available {
+		return nil, fmt.Errorf("pca: cannot calculate %d components from %d items with %d dimensions",
+			components, rows, cols)
+	}
+
+	basis := mat.DenseCopyOf(v.Slice(0, cols, 0, components))
+	for j := 0; j < components; j++ {
+		// the sign of a singular vector is arbitrary, flip it so that its
+		// largest entry is positive
+		var largest float64
+		for i := 0; i < cols; i++ {
+			if val := basis.At(i, j); math.Abs(val) > math.Abs(largest) {
+				largest = val
+			}
+		}
+
+		if largest < 0 {
+			for i := 0; i < cols; i++ {
+				basis.Set(i, j, -basis.At(i, j))
+			}
+		}
+	}
+
+	var out mat.Dense
+	out.Mul(centered, basis)
+	return &out, nil
+}
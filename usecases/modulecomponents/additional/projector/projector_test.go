@@ -12,6 +12,7 @@
 package projector
 
 import (
+	"math"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -68,4 +69,82 @@ func TestProjector(t *testing.T) {
 			assert.Len(t, fpElement.Vector, 2)
 		}
 	})
+	t.Run("with pca", func(t *testing.T) {
+		// all items lie on a single line, so the first principal component
+		// captures their position along it
+		testData := []search.Result{
+			{Vector: []float32{0, 0, 0}},
+			{Vector: []float32{1, 1, 0}},
+			{Vector: []float32{2, 2, 0}},
+		}
+
+		res, err := p.Reduce(testData, &Params{Algorithm: ptString(AlgorithmPCA), Dimensions: ptInt(1)})
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+
+		expected := []float32{-1.4142, 0, 1.4142}
+		for i := range res {
+			fp := res[i].AdditionalProperties["featureProjection"].(*FeatureProjection)
+			require.Len(t, fp.Vector, 1)
+			assert.InDelta(t, expected[i], fp.Vector[0], 0.001)
+		}
+	})
+
+	t.Run("with umap", func(t *testing.T) {
+		// two clearly separated clusters must remain separated
+		var testData []search.Result
+		for i := 0; i < 20; i++ {
+			vector := make([]float32, 5)
+			for j := range vector {
+				vector[j] = float32((i*7+j*3)%5) / 10
+			}
+			if i >= 10 {
+				vector[0] += 10
+			}
+			testData = append(testData, search.Result{Vector: vector})
+		}
+
+		reduce := func() [][]float32 {
+			in := make([]search.Result, len(testData))
+			copy(in, testData)
+			res, err := p.Reduce(in, &Params{
+				Algorithm: ptString(AlgorithmUMAP),
+				Neighbors: ptInt(5),
+				Seed:      ptInt(7),
+			})
+			require.Nil(t, err)
+
+			out := make([][]float32, len(res))
+			for i := range res {
+				fp := res[i].AdditionalProperties["featureProjection"].(*FeatureProjection)
+				require.Len(t, fp.Vector, 2)
+				out[i] = fp.Vector
+			}
+			return out
+		}
+
+		first := reduce()
+		assert.Equal(t, first, reduce(), "same seed must produce the same projection")
+
+		centroid := func(vectors [][]float32) []float32 {
+			out := make([]float32, 2)
+			for _, v := range vectors {
+				out[0] += v[0] / float32(len(vectors))
+				out[1] += v[1] / float32(len(vectors))
+			}
+			return out
+		}
+		distance := func(a, b []float32) float64 {
+			return math.Hypot(float64(a[0]-b[0]), float64(a[1]-b[1]))
+		}
+
+		left, right := centroid(first[:10]), centroid(first[10:])
+		for i, v := range first {
+			own, other := left, right
+			if i >= 10 {
+				own, other = right, left
+			}
+			assert.Less(t, distance(v, own), distance(v, other))
+		}
+	})
 }
@@ -0,0 +1,410 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package projector
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	umapNegativeSampleRate = 5
+	umapGradientClip       = 4.0
+	umapSpread             = 1.0
+	umapInitRange          = 10.0
+)
+
+// umap implements the UMAP dimensionality reduction (McInnes et al., 2018).
+// It builds a fuzzy k-nearest-neighbor graph of the input and then optimizes
+// a low-dimensional layout with a similar fuzzy topology using stochastic
+// gradient descent with negative sampling. The layout is initialized with
+// PCA and all randomness is derived from seed, so that the same input and
+// params always produce the same projection.
+type umap struct {
+	neighbors  int
+	minDist    float64
+	epochs     int
+	components int
+	rng        *rand.Rand
+}
+
+type umapEdge struct {
+	head, tail int
+	weight     float64
+}
+
+func newUMAP(params *Params) *umap {
+	return &umap{
+		neighbors:  *params.Neighbors,
+		minDist:    *params.MinDistance,
+		epochs:     *params.Iterations,
+		components: *params.Dimensions,
+		rng:        rand.New(rand.NewSource(int64(*params.Seed))),
+	}
+}
+
+func (u *umap) embed(data *mat.Dense) (*mat.Dense, error) {
+	rows, _ := data.Dims()
+
+	edges := u.fuzzyGraph(data)
+
+	embedding, err := u.initialEmbedding(data)
+	if err != nil {
+		return nil, err
+	}
+
+	a, b := fitCurve(u.minDist, umapSpread)
+	u.optimize(embedding, edges, rows, a, b)
+
+	return mat.NewDense(rows, u.components, flatten(embedding)), nil
+}
+
+// fuzzyGraph returns the edges of the symmetrized fuzzy simplicial set of
+// the k-nearest-neighbor graph, sorted for deterministic processing
+func (u *umap) fuzzyGraph(data *mat.Dense) []umapEdge {
+	rows, _ := data.Dims()
+	knnIndices, knnDists := u.nearestNeighbors(data)
+
+	weights := map[[2]int]float64{}
+	for i := 0; i < rows; i++ {
+		rho, sigma := smoothKNNDistance(knnDists[i], u.neighbors)
+		for n, j := range knnIndices[i] {
+			w := 1.0
+			if d := knnDists[i][n] - rho; d > 0 {
+				w = math.Exp(-d / sigma)
+			}
+			weights[[2]int{i, j}] = w
+		}
+	}
+
+	// symmetrize using the fuzzy union: a + b - a*b
+	var edges []umapEdge
+	for key, w := range weights {
+		transposed := weights[[2]int{key[1], key[0]}]
+		weight := w + transposed - w*transposed
+		edges = append(edges, umapEdge{head: key[0], tail: key[1], weight: weight})
+		if _, ok := weights[[2]int{key[1], key[0]}]; !ok {
+			edges = append(edges, umapEdge{head: key[1], tail: key[0], weight: weight})
+		}
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].head != edges[j].head {
+			return edges[i].head < edges[j].head
+		}
+		return edges[i].tail < edges[j].tail
+	})
+
+	return edges
+}
+
+// nearestNeighbors finds the exact k nearest neighbors of every row. This
+// is quadratic in the number of rows, which is why the number of items is
+// limited to MaxUMAPItems.
+func (u *umap) nearestNeighbors(data *mat.Dense) ([][]int, [][]float64) {
+	rows, _ := data.Dims()
+	indices := make([][]int, rows)
+	dists := make([][]float64, rows)
+
+	candidates := make([]int, 0, rows-1)
+	distances := make([]float64, rows)
+	for i := 0; i < rows; i++ {
+		candidates = candidates[:0]
+		for j := 0; j < rows; j++ {
+			if i == j {
+				continue
+			}
+			distances[j] = euclidean(data.RawRowView(i), data.RawRowView(j))
+			candidates = append(candidates, j)
+		}
+
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return distances[candidates[a]] < distances[candidates[b]]
+		})
+
+		indices[i] = make([]int, u.neighbors)
+		dists[i] = make([]float64, u.neighbors)
+		for n := 0; n < u.neighbors; n++ {
+			indices[i][n] = candidates[n]
+			dists[i][n] = distances[candidates[n]]
+		}
+	}
+
+	return indices, dists
+}
+
+// smoothKNNDistance finds the distance to the nearest neighbor (rho) and a
+// normalization factor (sigma), so that the fuzzy membership strengths of
+// the neighbors sum up to log2(k)
+func smoothKNNDistance(dists []float64, k int) (float64, float64) {
+	rho := 0.0
+	for _, d := range dists {
+		if d > 0 {
+			rho = d
+			break
+		}
+	}
+
+	target := math.Log2(float64(k))
+	lo, hi, sigma := 0.0, math.Inf(1), 1.0
+	for iter := 0; iter < 64; iter++ {
+		sum := 0.0
+		for _, d := range dists {
+			if d -= rho; d > 0 {
+				sum += math.Exp(-d / sigma)
+			} else {
+				sum += 1
+			}
+		}
+
+		if math.Abs(sum-target) < 1e-5 {
+			break
+		}
+
+		if sum > target {
+			hi = sigma
+			sigma = (lo + hi) / 2
+		} else {
+			lo = sigma
+			if math.IsInf(hi, 1) {
+				sigma *= 2
+			} else {
+				sigma = (lo + hi) / 2
+			}
+		}
+	}
+
+	// avoid collapsing the memberships if all neighbors are at the same
+	// distance
+	if mean := meanOf(dists); sigma < 1e-3*mean {
+		sigma = 1e-3 * mean
+	}
+	if sigma == 0 {
+		sigma = 1e-3
+	}
+
+	return rho, sigma
+}
+
+// initialEmbedding uses the principal components of the data as a starting
+// point, scaled to a fixed range
+func (u *umap) initialEmbedding(data *mat.Dense) ([][]float64, error) {
+	rows, _ := data.Dims()
+	projected, err := pca(data, u.components)
+	if err != nil {
+		return nil, err
+	}
+
+	embedding := make([][]float64, rows)
+	for i := range embedding {
+		embedding[i] = make([]float64, u.components)
+	}
+
+	for j := 0; j < u.components; j++ {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for i := 0; i < rows; i++ {
+			lo = math.Min(lo, projected.At(i, j))
+			hi = math.Max(hi, projected.At(i, j))
+		}
+
+		for i := 0; i < rows; i++ {
+			if hi > lo {
+				embedding[i][j] = umapInitRange * (projected.At(i, j) - lo) / (hi - lo)
+			} else {
+				// all items are identical in this component, spread them
+				// randomly to allow the optimization to separate them
+				embedding[i][j] = u.rng.Float64() * umapInitRange
+			}
+		}
+	}
+
+	return embedding, nil
+}
+
+func (u *umap) optimize(embedding [][]float64, edges []umapEdge, rows int, a, b float64) {
+	maxWeight := 0.0
+	for _, e := range edges {
+		maxWeight = math.Max(maxWeight, e.weight)
+	}
+
+	// edges with a higher weight are sampled more often, edges which would
+	// not be sampled at all within the given epochs are ignored
+	epochsPerSample := make([]float64, len(edges))
+	for i, e := range edges {
+		epochsPerSample[i] = -1
+		if e.weight >= maxWeight/float64(u.epochs) {
+			epochsPerSample[i] = maxWeight / e.weight
+		}
+	}
+
+	epochsPerNegativeSample := make([]float64, len(edges))
+	nextSample := make([]float64, len(edges))
+	nextNegativeSample := make([]float64, len(edges))
+	for i := range edges {
+		epochsPerNegativeSample[i] = epochsPerSample[i] / umapNegativeSampleRate
+		nextSample[i] = epochsPerSample[i]
+		nextNegativeSample[i] = epochsPerNegativeSample[i]
+	}
+
+	for epoch := 0; epoch < u.epochs; epoch++ {
+		alpha := 1 - float64(epoch)/float64(u.epochs)
+		for i, e := range edges {
+			if epochsPerSample[i] < 0 || nextSample[i] > float64(epoch) {
+				continue
+			}
+
+			head, tail := embedding[e.head], embedding[e.tail]
+			d2 := squaredDistance(head, tail)
+			if d2 > 0 {
+				pb := math.Pow(d2, b)
+				coeff := -2 * a * b * (pb / d2) / (a*pb + 1)
+				for d := range head {
+					grad := clip(coeff * (head[d] - tail[d]))
+					head[d] += grad * alpha
+					tail[d] -= grad * alpha
+				}
+			}
+			nextSample[i] += epochsPerSample[i]
+
+			negatives := int((float64(epoch) - nextNegativeSample[i]) / epochsPerNegativeSample[i])
+			for n := 0; n < negatives; n++ {
+				k := u.rng.Intn(rows)
+				if k == e.head {
+					continue
+				}
+
+				other := embedding[k]
+				d2 := squaredDistance(head, other)
+				coeff := 0.0
+				if d2 > 0 {
+					coeff = 2 * b / ((0.001 + d2) * (a*math.Pow(d2, b) + 1))
+				}
+				for d := range head {
+					grad := umapGradientClip
+					if coeff > 0 {
+						grad = clip(coeff * (head[d] - other[d]))
+					}
+					head[d] += grad * alpha
+				}
+			}
+			nextNegativeSample[i] += float64(negatives) * epochsPerNegativeSample[i]
+		}
+	}
+}
+
+// fitCurve finds a and b, so that 1 / (1 + a*x^(2b)) approximates the
+// membership strength in the embedding given by minDist and spread, using
+// Levenberg-Marquardt least squares
+func fitCurve(minDist, spread float64) (float64, float64) {
+	const samples = 300
+	xs := make([]float64, samples)
+	ys := make([]float64, samples)
+	for i := range xs {
+		xs[i] = 3 * spread * float64(i) / float64(samples-1)
+		if xs[i] < minDist {
+			ys[i] = 1
+		} else {
+			ys[i] = math.Exp(-(xs[i] - minDist) / spread)
+		}
+	}
+
+	cost := func(a, b float64) float64 {
+		var sum float64
+		for i, x := range xs {
+			r := 1/(1+a*math.Pow(x, 2*b)) - ys[i]
+			sum += r * r
+		}
+		return sum
+	}
+
+	a, b, lambda := 1.0, 1.0, 1e-3
+	current := cost(a, b)
+	for iter := 0; iter < 100; iter++ {
+		var jaa, jab, jbb, ga, gb float64
+		for i, x := range xs {
+			if x == 0 {
+				continue
+			}
+			p := math.Pow(x, 2*b)
+			f := 1 / (1 + a*p)
+			r := f - ys[i]
+			da := -p * f * f
+			db := -a * p * 2 * math.Log(x) * f * f
+			jaa += da * da
+			jab += da * db
+			jbb += db * db
+			ga += da * r
+			gb += db * r
+		}
+
+		// solve the damped normal equations for the 2x2 case
+		maa, mbb := jaa*(1+lambda), jbb*(1+lambda)
+		det := maa*mbb - jab*jab
+		if det == 0 {
+			break
+		}
+		stepA := -(mbb*ga - jab*gb) / det
+		stepB := -(maa*gb - jab*ga) / det
+
+		nextA, nextB := a+stepA, b+stepB
+		if nextA > 0 && nextB > 0 {
+			if next := cost(nextA, nextB); next < current {
+				a, b, current = nextA, nextB, next
+				lambda /= 10
+				continue
+			}
+		}
+		lambda *= 10
+	}
+
+	return a, b
+}
+
+func euclidean(a, b []float64) float64 {
+	return math.Sqrt(squaredDistance(a, b))
+}
+
+func squaredDistance(a, b []float64) float64 {
+	var sum float64
+	for i := range a {
+		diff := a[i] - b[i]
+		sum += diff * diff
+	}
+	return sum
+}
+
+func clip(val float64) float64 {
+	return math.Max(-umapGradientClip, math.Min(umapGradientClip, val))
+}
+
+func meanOf(in []float64) float64 {
+	if len(in) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, v := range in {
+		sum += v
+	}
+	return sum / float64(len(in))
+}
+
+func flatten(in [][]float64) []float64 {
+	out := make([]float64, 0, len(in)*len(in[0]))
+	for _, row := range in {
+		out = append(out, row...)
+	}
+	return out
+}
@@ -17,6 +17,7 @@ import (
 	"regexp"
 	"sync"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/tailor-inc/graphql"
@@ -27,6 +28,10 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/querycache"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -44,6 +49,7 @@ type Provider struct {
 	schemaGetter              schemaGetter
 	hasMultipleVectorizers    bool
 	targetVectorNameValidator *regexp.Regexp
+	queryCache                *querycache.Cache
 }
 
 type schemaGetter interface {
@@ -597,7 +603,10 @@ func (p *Provider) additionalExtend(ctx context.Context, in []search.Result,
 						searchVectorValue.SetSearchVector(searchVector)
 						searchValue = searchVectorValue
 					}
-					resArray, err := additionalPropertyFn(ctx, toBeExtended, searchValue, nil, argumentModuleParams, cfg)
+					actx, span := tracing.Start(ctx, "module.AdditionalProperty",
+						attribute.String("property", name), attribute.String("class", class.Class))
+					resArray, err := additionalPropertyFn(actx, toBeExtended, searchValue, nil, argumentModuleParams, cfg)
+					tracing.End(span, err)
 					if err != nil {
 						return nil, errors.Errorf("extend %s: %v", name, err)
 					}
@@ -611,6 +620,13 @@ func (p *Provider) additionalExtend(ctx context.Context, in []search.Result,
 	return toBeExtended, nil
 }
 
+// startModuleSpan starts a span for a call into a module, which typically
+// sends a request to a third-party inference API
+func startModuleSpan(ctx context.Context, name, module, className string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name,
+		attribute.String("module", module), attribute.String("class", className))
+}
+
 func (p *Provider) getClassFromSearchResult(in []search.Result) (*models.Class, error) {
 	if len(in) > 0 {
 		return p.getClass(in[0].ClassName)
@@ -717,10 +733,30 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 			if vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 					cfg := NewClassBasedModuleConfig(class, moduleName, tenant, targetVector)
-					vector, err := searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
+					key, cacheable := p.queryVectorKey(moduleName, cfg, param, params)
+					if cacheable {
+						if vector, ok := p.queryCache.Vector(key); ok {
+							return vector, targetVector, nil
+						}
+						// searches which look up the vectors of objects, e.g.
+						// nearText with moveTo, depend on the data and are not cached
+						findVector := findVectorFn
+						findVectorFn = func(ctx context.Context, className string, id strfmt.UUID,
+							tenant, targetVector string,
+						) ([]float32, string, error) {
+							cacheable = false
+							return findVector(ctx, className, id, tenant, targetVector)
+						}
+					}
+					vctx, span := startModuleSpan(ctx, "module.VectorizeQuery", moduleName, class.Class)
+					vector, err := searchVectorFn(vctx, params, class.Class, findVectorFn, cfg)
+					tracing.End(span, err)
 					if err != nil {
 						return nil, "", errors.Errorf("vectorize params: %v", err)
 					}
+					if cacheable {
+						p.queryCache.AddVector(key, vector)
+					}
 					return vector, targetVector, nil
 				}
 			}
@@ -742,7 +778,9 @@ func (p *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 			if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 					cfg := NewCrossClassModuleConfig()
-					vector, err := searchVectorFn(ctx, params, "", findVectorFn, cfg)
+					vctx, span := startModuleSpan(ctx, "module.VectorizeQuery", mod.Name(), "")
+					vector, err := searchVectorFn(vctx, params, "", findVectorFn, cfg)
+					tracing.End(span, err)
 					if err != nil {
 						return nil, "", errors.Errorf("vectorize params: %v", err)
 					}
@@ -805,7 +843,19 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 				if vectorizer, ok := mod.(modulecapabilities.InputVectorizer); ok {
 					// does not access any objects, therefore tenant is irrelevant
 					cfg := NewClassBasedModuleConfig(class, mod.Name(), "", targetVector)
-					return vectorizer.VectorizeInput(ctx, input, cfg)
+					key, cacheable := p.queryVectorKey(mod.Name(), cfg, "input", input)
+					if cacheable {
+						if vector, ok := p.queryCache.Vector(key); ok {
+							return vector, nil
+						}
+					}
+					vctx, span := startModuleSpan(ctx, "module.VectorizeInput", mod.Name(), class.Class)
+					vector, err := vectorizer.VectorizeInput(vctx, input, cfg)
+					tracing.End(span, err)
+					if err == nil && cacheable {
+						p.queryCache.AddVector(key, vector)
+					}
+					return vector, err
 				}
 			}
 		}
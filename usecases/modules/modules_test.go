@@ -528,6 +528,10 @@ func (m *dummyBackupModuleWithAltNames) SourceDataPath() string {
 	return ""
 }
 
+func (m *dummyBackupModuleWithAltNames) DeleteBackup(ctx context.Context, backupID string) error {
+	return nil
+}
+
 func (*dummyBackupModuleWithAltNames) IsExternal() bool {
 	return true
 }
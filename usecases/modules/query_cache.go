@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"github.com/weaviate/weaviate/usecases/querycache"
+)
+
+// SetQueryCache sets the cache of query vectors. It may be nil to not cache
+// query vectors.
+func (p *Provider) SetQueryCache(cache *querycache.Cache) {
+	p.queryCache = cache
+}
+
+// queryVectorKey returns the cache key of a query vector produced by the
+// module for the given class config. It returns false if the vector must not
+// be cached.
+func (p *Provider) queryVectorKey(moduleName string, cfg *ClassBasedModuleConfig,
+	param string, params interface{},
+) (string, bool) {
+	if p.queryCache == nil {
+		return "", false
+	}
+
+	return querycache.Key(moduleName, cfg.Class(), param, params)
+}
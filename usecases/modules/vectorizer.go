@@ -18,6 +18,7 @@ import (
 	"runtime"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
@@ -25,7 +26,9 @@ import (
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 var _NUMCPU = runtime.NumCPU()
@@ -207,7 +210,11 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 				})
 			}
 		}
-		vectors, addProps, vecErrors := vectorizer.VectorizeBatch(ctx, objects, skipRevectorization, cfg)
+		vctx, span := startModuleSpan(ctx, "module.VectorizeBatch", found.Name(), class.Class)
+		span.SetAttributes(attribute.Int("objects", len(objects)))
+		vectors, addProps, vecErrors := vectorizer.VectorizeBatch(vctx, objects, skipRevectorization, cfg)
+		span.SetAttributes(attribute.Int("errors", len(vecErrors)))
+		span.End()
 		for i := range objects {
 			if _, ok := vecErrors[i]; ok || skipRevectorization[i] {
 				continue
@@ -228,7 +235,9 @@ func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Obje
 		refVectorizer := found.(modulecapabilities.ReferenceVectorizer)
 		errs := make(map[int]error, 0)
 		for i, obj := range objects {
-			vector, err := refVectorizer.VectorizeObject(ctx, obj, cfg, findObjectFn)
+			vctx, span := startModuleSpan(ctx, "module.VectorizeObject", found.Name(), class.Class)
+			vector, err := refVectorizer.VectorizeObject(vctx, obj, cfg, findObjectFn)
+			tracing.End(span, err)
 			if err != nil {
 				errs[i] = fmt.Errorf("update reference vector: %w", err)
 			}
@@ -366,7 +375,9 @@ func (p *Provider) vectorize(ctx context.Context, object *models.Object, class *
 			needsRevectorization, additionalProperties, vector := reVectorize(ctx, cfg, vectorizer, object, class, targetProperties, targetVector, findObjectFn)
 			if needsRevectorization {
 				var err error
-				vector, additionalProperties, err = vectorizer.VectorizeObject(ctx, object, cfg)
+				vctx, span := startModuleSpan(ctx, "module.VectorizeObject", found.Name(), class.Class)
+				vector, additionalProperties, err = vectorizer.VectorizeObject(vctx, object, cfg)
+				tracing.End(span, err)
 				if err != nil {
 					return fmt.Errorf("update vector: %w", err)
 				}
@@ -379,7 +390,9 @@ func (p *Provider) vectorize(ctx context.Context, object *models.Object, class *
 		}
 	} else {
 		refVectorizer := found.(modulecapabilities.ReferenceVectorizer)
-		vector, err := refVectorizer.VectorizeObject(ctx, object, cfg, findObjectFn)
+		vctx, span := startModuleSpan(ctx, "module.VectorizeObject", found.Name(), class.Class)
+		vector, err := refVectorizer.VectorizeObject(vctx, object, cfg, findObjectFn)
+		tracing.End(span, err)
 		if err != nil {
 			return fmt.Errorf("update reference vector: %w", err)
 		}
@@ -462,7 +475,8 @@ func (p *Provider) getVectorIndexConfig(class *models.Class, targetVector string
 	}
 	hnswConfig, okHnsw := vectorIndexConfig.(hnsw.UserConfig)
 	_, okFlat := vectorIndexConfig.(flat.UserConfig)
-	if !(okHnsw || okFlat) {
+	_, okSparse := vectorIndexConfig.(sparse.UserConfig)
+	if !(okHnsw || okFlat || okSparse) {
 		return hnsw.UserConfig{}, fmt.Errorf(errorVectorIndexType, vectorIndexConfig)
 	}
 	return hnswConfig, nil
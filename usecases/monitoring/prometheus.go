@@ -53,6 +53,10 @@ type PrometheusMetrics struct {
 	BackupRestoreFromStorageDurations *prometheus.SummaryVec
 	BackupRestoreDataTransferred      *prometheus.CounterVec
 	BackupStoreDataTransferred        *prometheus.CounterVec
+	BackupScheduleRuns                *prometheus.CounterVec
+	BackupScheduleLastRun             *prometheus.GaugeVec
+	BackupScheduleLastSuccess         *prometheus.GaugeVec
+	BackupSchedulePrunedBackups       *prometheus.CounterVec
 
 	VectorIndexTombstones              *prometheus.GaugeVec
 	VectorIndexTombstoneCleanupThreads *prometheus.GaugeVec
@@ -84,6 +88,10 @@ type PrometheusMetrics struct {
 	TombstoneReassignNeighbors    *prometheus.CounterVec
 	TombstoneDeleteListSize       *prometheus.GaugeVec
 
+	QueryCacheHits    *prometheus.CounterVec
+	QueryCacheMisses  *prometheus.CounterVec
+	QueryCacheEntries *prometheus.GaugeVec
+
 	Group bool
 }
 
@@ -355,6 +363,35 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "backup_store_data_transferred",
 			Help: "Total number of bytes transferred during a backup store",
 		}, []string{"backend_name", "class_name"}),
+		BackupScheduleRuns: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "backup_schedule_runs_total",
+			Help: "Number of finished backups started by a backup schedule",
+		}, []string{"schedule_id", "status"}),
+		BackupScheduleLastRun: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "backup_schedule_last_run_timestamp_seconds",
+			Help: "Unix time when a backup schedule last started a backup",
+		}, []string{"schedule_id"}),
+		BackupScheduleLastSuccess: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "backup_schedule_last_success",
+			Help: "Whether the last finished backup of a backup schedule succeeded (1) or failed (0)",
+		}, []string{"schedule_id"}),
+		BackupSchedulePrunedBackups: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "backup_schedule_pruned_backups_total",
+			Help: "Number of backups deleted by the retention policy of a backup schedule",
+		}, []string{"schedule_id"}),
+
+		QueryCacheHits: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_cache_hits_total",
+			Help: "Number of lookups answered by the query cache",
+		}, []string{"cache"}),
+		QueryCacheMisses: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "query_cache_misses_total",
+			Help: "Number of lookups not answered by the query cache, including stale results",
+		}, []string{"cache"}),
+		QueryCacheEntries: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "query_cache_entries",
+			Help: "Number of entries in the query cache",
+		}, []string{"cache"}),
 
 		// Shard metrics
 		ShardsLoaded: promauto.NewGaugeVec(prometheus.GaugeOpts{
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // AddObject Class Instance to the connected DB.
@@ -32,6 +33,9 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal, ob
 	if err != nil {
 		return nil, err
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return nil, err
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
@@ -102,7 +106,20 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
+	if err := m.quotas.Check(ctx, object.Class, object.Tenant, 1); err != nil {
+		return nil, err
+	}
+	if err := m.vectorizeAndPutObject(ctx, principal, object, repl); err != nil {
+		m.quotas.Release(object.Class, object.Tenant, 1)
+		return nil, err
+	}
+
+	return object, nil
+}
 
+func (m *Manager) vectorizeAndPutObject(ctx context.Context, principal *models.Principal,
+	object *models.Object, repl *additional.ReplicationProperties,
+) error {
 	now := m.timeSource.Now()
 	object.CreationTimeUnix = now
 	object.LastUpdateTimeUnix = now
@@ -111,19 +128,19 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	}
 	class, _, err := m.schemaManager.GetClass(ctx, principal, object.Class)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = m.modulesProvider.UpdateVector(ctx, object, class, m.findObject, m.logger)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	err = m.vectorRepo.PutObject(ctx, object, object.Vector, object.Vectors, repl)
 	if err != nil {
-		return nil, fmt.Errorf("put object: %w", err)
+		return fmt.Errorf("put object: %w", err)
 	}
 
-	return object, nil
+	return nil
 }
 
 func (m *Manager) validateObjectAndNormalizeNames(ctx context.Context,
@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be empty, need at least one object for batching")
@@ -32,7 +33,16 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	if err != nil {
 		return nil, err
 	}
+	if err := b.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return nil, err
+	}
 
+	return b.addObjects(ctx, principal, objects, repl)
+}
+
+func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
@@ -50,8 +60,17 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	}
 
 	batchObjects := b.validateAndGetVector(ctx, principal, objects, repl)
+	// objects without an error passed the quota check, the ones which are not
+	// written in the end have to be released again
+	reserved := map[int]bool{}
+	for _, obj := range batchObjects {
+		if obj.Err == nil {
+			reserved[obj.OriginalIndex] = true
+		}
+	}
 
 	if err := b.autoSchemaManager.autoTenants(ctx, principal, objects); err != nil {
+		b.releaseQuotas(batchObjects, reserved, true)
 		return nil, fmt.Errorf("auto create tenants: %w", err)
 	}
 
@@ -62,12 +81,24 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	beforePersistence := time.Now()
 	defer b.metrics.BatchOp("total_persistence_level", beforePersistence.UnixNano())
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl); err != nil {
+		b.releaseQuotas(batchObjects, reserved, true)
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
+	b.releaseQuotas(res, reserved, false)
 
 	return res, nil
 }
 
+// releaseQuotas releases the quotas of the reserved objects of batch which
+// failed, or of all of them
+func (b *BatchManager) releaseQuotas(batch BatchObjects, reserved map[int]bool, all bool) {
+	for _, obj := range batch {
+		if reserved[obj.OriginalIndex] && (all || obj.Err != nil) {
+			b.quotas.Release(obj.Object.Class, obj.Object.Tenant, 1)
+		}
+	}
+}
+
 func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, repl *additional.ReplicationProperties,
 ) BatchObjects {
@@ -129,6 +160,11 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 			continue
 		}
 
+		if err := b.quotas.Check(ctx, obj.Class, obj.Tenant, 1); err != nil {
+			batchObjects[i].Err = err
+			continue
+		}
+
 		if objectsPerClass[obj.Class] == nil {
 			objectsPerClass[obj.Class] = make([]*models.Object, 0)
 			originalIndexPerClass[obj.Class] = make([]int, 0)
@@ -141,13 +177,17 @@ func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *mode
 		class := classPerClassName[className]
 		errorsPerObj, err := b.modulesProvider.BatchUpdateVector(ctx, class, objectsForClass, b.findObject, b.logger)
 		if err != nil {
-			for i := range objectsForClass {
+			for i, obj := range objectsForClass {
 				origIndex := originalIndexPerClass[className][i]
 				batchObjects[origIndex].Err = err
+				b.quotas.Release(obj.Class, obj.Tenant, 1)
 			}
 		}
 		for i, err := range errorsPerObj {
 			origIndex := originalIndexPerClass[className][i]
+			if err != nil && batchObjects[origIndex].Err == nil {
+				b.quotas.Release(className, objectsForClass[i].Tenant, 1)
+			}
 			batchObjects[origIndex].Err = err
 		}
 
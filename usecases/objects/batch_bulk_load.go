@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+// BulkLoad imports all objects read from r into the given class. Unlike
+// AddObjects, the objects are written as sorted segments of staging shards
+// directly, bypassing memtables and the write-ahead log, and the vector
+// indexes are built in bulk. The staging shards replace the shards of the
+// class once complete. If
+// the node crashes before, the staged objects are discarded and the bulk load
+// has to be repeated. The affected shards must be local to this node and
+// empty. Vectors are taken from the file as they are, vectorizer modules are
+// not called.
+func (b *BatchManager) BulkLoad(ctx context.Context, principal *models.Principal,
+	className string, r objectfile.Reader,
+) (int, error) {
+	err := b.authorizer.Authorize(principal, "create", "batch/objects")
+	if err != nil {
+		return 0, err
+	}
+	if err := b.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return 0, err
+	}
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return 0, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	class, _, err := b.schemaManager.GetClass(ctx, principal, className)
+	if err != nil {
+		return 0, err
+	}
+	if class == nil {
+		return 0, NewErrInvalidUserInput("class '%v' not present in schema", className)
+	}
+
+	validated := &bulkLoadReader{
+		ctx:       ctx,
+		r:         r,
+		class:     class,
+		validator: validation.New(b.vectorRepo.Exists, b.config, nil),
+		quotas:    b.quotas,
+		now:       time.Now().UnixNano() / int64(time.Millisecond),
+	}
+	count, err := b.vectorRepo.BulkLoadObjects(ctx, class.Class, validated)
+	if err != nil {
+		// none of the objects were written
+		for tenant, n := range validated.reserved {
+			b.quotas.Release(class.Class, tenant, n)
+		}
+		var inputErr ErrInvalidUserInput
+		if errors.As(err, &inputErr) {
+			return 0, NewErrInvalidUserInput("bulk load: %v", err)
+		}
+		return 0, NewErrInternal("bulk load: %v", err)
+	}
+	return count, nil
+}
+
+// bulkLoadReader validates objects as they are read, the same way as objects
+// added through a batch.
+type bulkLoadReader struct {
+	ctx       context.Context
+	r         objectfile.Reader
+	class     *models.Class
+	validator *validation.Validator
+	quotas    *Quotas
+	now       int64
+	reserved  map[string]int64 // objects which passed the quota check, by tenant
+}
+
+func (r *bulkLoadReader) Read() (*models.Object, error) {
+	obj, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Class != "" && obj.Class != r.class.Class {
+		return nil, NewErrInvalidUserInput("object %s: class '%v' does not match '%v'",
+			obj.ID, obj.Class, r.class.Class)
+	}
+	obj.Class = r.class.Class
+
+	if obj.ID == "" {
+		if obj.ID, err = generateUUID(); err != nil {
+			return nil, err
+		}
+	} else if _, err := uuid.Parse(obj.ID.String()); err != nil {
+		return nil, NewErrInvalidUserInput("object %s: %v", obj.ID, err)
+	}
+	if obj.Properties == nil {
+		obj.Properties = map[string]interface{}{}
+	}
+	if obj.CreationTimeUnix == 0 {
+		obj.CreationTimeUnix = r.now
+	}
+	if obj.LastUpdateTimeUnix == 0 {
+		obj.LastUpdateTimeUnix = obj.CreationTimeUnix
+	}
+
+	if err := r.validator.Object(r.ctx, r.class, obj, nil); err != nil {
+		return nil, NewErrInvalidUserInput("object %s: %v", obj.ID, err)
+	}
+	if err := r.quotas.Check(r.ctx, obj.Class, obj.Tenant, 1); err != nil {
+		return nil, err
+	}
+	if r.reserved == nil {
+		r.reserved = map[string]int64{}
+	}
+	r.reserved[obj.Tenant]++
+	return obj, nil
+}
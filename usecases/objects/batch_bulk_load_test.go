@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_BulkLoad(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		authorizer *fakeAuthorizer
+		manager    *BatchManager
+	)
+	ctx := context.Background()
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		authorizer = &fakeAuthorizer{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{{
+					Class:             "Foo",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{{
+						Name:     "name",
+						DataType: schema.DataTypeText.PropString(),
+					}},
+				}},
+			}},
+		}
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, getFakeModulesProvider(), &fakeLocks{},
+			schemaManager, &config.WeaviateConfig{}, logger, authorizer, nil)
+	}
+	reader := func(lines string) objectfile.Reader {
+		return objectfile.NewJSONLReader(bytes.NewBufferString(lines))
+	}
+
+	t.Run("valid objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BulkLoadObjects", "Foo", mock.Anything).Return(nil).Once()
+
+		count, err := manager.BulkLoad(ctx, nil, "Foo", reader(
+			`{"id":"8d5a3aa2-3c8d-4589-9ae1-3f638f506970","properties":{"name":"a"},"vector":[1,2]}
+			{"class":"Foo","properties":{"name":"b"},"creationTimeUnix":42}`))
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+
+		objs := vectorRepo.Calls[0].Arguments[1].([]*models.Object)
+		require.Len(t, objs, 2)
+		assert.Equal(t, "Foo", objs[0].Class)
+		assert.NotZero(t, objs[0].CreationTimeUnix)
+		assert.Equal(t, objs[0].CreationTimeUnix, objs[0].LastUpdateTimeUnix)
+		assert.Len(t, objs[1].ID, 36, "a uuid was set for the second object")
+		assert.Equal(t, int64(42), objs[1].LastUpdateTimeUnix)
+	})
+
+	for name, lines := range map[string]string{
+		"object of another class": `{"class":"Bar"}`,
+		"invalid id":              `{"id":"not-a-uuid"}`,
+		"invalid property":        `{"properties":{"name":17}}`,
+		"unknown property":        `{"properties":{"unknown":"a"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			reset()
+
+			_, err := manager.BulkLoad(ctx, nil, "Foo", reader(lines))
+			var inputErr ErrInvalidUserInput
+			assert.ErrorAs(t, err, &inputErr)
+			assert.Len(t, vectorRepo.Calls, 0)
+		})
+	}
+
+	t.Run("unknown class", func(t *testing.T) {
+		reset()
+
+		_, err := manager.BulkLoad(ctx, nil, "Bar", reader(`{}`))
+		assert.Equal(t, NewErrInvalidUserInput("class 'Bar' not present in schema"), err)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		reset()
+		authorizer.Err = NewErrInternal("forbidden")
+
+		_, err := manager.BulkLoad(ctx, nil, "Foo", reader(`{}`))
+		assert.Equal(t, authorizer.Err, err)
+	})
+}
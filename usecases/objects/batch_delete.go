@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // DeleteObjects deletes objects in batch based on the match filter
@@ -33,6 +34,9 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, err
 	}
+	if err := b.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return nil, err
+	}
 
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
@@ -55,6 +59,9 @@ func (b *BatchManager) DeleteObjectsFromGRPC(ctx context.Context, principal *mod
 	if err != nil {
 		return BatchDeleteResult{}, err
 	}
+	if err := b.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return BatchDeleteResult{}, err
+	}
 
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+// importBatchSize is the number of objects passed to AddObjects at once
+const importBatchSize = 1000
+
+type ImportParams struct {
+	Class  string
+	Tenant string
+	Repl   *additional.ReplicationProperties
+}
+
+// ImportObjects adds all objects read from r through AddObjects. The class
+// and tenant of the objects are replaced with the ones of params, so that an
+// export can be imported into a differently named class. The result holds
+// one entry per object, in the order in which they were read.
+func (b *BatchManager) ImportObjects(ctx context.Context, principal *models.Principal,
+	params ImportParams, r objectfile.Reader,
+) (BatchObjects, error) {
+	err := b.authorizer.Authorize(principal, "create", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+	if err := b.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return nil, err
+	}
+
+	var out BatchObjects
+	batch := make([]*models.Object, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		res, err := b.addObjects(ctx, principal, batch, params.Repl)
+		if err != nil {
+			return err
+		}
+		for i := range res {
+			res[i].OriginalIndex += len(out)
+		}
+		out = append(out, res...)
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		obj, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return out, NewErrInvalidUserInput("read object %d: %v", len(out)+len(batch), err)
+		}
+
+		obj.Class = params.Class
+		obj.Tenant = params.Tenant
+		batch = append(batch, obj)
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				return out, err
+			}
+		}
+	}
+
+	return out, flush()
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectfile"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_ImportObjects(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+	ctx := context.Background()
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+				Classes: []*models.Class{{
+					Class:             "Foo",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{{
+						Name:     "name",
+						DataType: schema.DataTypeText.PropString(),
+					}},
+				}},
+			}},
+		}
+		logger, _ := test.NewNullLogger()
+		cfg := &config.WeaviateConfig{}
+		cfg.Config.TrackVectorDimensions = true
+		modulesProvider = getFakeModulesProvider()
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			schemaManager, cfg, logger, &fakeAuthorizer{}, nil)
+	}
+
+	t.Run("objects are imported in batches into the target class", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+
+		var lines strings.Builder
+		for i := 0; i < importBatchSize+1; i++ {
+			// the exported class and tenant are replaced
+			fmt.Fprintf(&lines, `{"class":"Exported","tenant":"t","properties":{"name":"%d"},"vector":[1,2]}`+"\n", i)
+		}
+		// the last object is invalid
+		lines.WriteString(`{"properties":{"name":17}}`)
+
+		res, err := manager.ImportObjects(ctx, nil, ImportParams{Class: "Foo"},
+			objectfile.NewJSONLReader(strings.NewReader(lines.String())))
+		require.Nil(t, err)
+		require.Len(t, res, importBatchSize+2)
+		for i, obj := range res[:importBatchSize+1] {
+			require.Nil(t, obj.Err)
+			assert.Equal(t, i, obj.OriginalIndex)
+			assert.Equal(t, "Foo", obj.Object.Class)
+			assert.Equal(t, "", obj.Object.Tenant)
+		}
+		assert.NotNil(t, res[importBatchSize+1].Err)
+		assert.Equal(t, importBatchSize+1, res[importBatchSize+1].OriginalIndex)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("unreadable input", func(t *testing.T) {
+		reset()
+
+		_, err := manager.ImportObjects(ctx, nil, ImportParams{Class: "Foo"},
+			objectfile.NewJSONLReader(bytes.NewBufferString(`{"class":`)))
+		var inputErr ErrInvalidUserInput
+		assert.ErrorAs(t, err, &inputErr)
+		assert.Len(t, vectorRepo.Calls, 0)
+	})
+}
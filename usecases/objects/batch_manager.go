@@ -16,8 +16,10 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/objectfile"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // BatchManager manages kind changes in batch at a use-case level , i.e.
@@ -32,6 +34,14 @@ type BatchManager struct {
 	modulesProvider   ModulesProvider
 	autoSchemaManager *autoSchemaManager
 	metrics           *Metrics
+	rateLimits        *ratelimiter.Principals
+	quotas            *Quotas
+}
+
+// SetLimits sets the per-principal rate limits and the storage quotas. Both
+// may be nil to not limit requests.
+func (b *BatchManager) SetLimits(rateLimits *ratelimiter.Principals, quotas *Quotas) {
+	b.rateLimits, b.quotas = rateLimits, quotas
 }
 
 type BatchVectorRepo interface {
@@ -46,6 +56,8 @@ type batchRepoNew interface {
 		repl *additional.ReplicationProperties, tenant string) (BatchDeleteResult, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences,
 		repl *additional.ReplicationProperties) (BatchReferences, error)
+	BulkLoadObjects(ctx context.Context, className string,
+		r objectfile.Reader) (int, error)
 }
 
 // NewBatchManager creates a new manager
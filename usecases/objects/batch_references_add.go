@@ -18,6 +18,7 @@ import (
 	"sync"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -34,6 +35,9 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, err
 	}
+	if err := b.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return nil, err
+	}
 
 	unlock, err := b.locks.LockSchema()
 	if err != nil {
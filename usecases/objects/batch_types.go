@@ -40,6 +40,16 @@ type BatchObject struct {
 // type using the .Response() method
 type BatchObjects []BatchObject
 
+// Succeeded returns the number of objects without an error
+func (b BatchObjects) Succeeded() (n int64) {
+	for _, obj := range b {
+		if obj.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
 // BatchReference is a helper type that groups all the info about one references in a
 // batch that belongs together, i.e. from, to, original index and error state
 //
@@ -62,6 +72,16 @@ type BatchReference struct {
 // type using the .Response() method
 type BatchReferences []BatchReference
 
+// Succeeded returns the number of references without an error
+func (b BatchReferences) Succeeded() (n int64) {
+	for _, ref := range b {
+		if ref.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
 type BatchSimpleObject struct {
 	UUID strfmt.UUID
 	Err  error
@@ -69,6 +89,16 @@ type BatchSimpleObject struct {
 
 type BatchSimpleObjects []BatchSimpleObject
 
+// Succeeded returns the number of objects without an error
+func (b BatchSimpleObjects) Succeeded() (n int64) {
+	for _, obj := range b {
+		if obj.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
 type BatchDeleteParams struct {
 	ClassName schema.ClassName     `json:"className"`
 	Filters   *filters.LocalFilter `json:"filters"`
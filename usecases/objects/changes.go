@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+type ChangeStreamParams struct {
+	Class  string
+	Tenant string
+	// Offsets contains the offset of the next event to receive per shard.
+	// Shards without an entry start at their oldest retained event.
+	Offsets map[string]uint64
+	// IncludeObject loads the current state of the object for every put and
+	// merge event
+	IncludeObject bool
+}
+
+// StreamChanges passes all mutations of the objects of a class (or a single
+// tenant) stored on this node to fn, starting at the requested offsets. It
+// blocks and keeps streaming new events until ctx is cancelled or fn returns
+// an error.
+func (m *Manager) StreamChanges(ctx context.Context, principal *models.Principal,
+	params ChangeStreamParams, fn func(shard string, ev changelog.Event) error,
+) error {
+	path := fmt.Sprintf("objects/%s", params.Class)
+	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
+		return err
+	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return err
+	}
+
+	if m.schemaManager.ReadOnlyClass(params.Class) == nil {
+		return NewErrNotFound("class %q not found", params.Class)
+	}
+
+	return m.vectorRepo.StreamChanges(ctx, params.Class, params.Tenant, params.Offsets, params.IncludeObject, fn)
+}
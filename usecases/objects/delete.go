@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // DeleteObject Class Instance from the connected DB
@@ -37,6 +38,9 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return err
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -21,6 +21,7 @@ const (
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
 	StatusUnprocessableEntity = 422
+	StatusTooManyRequests     = 429
 	StatusInternalServerError = 500
 )
 
@@ -56,6 +57,10 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+func (e *Error) TooManyRequests() bool {
+	return e.Code == StatusTooManyRequests
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+)
+
+// DefaultExportBatchSize is the number of objects per page of an export, if
+// not specified otherwise.
+const DefaultExportBatchSize = 1000
+
+type ExportParams struct {
+	Class   string
+	Tenant  string
+	Filters *filters.LocalFilter
+	// After resumes an interrupted export after the object with this UUID,
+	// as passed to the callback of that export.
+	After     string
+	BatchSize int
+}
+
+// ExportObjects pages through the objects of a class (or a single tenant)
+// matching the optional filter in the order of their UUIDs. Each page is
+// passed to fn together with the UUID of its last object, which can be used
+// as ExportParams.After to resume the export later on.
+func (m *Manager) ExportObjects(ctx context.Context, principal *models.Principal,
+	params ExportParams, fn func(objs []*models.Object, cursor string) error,
+) error {
+	path := fmt.Sprintf("objects/%s", params.Class)
+	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
+		return err
+	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return err
+	}
+
+	if m.schemaManager.ReadOnlyClass(params.Class) == nil {
+		return NewErrNotFound("class %q not found", params.Class)
+	}
+
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultExportBatchSize
+	}
+
+	after := params.After
+	for {
+		objs, err := m.vectorRepo.ExportObjects(ctx, params.Class, params.Tenant,
+			params.Filters, after, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+
+		after = objs[len(objs)-1].ID.String()
+		if err := fn(objs, after); err != nil {
+			return err
+		}
+		if len(objs) < batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_ExportObjects(t *testing.T) {
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{Class: "Foo"}}}}
+	ids := []strfmt.UUID{
+		"00000000-0000-0000-0000-000000000001",
+		"00000000-0000-0000-0000-000000000002",
+		"00000000-0000-0000-0000-000000000003",
+	}
+	page := func(ids ...strfmt.UUID) []*models.Object {
+		out := make([]*models.Object, len(ids))
+		for i, id := range ids {
+			out[i] = &models.Object{Class: "Foo", ID: id}
+		}
+		return out
+	}
+	ctx := context.Background()
+
+	t.Run("pages through the class", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("ExportObjects", "Foo", "", (*filters.LocalFilter)(nil), "", 2).
+			Return(page(ids[0], ids[1]), nil).Once()
+		m.repo.On("ExportObjects", "Foo", "", (*filters.LocalFilter)(nil), ids[1].String(), 2).
+			Return(page(ids[2]), nil).Once()
+
+		var cursors []string
+		var count int
+		err := m.ExportObjects(ctx, nil, ExportParams{Class: "Foo", BatchSize: 2},
+			func(objs []*models.Object, cursor string) error {
+				count += len(objs)
+				cursors = append(cursors, cursor)
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, 3, count)
+		assert.Equal(t, []string{ids[1].String(), ids[2].String()}, cursors)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("resumes after cursor with full last page", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("ExportObjects", "Foo", "tenant1", (*filters.LocalFilter)(nil), ids[0].String(), 2).
+			Return(page(ids[1], ids[2]), nil).Once()
+		m.repo.On("ExportObjects", "Foo", "tenant1", (*filters.LocalFilter)(nil), ids[2].String(), 2).
+			Return(page(), nil).Once()
+
+		var calls int
+		err := m.ExportObjects(ctx, nil, ExportParams{
+			Class: "Foo", Tenant: "tenant1", After: ids[0].String(), BatchSize: 2,
+		}, func(objs []*models.Object, cursor string) error {
+			calls++
+			return nil
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 1, calls)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("callback error aborts the export", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("ExportObjects", "Foo", "", (*filters.LocalFilter)(nil), "", DefaultExportBatchSize).
+			Return(page(ids...), nil).Once()
+
+		sendErr := errors.New("client went away")
+		err := m.ExportObjects(ctx, nil, ExportParams{Class: "Foo"},
+			func(objs []*models.Object, cursor string) error { return sendErr })
+		assert.Equal(t, sendErr, err)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		err := m.ExportObjects(ctx, nil, ExportParams{Class: "Bar"},
+			func(objs []*models.Object, cursor string) error { return nil })
+		assert.Equal(t, NewErrNotFound("class %q not found", "Bar"), err)
+	})
+}
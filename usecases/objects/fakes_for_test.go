@@ -14,6 +14,7 @@ package objects
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -25,10 +26,12 @@ import (
 	"github.com/tailor-inc/graphql/language/ast"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/objectfile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -248,6 +251,26 @@ func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchRefe
 	return batch, args.Error(0)
 }
 
+// BulkLoadObjects reads all objects, like the real repo, so that validation
+// errors of the reader surface.
+func (f *fakeVectorRepo) BulkLoadObjects(ctx context.Context, className string,
+	r objectfile.Reader,
+) (int, error) {
+	var objs []*models.Object
+	for {
+		obj, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("read object %d: %w", len(objs), err)
+		}
+		objs = append(objs, obj)
+	}
+	args := f.Called(className, objs)
+	return len(objs), args.Error(0)
+}
+
 func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
 	repl *additional.ReplicationProperties, tenant string,
 ) (BatchDeleteResult, error) {
@@ -867,3 +890,17 @@ func (f *fakeMetrics) DeleteReferenceDec() {
 func (f *fakeMetrics) AddUsageDimensions(className, queryType, op string, dims int) {
 	f.Mock.MethodCalled("AddUsageDimensions", className, queryType, op, dims)
 }
+
+func (f *fakeVectorRepo) StreamChanges(ctx context.Context, class, tenant string,
+	offsets map[string]uint64, includeObject bool, fn func(shard string, ev changelog.Event) error,
+) error {
+	args := f.Called(class, tenant, offsets)
+	return args.Error(0)
+}
+
+func (f *fakeVectorRepo) ExportObjects(ctx context.Context, class, tenant string,
+	filters *filters.LocalFilter, after string, limit int,
+) ([]*models.Object, error) {
+	args := f.Called(class, tenant, filters, after, limit)
+	return args.Get(0).([]*models.Object), args.Error(1)
+}
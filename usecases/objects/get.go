@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // GetObject Class from the connected DB
@@ -37,6 +38,9 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return nil, err
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -68,6 +72,9 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return nil, err
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // HeadObject check object's existence in the connected DB
@@ -31,6 +32,9 @@ func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, c
 	if err := m.authorizer.Authorize(principal, "head", path); err != nil {
 		return false, &Error{path, StatusForbidden, err}
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return false, &Error{path, StatusTooManyRequests, err}
+	}
 
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
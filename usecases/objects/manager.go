@@ -24,6 +24,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -32,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 type schemaManager interface {
@@ -72,6 +74,8 @@ type Manager struct {
 	autoSchemaManager *autoSchemaManager
 	metrics           objectsMetrics
 	allocChecker      *memwatch.Monitor
+	rateLimits        *ratelimiter.Principals
+	quotas            *Quotas
 }
 
 type objectsMetrics interface {
@@ -135,6 +139,10 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	StreamChanges(ctx context.Context, class, tenant string, offsets map[string]uint64,
+		includeObject bool, fn func(shard string, ev changelog.Event) error) error
+	ExportObjects(ctx context.Context, class, tenant string, filters *filters.LocalFilter,
+		after string, limit int) ([]*models.Object, error)
 }
 
 type ModulesProvider interface {
@@ -176,6 +184,12 @@ func NewManager(locks locks, schemaManager schemaManager,
 	}
 }
 
+// SetLimits sets the per-principal rate limits and the storage quotas. Both
+// may be nil to not limit requests.
+func (m *Manager) SetLimits(rateLimits *ratelimiter.Principals, quotas *Quotas) {
+	m.rateLimits, m.quotas = rateLimits, quotas
+}
+
 func generateUUID() (strfmt.UUID, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 type MergeDocument struct {
@@ -47,6 +48,9 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return &Error{path, StatusTooManyRequests, err}
+	}
 
 	m.metrics.MergeObjectInc()
 	defer m.metrics.MergeObjectDec()
@@ -91,6 +95,9 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 	if updates.Properties == nil {
 		updates.Properties = map[string]interface{}{}
 	}
+	if err := m.quotas.Check(ctx, updates.Class, updates.Tenant, 0); err != nil {
+		return &Error{path, StatusTooManyRequests, err}
+	}
 
 	return m.patchObject(ctx, principal, prevObj, updates, repl, propertiesToDelete, updates.Tenant)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 type QueryInput struct {
@@ -70,6 +71,9 @@ func (m *Manager) Query(ctx context.Context, principal *models.Principal, params
 	if err := m.authorizer.Authorize(principal, "list", path); err != nil {
 		return nil, &Error{path, StatusForbidden, err}
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return nil, &Error{path, StatusTooManyRequests, err}
+	}
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, &Error{"cannot lock", StatusInternalServerError, err}
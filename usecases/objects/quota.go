@@ -0,0 +1,225 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+)
+
+// AllTenants as the tenant of a quota limits each tenant of the class
+const AllTenants = "*"
+
+// Quota limits the objects stored in a class or, if Tenant is set, in a
+// tenant of the class. Zero values don't limit.
+type Quota struct {
+	Class      string `json:"class" yaml:"class"`
+	Tenant     string `json:"tenant,omitempty" yaml:"tenant"`
+	MaxObjects int64  `json:"maxObjects,omitempty" yaml:"maxObjects"`
+	MaxBytes   int64  `json:"maxBytes,omitempty" yaml:"maxBytes"`
+}
+
+func (q Quota) appliesTo(tenant string) bool {
+	return q.Tenant == "" || q.Tenant == tenant || (q.Tenant == AllTenants && tenant != "")
+}
+
+// ErrQuotaExceeded is returned if a write would exceed a quota
+type ErrQuotaExceeded struct {
+	Quota   Quota
+	Tenant  string
+	Objects int64
+	Bytes   int64
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	scope := fmt.Sprintf("class %q", e.Quota.Class)
+	if e.Quota.Tenant != "" {
+		scope = fmt.Sprintf("tenant %q of class %q", e.Tenant, e.Quota.Class)
+	}
+	if e.Quota.MaxBytes > 0 && e.Bytes >= e.Quota.MaxBytes {
+		return fmt.Sprintf("quota exceeded: %s stores %d bytes, maximum is %d",
+			scope, e.Bytes, e.Quota.MaxBytes)
+	}
+	return fmt.Sprintf("quota exceeded: %s would store %d objects, maximum is %d",
+		scope, e.Objects, e.Quota.MaxObjects)
+}
+
+type usageReporter interface {
+	GetNodeStatus(ctx context.Context, className, output string) ([]*models.NodeStatus, error)
+}
+
+type usage struct {
+	objects int64
+	bytes   int64
+}
+
+// classUsage is the usage of a class and its tenants. Objects written since
+// the last refresh are counted as pending.
+type classUsage struct {
+	refreshed time.Time
+	total     usage
+	tenants   map[string]usage
+	pending   map[string]int64 // by tenant, "" for the class
+}
+
+// Quotas enforces storage quotas. Usage is taken from the node status of
+// the cluster and refreshed at most every refreshInterval. Objects written
+// in between are added to the usage, so that bursts of writes can't exceed
+// quotas by much. Sizes only include objects flushed to disk. A nil Quotas
+// doesn't limit anything.
+type Quotas struct {
+	sync.Mutex
+	quotas          map[string][]Quota // by class
+	usage           map[string]*classUsage
+	reporter        usageReporter
+	refreshInterval time.Duration
+	now             func() time.Time
+}
+
+// NewQuotas creates quotas checking the usage reported by reporter
+func NewQuotas(quotas []Quota, reporter usageReporter, refreshInterval time.Duration) *Quotas {
+	q := &Quotas{
+		reporter:        reporter,
+		refreshInterval: refreshInterval,
+		now:             time.Now,
+	}
+	q.SetQuotas(quotas)
+	return q
+}
+
+// SetQuotas replaces the quotas at runtime
+func (q *Quotas) SetQuotas(quotas []Quota) {
+	byClass := map[string][]Quota{}
+	for _, quota := range quotas {
+		byClass[quota.Class] = append(byClass[quota.Class], quota)
+	}
+	q.Lock()
+	defer q.Unlock()
+	q.quotas = byClass
+}
+
+// Check returns ErrQuotaExceeded if writing n new objects to tenant of class
+// would exceed one of its quotas. Otherwise the objects are counted as
+// pending, writes which fail afterwards must Release them. Updates of
+// existing objects pass n = 0, they are only rejected if a size quota is
+// already exceeded.
+func (q *Quotas) Check(ctx context.Context, class, tenant string, n int64) error {
+	if q == nil {
+		return nil
+	}
+	q.Lock()
+	quotas := q.quotas[class]
+	q.Unlock()
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	u, err := q.classUsage(ctx, class)
+	if err != nil {
+		return fmt.Errorf("check quota: %w", err)
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	for _, quota := range quotas {
+		if !quota.appliesTo(tenant) {
+			continue
+		}
+		used, pending := u.total, u.pending[""]
+		if quota.Tenant != "" {
+			used, pending = u.tenants[tenant], u.pending[tenant]
+		}
+		objects := used.objects + pending + n
+		if quota.MaxObjects > 0 && n > 0 && objects > quota.MaxObjects {
+			return ErrQuotaExceeded{Quota: quota, Tenant: tenant, Objects: objects, Bytes: used.bytes}
+		}
+		if quota.MaxBytes > 0 && used.bytes >= quota.MaxBytes {
+			return ErrQuotaExceeded{Quota: quota, Tenant: tenant, Objects: objects, Bytes: used.bytes}
+		}
+	}
+
+	if n > 0 {
+		u.pending[""] += n
+		if tenant != "" {
+			u.pending[tenant] += n
+		}
+	}
+	return nil
+}
+
+// Release stops counting n objects as pending which passed Check for tenant
+// of class, but were not written. If the usage was refreshed in between, the
+// objects were dropped from pending already, which never gets negative.
+func (q *Quotas) Release(class, tenant string, n int64) {
+	if q == nil || n <= 0 {
+		return
+	}
+	q.Lock()
+	defer q.Unlock()
+	u, ok := q.usage[class]
+	if !ok {
+		return
+	}
+	u.pending[""] = max(u.pending[""]-n, 0)
+	if tenant != "" {
+		u.pending[tenant] = max(u.pending[tenant]-n, 0)
+	}
+}
+
+// classUsage returns the usage of class, refreshed if it is outdated
+func (q *Quotas) classUsage(ctx context.Context, class string) (*classUsage, error) {
+	q.Lock()
+	u, ok := q.usage[class]
+	q.Unlock()
+	if ok && q.now().Sub(u.refreshed) < q.refreshInterval {
+		return u, nil
+	}
+
+	nodes, err := q.reporter.GetNodeStatus(ctx, class, verbosity.OutputVerbose)
+	if err != nil {
+		return nil, err
+	}
+	u = &classUsage{
+		refreshed: q.now(),
+		tenants:   map[string]usage{},
+		pending:   map[string]int64{},
+	}
+	for _, node := range nodes {
+		for _, shard := range node.Shards {
+			if shard.Class != class {
+				continue
+			}
+			// every replica reports the shard, count it once
+			s := u.tenants[shard.Name]
+			s.objects = max(s.objects, shard.ObjectCount)
+			s.bytes = max(s.bytes, shard.ObjectStorageBytes)
+			u.tenants[shard.Name] = s
+		}
+	}
+	for _, s := range u.tenants {
+		u.total.objects += s.objects
+		u.total.bytes += s.bytes
+	}
+
+	q.Lock()
+	defer q.Unlock()
+	if q.usage == nil {
+		q.usage = map[string]*classUsage{}
+	}
+	q.usage[class] = u
+	return u, nil
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeUsageReporter struct {
+	nodes []*models.NodeStatus
+	calls int
+}
+
+func (f *fakeUsageReporter) GetNodeStatus(ctx context.Context, className, output string,
+) ([]*models.NodeStatus, error) {
+	f.calls++
+	return f.nodes, nil
+}
+
+func TestQuotas(t *testing.T) {
+	ctx := context.Background()
+	shard := func(class, name string, objects, bytes int64) *models.NodeShardStatus {
+		return &models.NodeShardStatus{
+			Class: class, Name: name, ObjectCount: objects, ObjectStorageBytes: bytes,
+		}
+	}
+	reporter := &fakeUsageReporter{nodes: []*models.NodeStatus{
+		{Shards: []*models.NodeShardStatus{
+			shard("Article", "tenant1", 8, 100),
+			shard("Article", "tenant2", 2, 900),
+		}},
+		// replicas are only counted once
+		{Shards: []*models.NodeShardStatus{
+			shard("Article", "tenant1", 7, 100),
+		}},
+	}}
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	q := NewQuotas([]Quota{
+		{Class: "Article", MaxObjects: 12},
+		{Class: "Article", Tenant: AllTenants, MaxBytes: 500},
+	}, reporter, time.Minute)
+	q.now = func() time.Time { return now }
+
+	// classes without quotas
+	require.Nil(t, q.Check(ctx, "Paragraph", "", 100))
+	assert.Equal(t, 0, reporter.calls)
+
+	// within the class quota, writes are counted as pending
+	require.Nil(t, q.Check(ctx, "Article", "tenant1", 1))
+	require.Nil(t, q.Check(ctx, "Article", "tenant1", 1))
+	err := q.Check(ctx, "Article", "tenant1", 1)
+	assert.Equal(t, ErrQuotaExceeded{
+		Quota: Quota{Class: "Article", MaxObjects: 12}, Tenant: "tenant1", Objects: 13, Bytes: 1000,
+	}, err)
+
+	// updates are allowed at the object limit
+	require.Nil(t, q.Check(ctx, "Article", "tenant1", 0))
+
+	// tenants exceeding their size can't be written to
+	err = q.Check(ctx, "Article", "tenant2", 0)
+	assert.Equal(t, ErrQuotaExceeded{
+		Quota:  Quota{Class: "Article", Tenant: AllTenants, MaxBytes: 500},
+		Tenant: "tenant2", Objects: 2, Bytes: 900,
+	}, err)
+	assert.EqualError(t, err, `quota exceeded: tenant "tenant2" of class "Article" stores 900 bytes, maximum is 500`)
+	assert.Equal(t, 1, reporter.calls)
+
+	// usage is refreshed after the interval, which resets pending writes
+	reporter.nodes[0].Shards[0].ObjectCount = 5
+	reporter.nodes[1].Shards[0].ObjectCount = 5
+	now = now.Add(time.Minute)
+	require.Nil(t, q.Check(ctx, "Article", "tenant1", 5))
+	assert.Equal(t, 2, reporter.calls)
+
+	// quotas are replaced at runtime
+	q.SetQuotas(nil)
+	require.Nil(t, q.Check(ctx, "Article", "tenant2", 100))
+}
+
+func TestQuotasNil(t *testing.T) {
+	var q *Quotas
+	assert.Nil(t, q.Check(context.Background(), "Article", "", 1))
+}
+
+func TestQuotasRelease(t *testing.T) {
+	ctx := context.Background()
+	sch := schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+		{Class: "Foo", Vectorizer: config.VectorizerModuleNone, VectorIndexConfig: hnsw.UserConfig{}},
+	}}}
+	newQuotas := func() *Quotas {
+		return NewQuotas([]Quota{{Class: "Foo", MaxObjects: 1}}, &fakeUsageReporter{}, time.Minute)
+	}
+	cfg := &config.WeaviateConfig{}
+	logger, _ := test.NewNullLogger()
+
+	t.Run("released objects are no longer pending", func(t *testing.T) {
+		q := newQuotas()
+		require.Nil(t, q.Check(ctx, "Foo", "", 1))
+		require.NotNil(t, q.Check(ctx, "Foo", "", 1))
+		q.Release("Foo", "", 1)
+		require.Nil(t, q.Check(ctx, "Foo", "", 1))
+	})
+
+	t.Run("failed object is released", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(errors.New("disk full"))
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(nil, nil)
+		manager := NewManager(&fakeLocks{}, &fakeSchemaManager{GetSchemaResponse: sch}, cfg,
+			logger, &fakeAuthorizer{}, vectorRepo, modulesProvider, &fakeMetrics{}, nil)
+		q := newQuotas()
+		manager.SetLimits(nil, q)
+
+		for i := 0; i < 2; i++ {
+			_, err := manager.AddObject(ctx, nil, &models.Object{Class: "Foo"}, nil)
+			assert.ErrorContains(t, err, "disk full")
+		}
+		assert.Nil(t, q.Check(ctx, "Foo", "", 1))
+	})
+
+	t.Run("failed batch is released", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(errors.New("disk full"))
+		modulesProvider := getFakeModulesProvider()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		manager := NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: sch}, cfg, logger, &fakeAuthorizer{}, nil)
+		q := newQuotas()
+		manager.SetLimits(nil, q)
+
+		for i := 0; i < 2; i++ {
+			_, err := manager.AddObjects(ctx, nil, []*models.Object{{Class: "Foo"}}, nil, nil)
+			assert.ErrorContains(t, err, "disk full")
+		}
+		assert.Nil(t, q.Check(ctx, "Foo", "", 1))
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // AddObjectReference to an existing object. If the class contains a network
@@ -52,6 +53,9 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return &Error{path, StatusTooManyRequests, err}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
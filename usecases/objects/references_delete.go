@@ -20,6 +20,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // DeleteReferenceInput represents required inputs to delete a reference from an existing object.
@@ -73,6 +74,9 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return &Error{path, StatusTooManyRequests, err}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/usecases/objects/validation"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // PutReferenceInput represents required inputs to add a reference to an existing object.
@@ -64,6 +65,9 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	if err := m.authorizer.Authorize(principal, "update", path); err != nil {
 		return &Error{path, StatusForbidden, err}
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return &Error{path, StatusTooManyRequests, err}
+	}
 
 	unlock, err := m.locks.LockSchema()
 	if err != nil {
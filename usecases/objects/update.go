@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 // UpdateObject updates object of class.
@@ -36,6 +37,9 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	if err != nil {
 		return nil, err
 	}
+	if err := m.rateLimits.Allow(principal, ratelimiter.Writes); err != nil {
+		return nil, err
+	}
 
 	m.metrics.UpdateObjectInc()
 	defer m.metrics.UpdateObjectDec()
@@ -84,6 +88,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, NewErrInvalidUserInput("invalid object: %v", err)
 	}
+	if err := m.quotas.Check(ctx, updates.Class, updates.Tenant, 0); err != nil {
+		return nil, err
+	}
 
 	// Set the original creation timestamp before call to put,
 	// otherwise it is lost. This is because `class` is unmarshalled
@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package querycache contains the node-level cache of repeated queries. It
+// caches the query vectors produced by modules, so that e.g. the same
+// nearText query does not call the vectorizer again, and the results of shard
+// searches.
+//
+// Cached search results are stored together with the version of the shard
+// they were read from. Every write to a shard changes its version, so that
+// results which were cached before the write are never returned afterwards.
+package querycache
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	cacheVectors = "vectors"
+	cacheResults = "results"
+)
+
+// Cache is nil if query caching is disabled. All methods can be called on
+// the nil cache, lookups always miss.
+type Cache struct {
+	vectors *lru.Cache
+	results *lru.Cache
+	metrics *monitoring.PrometheusMetrics
+}
+
+type resultsEntry struct {
+	version uint64
+	objects []*storobj.Object
+	dists   []float32
+}
+
+// New returns nil if query caching is disabled. Metrics may be nil.
+func New(cfg config.QueryCache, metrics *monitoring.PrometheusMetrics) (*Cache, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	vectors, err := lru.New(cfg.MaxVectors)
+	if err != nil {
+		return nil, fmt.Errorf("create query vector cache: %w", err)
+	}
+	results, err := lru.New(cfg.MaxResults)
+	if err != nil {
+		return nil, fmt.Errorf("create query results cache: %w", err)
+	}
+
+	return &Cache{
+		vectors: vectors,
+		results: results,
+		metrics: metrics,
+	}, nil
+}
+
+// Key returns the cache key of a query described by the given parts, which
+// are encoded as JSON and hashed, so that keys are of fixed size. It returns
+// false if a part can not be encoded, such queries must not be cached.
+func Key(parts ...interface{}) (string, bool) {
+	h := sha256.New()
+	enc := json.NewEncoder(h)
+	for _, part := range parts {
+		if err := enc.Encode(part); err != nil {
+			return "", false
+		}
+	}
+	return string(h.Sum(nil)), true
+}
+
+// Vector returns the cached query vector of the key
+func (c *Cache) Vector(key string) ([]float32, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	v, ok := c.vectors.Get(key)
+	if !ok {
+		c.observe(cacheVectors, false)
+		return nil, false
+	}
+	c.observe(cacheVectors, true)
+	return copyVector(v.([]float32)), true
+}
+
+func (c *Cache) AddVector(key string, vector []float32) {
+	if c == nil {
+		return
+	}
+
+	c.vectors.Add(key, copyVector(vector))
+	c.observeLen(cacheVectors, c.vectors.Len())
+}
+
+// Results returns the cached results of the key if they were read from the
+// given version of the shard. Results of other versions are stale and
+// removed from the cache.
+func (c *Cache) Results(key string, version uint64) ([]*storobj.Object, []float32, bool) {
+	if c == nil {
+		return nil, nil, false
+	}
+
+	v, ok := c.results.Get(key)
+	if !ok {
+		c.observe(cacheResults, false)
+		return nil, nil, false
+	}
+	entry := v.(*resultsEntry)
+	if entry.version != version {
+		c.results.Remove(key)
+		c.observeLen(cacheResults, c.results.Len())
+		c.observe(cacheResults, false)
+		return nil, nil, false
+	}
+
+	c.observe(cacheResults, true)
+	return copyObjects(entry.objects), copyVector(entry.dists), true
+}
+
+// AddResults caches the results of the key, which were read from the given
+// version of the shard. The version must have been read before the search
+// started, so that a concurrent write invalidates the results.
+func (c *Cache) AddResults(key string, version uint64, objects []*storobj.Object, dists []float32) {
+	if c == nil {
+		return
+	}
+
+	c.results.Add(key, &resultsEntry{
+		version: version,
+		objects: copyObjects(objects),
+		dists:   copyVector(dists),
+	})
+	c.observeLen(cacheResults, c.results.Len())
+}
+
+func (c *Cache) observe(cache string, hit bool) {
+	if c.metrics == nil {
+		return
+	}
+
+	if hit {
+		c.metrics.QueryCacheHits.WithLabelValues(cache).Inc()
+	} else {
+		c.metrics.QueryCacheMisses.WithLabelValues(cache).Inc()
+	}
+}
+
+func (c *Cache) observeLen(cache string, n int) {
+	if c.metrics == nil {
+		return
+	}
+
+	c.metrics.QueryCacheEntries.WithLabelValues(cache).Set(float64(n))
+}
+
+func copyVector(in []float32) []float32 {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]float32, len(in))
+	copy(out, in)
+	return out
+}
+
+// copyObjects copies the objects, so that callers can't modify the cached
+// objects, e.g. by adding additional properties to the results
+func copyObjects(in []*storobj.Object) []*storobj.Object {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]*storobj.Object, len(in))
+	for i, obj := range in {
+		cp := obj.DeepCopyDangerous()
+		cp.VectorLen = obj.VectorLen
+		cp.BelongsToNode = obj.BelongsToNode
+		cp.BelongsToShard = obj.BelongsToShard
+		cp.IsConsistent = obj.IsConsistent
+		// the deep copy turns missing vectors into empty ones
+		if obj.Vector == nil {
+			cp.Vector = nil
+		}
+		if obj.Vectors == nil {
+			cp.Vectors = nil
+		}
+		if obj.MultiVectors == nil {
+			cp.MultiVectors = nil
+		}
+		if obj.SparseVectors == nil {
+			cp.SparseVectors = nil
+		}
+		if obj.Object.Vector == nil {
+			cp.Object.Vector = nil
+		}
+		if obj.Object.Vectors == nil {
+			cp.Object.Vectors = nil
+		}
+		if obj.Object.Additional != nil {
+			cp.Object.Additional = make(models.AdditionalProperties, len(obj.Object.Additional))
+			for k, v := range obj.Object.Additional {
+				cp.Object.Additional[k] = v
+			}
+		}
+		out[i] = cp
+	}
+	return out
+}
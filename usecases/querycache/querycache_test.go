@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package querycache
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestQueryCache(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		c, err := New(config.QueryCache{}, nil)
+		require.Nil(t, err)
+		require.Nil(t, c)
+
+		c.AddVector("key", []float32{1, 2})
+		_, ok := c.Vector("key")
+		assert.False(t, ok)
+
+		c.AddResults("key", 1, []*storobj.Object{testObject("1")}, []float32{0.1})
+		_, _, ok = c.Results("key", 1)
+		assert.False(t, ok)
+	})
+
+	c, err := New(config.QueryCache{Enabled: true, MaxVectors: 2, MaxResults: 2}, nil)
+	require.Nil(t, err)
+	require.NotNil(t, c)
+
+	t.Run("vectors", func(t *testing.T) {
+		_, ok := c.Vector("a")
+		assert.False(t, ok)
+
+		in := []float32{1, 2, 3}
+		c.AddVector("a", in)
+		in[0] = 100
+
+		vec, ok := c.Vector("a")
+		require.True(t, ok)
+		assert.Equal(t, []float32{1, 2, 3}, vec)
+
+		// callers can't modify the cached vector
+		vec[1] = 100
+		vec, _ = c.Vector("a")
+		assert.Equal(t, []float32{1, 2, 3}, vec)
+	})
+
+	t.Run("vectors are limited in size", func(t *testing.T) {
+		c.AddVector("b", []float32{4})
+		c.AddVector("c", []float32{5})
+
+		_, ok := c.Vector("a")
+		assert.False(t, ok)
+		_, ok = c.Vector("c")
+		assert.True(t, ok)
+	})
+
+	t.Run("results", func(t *testing.T) {
+		c.AddResults("q", 7, []*storobj.Object{testObject("1"), testObject("2")}, []float32{0.1, 0.2})
+
+		objs, dists, ok := c.Results("q", 7)
+		require.True(t, ok)
+		require.Len(t, objs, 2)
+		assert.Equal(t, strfmt.UUID("1"), objs[0].ID())
+		assert.Equal(t, strfmt.UUID("2"), objs[1].ID())
+		assert.Equal(t, []float32{0.1, 0.2}, dists)
+		assert.Nil(t, objs[0].Vector)
+
+		// callers can't modify the cached objects
+		objs[0].Object.Additional["distance"] = float32(0.5)
+		objs, _, ok = c.Results("q", 7)
+		require.True(t, ok)
+		assert.NotContains(t, objs[0].Object.Additional, "distance")
+	})
+
+	t.Run("results of other versions are stale", func(t *testing.T) {
+		_, _, ok := c.Results("q", 8)
+		assert.False(t, ok)
+
+		// and removed from the cache
+		_, _, ok = c.Results("q", 7)
+		assert.False(t, ok)
+	})
+}
+
+func TestQueryCacheKey(t *testing.T) {
+	a, ok := Key("vector", 10, []float32{1, 2})
+	require.True(t, ok)
+	b, ok := Key("vector", 10, []float32{1, 2})
+	require.True(t, ok)
+	c, ok := Key("vector", 11, []float32{1, 2})
+	require.True(t, ok)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+
+	_, ok = Key("vector", make(chan int))
+	assert.False(t, ok)
+}
+
+func testObject(id strfmt.UUID) *storobj.Object {
+	return &storobj.Object{
+		Object: models.Object{
+			ID:         id,
+			Class:      "Article",
+			Properties: map[string]interface{}{"title": "title " + string(id)},
+			Additional: models.AdditionalProperties{"id": id},
+		},
+	}
+}
@@ -0,0 +1,186 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"golang.org/x/time/rate"
+)
+
+// Kind of a rate limited request
+type Kind string
+
+const (
+	Queries Kind = "queries"
+	Writes  Kind = "writes"
+)
+
+// anonymous is the key of unauthenticated requests, which share their limits
+const anonymous = "anonymous"
+
+// sweepInterval is how often buckets of idle principals are evicted
+const sweepInterval = time.Minute
+
+// Rate is a token bucket refilled with PerSecond tokens per second up to
+// Burst tokens. A zero PerSecond does not limit requests at all.
+type Rate struct {
+	PerSecond float64 `json:"perSecond" yaml:"perSecond"`
+	Burst     int     `json:"burst" yaml:"burst"`
+}
+
+func (r Rate) unlimited() bool {
+	return r.PerSecond <= 0
+}
+
+// burst is the capacity of the bucket, at least a single request
+func (r Rate) burst() int {
+	return max(1, r.Burst)
+}
+
+// Rates are the limits of a principal for each kind of request
+type Rates struct {
+	Queries Rate `json:"queries" yaml:"queries"`
+	Writes  Rate `json:"writes" yaml:"writes"`
+}
+
+func (r Rates) of(kind Kind) Rate {
+	if kind == Writes {
+		return r.Writes
+	}
+	return r.Queries
+}
+
+// Limits configures the rates of all principals. Principals are identified
+// by their username, which for API keys is the user the key belongs to.
+// Principals without an entry are limited by Default, each with their own
+// buckets.
+type Limits struct {
+	Default    Rates            `json:"default" yaml:"default"`
+	Principals map[string]Rates `json:"principals" yaml:"principals"`
+}
+
+func (l Limits) of(principal string) Rates {
+	if r, ok := l.Principals[principal]; ok {
+		return r
+	}
+	return l.Default
+}
+
+// ErrRateLimited is returned if a principal exceeds its rate
+type ErrRateLimited struct {
+	Principal string
+	Kind      Kind
+	// RetryAfter is the time until the request would be allowed
+	RetryAfter time.Duration
+}
+
+func (e ErrRateLimited) Error() string {
+	return fmt.Sprintf("too many %s by %q, retry after %s",
+		e.Kind, e.Principal, e.RetryAfter.Round(time.Millisecond))
+}
+
+type bucketKey struct {
+	principal string
+	kind      Kind
+}
+
+type bucket struct {
+	*rate.Limiter
+	// full is the time from which on the bucket is full again
+	full time.Time
+}
+
+// Principals rate limits requests per principal using token buckets. A nil
+// Principals allows all requests.
+//
+// Buckets which are full again are evicted every sweepInterval, as a new
+// bucket behaves exactly the same. Only principals which made requests
+// recently take up memory.
+type Principals struct {
+	sync.Mutex
+	limits    Limits
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewPrincipals creates a rate limiter with the specified limits
+func NewPrincipals(limits Limits) *Principals {
+	return &Principals{
+		limits:  limits,
+		buckets: map[bucketKey]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// SetLimits replaces the limits at runtime. Buckets start full again, so
+// that lowered limits apply to the next requests.
+func (p *Principals) SetLimits(limits Limits) {
+	p.Lock()
+	defer p.Unlock()
+	p.limits = limits
+	p.buckets = map[bucketKey]*bucket{}
+}
+
+// Allow takes a token of principal for a request of the specified kind. It
+// returns ErrRateLimited if there is none left.
+func (p *Principals) Allow(principal *models.Principal, kind Kind) error {
+	if p == nil {
+		return nil
+	}
+	name := anonymous
+	if principal != nil {
+		name = principal.Username
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	r := p.limits.of(name).of(kind)
+	if r.unlimited() {
+		return nil
+	}
+
+	now := p.now()
+	if now.Sub(p.lastSweep) >= sweepInterval {
+		p.sweep(now)
+	}
+
+	key := bucketKey{name, kind}
+	b, ok := p.buckets[key]
+	if !ok {
+		b = &bucket{Limiter: rate.NewLimiter(rate.Limit(r.PerSecond), r.burst())}
+		p.buckets[key] = b
+	}
+	res := b.ReserveN(now, 1)
+	if wait := res.DelayFrom(now); wait > 0 {
+		res.CancelAt(now)
+		return ErrRateLimited{Principal: name, Kind: kind, RetryAfter: wait}
+	}
+	// the token taken is refilled last
+	missing := float64(b.Burst()) - b.TokensAt(now)
+	b.full = now.Add(time.Duration(missing / r.PerSecond * float64(time.Second)))
+	return nil
+}
+
+// sweep evicts all buckets which are full at now
+func (p *Principals) sweep(now time.Time) {
+	for key, b := range p.buckets {
+		if !now.Before(b.full) {
+			delete(p.buckets, key)
+		}
+	}
+	p.lastSweep = now
+}
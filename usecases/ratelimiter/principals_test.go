@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestPrincipals(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := NewPrincipals(Limits{
+		Default: Rates{Queries: Rate{PerSecond: 1, Burst: 2}},
+		Principals: map[string]Rates{
+			"batch-job": {Writes: Rate{PerSecond: 10}},
+		},
+	})
+	p.now = func() time.Time { return now }
+	jane := &models.Principal{Username: "jane"}
+	john := &models.Principal{Username: "john"}
+	job := &models.Principal{Username: "batch-job"}
+
+	// burst is used up by two requests
+	require.Nil(t, p.Allow(jane, Queries))
+	require.Nil(t, p.Allow(jane, Queries))
+	err := p.Allow(jane, Queries)
+	require.NotNil(t, err)
+	assert.Equal(t, ErrRateLimited{Principal: "jane", Kind: Queries, RetryAfter: time.Second}, err)
+
+	// other principals have their own buckets
+	require.Nil(t, p.Allow(john, Queries))
+	require.Nil(t, p.Allow(nil, Queries))
+
+	// writes are not limited by default
+	for i := 0; i < 100; i++ {
+		require.Nil(t, p.Allow(jane, Writes))
+	}
+
+	// principals with their own rates
+	for i := 0; i < 100; i++ {
+		require.Nil(t, p.Allow(job, Queries))
+	}
+	require.Nil(t, p.Allow(job, Writes))
+	err = p.Allow(job, Writes)
+	assert.Equal(t, ErrRateLimited{Principal: "batch-job", Kind: Writes, RetryAfter: 100 * time.Millisecond}, err)
+
+	// buckets are refilled over time
+	now = now.Add(500 * time.Millisecond)
+	err = p.Allow(jane, Queries)
+	assert.Equal(t, ErrRateLimited{Principal: "jane", Kind: Queries, RetryAfter: 500 * time.Millisecond}, err)
+	now = now.Add(500 * time.Millisecond)
+	require.Nil(t, p.Allow(jane, Queries))
+	require.NotNil(t, p.Allow(jane, Queries))
+
+	// changed limits apply immediately
+	p.SetLimits(Limits{})
+	for i := 0; i < 100; i++ {
+		require.Nil(t, p.Allow(jane, Queries))
+	}
+}
+
+func TestPrincipalsEvictsFullBuckets(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := NewPrincipals(Limits{
+		Default: Rates{Queries: Rate{PerSecond: 0.01, Burst: 2}},
+	})
+	p.now = func() time.Time { return now }
+	jane := &models.Principal{Username: "jane"}
+	john := &models.Principal{Username: "john"}
+
+	require.Nil(t, p.Allow(jane, Queries))
+	require.Nil(t, p.Allow(jane, Queries))
+	require.Nil(t, p.Allow(john, Queries))
+	assert.Len(t, p.buckets, 2)
+
+	// john's bucket is full again after 100s, jane's after 200s
+	now = now.Add(150 * time.Second)
+	err := p.Allow(nil, Queries)
+	require.Nil(t, err)
+	assert.Len(t, p.buckets, 2)
+	assert.Contains(t, p.buckets, bucketKey{"jane", Queries})
+	assert.NotContains(t, p.buckets, bucketKey{"john", Queries})
+
+	// jane's bucket is not refilled by the eviction
+	require.Nil(t, p.Allow(jane, Queries))
+	assert.NotNil(t, p.Allow(jane, Queries))
+
+	now = now.Add(time.Hour)
+	require.Nil(t, p.Allow(john, Queries))
+	assert.Len(t, p.buckets, 1)
+}
+
+func TestPrincipalsNil(t *testing.T) {
+	var p *Principals
+	assert.Nil(t, p.Allow(nil, Writes))
+}
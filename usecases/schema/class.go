@@ -17,15 +17,18 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -101,17 +104,9 @@ func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 }
 
 func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m map[string]string) error {
-	// get schema and sharding state
-	class := &models.Class{}
-	if err := json.Unmarshal(d.Schema, &class); err != nil {
-		return fmt.Errorf("marshal class schema: %w", err)
-	}
-	var shardingState sharding.State
-	if d.ShardingState != nil {
-		err := json.Unmarshal(d.ShardingState, &shardingState)
-		if err != nil {
-			return fmt.Errorf("marshal sharding state: %w", err)
-		}
+	class, shardingState, err := unmarshalClassDescriptor(d)
+	if err != nil {
+		return err
 	}
 
 	metric, err := monitoring.GetMetrics().BackupRestoreClassDurations.GetMetricWithLabelValues(class.Class)
@@ -146,6 +141,187 @@ func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m
 	return err
 }
 
+// RestoreTenants adds the tenants of a class backup to an existing
+// multi-tenant class. The schema of the backup must be compatible with
+// the existing class and none of the restored tenants may exist already.
+func (h *Handler) RestoreTenants(ctx context.Context, d *backup.ClassDescriptor, m map[string]string) error {
+	restored, shardingState, err := unmarshalClassDescriptor(d)
+	if err != nil {
+		return err
+	}
+	restored.Class = schema.UppercaseClassName(restored.Class)
+	restored.Properties = schema.LowercaseAllPropertyNames(restored.Properties)
+
+	class := h.metaReader.ReadOnlyClass(restored.Class)
+	if class == nil {
+		return fmt.Errorf("class %q: %w", restored.Class, ErrNotFound)
+	}
+	if !schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("class %q does not have multi-tenancy enabled", class.Class)
+	}
+	if err := validateRestoredSchema(class, restored); err != nil {
+		return fmt.Errorf("restore tenants into class %q: %w", class.Class, err)
+	}
+
+	shardingState.MigrateFromOldFormat()
+	shardingState.ApplyNodeMapping(m)
+
+	existing := h.metaReader.CopyShardingState(class.Class)
+	request := api.AddTenantsRequest{
+		Tenants: make([]*api.Tenant, 0, len(shardingState.Physical)),
+	}
+	for name, phys := range shardingState.Physical {
+		if existing != nil {
+			if _, ok := existing.Physical[name]; ok {
+				return fmt.Errorf("tenant %q exists already in class %q", name, class.Class)
+			}
+		}
+		request.Tenants = append(request.Tenants, &api.Tenant{
+			Name:   name,
+			Nodes:  phys.BelongsToNodes,
+			Status: phys.ActivityStatus(),
+		})
+	}
+	sort.Slice(request.Tenants, func(i, j int) bool {
+		return request.Tenants[i].Name < request.Tenants[j].Name
+	})
+
+	_, err = h.metaWriter.RestoreTenants(class.Class, &request)
+	return err
+}
+
+// unmarshalClassDescriptor returns the class and sharding state of a class backup
+func unmarshalClassDescriptor(d *backup.ClassDescriptor) (*models.Class, sharding.State, error) {
+	class := &models.Class{}
+	var shardingState sharding.State
+	if err := json.Unmarshal(d.Schema, &class); err != nil {
+		return nil, shardingState, fmt.Errorf("marshal class schema: %w", err)
+	}
+	if d.ShardingState != nil {
+		err := json.Unmarshal(d.ShardingState, &shardingState)
+		if err != nil {
+			return nil, shardingState, fmt.Errorf("marshal sharding state: %w", err)
+		}
+	}
+	return class, shardingState, nil
+}
+
+// validateRestoredSchema checks that objects of the restored class can be
+// stored in the existing class. Restored shards contain indexes built with
+// the settings of the restored class, so every setting which determines the
+// on-disk layout of properties and vectors has to match.
+func validateRestoredSchema(existing, restored *models.Class) error {
+	if restored.VectorIndexType != "" && existing.VectorIndexType != "" &&
+		restored.VectorIndexType != existing.VectorIndexType {
+		return fmt.Errorf("vector index type %q differs from %q",
+			restored.VectorIndexType, existing.VectorIndexType)
+	}
+	if err := validateRestoredMultivector(existing.VectorIndexConfig,
+		restored.VectorIndexConfig); err != nil {
+		return err
+	}
+	if err := validateRestoredVectorConfig(existing.VectorConfig,
+		restored.VectorConfig); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(existing.InvertedIndexConfig, restored.InvertedIndexConfig) {
+		return fmt.Errorf("inverted index config differs")
+	}
+
+	props := make(map[string]*models.Property, len(existing.Properties))
+	for _, p := range existing.Properties {
+		props[strings.ToLower(p.Name)] = p
+	}
+	for _, p := range restored.Properties {
+		prop, ok := props[strings.ToLower(p.Name)]
+		if !ok {
+			return fmt.Errorf("property %q does not exist", p.Name)
+		}
+		if !reflect.DeepEqual(prop.DataType, p.DataType) {
+			return fmt.Errorf("property %q has data type %v instead of %v",
+				p.Name, p.DataType, prop.DataType)
+		}
+		if prop.Tokenization != p.Tokenization {
+			return fmt.Errorf("property %q has tokenization %q instead of %q",
+				p.Name, p.Tokenization, prop.Tokenization)
+		}
+		for name, flags := range map[string][2]*bool{
+			"indexFilterable": {prop.IndexFilterable, p.IndexFilterable},
+			"indexSearchable": {prop.IndexSearchable, p.IndexSearchable},
+		} {
+			if !reflect.DeepEqual(flags[0], flags[1]) {
+				return fmt.Errorf("property %q has a different %s setting", p.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRestoredVectorConfig checks that the restored class has the same
+// named vectors as the existing one, indexed the same way
+func validateRestoredVectorConfig(existing, restored map[string]models.VectorConfig) error {
+	if len(existing) != len(restored) {
+		return fmt.Errorf("class has %d named vectors instead of %d",
+			len(restored), len(existing))
+	}
+	for name, rcfg := range restored {
+		ecfg, ok := existing[name]
+		if !ok {
+			return fmt.Errorf("named vector %q does not exist", name)
+		}
+		if rcfg.VectorIndexType != ecfg.VectorIndexType {
+			return fmt.Errorf("named vector %q has vector index type %q instead of %q",
+				name, rcfg.VectorIndexType, ecfg.VectorIndexType)
+		}
+		if err := validateRestoredMultivector(ecfg.VectorIndexConfig,
+			rcfg.VectorIndexConfig); err != nil {
+			return fmt.Errorf("named vector %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func validateRestoredMultivector(existing, restored interface{}) error {
+	emv, err := multivectorConfig(existing)
+	if err != nil {
+		return err
+	}
+	rmv, err := multivectorConfig(restored)
+	if err != nil {
+		return err
+	}
+	if emv != rmv {
+		return fmt.Errorf("multi-vector settings %+v differ from %+v", rmv, emv)
+	}
+	return nil
+}
+
+// multivectorConfig returns the multi-vector settings of a vector index
+// config, which is either parsed already or a map as unmarshalled from a
+// backup
+func multivectorConfig(cfg interface{}) (hnsw.MultivectorConfig, error) {
+	var parsed struct {
+		Multivector *hnsw.MultivectorConfig `json:"multivector"`
+	}
+	if cfg != nil {
+		b, err := json.Marshal(cfg)
+		if err != nil {
+			return hnsw.MultivectorConfig{}, fmt.Errorf("marshal vector index config: %w", err)
+		}
+		if err := json.Unmarshal(b, &parsed); err != nil {
+			return hnsw.MultivectorConfig{}, fmt.Errorf("unmarshal vector index config: %w", err)
+		}
+	}
+	if parsed.Multivector == nil || !parsed.Multivector.Enabled {
+		return hnsw.MultivectorConfig{}, nil
+	}
+	mv := *parsed.Multivector
+	if mv.Aggregation == "" {
+		mv.Aggregation = hnsw.DefaultMultivectorAggregation
+	}
+	return mv, nil
+}
+
 // DeleteClass from the schema
 func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
 	err := h.Authorizer.Authorize(principal, "delete", "schema/objects")
@@ -613,6 +789,12 @@ func (m *Handler) validateVectorSettings(class *models.Class) error {
 				}
 			}
 		}
+		if cfg.VectorIndexType == "sparse" {
+			if err := m.validateSparseVectorizer(cfg.Vectorizer); err != nil {
+				return fmt.Errorf("target vector %q: %w", name, err)
+			}
+			continue
+		}
 		if err := m.validateVectorIndexType(cfg.VectorIndexType); err != nil {
 			return fmt.Errorf("target vector %q: %w", name, err)
 		}
@@ -620,6 +802,19 @@ func (m *Handler) validateVectorSettings(class *models.Class) error {
 	return nil
 }
 
+// validateSparseVectorizer makes sure sparse target vectors are not
+// configured with a vectorizer, as sparse vectors can only be imported
+func (m *Handler) validateSparseVectorizer(vectorizer interface{}) error {
+	vm, ok := vectorizer.(map[string]interface{})
+	if !ok || len(vm) == 0 {
+		return nil
+	}
+	if _, ok := vm[config.VectorizerModuleNone]; ok && len(vm) == 1 {
+		return nil
+	}
+	return fmt.Errorf("sparse vectors require vectorizer %q", config.VectorizerModuleNone)
+}
+
 func (m *Handler) validateVectorizer(vectorizer string) error {
 	if vectorizer == config.VectorizerModuleNone {
 		return nil
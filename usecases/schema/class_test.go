@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -364,6 +365,24 @@ func Test_AddClass(t *testing.T) {
 			}},
 		})
 		assert.EqualError(t, err, "target vector \"custom\": vectorizer: invalid vectorizer \"invalid\"")
+
+		// sparse VectorConfig with a vectorizer
+		_, err = handler.AddClass(ctx, nil, &models.Class{
+			Class: "NewClass",
+			VectorConfig: map[string]models.VectorConfig{"custom": {
+				VectorIndexType: "sparse",
+				Vectorizer:      map[string]interface{}{"none": map[string]interface{}{}, "other": nil},
+			}},
+		})
+		assert.EqualError(t, err, "target vector \"custom\": sparse vectors require vectorizer \"none\"")
+
+		// sparse VectorIndexType without VectorConfig
+		_, err = handler.AddClass(ctx, nil, &models.Class{
+			Class:           "NewClass",
+			VectorIndexType: "sparse",
+			Vectorizer:      "none",
+		})
+		assert.EqualError(t, err, "unrecognized or unsupported vectorIndexType \"sparse\"")
 	})
 }
 
@@ -1369,6 +1388,173 @@ func TestRestoreClass_WithNodeMapping(t *testing.T) {
 	}
 }
 
+func TestRestoreTenants(t *testing.T) {
+	existing := &models.Class{
+		Class:              "MultiTenant",
+		VectorIndexType:    "hnsw",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	classDescriptor := func(t *testing.T, class models.Class) *backup.ClassDescriptor {
+		schemaBytes, err := json.Marshal(class)
+		require.Nil(t, err)
+		ss := sharding.State{
+			IndexID:             class.Class,
+			PartitioningEnabled: true,
+			Physical: map[string]sharding.Physical{
+				"tenant2": {Name: "tenant2", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusCOLD},
+				"tenant1": {Name: "tenant1", BelongsToNodes: []string{"node1"}, Status: models.TenantActivityStatusHOT},
+			},
+		}
+		ssBytes, err := json.Marshal(ss)
+		require.Nil(t, err)
+		return &backup.ClassDescriptor{Name: class.Class, Schema: schemaBytes, ShardingState: ssBytes}
+	}
+	descriptor := func(t *testing.T, props []*models.Property) *backup.ClassDescriptor {
+		class := *existing
+		class.Properties = props
+		return classDescriptor(t, class)
+	}
+	existingState := &sharding.State{
+		PartitioningEnabled: true,
+		Physical:            map[string]sharding.Physical{"tenant0": {Name: "tenant0"}},
+	}
+
+	t.Run("tenants are added", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(existing)
+		fakeMetaHandler.On("CopyShardingState", existing.Class).Return(existingState)
+		expected := &api.AddTenantsRequest{Tenants: []*api.Tenant{
+			{Name: "tenant1", Nodes: []string{"new-node1"}, Status: models.TenantActivityStatusHOT},
+			{Name: "tenant2", Nodes: []string{"new-node1"}, Status: models.TenantActivityStatusCOLD},
+		}}
+		fakeMetaHandler.On("RestoreTenants", existing.Class, expected).Return(nil)
+
+		err := handler.RestoreTenants(context.Background(), descriptor(t, existing.Properties[:1]),
+			map[string]string{"node1": "new-node1"})
+		require.Nil(t, err)
+		fakeMetaHandler.AssertExpectations(t)
+	})
+
+	t.Run("class does not exist", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(nil)
+		err := handler.RestoreTenants(context.Background(), descriptor(t, nil), nil)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("incompatible property", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(existing)
+		props := []*models.Property{{Name: "count", DataType: schema.DataTypeText.PropString()}}
+		err := handler.RestoreTenants(context.Background(), descriptor(t, props), nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "data type")
+	})
+
+	t.Run("unknown property", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(existing)
+		props := []*models.Property{{Name: "other", DataType: schema.DataTypeText.PropString()}}
+		err := handler.RestoreTenants(context.Background(), descriptor(t, props), nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "does not exist")
+	})
+
+	t.Run("incompatible settings", func(t *testing.T) {
+		vFalse := false
+		for name, tc := range map[string]struct {
+			modify   func(class *models.Class)
+			expected string
+		}{
+			"tokenization": {
+				modify: func(class *models.Class) {
+					class.Properties = []*models.Property{{
+						Name: "name", DataType: schema.DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationField,
+					}}
+				},
+				expected: "tokenization",
+			},
+			"filterable index": {
+				modify: func(class *models.Class) {
+					class.Properties = []*models.Property{{
+						Name: "count", DataType: schema.DataTypeInt.PropString(),
+						IndexFilterable: &vFalse,
+					}}
+				},
+				expected: "indexFilterable",
+			},
+			"searchable index": {
+				modify: func(class *models.Class) {
+					class.Properties = []*models.Property{{
+						Name: "name", DataType: schema.DataTypeText.PropString(),
+						IndexSearchable: &vFalse,
+					}}
+				},
+				expected: "indexSearchable",
+			},
+			"inverted index config": {
+				modify: func(class *models.Class) {
+					class.InvertedIndexConfig = &models.InvertedIndexConfig{IndexTimestamps: true}
+				},
+				expected: "inverted index config",
+			},
+			"named vectors": {
+				modify: func(class *models.Class) {
+					class.VectorConfig = map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw"},
+					}
+				},
+				expected: "named vectors",
+			},
+			"multi-vector": {
+				modify: func(class *models.Class) {
+					class.VectorIndexConfig = map[string]interface{}{
+						"multivector": map[string]interface{}{"enabled": true},
+					}
+				},
+				expected: "multi-vector",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+				fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(existing)
+				class := *existing
+				tc.modify(&class)
+				err := handler.RestoreTenants(context.Background(), classDescriptor(t, class), nil)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tc.expected)
+			})
+		}
+	})
+
+	t.Run("tenant exists already", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(existing)
+		fakeMetaHandler.On("CopyShardingState", existing.Class).Return(&sharding.State{
+			PartitioningEnabled: true,
+			Physical:            map[string]sharding.Physical{"tenant2": {Name: "tenant2"}},
+		})
+		err := handler.RestoreTenants(context.Background(), descriptor(t, nil), nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "exists already")
+	})
+
+	t.Run("class is not multi-tenant", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		class := *existing
+		class.MultiTenancyConfig = nil
+		fakeMetaHandler.On("ReadOnlyClass", existing.Class).Return(&class)
+		err := handler.RestoreTenants(context.Background(), descriptor(t, nil), nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "multi-tenancy")
+	})
+}
+
 func Test_DeleteClass(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
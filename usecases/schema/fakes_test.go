@@ -63,6 +63,11 @@ func (f *fakeMetaHandler) AddTenants(class string, req *command.AddTenantsReques
 	return 0, args.Error(0)
 }
 
+func (f *fakeMetaHandler) RestoreTenants(class string, req *command.AddTenantsRequest) (uint64, error) {
+	args := f.Called(class, req)
+	return 0, args.Error(0)
+}
+
 func (f *fakeMetaHandler) UpdateTenants(class string, req *command.UpdateTenantsRequest) (uint64, error) {
 	args := f.Called(class, req)
 	return 0, args.Error(0)
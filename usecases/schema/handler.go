@@ -38,6 +38,7 @@ type metaWriter interface {
 	AddProperty(class string, p ...*models.Property) (uint64, error)
 	UpdateShardStatus(class, shard, status string) (uint64, error)
 	AddTenants(class string, req *command.AddTenantsRequest) (uint64, error)
+	RestoreTenants(class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(class string, req *command.DeleteTenantsRequest) (uint64, error)
 
@@ -76,7 +76,7 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []float32, targetVector string, limit int, filters *filters.LocalFilter,
+		searchVector []float32, multiVector [][]float32, targetVector string, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties,
@@ -241,6 +241,7 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	queryVec []float32,
+	queryMultiVec [][]float32,
 	targetVector string,
 	limit int,
 	filters *filters.LocalFilter,
@@ -257,7 +258,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	}
 	f := func(node, host string) (interface{}, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds)
+			queryVec, queryMultiVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is a stand-in for an OTLP/HTTP collector
+type collector struct {
+	sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/traces" {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var req coltracepb.ExportTraceServiceRequest
+	if err := proto.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			c.spans = append(c.spans, ss.Spans...)
+		}
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(nil)
+}
+
+func (c *collector) span(name string) *tracepb.Span {
+	c.Lock()
+	defer c.Unlock()
+	for _, s := range c.spans {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func TestTracing(t *testing.T) {
+	col := &collector{}
+	srv := httptest.NewServer(col)
+	defer srv.Close()
+
+	ctx := context.Background()
+	shutdown, err := Init(ctx, Config{
+		Enabled:     true,
+		Endpoint:    strings.TrimPrefix(srv.URL, "http://"),
+		Insecure:    true,
+		SampleRatio: 1,
+	}, "node1")
+	require.Nil(t, err)
+
+	t.Run("trace context is propagated between nodes", func(t *testing.T) {
+		ctx, span := Start(ctx, "coordinator")
+		defer span.End()
+
+		header := http.Header{}
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+		require.NotEmpty(t, header.Get("traceparent"))
+
+		remote := otel.GetTextMapPropagator().Extract(context.Background(),
+			propagation.HeaderCarrier(header))
+		_, remoteSpan := Start(remote, "remote shard")
+		defer remoteSpan.End()
+		assert.Equal(t, span.SpanContext().TraceID(), remoteSpan.SpanContext().TraceID())
+	})
+
+	t.Run("spans are exported to the collector", func(t *testing.T) {
+		ctx, parent := Start(ctx, "parent", attribute.String("class", "Article"))
+		_, child := Start(ctx, "child")
+		End(child, errors.New("shard failed"))
+		End(parent, nil)
+
+		require.Nil(t, shutdown(context.Background()))
+
+		p, c := col.span("parent"), col.span("child")
+		require.NotNil(t, p)
+		require.NotNil(t, c)
+		assert.Equal(t, p.TraceId, c.TraceId)
+		assert.Equal(t, p.SpanId, c.ParentSpanId)
+		assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, c.Status.Code)
+		assert.Equal(t, "shard failed", c.Status.Message)
+		require.Len(t, p.Attributes, 1)
+		assert.Equal(t, "class", p.Attributes[0].Key)
+		assert.Equal(t, "Article", p.Attributes[0].Value.GetStringValue())
+	})
+}
+
+func TestTracingDisabled(t *testing.T) {
+	shutdown, err := Init(context.Background(), Config{}, "node1")
+	require.Nil(t, err)
+	assert.Nil(t, shutdown(context.Background()))
+}
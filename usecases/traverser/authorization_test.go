@@ -68,6 +68,10 @@ func Test_Traverser_Authorization(t *testing.T) {
 		}
 
 		for _, method := range allExportedMethods(&Traverser{}) {
+			if method == "SetRateLimits" || method == "SlowQueryLog" {
+				// wiring only, not an operation requiring authorization
+				continue
+			}
 			assert.Contains(t, testedMethods, method)
 		}
 	})
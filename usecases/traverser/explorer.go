@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/inverted"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/profile"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -138,6 +139,14 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if params.Recommend != nil {
+		res, searchVector, err := e.getClassRecommend(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return e.searchResultsToGetResponse(ctx, res, searchVector, params)
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -166,7 +175,15 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
-	if len(params.KeywordRanking.Query) == 0 {
+	if params.KeywordRanking.Type == searchparams.KeywordRankingTypeSparse {
+		sparseVector, err := e.sparseVectorFromParams(params.ClassName, params.KeywordRanking.SparseVector)
+		if err != nil {
+			return nil, err
+		}
+		keywordRanking := *params.KeywordRanking
+		keywordRanking.SparseVector = sparseVector
+		params.KeywordRanking = &keywordRanking
+	} else if len(params.KeywordRanking.Query) == 0 {
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
@@ -206,6 +223,33 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 	return res, nil
 }
 
+// sparseVectorFromParams validates the sparse vector of a search and resolves
+// its target vector
+func (e *Explorer) sparseVectorFromParams(className string,
+	sparseVector *searchparams.NearSparseVector,
+) (*searchparams.NearSparseVector, error) {
+	if sparseVector == nil {
+		return nil, errors.Errorf("sparse vector search must have a sparse vector set")
+	}
+	if len(sparseVector.Indices) == 0 {
+		return nil, errors.Errorf("sparse vector search must have at least one index set")
+	}
+	if len(sparseVector.Indices) != len(sparseVector.Values) {
+		return nil, errors.Errorf("sparse vector search: got %d indices but %d values",
+			len(sparseVector.Indices), len(sparseVector.Values))
+	}
+
+	targetVector, err := e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+		className, sparseVector.TargetVector)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: validate target vector: %v", err)
+	}
+
+	out := *sparseVector
+	out.TargetVector = targetVector
+	return &out, nil
+}
+
 func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, []float32, error) {
@@ -221,6 +265,9 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	}
 	params.TargetVector = targetVector
 	params.SearchVector = searchVector
+	if params.NearVector != nil && len(params.NearVector.MultiVector) > 0 {
+		params.SearchMultiVector = params.NearVector.MultiVector
+	}
 
 	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
 		// if a module-specific additional prop is set, assume it needs the vector
@@ -464,6 +511,14 @@ func (e *Explorer) searchResultsToGetResponseWithType(ctx context.Context,
 			additionalProperties["isConsistent"] = res.IsConsistent
 		}
 
+		if params.AdditionalProperties.Profile && len(output) == 0 {
+			// the profile describes the whole query, it is only returned with
+			// the first result
+			if prof := profile.FromContext(ctx); prof != nil {
+				additionalProperties["profile"] = prof
+			}
+		}
+
 		if len(additionalProperties) > 0 {
 			if additionalProperties["group"] != nil {
 				e.extractAdditionalPropertiesFromGroupRefs(additionalProperties["group"], params.Properties)
@@ -649,6 +704,9 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	}
 
 	if params.NearVector != nil {
+		if len(params.NearVector.MultiVector) > 0 {
+			return nil, "", errors.Errorf("nearVector: multiVector is not supported in explore queries")
+		}
 		targetVector := ""
 		if len(params.NearVector.TargetVectors) == 1 {
 			targetVector = params.NearVector.TargetVectors[0]
@@ -730,6 +788,12 @@ func ExtractDistanceFromParams(params dto.GetParams) (distance float64, withDist
 		return
 	}
 
+	if params.Recommend != nil {
+		distance = params.Recommend.Distance
+		withDistance = params.Recommend.WithDistance
+		return
+	}
+
 	if len(params.ModuleParams) == 1 {
 		distance, withDistance = extractDistanceFromModuleParams(params.ModuleParams)
 	}
@@ -748,6 +812,11 @@ func ExtractCertaintyFromParams(params dto.GetParams) (certainty float64) {
 		return
 	}
 
+	if params.Recommend != nil {
+		certainty = params.Recommend.Certainty
+		return
+	}
+
 	if len(params.ModuleParams) == 1 {
 		certainty = extractCertaintyFromModuleParams(params.ModuleParams)
 		return
@@ -854,6 +923,10 @@ func (e *Explorer) usageOperationFromGetParams(params dto.GetParams) string {
 		return "nearVector"
 	}
 
+	if params.Recommend != nil {
+		return "recommend"
+	}
+
 	// there is at most one module param, so we can return the first we find
 	for param := range params.ModuleParams {
 		return param
@@ -25,13 +25,26 @@ import (
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
-// Do a bm25 search.  The results will be used in the hybrid algorithm
+// Do a bm25 search, or a sparse vector search if a sparse vector is given.
+// The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
+	name := "keyword,bm25"
 	params.KeywordRanking = &searchparams.KeywordRanking{
 		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
+		Type:       searchparams.KeywordRankingTypeBM25,
 		Properties: params.HybridSearch.Properties,
 	}
+	if params.HybridSearch.SparseVectorParams != nil {
+		sparseVector, err := e.sparseVectorFromParams(params.ClassName, params.HybridSearch.SparseVectorParams)
+		if err != nil {
+			return nil, "", err
+		}
+		name = "keyword,sparse"
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         searchparams.KeywordRankingTypeSparse,
+			SparseVector: sparseVector,
+		}
+	}
 
 	if params.Pagination == nil {
 		return nil, "", fmt.Errorf("invalid params, pagination object is nil")
@@ -60,7 +73,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		out[i] = &sr
 	}
 
-	return out, "keyword,bm25", nil
+	return out, name, nil
 }
 
 // Do a nearvector search.  The results will be used in the hybrid algorithm
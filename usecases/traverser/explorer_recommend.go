@@ -0,0 +1,406 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/autocut"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/usecases/traverser/grouper"
+	libvectorizer "github.com/weaviate/weaviate/usecases/vectorizer"
+)
+
+// getClassRecommend searches for objects similar to the positive and
+// dissimilar to the negative examples of the recommend params. The returned
+// search vector is the vector searched with the average strategy and the
+// average of the positive examples with the best score strategy.
+func (e *Explorer) getClassRecommend(ctx context.Context,
+	params dto.GetParams,
+) ([]search.Result, []float32, error) {
+	if err := validateRecommendParams(params); err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: recommend: %v", err)
+	}
+	rec := params.Recommend
+
+	targetVector := ""
+	if len(rec.TargetVectors) == 1 {
+		targetVector = rec.TargetVectors[0]
+	}
+	targetVector, err := e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+		params.ClassName, targetVector)
+	if err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: validate target vector: %v", err)
+	}
+
+	positive, err := e.recommendExamples(ctx, params, rec.Positive, rec.PositiveVectors, targetVector)
+	if err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: recommend: positive: %v", err)
+	}
+	negative, err := e.recommendExamples(ctx, params, rec.Negative, rec.NegativeVectors, targetVector)
+	if err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: recommend: negative: %v", err)
+	}
+	if err := validateRecommendDims(positive, negative); err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: recommend: %v", err)
+	}
+
+	totalLimit, err := e.CalculateTotalLimit(params.Pagination)
+	if err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: recommend: %v", err)
+	}
+
+	// the example objects can be found by the searches, so more results are
+	// requested and the examples removed afterwards
+	exclude := recommendExcludedIDs(rec)
+	searchParams := params
+	searchParams.TargetVector = targetVector
+	searchParams.Pagination = &filters.Pagination{Limit: totalLimit + len(exclude)}
+	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
+		// see getClassVectorSearch
+		searchParams.AdditionalProperties.Vector = true
+	}
+
+	var res []search.Result
+	var searchVector []float32
+	switch rec.Strategy {
+	case searchparams.RecommendStrategyBestScore:
+		searchVector = libvectorizer.CombineVectors(positive)
+		res, err = e.recommendBestScore(ctx, searchParams, positive, negative, exclude)
+	default:
+		searchVector = recommendAverageVector(positive, negative)
+		searchParams.SearchVector = searchVector
+		res, err = e.searcher.VectorSearch(ctx, searchParams)
+		res = excludeRecommendExamples(res, exclude)
+	}
+	if err != nil {
+		return nil, nil, errors.Errorf("explorer: get class: recommend: vector search: %v", err)
+	}
+
+	res = paginateRecommendResults(res, params.Pagination.Offset, totalLimit)
+
+	if params.Pagination.Autocut > 0 {
+		scores := make([]float32, len(res))
+		for i := range res {
+			scores[i] = res[i].Dist
+		}
+		cutOff := autocut.Autocut(scores, params.Pagination.Autocut)
+		res = res[:cutOff]
+	}
+
+	if params.Group != nil {
+		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
+		if err != nil {
+			return nil, nil, errors.Errorf("grouper: %v", err)
+		}
+
+		res = grouped
+	}
+
+	if e.modulesProvider != nil {
+		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res,
+			params.AdditionalProperties.ModuleParams, searchVector, params.ModuleParams)
+		if err != nil {
+			return nil, nil, errors.Errorf("explorer: get class: extend: %v", err)
+		}
+	}
+
+	e.trackUsageGet(res, params)
+
+	return res, searchVector, nil
+}
+
+func validateRecommendParams(params dto.GetParams) error {
+	rec := params.Recommend
+
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 ||
+		params.KeywordRanking != nil || params.HybridSearch != nil {
+		return errors.Errorf("found 'recommend' and other search parameters " +
+			"which are conflicting, choose one instead")
+	}
+
+	if params.GroupBy != nil {
+		return errors.Errorf("groupBy is not supported")
+	}
+
+	if len(rec.Positive) == 0 && len(rec.PositiveVectors) == 0 {
+		return errors.Errorf("at least one positive example is required")
+	}
+
+	if rec.Certainty != 0 && rec.WithDistance {
+		return errors.Errorf("found 'certainty' and 'distance' set in recommend " +
+			"which are conflicting, choose one instead")
+	}
+
+	if len(rec.TargetVectors) > 1 {
+		return errors.Errorf("only one target vector is supported, got %d", len(rec.TargetVectors))
+	}
+
+	switch rec.Strategy {
+	case "", searchparams.RecommendStrategyAverage, searchparams.RecommendStrategyBestScore:
+		return nil
+	default:
+		return errors.Errorf("unknown strategy %q, choose one of [%q, %q]", rec.Strategy,
+			searchparams.RecommendStrategyAverage, searchparams.RecommendStrategyBestScore)
+	}
+}
+
+// recommendExamples returns the vectors of the example objects followed by
+// the raw example vectors
+func (e *Explorer) recommendExamples(ctx context.Context, params dto.GetParams,
+	ids []string, vectors [][]float32, targetVector string,
+) ([][]float32, error) {
+	examples := make([][]float32, 0, len(ids)+len(vectors))
+
+	for _, id := range ids {
+		vector, _, err := e.nearParamsVector.classFindVector(ctx, params.ClassName,
+			strfmt.UUID(id), params.Tenant, targetVector)
+		if err != nil {
+			return nil, errors.Errorf("object %s: %v", id, err)
+		}
+		if len(vector) == 0 {
+			return nil, errors.Errorf("object %s has no vector", id)
+		}
+		examples = append(examples, vector)
+	}
+
+	for i, vector := range vectors {
+		if len(vector) == 0 {
+			return nil, errors.Errorf("vector %d is empty", i)
+		}
+		examples = append(examples, vector)
+	}
+
+	return examples, nil
+}
+
+func validateRecommendDims(positive, negative [][]float32) error {
+	dims := len(positive[0])
+	for _, examples := range [][][]float32{positive, negative} {
+		for _, vector := range examples {
+			if len(vector) != dims {
+				return errors.Errorf("examples have different dimensions: %d and %d",
+					dims, len(vector))
+			}
+		}
+	}
+	return nil
+}
+
+// recommendAverageVector moves the average of the positive examples away from
+// the average of the negative examples by the distance between both
+func recommendAverageVector(positive, negative [][]float32) []float32 {
+	vector := libvectorizer.CombineVectors(positive)
+	if len(negative) == 0 {
+		return vector
+	}
+
+	avgNegative := libvectorizer.CombineVectors(negative)
+	for i := range vector {
+		vector[i] += vector[i] - avgNegative[i]
+	}
+	return vector
+}
+
+// recommendBestScore searches near each of the positive examples. The found
+// objects are ranked by their distance to the closest positive example,
+// objects which are closer to a negative example than to any positive one
+// are ranked last.
+func (e *Explorer) recommendBestScore(ctx context.Context, params dto.GetParams,
+	positive, negative [][]float32, exclude map[string]struct{},
+) ([]search.Result, error) {
+	provider, err := e.recommendDistanceProvider(params.ClassName, params.TargetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	// the vectors of the found objects are compared with all examples
+	if params.TargetVector == "" {
+		params.AdditionalProperties.Vector = true
+	} else {
+		params.AdditionalProperties.Vectors = append([]string{params.TargetVector},
+			params.AdditionalProperties.Vectors...)
+	}
+
+	var res []search.Result
+	seen := map[strfmt.UUID]struct{}{}
+	for _, vector := range positive {
+		params.SearchVector = vector
+		found, err := e.searcher.VectorSearch(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range excludeRecommendExamples(found, exclude) {
+			if _, ok := seen[r.ID]; ok {
+				continue
+			}
+			seen[r.ID] = struct{}{}
+			res = append(res, r)
+		}
+	}
+
+	normalize := provider.Type() == "cosine-dot"
+	positive = recommendNormalize(positive, normalize)
+	negative = recommendNormalize(negative, normalize)
+
+	penalized := make([]bool, len(res))
+	negativeDists := make([]float32, len(res))
+	for i := range res {
+		vector := res[i].Vector
+		if params.TargetVector != "" {
+			vector = res[i].Vectors[params.TargetVector]
+		}
+		if len(vector) == 0 {
+			return nil, errors.Errorf("object %s has no vector", res[i].ID)
+		}
+		if normalize {
+			vector = distancer.Normalize(vector)
+		}
+
+		positiveDist, err := closestRecommendExample(provider, vector, positive)
+		if err != nil {
+			return nil, err
+		}
+		negativeDist, err := closestRecommendExample(provider, vector, negative)
+		if err != nil {
+			return nil, err
+		}
+
+		res[i].Dist = positiveDist
+		negativeDists[i] = negativeDist
+		penalized[i] = negativeDist < positiveDist
+	}
+
+	order := make([]int, len(res))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		i, j := order[a], order[b]
+		if penalized[i] != penalized[j] {
+			return !penalized[i]
+		}
+		if penalized[i] {
+			// the further away from the negative examples, the better
+			return negativeDists[i] > negativeDists[j]
+		}
+		return res[i].Dist < res[j].Dist
+	})
+
+	sorted := make([]search.Result, len(res))
+	for a, i := range order {
+		sorted[a] = res[i]
+	}
+	return sorted, nil
+}
+
+func (e *Explorer) recommendDistanceProvider(className, targetVector string) (distancer.Provider, error) {
+	class := e.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return nil, errors.Errorf("failed to get class: %s", className)
+	}
+	vectorConfig, err := schemaConfig.TypeAssertVectorIndex(class, []string{targetVector})
+	if err != nil {
+		return nil, err
+	}
+
+	switch dn := vectorConfig.DistanceName(); dn {
+	case "", common.DistanceCosine:
+		return distancer.NewCosineDistanceProvider(), nil
+	case common.DistanceDot:
+		return distancer.NewDotProductProvider(), nil
+	case common.DistanceL2Squared:
+		return distancer.NewL2SquaredProvider(), nil
+	case common.DistanceManhattan:
+		return distancer.NewManhattanProvider(), nil
+	case common.DistanceHamming:
+		return distancer.NewHammingProvider(), nil
+	default:
+		return nil, errors.Errorf("unrecognized distance metric %q", dn)
+	}
+}
+
+func recommendNormalize(vectors [][]float32, normalize bool) [][]float32 {
+	if !normalize {
+		return vectors
+	}
+
+	out := make([][]float32, len(vectors))
+	for i := range vectors {
+		out[i] = distancer.Normalize(vectors[i])
+	}
+	return out
+}
+
+// closestRecommendExample returns the distance to the closest of the
+// examples, or +Inf if there are no examples
+func closestRecommendExample(provider distancer.Provider, vector []float32,
+	examples [][]float32,
+) (float32, error) {
+	closest := float32(math.Inf(1))
+	for _, example := range examples {
+		dist, _, err := provider.SingleDist(vector, example)
+		if err != nil {
+			return 0, err
+		}
+		if dist < closest {
+			closest = dist
+		}
+	}
+	return closest, nil
+}
+
+func recommendExcludedIDs(rec *searchparams.Recommend) map[string]struct{} {
+	exclude := make(map[string]struct{}, len(rec.Positive)+len(rec.Negative))
+	for _, ids := range [][]string{rec.Positive, rec.Negative} {
+		for _, id := range ids {
+			exclude[strings.ToLower(id)] = struct{}{}
+		}
+	}
+	return exclude
+}
+
+func excludeRecommendExamples(res []search.Result, exclude map[string]struct{}) []search.Result {
+	if len(exclude) == 0 {
+		return res
+	}
+
+	out := make([]search.Result, 0, len(res))
+	for _, r := range res {
+		if _, ok := exclude[strings.ToLower(r.ID.String())]; ok {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func paginateRecommendResults(res []search.Result, offset, totalLimit int) []search.Result {
+	if offset >= len(res) {
+		return nil
+	}
+	if totalLimit < len(res) {
+		res = res[:totalLimit]
+	}
+	return res[offset:]
+}
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_Explorer_GetClass_Recommend(t *testing.T) {
+	newResult := func(id strfmt.UUID, vector []float32) search.Result {
+		return search.Result{
+			ID:     id,
+			Vector: vector,
+			Dims:   len(vector),
+			Schema: map[string]interface{}{"name": id.String()},
+		}
+	}
+
+	t.Run("with the average strategy", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			Recommend: &searchparams.Recommend{
+				Positive:        []string{"example"},
+				NegativeVectors: [][]float32{{0, 1}},
+			},
+			Pagination: &filters.Pagination{Limit: 2},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics, defaultConfig)
+		explorer.SetSchemaGetter(newFakeSchemaGetter("BestClass"))
+
+		expectedParamsToSearch := params
+		expectedParamsToSearch.SearchVector = []float32{2, -1}
+		expectedParamsToSearch.Pagination = &filters.Pagination{Limit: 3}
+
+		searcher.
+			On("Object", "BestClass", strfmt.UUID("example")).
+			Return(&search.Result{Vector: []float32{1, 0}}, nil)
+		searcher.
+			On("VectorSearch", expectedParamsToSearch).
+			Return([]search.Result{
+				newResult("example", []float32{1, 0}),
+				newResult("id1", []float32{2, 0}),
+				newResult("id2", []float32{2, -2}),
+				newResult("id3", []float32{3, -2}),
+			}, nil)
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "recommend", 2)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+
+		require.Len(t, res, 2)
+		assert.Equal(t, map[string]interface{}{"name": "id1"}, res[0])
+		assert.Equal(t, map[string]interface{}{"name": "id2"}, res[1])
+	})
+
+	t.Run("with the best score strategy", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			Recommend: &searchparams.Recommend{
+				PositiveVectors: [][]float32{{0, 0}, {10, 0}},
+				NegativeVectors: [][]float32{{5, 5}},
+				Strategy:        searchparams.RecommendStrategyBestScore,
+			},
+			Pagination:           &filters.Pagination{Limit: 10},
+			AdditionalProperties: additional.Properties{Distance: true},
+		}
+
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		metrics := &fakeMetrics{}
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), metrics, defaultConfig)
+		schemaGetter := newFakeSchemaGetter("BestClass")
+		schemaGetter.SetVectorIndexConfig(hnsw.UserConfig{Distance: "l2-squared"})
+		explorer.SetSchemaGetter(schemaGetter)
+
+		// both searches find the same objects, the ones closest to the
+		// negative example first
+		searcher.
+			On("VectorSearch", mock.Anything).
+			Return([]search.Result{
+				newResult("closeToNegative", []float32{4, 5}),
+				newResult("nearNegative", []float32{5, 3}),
+				newResult("nearSecond", []float32{10, 2}),
+				newResult("nearFirst", []float32{0, 1}),
+			}, nil)
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "recommend", 2)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertNumberOfCalls(t, "VectorSearch", 2)
+
+		names := make([]interface{}, len(res))
+		distances := make([]interface{}, len(res))
+		for i := range res {
+			names[i] = res[i].(map[string]interface{})["name"]
+			distances[i] = res[i].(map[string]interface{})["_additional"].(map[string]interface{})["distance"]
+		}
+		assert.Equal(t, []interface{}{"nearFirst", "nearSecond", "nearNegative", "closeToNegative"}, names)
+		assert.Equal(t, []interface{}{float32(1), float32(4), float32(34), float32(41)}, distances)
+	})
+
+	t.Run("with invalid params", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			params      dto.GetParams
+			expectedErr string
+		}{
+			{
+				name: "without positive examples",
+				params: dto.GetParams{
+					Recommend: &searchparams.Recommend{
+						NegativeVectors: [][]float32{{1, 2}},
+					},
+				},
+				expectedErr: "at least one positive example is required",
+			},
+			{
+				name: "together with nearVector",
+				params: dto.GetParams{
+					Recommend: &searchparams.Recommend{
+						PositiveVectors: [][]float32{{1, 2}},
+					},
+					NearVector: &searchparams.NearVector{Vector: []float32{1, 2}},
+				},
+				expectedErr: "found 'recommend' and other search parameters which are conflicting",
+			},
+			{
+				name: "with an unknown strategy",
+				params: dto.GetParams{
+					Recommend: &searchparams.Recommend{
+						PositiveVectors: [][]float32{{1, 2}},
+						Strategy:        "median",
+					},
+				},
+				expectedErr: `unknown strategy "median"`,
+			},
+			{
+				name: "with examples of different dimensions",
+				params: dto.GetParams{
+					Recommend: &searchparams.Recommend{
+						PositiveVectors: [][]float32{{1, 2}},
+						NegativeVectors: [][]float32{{1, 2, 3}},
+					},
+				},
+				expectedErr: "examples have different dimensions: 2 and 3",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				log, _ := test.NewNullLogger()
+				explorer := NewExplorer(&fakeVectorSearcher{}, log, getFakeModulesProvider(),
+					&fakeMetrics{}, defaultConfig)
+				explorer.SetSchemaGetter(newFakeSchemaGetter("BestClass"))
+
+				tt.params.ClassName = "BestClass"
+				tt.params.Pagination = &filters.Pagination{Limit: 10}
+				_, err := explorer.GetClass(context.Background(), tt.params)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			})
+		}
+	})
+}
@@ -22,7 +22,8 @@ import (
 func (e *Explorer) validateCursor(params dto.GetParams) error {
 	if params.Cursor != nil {
 		if params.Group != nil || params.HybridSearch != nil || params.KeywordRanking != nil ||
-			params.NearObject != nil || params.NearVector != nil || len(params.ModuleParams) > 0 {
+			params.NearObject != nil || params.NearVector != nil || params.Recommend != nil ||
+			len(params.ModuleParams) > 0 {
 			return fmt.Errorf("other params cannot be set with after and limit parameters")
 		}
 		if err := filters.ValidateCursor(schema.ClassName(params.ClassName),
@@ -14,11 +14,13 @@ package hybrid
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/autocut"
+	"github.com/weaviate/weaviate/entities/profile"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -148,13 +150,9 @@ func Search(ctx context.Context, params *Params, logger logrus.FieldLogger, spar
 		return nil, fmt.Errorf("length of weights and results do not match for hybrid search %v vs. %v", len(weights), len(found))
 	}
 
-	var fused []*search.Result
-	if params.FusionAlgorithm == common_filters.HybridRankedFusion {
-		fused = FusionRanked(weights, found, names)
-	} else if params.FusionAlgorithm == common_filters.HybridRelativeScoreFusion {
-		fused = FusionRelativeScore(weights, found, names)
-	} else {
-		return nil, fmt.Errorf("unknown ranking algorithm %v for hybrid search", params.FusionAlgorithm)
+	fused, err := fuse(ctx, params.FusionAlgorithm, weights, found, names)
+	if err != nil {
+		return nil, err
 	}
 
 	if postProc != nil {
@@ -191,13 +189,9 @@ func HybridSubsearch(ctx context.Context, params *Params, resultSet [][]*search.
 		return nil, fmt.Errorf("length of weights and names do not match for hybrid search %v vs. %v", len(weights), len(names))
 	}
 
-	var fused []*search.Result
-	if params.FusionAlgorithm == common_filters.HybridRankedFusion {
-		fused = FusionRanked(weights, resultSet, names)
-	} else if params.FusionAlgorithm == common_filters.HybridRelativeScoreFusion {
-		fused = FusionRelativeScore(weights, resultSet, names)
-	} else {
-		return nil, fmt.Errorf("unknown ranking algorithm %v for hybrid search", params.FusionAlgorithm)
+	fused, err := fuse(ctx, params.FusionAlgorithm, weights, resultSet, names)
+	if err != nil {
+		return nil, err
 	}
 
 	if postProc != nil {
@@ -225,6 +219,23 @@ func HybridSubsearch(ctx context.Context, params *Params, resultSet [][]*search.
 	return fused, nil
 }
 
+// fuse combines the result sets using the fusion algorithm of the query
+func fuse(ctx context.Context, algorithm int, weights []float64,
+	resultSets [][]*search.Result, names []string,
+) ([]*search.Result, error) {
+	before := time.Now()
+	defer func() { profile.FromContext(ctx).ObservePhase(profile.PhaseFusion, time.Since(before)) }()
+
+	switch algorithm {
+	case common_filters.HybridRankedFusion:
+		return FusionRanked(weights, resultSets, names), nil
+	case common_filters.HybridRelativeScoreFusion:
+		return FusionRelativeScore(weights, resultSets, names), nil
+	default:
+		return nil, fmt.Errorf("unknown ranking algorithm %v for hybrid search", algorithm)
+	}
+}
+
 func processSparseSearch(results []*storobj.Object, scores []float32, err error) ([]*search.Result, error) {
 	if err != nil {
 		return nil, fmt.Errorf("sparse search: %w", err)
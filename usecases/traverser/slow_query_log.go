@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/profile"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// SlowQueryLog logs Get queries which take at least the configured
+// threshold. Only the shape of a query is logged, i.e. the class, tenant,
+// search type, limit and the operators and properties of the filters, but
+// never the values of the filters or the search terms.
+//
+// Tracked queries are profiled, so that the durations and result counts of
+// the individual shard searches can be logged as well.
+type SlowQueryLog struct {
+	logger    logrus.FieldLogger
+	threshold time.Duration
+}
+
+// NewSlowQueryLog returns nil if the slow query log is disabled. All methods
+// can be called on the nil log.
+func NewSlowQueryLog(logger logrus.FieldLogger, cfg config.SlowQueryLog) *SlowQueryLog {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &SlowQueryLog{
+		logger:    logger,
+		threshold: cfg.Threshold,
+	}
+}
+
+type slowQueryKey struct{}
+
+// SlowQuery is a single query tracked by the SlowQueryLog
+type SlowQuery struct {
+	log     *SlowQueryLog
+	api     string
+	started time.Time
+	profile *profile.Profile
+}
+
+// Start starts tracking a query which was received through the given api.
+// If the query is tracked already, e.g. because the traverser is called
+// from the gRPC search, nil is returned, so that each query is only logged
+// once by the outermost caller.
+func (l *SlowQueryLog) Start(ctx context.Context, api string) (context.Context, *SlowQuery) {
+	if l == nil || ctx.Value(slowQueryKey{}) != nil {
+		return ctx, nil
+	}
+
+	prof := profile.FromContext(ctx)
+	if prof == nil {
+		prof = profile.New()
+		ctx = profile.NewContext(ctx, prof)
+	}
+
+	q := &SlowQuery{
+		log:     l,
+		api:     api,
+		started: time.Now(),
+		profile: prof,
+	}
+	return context.WithValue(ctx, slowQueryKey{}, q), q
+}
+
+// End logs the query if it took at least the threshold of the log
+func (q *SlowQuery) End(params dto.GetParams, err error) {
+	if q == nil {
+		return
+	}
+
+	took := time.Since(q.started)
+	if took < q.log.threshold {
+		return
+	}
+
+	fields := logrus.Fields{
+		"action":         "query_slow_log",
+		"api":            q.api,
+		"class":          params.ClassName,
+		"search_type":    slowQuerySearchType(params),
+		"total_duration": took,
+		"shards":         slowQueryShards(q.profile),
+		"failed":         err != nil,
+	}
+	if params.Tenant != "" {
+		fields["tenant"] = params.Tenant
+	}
+	if params.Pagination != nil {
+		fields["limit"] = params.Pagination.Limit
+		fields["offset"] = params.Pagination.Offset
+	}
+	if params.Filters != nil {
+		fields["filters"] = sanitizeFilter(params.Filters.Root)
+	}
+
+	q.log.logger.WithFields(fields).Warnf("slow query")
+}
+
+func slowQuerySearchType(params dto.GetParams) string {
+	switch {
+	case params.HybridSearch != nil:
+		return "hybrid"
+	case params.KeywordRanking != nil && params.KeywordRanking.Type == searchparams.KeywordRankingTypeSparse:
+		return "sparse"
+	case params.KeywordRanking != nil:
+		return "bm25"
+	case params.NearVector != nil:
+		return "nearVector"
+	case params.NearObject != nil:
+		return "nearObject"
+	case params.Recommend != nil:
+		return "recommend"
+	case len(params.ModuleParams) > 0:
+		// module searches such as nearText, the module params don't contain
+		// anything else
+		names := make([]string, 0, len(params.ModuleParams))
+		for name := range params.ModuleParams {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return strings.Join(names, ",")
+	case params.Filters != nil:
+		return "filter"
+	default:
+		return "list"
+	}
+}
+
+type slowQueryShard struct {
+	Class   string  `json:"class"`
+	Shard   string  `json:"shard"`
+	Node    string  `json:"node"`
+	Search  string  `json:"search"`
+	TookMs  float64 `json:"tookMs"`
+	Results int     `json:"results"`
+}
+
+func slowQueryShards(prof *profile.Profile) []slowQueryShard {
+	prof.Lock()
+	defer prof.Unlock()
+
+	shards := make([]slowQueryShard, len(prof.Shards))
+	for i, s := range prof.Shards {
+		shards[i] = slowQueryShard{
+			Class:   s.Class,
+			Shard:   s.Shard,
+			Node:    s.Node,
+			Search:  s.Search,
+			TookMs:  float64(s.Took) / float64(time.Millisecond),
+			Results: s.Results,
+		}
+	}
+	return shards
+}
+
+// sanitizeFilter returns the operators and property paths of the filter,
+// e.g. "And(Equal(name), GreaterThan(wordCount))", leaving out the values
+func sanitizeFilter(clause *filters.Clause) string {
+	if clause == nil {
+		return ""
+	}
+
+	if clause.Operator == filters.OperatorAnd || clause.Operator == filters.OperatorOr {
+		operands := make([]string, len(clause.Operands))
+		for i := range clause.Operands {
+			operands[i] = sanitizeFilter(&clause.Operands[i])
+		}
+		return clause.Operator.Name() + "(" + strings.Join(operands, ", ") + ")"
+	}
+
+	var path string
+	if clause.On != nil {
+		path = strings.Join(clause.On.Slice(), ".")
+	}
+	return clause.Operator.Name() + "(" + path + ")"
+}
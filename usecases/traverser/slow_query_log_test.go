@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/profile"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestSlowQueryLog(t *testing.T) {
+	params := dto.GetParams{
+		ClassName:  "Article",
+		Tenant:     "tenant1",
+		Pagination: &filters.Pagination{Limit: 10},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:  "bm25",
+			Query: "secret search terms",
+		},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: "Article", Property: "title"},
+					Value:    &filters.Value{Value: "secret value", Type: schema.DataTypeText},
+				},
+				{
+					Operator: filters.OperatorGreaterThan,
+					On: &filters.Path{
+						Class: "Article", Property: "hasAuthor",
+						Child: &filters.Path{Class: "Author", Property: "age"},
+					},
+					Value: &filters.Value{Value: 30, Type: schema.DataTypeInt},
+				},
+			},
+		}},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		l := NewSlowQueryLog(nil, config.SlowQueryLog{})
+		require.Nil(t, l)
+
+		ctx, q := l.Start(context.Background(), "graphql")
+		assert.Nil(t, q)
+		assert.Nil(t, profile.FromContext(ctx))
+		q.End(params, nil)
+	})
+
+	t.Run("below threshold", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		l := NewSlowQueryLog(logger, config.SlowQueryLog{Enabled: true, Threshold: time.Hour})
+
+		_, q := l.Start(context.Background(), "graphql")
+		q.End(params, nil)
+		assert.Empty(t, hook.AllEntries())
+	})
+
+	t.Run("slow query", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		l := NewSlowQueryLog(logger, config.SlowQueryLog{Enabled: true})
+
+		ctx, q := l.Start(context.Background(), "grpc")
+		require.NotNil(t, q)
+
+		// nested calls of the same query are not tracked twice
+		_, nested := l.Start(ctx, "graphql")
+		assert.Nil(t, nested)
+
+		_, shard := profile.FromContext(ctx).StartShard(ctx, "Article", "shard1", "node1", profile.SearchBM25)
+		shard.End(7)
+		q.End(params, nil)
+
+		require.Len(t, hook.AllEntries(), 1)
+		entry := hook.LastEntry()
+		assert.Equal(t, "query_slow_log", entry.Data["action"])
+		assert.Equal(t, "grpc", entry.Data["api"])
+		assert.Equal(t, "Article", entry.Data["class"])
+		assert.Equal(t, "tenant1", entry.Data["tenant"])
+		assert.Equal(t, "bm25", entry.Data["search_type"])
+		assert.Equal(t, 10, entry.Data["limit"])
+		assert.Equal(t, "And(Equal(title), GreaterThan(hasAuthor.Author.age))", entry.Data["filters"])
+
+		shards := entry.Data["shards"].([]slowQueryShard)
+		require.Len(t, shards, 1)
+		assert.Equal(t, "shard1", shards[0].Shard)
+		assert.Equal(t, 7, shards[0].Results)
+
+		assert.NotContains(t, entry.Data, "query")
+		for _, v := range entry.Data {
+			s, ok := v.(string)
+			if ok {
+				assert.NotContains(t, s, "secret")
+			}
+		}
+	})
+}
@@ -49,6 +49,9 @@ func (t *TargetVectorParamHelper) GetTargetVectorFromParams(params dto.GetParams
 	if params.NearVector != nil && len(params.NearVector.TargetVectors) == 1 {
 		return params.NearVector.TargetVectors[0]
 	}
+	if params.Recommend != nil && len(params.Recommend.TargetVectors) == 1 {
+		return params.Recommend.TargetVectors[0]
+	}
 	if params.HybridSearch != nil && len(params.HybridSearch.TargetVectors) == 1 {
 		return params.HybridSearch.TargetVectors[0]
 	}
@@ -48,6 +48,8 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+	rateLimits              *ratelimiter.Principals
+	slowQueryLog            *SlowQueryLog
 }
 
 type VectorSearcher interface {
@@ -72,6 +74,11 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 	modulesProvider ModulesProvider,
 	metrics *Metrics, maxGetRequests int,
 ) *Traverser {
+	var slowQueryLog *SlowQueryLog
+	if config != nil {
+		slowQueryLog = NewSlowQueryLog(logger, config.Config.SlowQueryLog)
+	}
+
 	return &Traverser{
 		config:                  config,
 		locks:                   locks,
@@ -84,9 +91,21 @@ func NewTraverser(config *config.WeaviateConfig, locks locks,
 		targetVectorParamHelper: NewTargetParamHelper(),
 		metrics:                 metrics,
 		ratelimiter:             ratelimiter.New(maxGetRequests),
+		slowQueryLog:            slowQueryLog,
 	}
 }
 
+// SetRateLimits sets the per-principal rate limits of queries. It may be
+// nil to not limit queries per principal.
+func (t *Traverser) SetRateLimits(rateLimits *ratelimiter.Principals) {
+	t.rateLimits = rateLimits
+}
+
+// SlowQueryLog returns the log of slow Get queries, nil if it is disabled
+func (t *Traverser) SlowQueryLog() *SlowQueryLog {
+	return t.slowQueryLog
+}
+
 // TraverserRepo describes the dependencies of the Traverser UC to the
 // connected database
 type TraverserRepo interface {
@@ -20,19 +20,29 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
-) (interface{}, error) {
+) (_ interface{}, err error) {
+	ctx, span := tracing.Start(ctx, "traverser.Aggregate",
+		attribute.String("class", params.ClassName.String()))
+	defer func() { tracing.End(span, err) }()
+
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err = t.authorizer.Authorize(principal, "get", "traversal/*")
 	if err != nil {
 		return nil, err
 	}
+	if err := t.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return nil, err
+	}
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
@@ -49,6 +59,9 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		if err != nil {
 			return nil, err
 		}
+		if params.NearVector != nil && len(params.NearVector.MultiVector) > 0 {
+			return nil, fmt.Errorf("nearVector: multiVector is not supported in aggregations")
+		}
 		searchVector, targetVector, err := t.nearParamsVector.vectorFromParams(ctx,
 			params.NearVector, params.NearObject, params.ModuleParams, className, params.Tenant)
 		if err != nil {
@@ -17,20 +17,28 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 // Explore through unstructured search terms
 func (t *Traverser) Explore(ctx context.Context,
 	principal *models.Principal, params ExploreParams,
-) ([]search.Result, error) {
+) (_ []search.Result, err error) {
+	ctx, span := tracing.Start(ctx, "traverser.Explore")
+	defer func() { tracing.End(span, err) }()
+
 	if params.Limit == 0 {
 		params.Limit = 20
 	}
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err = t.authorizer.Authorize(principal, "get", "traversal/*")
 	if err != nil {
 		return nil, err
 	}
+	if err := t.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return nil, err
+	}
 
 	// to conduct a cross-class vector search, all classes must
 	// be configured with the same vector index distance type.
@@ -18,11 +18,22 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/profile"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
-) ([]interface{}, error) {
+) (_ []interface{}, err error) {
+	ctx, span := tracing.Start(ctx, "traverser.GetClass",
+		attribute.String("class", params.ClassName))
+	defer func() { tracing.End(span, err) }()
+
+	ctx, slowQuery := t.slowQueryLog.Start(ctx, "graphql")
+	defer func() { slowQuery.End(params, err) }()
+
 	before := time.Now()
 
 	ok := t.ratelimiter.TryInc()
@@ -39,10 +50,13 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	err = t.authorizer.Authorize(principal, "get", "traversal/*")
 	if err != nil {
 		return nil, err
 	}
+	if err := t.rateLimits.Allow(principal, ratelimiter.Queries); err != nil {
+		return nil, err
+	}
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
@@ -60,5 +74,9 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		}
 	}
 
+	if params.AdditionalProperties.Profile && profile.FromContext(ctx) == nil {
+		ctx = profile.NewContext(ctx, profile.New())
+	}
+
 	return t.explorer.GetClass(ctx, params)
 }